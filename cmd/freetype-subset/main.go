@@ -0,0 +1,136 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+// The freetype-subset command writes a TrueType font that contains only the
+// glyphs needed for the given text or Unicode ranges, such as to reduce the
+// size of a web font. For example:
+//
+//	freetype-subset -fontfile luxisr.ttf -text page.html -unicodes U+20-7E -o subset.ttf
+//
+// The -features flag also includes the glyphs that the font's GSUB
+// substitutions for the given features, such as liga or smcp, can replace the
+// text's glyphs with. The subset font has no GSUB or GPOS table, so it is for
+// text that is shaped with the original font. An output filename that ends in
+// ".woff2" writes a WOFF2 font instead of a TTF font.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/lukevers/freetype-go/freetype/truetype"
+)
+
+var (
+	fontfile = flag.String("fontfile", "", "filename of the ttf font to subset")
+	output   = flag.String("o", "subset.ttf", "filename of the subset font to write, as woff2 if it ends in .woff2")
+	textfile = flag.String("text", "", "filename of UTF-8 text whose characters to include")
+	unicodes = flag.String("unicodes", "", "comma-separated code points and ranges to include, such as U+20-7E,U+20AC")
+	features = flag.String("features", "", "comma-separated GSUB features whose substitutions to include, such as liga,smcp")
+)
+
+// parseRanges returns the runes in a comma-separated list of hexadecimal code
+// points and ranges of code points, with an optional "U+" prefix.
+func parseRanges(s string) ([]rune, error) {
+	var runes []rune
+	for _, r := range strings.Split(s, ",") {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		lo, hi := r, r
+		if i := strings.IndexByte(r, '-'); i >= 0 {
+			lo, hi = r[:i], r[i+1:]
+		}
+		l, err := parseCodePoint(lo)
+		if err != nil {
+			return nil, err
+		}
+		h, err := parseCodePoint(hi)
+		if err != nil {
+			return nil, err
+		}
+		if l > h {
+			return nil, fmt.Errorf("bad range %q", r)
+		}
+		for c := l; c <= h; c++ {
+			runes = append(runes, c)
+		}
+	}
+	return runes, nil
+}
+
+// parseCodePoint parses a hexadecimal code point, such as "U+20AC" or "20ac".
+func parseCodePoint(s string) (rune, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "U+"), "u+")
+	x, err := strconv.ParseUint(s, 16, 32)
+	if err != nil || x > 0x10ffff {
+		return 0, fmt.Errorf("bad code point %q", s)
+	}
+	return rune(x), nil
+}
+
+func main() {
+	flag.Parse()
+	if *fontfile == "" || (*textfile == "" && *unicodes == "") {
+		log.Fatal("usage: freetype-subset -fontfile font.ttf [-text file] [-unicodes ranges] [-features tags] [-o subset.ttf]")
+	}
+	b, err := ioutil.ReadFile(*fontfile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	font, err := truetype.Parse(b)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	runes, err := parseRanges(*unicodes)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *textfile != "" {
+		text, err := ioutil.ReadFile(*textfile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		runes = append(runes, []rune(string(text))...)
+	}
+
+	var tags []string
+	for _, tag := range strings.Split(*features, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	var (
+		subset []byte
+		m      *truetype.IndexMap
+	)
+	if len(tags) > 0 {
+		subset, m, err = font.SubsetFeatures(runes, tags...)
+	} else {
+		subset, m, err = font.Subset(runes)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if strings.HasSuffix(*output, ".woff2") {
+		f, err := truetype.Parse(subset)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if subset, err = f.Write(&truetype.WriteOptions{WOFF2: true}); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(*output, subset, 0644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Wrote %d of %d glyphs, %d bytes, to %s\n", m.Len(), font.NumGlyphs(), len(subset), *output)
+}
@@ -0,0 +1,39 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseRanges(t *testing.T) {
+	testCases := []struct {
+		s, want string
+	}{
+		{"", "[]"},
+		{"U+41", "[65]"},
+		{"41,u+42, 43", "[65 66 67]"},
+		{"U+20ac", "[8364]"},
+		{"U+41-U+43", "[65 66 67]"},
+		{"61-63,,U+10FFFF", "[97 98 99 1114111]"},
+		{"U+43-41", "error"},
+		{"U+110000", "error"},
+		{"U+4G", "error"},
+		{"41-", "error"},
+		{"-41", "error"},
+	}
+	for _, tc := range testCases {
+		runes, err := parseRanges(tc.s)
+		got := fmt.Sprint(runes)
+		if err != nil {
+			got = "error"
+		}
+		if got != tc.want {
+			t.Errorf("%q: got %s, want %s", tc.s, got, tc.want)
+		}
+	}
+}
@@ -23,7 +23,7 @@ import (
 var (
 	dpi      = flag.Float64("dpi", 72, "screen resolution in Dots Per Inch")
 	fontfile = flag.String("fontfile", "../../testdata/luxisr.ttf", "filename of the ttf font")
-	hinting  = flag.String("hinting", "none", "none | full")
+	hinting  = flag.String("hinting", "none", "none | full | subpixel | vertical | auto")
 	size     = flag.Float64("size", 12, "font size in points")
 	spacing  = flag.Float64("spacing", 1.5, "line spacing (e.g. 2 means double spaced)")
 	wonb     = flag.Bool("whiteonblack", false, "white text on a black background")
@@ -102,6 +102,12 @@ func main() {
 		c.SetHinting(freetype.NoHinting)
 	case "full":
 		c.SetHinting(freetype.FullHinting)
+	case "subpixel":
+		c.SetHinting(freetype.SubpixelHinting)
+	case "vertical":
+		c.SetHinting(freetype.VerticalHinting)
+	case "auto":
+		c.SetHinting(freetype.AutoHinting)
 	}
 
 	// Draw the guidelines.
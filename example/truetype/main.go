@@ -14,7 +14,11 @@ import (
 	"github.com/lukevers/freetype-go/freetype/truetype"
 )
 
-var fontfile = flag.String("fontfile", "../../testdata/luxisr.ttf", "filename of the ttf font")
+var (
+	fontfile = flag.String("fontfile", "../../testdata/luxisr.ttf", "filename of the ttf font")
+	hinting  = flag.String("hinting", "none", "none | full | subpixel | vertical | auto")
+	ppem     = flag.Int("ppem", 0, "pixels per em for the glyph's points, or 0 for FUnits")
+)
 
 func printBounds(b truetype.Bounds) {
 	fmt.Printf("XMin:%d YMin:%d XMax:%d YMax:%d\n", b.XMin, b.YMin, b.XMax, b.YMax)
@@ -57,17 +61,45 @@ func main() {
 
 	c0, c1 := 'A', 'V'
 
+	// Hinting snaps the glyph's points to a pixel grid, so it only makes
+	// sense when the scale is given in pixels, at 64 units per pixel.
+	scale, h := fupe, truetype.NoHinting
+	if *ppem > 0 {
+		scale = int32(*ppem) << 6
+	}
+	switch *hinting {
+	case "none":
+	case "full", "subpixel", "vertical", "auto":
+		if *ppem <= 0 {
+			log.Printf("-hinting=%s requires a positive -ppem", *hinting)
+			return
+		}
+		switch *hinting {
+		case "full":
+			h = truetype.FullHinting
+		case "subpixel":
+			h = truetype.SubpixelHinting
+		case "vertical":
+			h = truetype.VerticalHinting
+		case "auto":
+			h = truetype.AutoHinting
+		}
+	default:
+		log.Printf("unknown -hinting value %q", *hinting)
+		return
+	}
+
 	i0 := font.Index(c0)
 	hm := font.HMetric(fupe, i0)
 	g := truetype.NewGlyphBuf()
-	err = g.Load(font, fupe, i0, truetype.NoHinting)
+	err = g.Load(font, scale, i0, h)
 	if err != nil {
 		log.Println(err)
 		return
 	}
-	fmt.Printf("'%c' glyph\n", c0)
+	fmt.Printf("'%c' glyph, scale %d, hinting %s\n", c0, scale, *hinting)
 	fmt.Printf("AdvanceWidth:%d LeftSideBearing:%d\n", hm.AdvanceWidth, hm.LeftSideBearing)
 	printGlyph(g)
 	i1 := font.Index(c1)
-	fmt.Printf("\n'%c', '%c' Kerning:%d\n", c0, c1, font.Kerning(fupe, i0, i1))
+	fmt.Printf("\n'%c', '%c' Kerning:%d\n", c0, c1, font.Kern(fupe, i0, i1))
 }
@@ -0,0 +1,258 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package freetype
+
+import (
+	"container/list"
+	"sync"
+)
+
+// A Budget caps the memory used by text rendering: the glyph and outline
+// caches and the rasterizer and hinter buffers of the Contexts that share it,
+// and the caches of the fonts that are parsed with it as their
+// truetype.ParseOptions' Budget, such as the reverse character map, the parsed
+// GSUB lookups and the hinter's results of running a font's prep program at
+// each size. When a new cache entry would exceed the cap, the least recently
+// used entries, of any of those Contexts and fonts, are evicted to make room.
+// Evicted font caches are rebuilt when they are next needed.
+//
+// A Context's rasterizer and hinter buffers are in use while it draws, so
+// they are charged but never evicted. They grow with the font size, up to the
+// bound set by SetGlyphSizeLimit, and the Budget's usage can exceed its limit
+// if they alone exceed it. The parsed fonts themselves, whose size is
+// proportional to their data, are not charged.
+//
+// A Budget can be shared by Contexts and fonts that are used by different
+// goroutines. The Contexts' caches are then guarded by the Budget's lock.
+type Budget struct {
+	mu    sync.Mutex
+	limit int64
+	used  int64
+	// lru holds the *budgetItems of the cache entries, most recently used
+	// first.
+	lru list.List
+}
+
+// budgetItem is the charge for a cached glyph mask or outline, or for an
+// entry of a font's caches.
+type budgetItem struct {
+	// c is the Context of a glyph mask or outline, or nil for a font's cache
+	// entry, which evict evicts.
+	c     *Context
+	evict func()
+	// outline is whether the item is in the outline cache, rather than the
+	// glyph cache, and slot is its index in that cache.
+	outline bool
+	slot    int
+	size    int64
+	// e is the item's element of the Budget's lru, or nil once the charge is
+	// removed.
+	e *list.Element
+}
+
+// budgetEntryOverhead is the approximate size, in bytes, of a cache entry's
+// bookkeeping, in addition to its pixels or points.
+const budgetEntryOverhead = 128
+
+// NewBudget returns a Budget that caps cached glyphs and outlines at the given
+// number of bytes.
+func NewBudget(limit int64) *Budget {
+	return &Budget{limit: limit}
+}
+
+// Limit returns the maximum number of bytes used by cached glyphs and
+// outlines.
+func (b *Budget) Limit() int64 {
+	return b.limit
+}
+
+// Used returns the number of bytes currently used by cached glyphs and
+// outlines.
+func (b *Budget) Used() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.used
+}
+
+// Charge charges size bytes for an entry of a font's caches, evicting the
+// least recently used entries as necessary, and returns a function that
+// releases the charge, or nil if the entry is larger than the limit. It
+// implements truetype.Budget, and is not otherwise needed.
+func (b *Budget) Charge(size int64, evict func()) (release func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	it := b.add(&budgetItem{evict: evict, size: size})
+	if it == nil {
+		return nil
+	}
+	return func() {
+		b.mu.Lock()
+		b.remove(it)
+		b.mu.Unlock()
+	}
+}
+
+// add charges a cache entry to b, evicting the least recently used entries as
+// necessary, and returns the charge. It returns nil if the entry is larger
+// than the limit, in which case it should not be cached. b.mu must be held.
+func (b *Budget) add(it *budgetItem) *budgetItem {
+	it.size += budgetEntryOverhead
+	if it.size > b.limit {
+		return nil
+	}
+	b.makeRoom(it.size)
+	it.e = b.lru.PushFront(it)
+	b.used += it.size
+	return it
+}
+
+// makeRoom evicts the least recently used entries until size more bytes fit
+// within the limit, or there are none left. b.mu must be held.
+func (b *Budget) makeRoom(size int64) {
+	for b.used+size > b.limit && b.lru.Len() > 0 {
+		it := b.lru.Back().Value.(*budgetItem)
+		if it.c != nil {
+			it.c.evict(it)
+		} else {
+			it.evict()
+		}
+		b.remove(it)
+	}
+}
+
+// remove removes a charge from b, unless it is already removed. b.mu must be
+// held.
+func (b *Budget) remove(it *budgetItem) {
+	if it.e == nil {
+		return
+	}
+	b.lru.Remove(it.e)
+	it.e = nil
+	b.used -= it.size
+}
+
+// touch marks a charged cache entry as recently used. b.mu must be held.
+func (b *Budget) touch(it *budgetItem) {
+	b.lru.MoveToFront(it.e)
+}
+
+// SetBudget sets the Budget that caps the memory used by the Context's glyph
+// and outline caches and its rasterizer and hinter buffers, which may be
+// shared with other Contexts. A nil Budget means that the caches are only
+// bounded by their number of entries. To also cap the caches of the
+// Context's font, parse it with the Budget as its truetype.ParseOptions'
+// Budget.
+func (c *Context) SetBudget(b *Budget) {
+	if c.budget == b {
+		return
+	}
+	c.clearCache()
+	c.clearOutlines()
+	c.chargeBuffers(0)
+	c.budget = b
+}
+
+// chargeBuffers sets the charge to the Budget, if there is one, for the
+// Context's rasterizer and hinter buffers to the given number of bytes,
+// evicting cache entries as necessary to make room.
+func (c *Context) chargeBuffers(size int64) {
+	if c.budget == nil {
+		return
+	}
+	c.budget.mu.Lock()
+	if size > c.buffers {
+		c.budget.makeRoom(size - c.buffers)
+	}
+	c.budget.used += size - c.buffers
+	c.buffers = size
+	c.budget.mu.Unlock()
+}
+
+// lockCache locks the glyph and outline caches, if they can be used
+// concurrently, either by this Context's drawing methods or, through a
+// shared Budget, by other Contexts.
+func (c *Context) lockCache() {
+	if c.budget != nil {
+		c.budget.mu.Lock()
+	} else if c.concurrent {
+		c.cacheMu.Lock()
+	}
+}
+
+// unlockCache unlocks the glyph and outline caches.
+func (c *Context) unlockCache() {
+	if c.budget != nil {
+		c.budget.mu.Unlock()
+	} else if c.concurrent {
+		c.cacheMu.Unlock()
+	}
+}
+
+// clearCache empties the glyph cache.
+func (c *Context) clearCache() {
+	c.lockCache()
+	for i := range c.cache {
+		if it := c.cache[i].item; it != nil {
+			c.budget.remove(it)
+		}
+		c.cache[i] = cacheEntry{}
+	}
+	c.unlockCache()
+}
+
+// clearOutlines empties the outline cache.
+func (c *Context) clearOutlines() {
+	c.lockCache()
+	for i := range c.outlines {
+		if it := c.outlines[i].item; it != nil {
+			c.budget.remove(it)
+		}
+		c.outlines[i] = outlineEntry{}
+	}
+	c.unlockCache()
+}
+
+// storeGlyph puts an entry into slot t of the glyph cache, charging it to
+// the Budget, if there is one. The cache must be locked.
+func (c *Context) storeGlyph(t int, e cacheEntry) {
+	if it := c.cache[t].item; it != nil {
+		c.budget.remove(it)
+	}
+	if c.budget != nil {
+		e.item = c.budget.add(&budgetItem{c: c, slot: t, size: int64(len(e.mask.Pix))})
+		if e.item == nil {
+			e = cacheEntry{}
+		}
+	}
+	c.cache[t] = e
+}
+
+// storeOutline puts an entry into slot t of the outline cache, charging it to
+// the Budget, if there is one. The cache must be locked.
+func (c *Context) storeOutline(t int, e outlineEntry) {
+	if it := c.outlines[t].item; it != nil {
+		c.budget.remove(it)
+	}
+	if c.budget != nil {
+		e.item = c.budget.add(&budgetItem{c: c, outline: true, slot: t, size: int64(12*len(e.point) + 8*len(e.end))})
+		if e.item == nil {
+			e = outlineEntry{}
+		}
+	}
+	c.outlines[t] = e
+}
+
+// evict removes a cache entry that the Budget is evicting. The cache must be
+// locked.
+func (c *Context) evict(it *budgetItem) {
+	if it.outline {
+		if c.outlines[it.slot].item == it {
+			c.outlines[it.slot] = outlineEntry{}
+		}
+	} else if c.cache[it.slot].item == it {
+		c.cache[it.slot] = cacheEntry{}
+	}
+}
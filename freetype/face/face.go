@@ -0,0 +1,96 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+// Package face adapts a freetype.Context to the font.Face interface of
+// golang.org/x/image/font, so that a TrueType font can be used where the
+// fixed-size faces of golang.org/x/image/font/basicfont are, such as by a
+// font.Drawer. It is a separate package so that the freetype package does not
+// depend on golang.org/x/image.
+//
+// The face's metrics, advances and kerning are those of the Context's Metrics,
+// GlyphAdvance and Kern methods, converted from 24.8 to 26.6 fixed point
+// numbers, so they have the same whole-pixel rounding as basicfont faces when
+// the Context hints. The Context's font, size, hinting and other settings are
+// those of the face, and may be changed between uses of it.
+package face
+
+import (
+	"image"
+
+	"github.com/lukevers/freetype-go/freetype"
+	"github.com/lukevers/freetype-go/freetype/raster"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// face implements font.Face.
+type face struct {
+	c *freetype.Context
+}
+
+// NewFace returns a font.Face that draws glyphs as the given Context draws
+// them. The Context must not use LCD rendering, as the face's glyph masks
+// are alpha masks of whole pixels. The face's methods may be called
+// concurrently if the Context's SetConcurrent allows it.
+func NewFace(c *freetype.Context) font.Face {
+	return face{c}
+}
+
+// Close implements font.Face. It does nothing.
+func (f face) Close() error {
+	return nil
+}
+
+// Metrics implements font.Face.
+func (f face) Metrics() font.Metrics {
+	m := f.c.Metrics()
+	return font.Metrics{
+		Height:     fixed.Int26_6(m.Height >> 2),
+		Ascent:     fixed.Int26_6(m.Ascent >> 2),
+		Descent:    fixed.Int26_6(m.Descent >> 2),
+		XHeight:    fixed.Int26_6(m.XHeight >> 2),
+		CapHeight:  fixed.Int26_6(m.CapHeight >> 2),
+		CaretSlope: image.Point{X: 0, Y: 1},
+	}
+}
+
+// Kern implements font.Face.
+func (f face) Kern(r0, r1 rune) fixed.Int26_6 {
+	return fixed.Int26_6(f.c.Kern(r0, r1) >> 2)
+}
+
+// GlyphAdvance implements font.Face.
+func (f face) GlyphAdvance(r rune) (advance fixed.Int26_6, ok bool) {
+	a, ok := f.c.GlyphAdvance(r)
+	return fixed.Int26_6(a >> 2), ok
+}
+
+// Glyph implements font.Face.
+func (f face) Glyph(dot fixed.Point26_6, r rune) (
+	dr image.Rectangle, mask image.Image, maskp image.Point, advance fixed.Int26_6, ok bool) {
+
+	p := raster.Point{X: raster.Fix32(dot.X) << 2, Y: raster.Fix32(dot.Y) << 2}
+	a, m, offset, ok, err := f.c.GlyphMask(r, p)
+	if err != nil {
+		return image.Rectangle{}, nil, image.Point{}, 0, false
+	}
+	return m.Bounds().Add(offset), m, m.Bounds().Min, fixed.Int26_6(a >> 2), ok
+}
+
+// GlyphBounds implements font.Face. The bounds are those of the glyph's mask,
+// drawn at the origin, so they are whole pixels, as they are for basicfont
+// faces.
+func (f face) GlyphBounds(r rune) (bounds fixed.Rectangle26_6, advance fixed.Int26_6, ok bool) {
+	a, m, offset, ok, err := f.c.GlyphMask(r, raster.Point{})
+	if err != nil {
+		return fixed.Rectangle26_6{}, 0, false
+	}
+	b := m.Bounds().Add(offset)
+	bounds = fixed.Rectangle26_6{
+		Min: fixed.Point26_6{X: fixed.I(b.Min.X), Y: fixed.I(b.Min.Y)},
+		Max: fixed.Point26_6{X: fixed.I(b.Max.X), Y: fixed.I(b.Max.Y)},
+	}
+	return bounds, fixed.Int26_6(a >> 2), ok
+}
@@ -0,0 +1,73 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package face
+
+import (
+	"image"
+	"io/ioutil"
+	"testing"
+
+	"github.com/lukevers/freetype-go/freetype"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestFace(t *testing.T) {
+	data, err := ioutil.ReadFile("../../testdata/luxisr.ttf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	font, err := freetype.ParseFont(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := freetype.NewContext()
+	c.SetFont(font)
+	c.SetFontSize(12)
+	c.SetHinting(freetype.FullHinting)
+	f := NewFace(c)
+
+	m, want := f.Metrics(), c.Metrics()
+	if m.Height != fixed.Int26_6(want.Height>>2) || m.Ascent != fixed.Int26_6(want.Ascent>>2) || m.Descent != fixed.Int26_6(want.Descent>>2) {
+		t.Errorf("Metrics: got %+v, want those of %+v", m, want)
+	}
+	// The font's OS/2 table is too old to record its x-height and cap height.
+	if m.XHeight != 0 || m.CapHeight != 0 {
+		t.Errorf("Metrics: got %+v, want zero XHeight and CapHeight", m)
+	}
+	for _, x := range []fixed.Int26_6{m.Height, m.Ascent, m.Descent} {
+		if x <= 0 || x&63 != 0 {
+			t.Errorf("Metrics: got %+v, want positive whole pixels", m)
+			break
+		}
+	}
+
+	advance, ok := f.GlyphAdvance('m')
+	if !ok || advance <= 0 || advance&63 != 0 {
+		t.Errorf("GlyphAdvance('m'): got %v, %t, want a positive whole pixel advance", advance, ok)
+	}
+	dot := fixed.Point26_6{X: fixed.I(10), Y: fixed.I(20)}
+	dr, mask, maskp, a, ok := f.Glyph(dot, 'm')
+	if !ok || a != advance {
+		t.Errorf("Glyph('m'): got advance %v, %t, want %v, true", a, ok, advance)
+	}
+	if mask == nil || dr.Empty() || dr.Size() != mask.Bounds().Size() || maskp != mask.Bounds().Min {
+		t.Fatalf("Glyph('m'): got dr %v, mask %v, maskp %v", dr, mask, maskp)
+	}
+	bounds, a, ok := f.GlyphBounds('m')
+	if !ok || a != advance {
+		t.Errorf("GlyphBounds('m'): got advance %v, %t, want %v, true", a, ok, advance)
+	}
+	b := image.Rect(int(bounds.Min.X>>6), int(bounds.Min.Y>>6), int(bounds.Max.X>>6), int(bounds.Max.Y>>6))
+	if got := b.Add(image.Pt(10, 20)); got != dr {
+		t.Errorf("GlyphBounds('m'): got %v at (10, 20), want the Glyph bounds %v", got, dr)
+	}
+	if k := f.Kern('A', 'V'); k >= 0 || k&63 != 0 {
+		t.Errorf("Kern('A', 'V'): got %v, want a negative whole pixel kerning", k)
+	}
+	if _, ok := f.GlyphAdvance('一'); ok {
+		t.Errorf("GlyphAdvance('\\u4e00'): got ok, want not ok")
+	}
+}
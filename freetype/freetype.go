@@ -9,9 +9,14 @@
 package freetype
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"image"
 	"image/draw"
+	"math"
+	"sync"
+	"unicode/utf8"
 
 	"github.com/lukevers/freetype-go/freetype/raster"
 	"github.com/lukevers/freetype-go/freetype/truetype"
@@ -27,15 +32,38 @@ const (
 	nYFractions = 1
 )
 
+// DefaultRotationSteps is the default number of rotation angles that a full
+// turn is quantized to.
+const DefaultRotationSteps = 360
+
 // An entry in the glyph cache is keyed explicitly by the glyph index and
-// implicitly by the quantized x and y fractional offset. It maps to a mask
-// image and an offset.
+// quantized rotation, and implicitly by the quantized x and y fractional
+// offset. It maps to a mask image and an offset.
 type cacheEntry struct {
 	valid        bool
 	glyph        truetype.Index
+	rotation     int
 	advanceWidth raster.Fix32
 	mask         *image.Alpha
 	offset       image.Point
+	// item is the entry's charge to the Context's Budget, if it has one.
+	item *budgetItem
+}
+
+// An outlineEntry caches a glyph's unhinted outline in FUnits, keyed by the
+// glyph index modulo nGlyphs. Unlike the glyph cache, it does not depend on
+// the font size, so that rendering the same glyph at many sizes, such as
+// during a zoom animation, doesn't re-parse the glyf data each time. Hinted
+// outlines and compound glyphs' outlines depend on the size in non-linear
+// ways, and so are not cached.
+type outlineEntry struct {
+	valid        bool
+	glyph        truetype.Index
+	advanceWidth int32
+	point        []truetype.Point
+	end          []int
+	// item is the entry's charge to the Context's Budget, if it has one.
+	item *budgetItem
 }
 
 // ParseFont just calls the Parse function from the freetype/truetype package.
@@ -62,8 +90,68 @@ const (
 	NoHinting = Hinting(truetype.NoHinting)
 	// FullHinting means to use the font's hinting instructions.
 	FullHinting = Hinting(truetype.FullHinting)
+	// SubpixelHinting means to use the font's hinting instructions, as
+	// interpreted by ClearType's subpixel rasterizer.
+	SubpixelHinting = Hinting(truetype.SubpixelHinting)
+	// VerticalHinting means to use the font's hinting instructions only in
+	// the y direction, keeping horizontal positions and advances fractional.
+	VerticalHinting = Hinting(truetype.VerticalHinting)
+	// AutoHinting means to ignore the font's hinting instructions and to
+	// detect and hint the glyphs' horizontal stems and edges instead, in the
+	// y direction only. It suits fonts without hinting instructions.
+	AutoHinting = Hinting(truetype.AutoHinting)
+)
+
+// Digits is the script in which to draw the European digits '0' to '9'. Its
+// value is that script's digit zero.
+type Digits rune
+
+const (
+	// EuropeanDigits means to draw digits as is.
+	EuropeanDigits Digits = '0'
+	// ArabicIndicDigits means to draw digits as U+0660 to U+0669, as used
+	// with Arabic.
+	ArabicIndicDigits Digits = '\u0660'
+	// ExtendedArabicIndicDigits means to draw digits as U+06F0 to U+06F9, as
+	// used with Persian and Urdu.
+	ExtendedArabicIndicDigits Digits = '\u06f0'
+	// DevanagariDigits means to draw digits as U+0966 to U+096F.
+	DevanagariDigits Digits = '\u0966'
+	// BengaliDigits means to draw digits as U+09E6 to U+09EF.
+	BengaliDigits Digits = '\u09e6'
+	// ThaiDigits means to draw digits as U+0E50 to U+0E59.
+	ThaiDigits Digits = '\u0e50'
 )
 
+// OversizePolicy is the policy for glyphs whose scaled bounds exceed a
+// Context's glyph size limit.
+type OversizePolicy int32
+
+const (
+	// RejectOversize means that drawing an oversized glyph returns an
+	// *OversizeError.
+	RejectOversize OversizePolicy = iota
+	// DownscaleOversize means that an oversized glyph is drawn scaled down
+	// to fit within the limit. Its advance width is not affected.
+	DownscaleOversize
+)
+
+// An OversizeError is returned when a glyph's scaled bounds exceed a
+// Context's glyph size limit and the policy is RejectOversize.
+type OversizeError struct {
+	// Glyph is the index of the oversized glyph.
+	Glyph truetype.Index
+	// Width and Height are the glyph's scaled bounds, in pixels.
+	Width, Height int
+	// Limit is the Context's glyph size limit, in pixels.
+	Limit int
+}
+
+func (e *OversizeError) Error() string {
+	return fmt.Sprintf("freetype: glyph %d is %dx%d pixels, exceeding the limit of %d",
+		e.Glyph, e.Width, e.Height, e.Limit)
+}
+
 // A Context holds the state for drawing text in a given font and size.
 type Context struct {
 	r        *raster.Rasterizer
@@ -79,8 +167,61 @@ type Context struct {
 	fontSize, dpi float64
 	scale         int32
 	hinting       Hinting
+	// gasp is whether to honor the font's gasp table, and gaspNoHinting is
+	// whether that table recommends no hinting at the current scale.
+	gasp, gaspNoHinting bool
+	// trak is whether to apply the font's trak table, and tracking is the
+	// table's adjustment to the spacing between glyphs at the current size.
+	trak     bool
+	tracking raster.Fix32
+	// rotation is the rotation angle, counter-clockwise, in units of
+	// 1/rotationSteps of a full turn, and cos and sin are its cosine and
+	// sine.
+	rotation, rotationSteps int
+	cos, sin                float64
+	// widthScale is the 16.16 fixed point horizontal scaling factor, or zero
+	// for no horizontal scaling.
+	widthScale int32
+	// varied is whether SetVariation set an instance other than the font's
+	// default instance.
+	varied bool
+	// gamma and floor adjust the coverage of rendered glyphs, as set by
+	// SetDarkening. A zero gamma means no gamma correction. gammaPainter is
+	// built for gamma, and wraps each glyph's Painter in turn.
+	gamma        float64
+	floor        uint32
+	gammaPainter *raster.GammaCorrectionPainter
+	// digits is the script for drawing digits. Zero means EuropeanDigits.
+	digits Digits
+	// lcd is the LCD rendering policy, lcdFilter is the filter for LCD glyph
+	// masks, and lcdRow is a scratch buffer for filtering them.
+	lcd       LCDMode
+	lcdFilter LCDFilter
+	lcdRow    []uint8
+	// indexMap, if non-nil, maps the glyph indexes passed to DrawGlyphs to
+	// those of font.
+	indexMap *truetype.IndexMap
+	// sizeLimit is the maximum width and height, in pixels, of a rendered
+	// glyph, or zero for no limit. oversize is the policy for glyphs that
+	// exceed it.
+	sizeLimit int
+	oversize  OversizePolicy
+	// concurrent is whether drawing methods may be called concurrently. If
+	// so, cacheMu guards cache and outlines, and rasterMu guards r,
+	// glyphBuf and gammaPainter. If the Context has a Budget, its lock guards cache and
+	// outlines instead of cacheMu.
+	concurrent bool
+	cacheMu    sync.Mutex
+	rasterMu   sync.Mutex
 	// cache is the glyph cache.
 	cache [nGlyphs * nXFractions * nYFractions]cacheEntry
+	// outlines is the unhinted outline cache.
+	outlines [nGlyphs]outlineEntry
+	// budget, if non-nil, caps the memory used by cache and outlines, and
+	// is charged buffers bytes for r and glyphBuf. buffers is guarded by the
+	// Budget's lock.
+	budget  *Budget
+	buffers int64
 }
 
 // PointToFix32 converts the given number of points (as in ``a 12 point font'')
@@ -98,7 +239,8 @@ func (c *Context) drawContour(ps []truetype.Point, dx, dy raster.Fix32) {
 	// The low bit of each point's Flags value is whether the point is on the
 	// curve. Truetype fonts only have quadratic Bézier curves, not cubics.
 	// Thus, two consecutive off-curve points imply an on-curve point in the
-	// middle of those two.
+	// middle of those two. Fonts with CFF outlines have cubic curves, whose
+	// pairs of control points have the truetype.FlagCubic bit.
 	//
 	// See http://chanae.walon.org/pub/ttf/ttf_glyphs.htm for more details.
 
@@ -130,11 +272,32 @@ func (c *Context) drawContour(ps []truetype.Point, dx, dy raster.Fix32) {
 	}
 	c.r.Start(start)
 	q0, on0 := start, true
-	for _, p := range others {
+	for i := 0; i < len(others); i++ {
+		p := others[i]
 		q := raster.Point{
 			X: dx + raster.Fix32(p.X<<2),
 			Y: dy - raster.Fix32(p.Y<<2),
 		}
+		if p.Flags&truetype.FlagCubic != 0 {
+			// The second control point and the end point default to the
+			// start point, at the end of the contour.
+			q1, q2 := start, start
+			if i+1 < len(others) {
+				q1.X = dx + raster.Fix32(others[i+1].X<<2)
+				q1.Y = dy - raster.Fix32(others[i+1].Y<<2)
+			}
+			if i+2 < len(others) {
+				q2.X = dx + raster.Fix32(others[i+2].X<<2)
+				q2.Y = dy - raster.Fix32(others[i+2].Y<<2)
+			}
+			c.r.Add3(q, q1, q2)
+			if i+2 >= len(others) {
+				return
+			}
+			i += 2
+			q0, on0 = q2, true
+			continue
+		}
 		on := p.Flags&0x01 != 0
 		if on {
 			if on0 {
@@ -166,26 +329,51 @@ func (c *Context) drawContour(ps []truetype.Point, dx, dy raster.Fix32) {
 // rasterize returns the advance width, glyph mask and integer-pixel offset
 // to render the given glyph at the given sub-pixel offsets.
 // The 24.8 fixed point arguments fx and fy must be in the range [0, 1).
-func (c *Context) rasterize(glyph truetype.Index, fx, fy raster.Fix32) (
+func (c *Context) rasterize(ctx context.Context, glyph truetype.Index, fx, fy raster.Fix32) (
 	raster.Fix32, *image.Alpha, image.Point, error) {
 
-	if err := c.glyphBuf.Load(c.font, c.scale, glyph, truetype.Hinting(c.hinting)); err != nil {
+	if err := c.loadGlyph(ctx, glyph, c.scale); err != nil {
 		return 0, nil, image.Point{}, err
 	}
+	c.transformGlyph()
+	advanceWidth := raster.Fix32(c.glyphBuf.AdvanceWidth << 2)
 	// Calculate the integer-pixel bounds for the glyph.
-	xmin := int(fx+raster.Fix32(c.glyphBuf.B.XMin<<2)) >> 8
-	ymin := int(fy-raster.Fix32(c.glyphBuf.B.YMax<<2)) >> 8
-	xmax := int(fx+raster.Fix32(c.glyphBuf.B.XMax<<2)+0xff) >> 8
-	ymax := int(fy-raster.Fix32(c.glyphBuf.B.YMin<<2)+0xff) >> 8
+	xmin, ymin, xmax, ymax := c.maskBounds(fx, fy)
 	if xmin > xmax || ymin > ymax {
 		return 0, nil, image.Point{}, errors.New("freetype: negative sized glyph")
 	}
+	if n := c.sizeLimit; n > 0 && (xmax-xmin > n || ymax-ymin > n) {
+		oversizeErr := &OversizeError{glyph, xmax - xmin, ymax - ymin, n}
+		if c.oversize != DownscaleOversize {
+			return 0, nil, image.Point{}, oversizeErr
+		}
+		// Re-load the glyph at a scale at which it fits. Rounding the bounds
+		// out to whole pixels can add up to two pixels in each dimension.
+		m := xmax - xmin
+		if m < ymax-ymin {
+			m = ymax - ymin
+		}
+		scale := int32(int64(c.scale) * int64(n) / int64(m+2))
+		if err := c.loadGlyph(ctx, glyph, scale); err != nil {
+			return 0, nil, image.Point{}, err
+		}
+		c.transformGlyph()
+		xmin, ymin, xmax, ymax = c.maskBounds(fx, fy)
+		if xmax-xmin > n || ymax-ymin > n {
+			return 0, nil, image.Point{}, oversizeErr
+		}
+	}
 	// A TrueType's glyph's nodes can have negative co-ordinates, but the
 	// rasterizer clips anything left of x=0 or above y=0. xmin and ymin
 	// are the pixel offsets, based on the font's FUnit metrics, that let
 	// a negative co-ordinate in TrueType space be non-negative in
-	// rasterizer space. xmin and ymin are typically <= 0.
-	fx += raster.Fix32(-xmin << 8)
+	// rasterizer space. xmin and ymin are typically <= 0. For LCD
+	// rendering, the mask's pixels are subpixels.
+	sx := 1
+	if c.lcd != NoLCD {
+		sx = 3
+	}
+	fx = raster.Fix32(sx)*fx + raster.Fix32(-sx*xmin<<8)
 	fy += raster.Fix32(-ymin << 8)
 	// Rasterize the glyph's vectors.
 	c.r.Clear()
@@ -194,36 +382,162 @@ func (c *Context) rasterize(glyph truetype.Index, fx, fy raster.Fix32) (
 		c.drawContour(c.glyphBuf.Point[e0:e1], fx, fy)
 		e0 = e1
 	}
-	a := image.NewAlpha(image.Rect(0, 0, xmax-xmin, ymax-ymin))
-	c.r.Rasterize(raster.NewAlphaSrcPainter(a))
-	return raster.Fix32(c.glyphBuf.AdvanceWidth << 2), a, image.Point{xmin, ymin}, nil
+	a := image.NewAlpha(image.Rect(0, 0, sx*(xmax-xmin), ymax-ymin))
+	var p raster.Painter = raster.NewAlphaSrcPainter(a)
+	if c.floor != 0 {
+		p = raster.CoverageClampPainter{Painter: p, Min: c.floor}
+	}
+	if c.gamma != 0 {
+		c.gammaPainter.Painter = p
+		p = c.gammaPainter
+	}
+	if err := c.r.RasterizeContext(ctx, p); err != nil {
+		return 0, nil, image.Point{}, err
+	}
+	if c.lcd != NoLCD {
+		c.filterLCD(a)
+	}
+	return advanceWidth, a, image.Point{xmin, ymin}, nil
+}
+
+// loadGlyph loads the given glyph at the given scale into c.glyphBuf. Unhinted
+// simple glyphs are scaled from the outline cache.
+func (c *Context) loadGlyph(ctx context.Context, glyph truetype.Index, scale int32) error {
+	if h := c.glyphHinting(); h != NoHinting || c.font.IsCompound(glyph) {
+		c.glyphBuf.SetWidthScale(c.widthScale)
+		return c.glyphBuf.LoadContext(ctx, c.font, scale, glyph, truetype.Hinting(h))
+	}
+	unitsPerEm := c.font.FUnitsPerEm()
+	t := int(glyph) % nGlyphs
+	c.lockCache()
+	e := c.outlines[t]
+	hit := e.valid && e.glyph == glyph
+	if hit && e.item != nil {
+		c.budget.touch(e.item)
+	}
+	c.unlockCache()
+	if !hit {
+		// Loading at a scale of one FUnit per 26.6 fixed point unit gives
+		// the outline in FUnits.
+		c.glyphBuf.SetWidthScale(0)
+		if err := c.glyphBuf.LoadContext(ctx, c.font, unitsPerEm, glyph, truetype.NoHinting); err != nil {
+			return err
+		}
+		// The entry's slices are not reused, as a Budget may evict it
+		// while they are being read below.
+		e = outlineEntry{
+			valid:        true,
+			glyph:        glyph,
+			advanceWidth: c.glyphBuf.AdvanceWidth,
+			point:        append([]truetype.Point(nil), c.glyphBuf.Point...),
+			end:          append([]int(nil), c.glyphBuf.End...),
+		}
+		c.lockCache()
+		c.storeOutline(t, e)
+		c.unlockCache()
+	}
+	g, xScale := c.glyphBuf, c.horizontalScale(scale)
+	g.AdvanceWidth = scaleFUnits(e.advanceWidth, xScale, unitsPerEm)
+	g.Point = g.Point[:0]
+	for _, p := range e.point {
+		p.X = scaleFUnits(p.X, xScale, unitsPerEm)
+		p.Y = scaleFUnits(p.Y, scale, unitsPerEm)
+		g.Point = append(g.Point, p)
+	}
+	g.End = append(g.End[:0], e.end...)
+	g.B = truetype.Bounds{}
+	for i, p := range g.Point {
+		if i == 0 || g.B.XMin > p.X {
+			g.B.XMin = p.X
+		}
+		if i == 0 || g.B.XMax < p.X {
+			g.B.XMax = p.X
+		}
+		if i == 0 || g.B.YMin > p.Y {
+			g.B.YMin = p.Y
+		}
+		if i == 0 || g.B.YMax < p.Y {
+			g.B.YMax = p.Y
+		}
+	}
+	return nil
+}
+
+// horizontalScale returns the horizontal scale for the given scale.
+func (c *Context) horizontalScale(scale int32) int32 {
+	if c.widthScale == 0 {
+		return scale
+	}
+	return int32(int64(scale) * int64(c.widthScale) >> 16)
+}
+
+// scaleFUnits scales x, in FUnits, to the given scale, rounding to nearest in
+// the same way as the truetype package.
+func scaleFUnits(x, scale, unitsPerEm int32) int32 {
+	x *= scale
+	if x >= 0 {
+		x += unitsPerEm / 2
+	} else {
+		x -= unitsPerEm / 2
+	}
+	return x / unitsPerEm
+}
+
+// glyphBounds returns the integer-pixel bounds for the glyph in c.glyphBuf,
+// at the given sub-pixel offsets.
+func (c *Context) glyphBounds(fx, fy raster.Fix32) (xmin, ymin, xmax, ymax int) {
+	xmin = int(fx+raster.Fix32(c.glyphBuf.B.XMin<<2)) >> 8
+	ymin = int(fy-raster.Fix32(c.glyphBuf.B.YMax<<2)) >> 8
+	xmax = int(fx+raster.Fix32(c.glyphBuf.B.XMax<<2)+0xff) >> 8
+	ymax = int(fy-raster.Fix32(c.glyphBuf.B.YMin<<2)+0xff) >> 8
+	return xmin, ymin, xmax, ymax
 }
 
 // glyph returns the advance width, glyph mask and integer-pixel offset to
 // render the given glyph at the given sub-pixel point. It is a cache for the
 // rasterize method. Unlike rasterize, p's co-ordinates do not have to be in
 // the range [0, 1).
-func (c *Context) glyph(glyph truetype.Index, p raster.Point) (
+func (c *Context) glyph(ctx context.Context, glyph truetype.Index, p raster.Point) (
 	raster.Fix32, *image.Alpha, image.Point, error) {
 
 	// Split p.X and p.Y into their integer and fractional parts.
 	ix, fx := int(p.X>>8), p.X&0xff
 	iy, fy := int(p.Y>>8), p.Y&0xff
 	// Calculate the index t into the cache array.
-	tg := int(glyph) % nGlyphs
+	tg := (int(glyph) + c.rotation) % nGlyphs
 	tx := int(fx) / (256 / nXFractions)
 	ty := int(fy) / (256 / nYFractions)
 	t := ((tg*nXFractions)+tx)*nYFractions + ty
 	// Check for a cache hit.
-	if e := c.cache[t]; e.valid && e.glyph == glyph {
+	c.lockCache()
+	e := c.cache[t]
+	hit := e.valid && e.glyph == glyph && e.rotation == c.rotation
+	if hit && e.item != nil {
+		c.budget.touch(e.item)
+	}
+	c.unlockCache()
+	if hit {
 		return e.advanceWidth, e.mask, e.offset.Add(image.Point{ix, iy}), nil
 	}
-	// Rasterize the glyph and put the result into the cache.
-	advanceWidth, mask, offset, err := c.rasterize(glyph, fx, fy)
+	// Rasterize the glyph and put the result into the cache. The cache is
+	// not locked while rasterizing, so that other goroutines can draw
+	// cached glyphs in the meantime.
+	if c.concurrent {
+		c.rasterMu.Lock()
+	}
+	advanceWidth, mask, offset, err := c.rasterize(ctx, glyph, fx, fy)
+	if c.budget != nil {
+		c.chargeBuffers(int64(c.r.BufferSize() + c.glyphBuf.BufferSize()))
+	}
+	if c.concurrent {
+		c.rasterMu.Unlock()
+	}
 	if err != nil {
 		return 0, nil, image.Point{}, err
 	}
-	c.cache[t] = cacheEntry{true, glyph, advanceWidth, mask, offset}
+	c.lockCache()
+	c.storeGlyph(t, cacheEntry{true, glyph, c.rotation, advanceWidth, mask, offset, nil})
+	c.unlockCache()
 	return advanceWidth, mask, offset.Add(image.Point{ix, iy}), nil
 }
 
@@ -235,52 +549,434 @@ func (c *Context) glyph(glyph truetype.Index, p raster.Point) (
 // affect pixels below and left of the point.
 // p is a raster.Point and can therefore represent sub-pixel positions.
 func (c *Context) DrawString(s string, p raster.Point) (raster.Point, error) {
+	return c.DrawStringContext(context.Background(), s, p)
+}
+
+// DrawStringContext is like DrawString, but stops with ctx's error, such as
+// context.DeadlineExceeded, once ctx is done, so that servers can abort
+// drawing pathological text or fonts. Loading, hinting and rasterizing each
+// glyph are interrupted. The glyphs drawn before then are not erased, so the
+// destination image may be left with only part of the text.
+func (c *Context) DrawStringContext(ctx context.Context, s string, p raster.Point) (raster.Point, error) {
 	if c.font == nil {
 		return raster.Point{}, errors.New("freetype: DrawText called with a nil font")
 	}
+	return c.layout(ctx, s, p, nil, func(_ GlyphPosition, mask *image.Alpha, offset image.Point) error {
+		return c.drawMask(mask, offset)
+	})
+}
+
+// index returns the glyph index for the given rune, substituting digits as
+// per the Context's digit shapes.
+func (c *Context) index(rune rune) truetype.Index {
+	index := c.font.Index(rune)
+	if '0' <= rune && rune <= '9' && c.digits != 0 && c.digits != EuropeanDigits {
+		// Fall back to the European digit if the font lacks the other.
+		if i := c.font.Index(rune - '0' + int32(c.digits)); i != 0 {
+			index = i
+		}
+	}
+	return index
+}
+
+// A GlyphPosition is the position of one glyph of laid out text.
+type GlyphPosition struct {
+	// ID identifies the glyph within its text. It is the byte offset, in the
+	// string passed to Layout, of the rune that the glyph is for. Unlike the
+	// other fields, it does not depend on the font size, position or other
+	// settings, so that animations can match each glyph of one layout of a
+	// string with the same glyph of another layout of that string. A
+	// ligature's ID is that of its first rune.
+	ID int
+	// Rune is the rune that the glyph is for, or a ligature's first rune, and
+	// Index is the glyph index.
+	Rune  rune
+	Index truetype.Index
+	// Dot is where the left edge of the glyph's em square and the baseline
+	// intersect, after kerning, and Advance is the glyph's advance width.
+	Dot     raster.Point
+	Advance raster.Fix32
+}
+
+// Layout lays out s at p as DrawString would, but instead of drawing the
+// glyphs it returns their positions, as well as p advanced by the text extent.
+// The positions can be moved, such as by interpolating between the positions
+// of two layouts, and then drawn by DrawLayout.
+//
+// If any OpenType features are given, such as "liga" for ligatures, "smcp"
+// for small capitals, "onum" for oldstyle figures or "ss01" for the first
+// stylistic set, the font's GSUB substitutions for those features are applied
+// to the glyphs, as per the truetype.Font's Substitute method. Otherwise, as
+// for DrawString, its default features, such as "liga" and "ccmp" for
+// ligatures and glyph composition, are applied.
+//
+// Marks, such as combining accents, are placed on the anchors of the glyphs
+// that they attach to, as per the truetype.Font's PositionMarks method. An
+// attached mark does not advance the pen, and does not interrupt kerning.
+func (c *Context) Layout(s string, p raster.Point, features ...string) ([]GlyphPosition, raster.Point, error) {
+	return c.LayoutContext(context.Background(), s, p, features...)
+}
+
+// LayoutContext is like Layout, but stops with ctx's error once ctx is done.
+func (c *Context) LayoutContext(ctx context.Context, s string, p raster.Point, features ...string) ([]GlyphPosition, raster.Point, error) {
+	if c.font == nil {
+		return nil, raster.Point{}, errors.New("freetype: Layout called with a nil font")
+	}
+	var glyphs []GlyphPosition
+	p, err := c.layout(ctx, s, p, features, func(g GlyphPosition, _ *image.Alpha, _ image.Point) error {
+		glyphs = append(glyphs, g)
+		return nil
+	})
+	if err != nil {
+		return nil, raster.Point{}, err
+	}
+	return glyphs, p, nil
+}
+
+// layout lays out s at p, calling fn with each glyph's position and its mask
+// at its integer-pixel offset, in turn, and returns p advanced by the text
+// extent. Unless features are given or the font has layout tables, the
+// glyphs do not depend on each other, and are laid out in a single pass over
+// s.
+func (c *Context) layout(ctx context.Context, s string, p raster.Point, features []string,
+	fn func(g GlyphPosition, mask *image.Alpha, offset image.Point) error) (raster.Point, error) {
+
 	prev, hasPrev := truetype.Index(0), false
-	for _, rune := range s {
-		index := c.font.Index(rune)
-		if hasPrev {
-			kern := raster.Fix32(c.font.Kerning(c.scale, prev, index)) << 2
-			if c.hinting != NoHinting {
-				kern = (kern + 128) &^ 255
+	if len(features) == 0 && !c.hasLayoutTables() {
+		for id, rune := range s {
+			if isBidiControl(rune) {
+				continue
 			}
-			p.X += kern
+			index := c.index(rune)
+			p = c.kern(prev, hasPrev, index, p)
+			advanceWidth, err := c.placeGlyph(ctx, GlyphPosition{ID: id, Rune: rune, Index: index, Dot: p}, fn)
+			if err != nil {
+				return raster.Point{}, err
+			}
+			p = c.advance(p, advanceWidth+c.track())
+			prev, hasPrev = index, true
 		}
-		advanceWidth, mask, offset, err := c.glyph(index, p)
+		return p, nil
+	}
+
+	var (
+		indexes []truetype.Index
+		ids     []int
+	)
+	for id, rune := range s {
+		if isBidiControl(rune) {
+			continue
+		}
+		indexes = append(indexes, c.index(rune))
+		ids = append(ids, id)
+	}
+	indexes, ids = c.font.Substitute(indexes, ids, features...)
+	marks := c.font.PositionMarks(c.scale, indexes)
+	dots := make([]raster.Point, len(indexes))
+	for i, index := range indexes {
+		rune, _ := utf8.DecodeRuneInString(s[ids[i]:])
+		if m := marks[i]; m.Base >= 0 {
+			dots[i] = c.markOffset(dots[m.Base], m)
+			if _, err := c.placeGlyph(ctx, GlyphPosition{ID: ids[i], Rune: rune, Index: index, Dot: dots[i]}, fn); err != nil {
+				return raster.Point{}, err
+			}
+			continue
+		}
+		p = c.kern(prev, hasPrev, index, p)
+		dots[i] = p
+		advanceWidth, err := c.placeGlyph(ctx, GlyphPosition{ID: ids[i], Rune: rune, Index: index, Dot: p}, fn)
 		if err != nil {
 			return raster.Point{}, err
 		}
-		p.X += advanceWidth
-		glyphRect := mask.Bounds().Add(offset)
-		dr := c.clip.Intersect(glyphRect)
-		if !dr.Empty() {
-			mp := image.Point{0, dr.Min.Y - glyphRect.Min.Y}
-			draw.DrawMask(c.dst, dr, c.src, image.ZP, mask, mp, draw.Over)
-		}
+		p = c.advance(p, advanceWidth+c.track())
 		prev, hasPrev = index, true
 	}
 	return p, nil
 }
 
+// hasLayoutTables returns whether the font has a GSUB, GPOS or morx table,
+// whose substitutions and positions depend on a glyph's neighbours.
+func (c *Context) hasLayoutTables() bool {
+	for _, tag := range [...]string{"GSUB", "GPOS", "morx"} {
+		if _, ok := c.font.Table(tag); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// placeGlyph calls fn with the glyph g, at its Dot, and its mask, and returns
+// its advance width.
+func (c *Context) placeGlyph(ctx context.Context, g GlyphPosition,
+	fn func(g GlyphPosition, mask *image.Alpha, offset image.Point) error) (raster.Fix32, error) {
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	advanceWidth, mask, offset, err := c.glyph(ctx, g.Index, g.Dot)
+	if err != nil {
+		return 0, err
+	}
+	g.Advance = advanceWidth
+	return advanceWidth, fn(g, mask, offset)
+}
+
+// DrawLayout draws each of the given glyphs at its Dot.
+func (c *Context) DrawLayout(glyphs []GlyphPosition) error {
+	return c.DrawLayoutContext(context.Background(), glyphs)
+}
+
+// DrawLayoutContext is like DrawLayout, but stops with ctx's error once ctx
+// is done.
+func (c *Context) DrawLayoutContext(ctx context.Context, glyphs []GlyphPosition) error {
+	if c.font == nil {
+		return errors.New("freetype: DrawLayout called with a nil font")
+	}
+	for _, g := range glyphs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		_, mask, offset, err := c.glyph(ctx, g.Index, g.Dot)
+		if err != nil {
+			return err
+		}
+		if err := c.drawMask(mask, offset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isBidiControl returns whether r is one of the Unicode bidirectional
+// formatting characters: the explicit embeddings, overrides and isolates, and
+// the implicit directional marks. These are invisible and zero width. This
+// package does not reorder text, so they have no other effect on drawing, but
+// they are skipped rather than drawn as missing glyphs, and do not interrupt
+// kerning.
+func isBidiControl(r rune) bool {
+	switch r {
+	case '\u061c', // ARABIC LETTER MARK.
+		'\u200e', '\u200f', // LEFT-TO-RIGHT MARK, RIGHT-TO-LEFT MARK.
+		'\u202a', '\u202b', '\u202c', '\u202d', '\u202e', // LRE, RLE, PDF, LRO, RLO.
+		'\u2066', '\u2067', '\u2068', '\u2069': // LRI, RLI, FSI, PDI.
+		return true
+	}
+	return false
+}
+
+// DrawGlyphs is like DrawString, except that the text is given as a sequence
+// of glyph indexes instead of runes, bypassing the font's character map. This
+// is useful for text whose glyphs are already known, such as from a PDF
+// content stream, and for fonts that have no usable character map.
+//
+// If an index map has been set, the glyph indexes are those of the original
+// font, and are mapped to those of the Context's subset font.
+func (c *Context) DrawGlyphs(indexes []truetype.Index, p raster.Point) (raster.Point, error) {
+	if c.font == nil {
+		return raster.Point{}, errors.New("freetype: DrawGlyphs called with a nil font")
+	}
+	prev := truetype.Index(0)
+	for i, index := range indexes {
+		if c.indexMap != nil {
+			index, _ = c.indexMap.New(index)
+		}
+		var err error
+		if p, err = c.drawGlyph(prev, i > 0, index, p); err != nil {
+			return raster.Point{}, err
+		}
+		prev = index
+	}
+	return p, nil
+}
+
+// drawGlyph draws the glyph with the given index at p, kerned against the
+// previous glyph if there is one, and returns p advanced by the glyph's
+// advance width.
+func (c *Context) drawGlyph(prev truetype.Index, hasPrev bool, index truetype.Index, p raster.Point) (raster.Point, error) {
+	p = c.kern(prev, hasPrev, index, p)
+	advanceWidth, mask, offset, err := c.glyph(context.Background(), index, p)
+	if err != nil {
+		return raster.Point{}, err
+	}
+	p = c.advance(p, advanceWidth+c.track())
+	if err := c.drawMask(mask, offset); err != nil {
+		return raster.Point{}, err
+	}
+	return p, nil
+}
+
+// kern returns p advanced by the kerning between the previous glyph, if there
+// is one, and the glyph with the given index.
+func (c *Context) kern(prev truetype.Index, hasPrev bool, index truetype.Index, p raster.Point) raster.Point {
+	if !hasPrev {
+		return p
+	}
+	return c.advance(p, c.kernWidth(prev, index))
+}
+
+// kernWidth returns the kerning between the glyphs with the given indexes,
+// rounded to a whole pixel if hinting.
+func (c *Context) kernWidth(i0, i1 truetype.Index) raster.Fix32 {
+	kern := raster.Fix32(c.font.Kern(c.horizontalScale(c.scale), i0, i1)) << 2
+	if h := c.glyphHinting(); h == FullHinting || h == SubpixelHinting {
+		kern = (kern + 128) &^ 255
+	}
+	return kern
+}
+
+// track returns the tracking to add to each glyph's advance width, rounded
+// to a whole pixel if hinting.
+func (c *Context) track() raster.Fix32 {
+	t := c.tracking
+	if h := c.glyphHinting(); h == FullHinting || h == SubpixelHinting {
+		t = (t + 128) &^ 255
+	}
+	return t
+}
+
+// markOffset returns the position of an attached mark, given the position p
+// of the glyph that it is attached to.
+func (c *Context) markOffset(p raster.Point, m truetype.MarkPosition) raster.Point {
+	dx := raster.Fix32(int64(m.X)*int64(c.horizontalScale(c.scale))/int64(c.scale)) << 2
+	dy := raster.Fix32(m.Y) << 2
+	if h := c.glyphHinting(); h == FullHinting || h == SubpixelHinting {
+		dx, dy = (dx+128)&^255, (dy+128)&^255
+	}
+	if c.rotation == 0 {
+		p.X += dx
+		p.Y -= dy
+		return p
+	}
+	// The mark's y offset is perpendicular to the rotated baseline.
+	p.X += raster.Fix32(math.Floor(float64(dx)*c.cos - float64(dy)*c.sin + 0.5))
+	p.Y -= raster.Fix32(math.Floor(float64(dx)*c.sin + float64(dy)*c.cos + 0.5))
+	return p
+}
+
+// errNilDst is returned when drawing a glyph inside the clip rectangle with no
+// destination image. With no destination image and no clip rectangle, drawing
+// only measures.
+var errNilDst = errors.New("freetype: drawing with a nil destination image")
+
+// drawMask draws the source image through the given glyph mask, at the given
+// integer-pixel offset, onto the destination image.
+func (c *Context) drawMask(mask *image.Alpha, offset image.Point) error {
+	if c.lcd != NoLCD {
+		return c.drawLCDMask(mask, offset)
+	}
+	glyphRect := mask.Bounds().Add(offset)
+	dr := c.clip.Intersect(glyphRect)
+	if !dr.Empty() {
+		if c.dst == nil {
+			return errNilDst
+		}
+		mp := dr.Min.Sub(glyphRect.Min)
+		draw.DrawMask(c.dst, dr, c.src, image.ZP, mask, mp, draw.Over)
+	}
+	return nil
+}
+
+// advance returns p moved by d along the baseline, which is rotated by the
+// Context's rotation.
+func (c *Context) advance(p raster.Point, d raster.Fix32) raster.Point {
+	if c.rotation == 0 {
+		p.X += d
+		return p
+	}
+	p.X += raster.Fix32(math.Floor(float64(d)*c.cos + 0.5))
+	p.Y -= raster.Fix32(math.Floor(float64(d)*c.sin + 0.5))
+	return p
+}
+
+// rotateGlyph rotates the glyph loaded into c.glyphBuf by the Context's
+// rotation, and updates its bounds.
+func (c *Context) rotateGlyph() {
+	if c.rotation == 0 {
+		return
+	}
+	g := c.glyphBuf
+	for i := range g.Point {
+		p := &g.Point[i]
+		x, y := float64(p.X), float64(p.Y)
+		p.X = int32(math.Floor(x*c.cos - y*c.sin + 0.5))
+		p.Y = int32(math.Floor(x*c.sin + y*c.cos + 0.5))
+	}
+	g.B = truetype.Bounds{}
+	for i, p := range g.Point {
+		if i == 0 || g.B.XMin > p.X {
+			g.B.XMin = p.X
+		}
+		if i == 0 || g.B.XMax < p.X {
+			g.B.XMax = p.X
+		}
+		if i == 0 || g.B.YMin > p.Y {
+			g.B.YMin = p.Y
+		}
+		if i == 0 || g.B.YMax < p.Y {
+			g.B.YMax = p.Y
+		}
+	}
+}
+
 // recalc recalculates scale and bounds values from the font size, screen
 // resolution and font metrics, and invalidates the glyph cache.
 func (c *Context) recalc() {
 	c.scale = int32(c.fontSize * c.dpi * (64.0 / 72.0))
+	c.glyphBuf.SetPointSize(int32(c.fontSize * 64))
+	c.gaspNoHinting = false
+	if c.gasp && c.font != nil {
+		b, ok := c.font.Gasp(c.scale)
+		c.gaspNoHinting = ok && b&(truetype.GaspGridFit|truetype.GaspSymmetricGridFit) == 0
+	}
+	c.setTracking()
+	c.setBounds()
+	c.clearCache()
+}
+
+// setTracking sets the tracking to add to each glyph's advance width at the
+// Context's size.
+func (c *Context) setTracking() {
+	c.tracking = 0
+	if c.trak && c.font != nil {
+		t, _ := c.font.Tracking(c.horizontalScale(c.scale), int32(c.fontSize*64), 0)
+		c.tracking = raster.Fix32(t) << 2
+	}
+}
+
+// setBounds sets the rasterizer's bounds to be big enough to handle the
+// largest glyph at the Context's scale and rotation.
+func (c *Context) setBounds() {
 	if c.font == nil {
 		c.r.SetBounds(0, 0)
 	} else {
-		// Set the rasterizer's bounds to be big enough to handle the largest glyph.
-		b := c.font.Bounds(c.scale)
-		xmin := +int(b.XMin) >> 6
+		b, bx := c.font.Bounds(c.scale), c.font.Bounds(c.horizontalScale(c.scale))
+		xmin := +int(bx.XMin) >> 6
 		ymin := -int(b.YMax) >> 6
-		xmax := +int(b.XMax+63) >> 6
+		xmax := +int(bx.XMax+63) >> 6
 		ymax := -int(b.YMin-63) >> 6
-		c.r.SetBounds(xmax-xmin, ymax-ymin)
-	}
-	for i := range c.cache {
-		c.cache[i] = cacheEntry{}
+		w, h := xmax-xmin, ymax-ymin
+		if c.rotation != 0 {
+			// A rotated glyph fits in a square whose side is the diagonal
+			// of the unrotated bounds.
+			d := int(math.Ceil(math.Hypot(float64(w), float64(h)))) + 1
+			w, h = d, d
+		}
+		// No glyph larger than the size limit is rasterized, so there is
+		// no need to allocate for one.
+		if n := c.sizeLimit; n > 0 {
+			if w > n {
+				w = n
+			}
+			if h > n {
+				h = n
+			}
+		}
+		if c.lcd != NoLCD {
+			// An LCD glyph mask's pixels are subpixels, and filtering
+			// spreads the glyph by up to a pixel on either side.
+			w = 3 * (w + 2)
+		}
+		c.r.SetBounds(w, h)
 	}
 }
 
@@ -299,6 +995,7 @@ func (c *Context) SetFont(font *truetype.Font) {
 		return
 	}
 	c.font = font
+	c.clearOutlines()
 	c.recalc()
 }
 
@@ -314,12 +1011,183 @@ func (c *Context) SetFontSize(fontSize float64) {
 // SetHinting sets the hinting policy.
 func (c *Context) SetHinting(hinting Hinting) {
 	c.hinting = hinting
-	for i := range c.cache {
-		c.cache[i] = cacheEntry{}
+	c.clearCache()
+}
+
+// SetGasp sets whether to honor the font's gasp table, which recommends
+// whether to hint glyphs at each size. If honor is true, glyphs are not hinted
+// at sizes for which the table recommends no grid-fitting, whatever the
+// hinting policy. As a Context always anti-aliases text, the table's
+// anti-aliasing recommendations are not used.
+func (c *Context) SetGasp(honor bool) {
+	if c.gasp == honor {
+		return
 	}
+	c.gasp = honor
+	c.recalc()
+}
+
+// SetTracking sets whether to apply the normal track of the font's AAT trak
+// table, which adjusts the spacing between glyphs for each size, such as to
+// tighten large text. The font's Tracking method gives the spacing of its
+// other tracks.
+func (c *Context) SetTracking(apply bool) {
+	if c.trak == apply {
+		return
+	}
+	c.trak = apply
+	// Tracking changes advances, not glyph masks, so the cache is kept.
+	c.setTracking()
+}
+
+// SetHinterOptions sets the options for the bytecode hinter, such as its
+// limits or a Profile to record hinting statistics. A nil o means to use the
+// defaults.
+func (c *Context) SetHinterOptions(o *truetype.HinterOptions) {
+	c.glyphBuf.SetHinterOptions(o)
+	c.clearCache()
+}
+
+// SetDarkening adjusts the coverage of rendered glyphs to tune the perceived
+// weight of text, which is most noticeable for small sizes. Coverage is
+// raised to the power of gamma, so that a gamma less than 1 darkens, and
+// emboldens, antialiased edges and a gamma greater than 1 lightens them. A
+// non-zero floor, in the range [0, 1], is the minimum coverage of any pixel
+// that a glyph touches, so that thin stems do not fade away. SetDarkening(1,
+// 0) restores the default of no adjustment.
+//
+// A gamma that is not positive and finite means no gamma adjustment, and a
+// floor that is not a number means no floor. The adjustment is made to the
+// rendered coverage, which does not record the direction of a glyph's edges,
+// so unlike FreeType's stem darkening, horizontal and vertical stems cannot be
+// adjusted separately.
+func (c *Context) SetDarkening(gamma, floor float64) {
+	if gamma == 1 || !(gamma > 0) || math.IsInf(gamma, 1) {
+		gamma = 0
+	}
+	if floor < 0 || math.IsNaN(floor) {
+		floor = 0
+	} else if floor > 1 {
+		floor = 1
+	}
+	f := uint32(floor * (1<<32 - 1))
+	if c.gamma == gamma && c.floor == f {
+		return
+	}
+	c.gamma, c.floor = gamma, f
+	c.gammaPainter = nil
+	if gamma != 0 {
+		c.gammaPainter = raster.NewGammaCorrectionPainter(nil, gamma)
+	}
+	c.clearCache()
+}
+
+// SetRotation sets the angle, in radians counter-clockwise, by which text is
+// rotated. Both the glyphs and the baseline along which they are advanced are
+// rotated about the point passed to DrawString. The angle is rounded to the
+// nearest multiple of a full turn divided by steps, and rendered glyphs are
+// cached for each such angle, so that text that rotates a little each frame,
+// such as map or chart labels, is not re-rasterized every frame. A
+// non-positive steps means DefaultRotationSteps. Glyphs are hinted before
+// they are rotated, so hinting is best turned off for rotated text.
+func (c *Context) SetRotation(angle float64, steps int) {
+	if steps <= 0 {
+		steps = DefaultRotationSteps
+	}
+	r := int(math.Floor(angle*float64(steps)/(2*math.Pi)+0.5)) % steps
+	if r < 0 {
+		r += steps
+	}
+	if c.rotation == r && (r == 0 || c.rotationSteps == steps) {
+		return
+	}
+	// The cached glyphs are keyed by rotation, so they need only be cleared
+	// if the rotation's units change.
+	if c.rotationSteps != 0 && c.rotationSteps != steps {
+		c.clearCache()
+	}
+	c.rotation, c.rotationSteps = r, steps
+	a := 2 * math.Pi * float64(r) / float64(steps)
+	c.cos, c.sin = math.Cos(a), math.Sin(a)
+	c.setBounds()
+}
+
+// SetWidthScale sets the horizontal scaling factor, so that condensed or
+// expanded styles can be synthesized from a regular font. A factor of 0.8
+// draws glyphs, their advance widths and kerning at 80% of their normal
+// width. The factor is applied before hinting, so that hinted glyphs are
+// still fitted to the pixel grid, unlike stretching the rendered text.
+func (c *Context) SetWidthScale(s float64) {
+	w := int32(s * (1 << 16))
+	if s <= 0 || w == 1<<16 {
+		w = 0
+	}
+	if c.widthScale == w {
+		return
+	}
+	c.widthScale = w
+	c.recalc()
+}
+
+// SetVariation sets the instance of a variable font to draw, as normalized
+// co-ordinates returned by the font's NormalizeVariation method. A nil coords
+// means the font's default instance.
+func (c *Context) SetVariation(coords []int16) {
+	c.glyphBuf.SetVariation(coords)
+	c.varied = len(coords) != 0
+	c.clearOutlines()
+	c.clearCache()
+}
+
+// SetGlyphSizeLimit sets the maximum width and height, in pixels, of a
+// rendered glyph, and the policy for glyphs that exceed it. A limit of zero
+// means no limit. Setting a limit protects against fonts or font sizes that
+// would otherwise require huge rasterizer allocations.
+func (c *Context) SetGlyphSizeLimit(limit int, policy OversizePolicy) {
+	if limit < 0 {
+		limit = 0
+	}
+	if c.sizeLimit == limit && c.oversize == policy {
+		return
+	}
+	c.sizeLimit = limit
+	c.oversize = policy
+	c.recalc()
+}
+
+// SetIndexMap sets the mapping from the glyph indexes passed to DrawGlyphs to
+// the glyph indexes of the Context's font. This lets text that refers to an
+// original font's glyphs be drawn with a subset of that font. Glyphs that are
+// not in the subset are drawn as the missing glyph. A nil map means that the
+// glyph indexes are used as is.
+func (c *Context) SetIndexMap(m *truetype.IndexMap) {
+	c.indexMap = m
+}
+
+// SetDigits sets the script in which DrawString draws the European digits
+// '0' to '9', such as for Arabic or Persian text whose numbers are stored
+// with European digits. Digits that the font lacks are drawn as is.
+func (c *Context) SetDigits(d Digits) {
+	c.digits = d
+}
+
+// SetConcurrent sets whether the Context's drawing methods, such as
+// DrawString, may be called concurrently from multiple goroutines. If so, the
+// Context's glyph cache and rasterizer are guarded by locks, which costs a
+// little performance even when there is no contention. Each goroutine still
+// draws onto the same destination image, so concurrent calls should draw
+// onto disjoint parts of it, or onto an image whose Set methods are safe for
+// concurrent use. The Context's setters, such as SetFontSize, must not be
+// called concurrently with each other or with drawing methods. Using one
+// Context per goroutine avoids locking altogether, and is preferable where
+// possible.
+func (c *Context) SetConcurrent(concurrent bool) {
+	c.concurrent = concurrent
 }
 
-// SetDst sets the destination image for draw operations.
+// SetDst sets the destination image for draw operations. With no destination
+// image, draw operations only measure, and drawing a glyph inside the clip
+// rectangle is an error.
 func (c *Context) SetDst(dst draw.Image) {
 	c.dst = dst
 }
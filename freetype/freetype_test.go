@@ -6,14 +6,43 @@
 package freetype
 
 import (
+	"bytes"
+	"context"
+	"fmt"
 	"image"
+	"image/color"
 	"image/draw"
 	"io/ioutil"
+	"math"
 	"runtime"
 	"strings"
 	"testing"
+	"unicode"
+
+	"github.com/lukevers/freetype-go/freetype/raster"
+	"github.com/lukevers/freetype-go/freetype/truetype"
 )
 
+// parseTestFont parses the named font in the testdata directory.
+func parseTestFont(t *testing.T, name string) *truetype.Font {
+	data, err := ioutil.ReadFile("../testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	font, err := ParseFont(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return font
+}
+
+// newTestContext returns a new Context that draws with the Luxi Sans font.
+func newTestContext(t *testing.T) *Context {
+	c := NewContext()
+	c.SetFont(parseTestFont(t, "luxisr.ttf"))
+	return c
+}
+
 func BenchmarkDrawString(b *testing.B) {
 	data, err := ioutil.ReadFile("../licenses/gpl.txt")
 	if err != nil {
@@ -57,3 +86,1037 @@ func BenchmarkDrawString(b *testing.B) {
 	mallocs = ms.Mallocs - mallocs
 	b.Logf("%d iterations, %d mallocs per iteration\n", b.N, int(mallocs)/b.N)
 }
+
+func TestGlyphSizeLimit(t *testing.T) {
+	dst := image.NewAlpha(image.Rect(0, 0, 64, 64))
+
+	c := newTestContext(t)
+	c.SetDst(dst)
+	c.SetClip(dst.Bounds())
+	c.SetSrc(image.Opaque)
+	c.SetFontSize(1000)
+
+	c.SetGlyphSizeLimit(100, RejectOversize)
+	_, err := c.DrawString("A", Pt(0, 50))
+	if _, ok := err.(*OversizeError); !ok {
+		t.Fatalf("RejectOversize: got error %v, want an *OversizeError", err)
+	}
+
+	c.SetGlyphSizeLimit(100, DownscaleOversize)
+	p, err := c.DrawString("A", Pt(0, 50))
+	if err != nil {
+		t.Fatalf("DownscaleOversize: %v", err)
+	}
+	// The advance width is that of the full-sized glyph.
+	if p.X < 100<<8 {
+		t.Errorf("DownscaleOversize: got advance %d, want at least %d", p.X, 100<<8)
+	}
+}
+
+func TestDrawStringBidiControls(t *testing.T) {
+	c := newTestContext(t)
+	c.SetDst(image.NewAlpha(image.Rect(0, 0, 100, 20)))
+	c.SetSrc(image.Opaque)
+
+	want, err := c.DrawString("AV", Pt(0, 15))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.DrawString("\u2067A\u202bV\u202c\u200f\u2069", Pt(0, 15))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestDrawStringClipped(t *testing.T) {
+	c := newTestContext(t)
+	c.SetSrc(image.Opaque)
+	c.SetFontSize(20)
+
+	want := image.NewAlpha(image.Rect(0, 0, 40, 30))
+	c.SetDst(want)
+	c.SetClip(want.Bounds())
+	if _, err := c.DrawString("AW", Pt(2, 22)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Draw into a sub-image whose bounds cut through the glyphs. The pixels
+	// inside the clip should be the same as for the unclipped image.
+	got := image.NewAlpha(image.Rect(0, 0, 40, 30))
+	clip := image.Rect(7, 9, 31, 25)
+	c.SetDst(got.SubImage(clip).(*image.Alpha))
+	c.SetClip(clip)
+	if _, err := c.DrawString("AW", Pt(2, 22)); err != nil {
+		t.Fatal(err)
+	}
+	for y := 0; y < 30; y++ {
+		for x := 0; x < 40; x++ {
+			w := uint8(0)
+			if (image.Point{x, y}).In(clip) {
+				w = want.AlphaAt(x, y).A
+			}
+			if g := got.AlphaAt(x, y).A; g != w {
+				t.Fatalf("(%d, %d): got %#02x, want %#02x", x, y, g, w)
+			}
+		}
+	}
+}
+
+func TestSetDarkening(t *testing.T) {
+	c := newTestContext(t)
+	c.SetSrc(image.Opaque)
+	c.SetFontSize(9)
+	draw := func() (sum, partial int) {
+		dst := image.NewAlpha(image.Rect(0, 0, 60, 20))
+		c.SetDst(dst)
+		c.SetClip(dst.Bounds())
+		if _, err := c.DrawString("ilm", Pt(2, 15)); err != nil {
+			t.Fatal(err)
+		}
+		for _, a := range dst.Pix {
+			sum += int(a)
+			if a != 0 && a != 0xff {
+				partial++
+			}
+		}
+		return sum, partial
+	}
+
+	sum0, partial0 := draw()
+	if partial0 == 0 {
+		t.Fatal("no antialiased pixels")
+	}
+	c.SetDarkening(0.5, 0)
+	if sum, _ := draw(); sum <= sum0 {
+		t.Errorf("gamma 0.5: got coverage %d, want more than %d", sum, sum0)
+	}
+	c.SetDarkening(2, 0)
+	if sum, _ := draw(); sum >= sum0 {
+		t.Errorf("gamma 2: got coverage %d, want less than %d", sum, sum0)
+	}
+	c.SetDarkening(1, 1)
+	if _, partial := draw(); partial != 0 {
+		t.Errorf("floor 1: got %d antialiased pixels, want 0", partial)
+	}
+	c.SetDarkening(1, 0)
+	if sum, _ := draw(); sum != sum0 {
+		t.Errorf("reset: got coverage %d, want %d", sum, sum0)
+	}
+	for _, gamma := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		c.SetDarkening(gamma, math.NaN())
+		if sum, _ := draw(); sum != sum0 {
+			t.Errorf("gamma %v: got coverage %d, want %d", gamma, sum, sum0)
+		}
+	}
+}
+
+func TestSetWidthScale(t *testing.T) {
+	for _, h := range []Hinting{NoHinting, FullHinting} {
+		c := newTestContext(t)
+		c.SetDst(image.NewAlpha(image.Rect(0, 0, 200, 40)))
+		c.SetSrc(image.Opaque)
+		c.SetFontSize(24)
+		c.SetHinting(h)
+		p0, err := c.DrawString("HHHH", Pt(0, 30))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.SetWidthScale(0.5)
+		p1, err := c.DrawString("HHHH", Pt(0, 30))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := p0.X/2 - p1.X; d < -4<<8 || d > 4<<8 {
+			t.Errorf("hinting=%d: got advance %d, want approximately %d", h, p1.X, p0.X/2)
+		}
+	}
+}
+
+func TestSetRotation(t *testing.T) {
+	c := newTestContext(t)
+	c.SetSrc(image.Opaque)
+	c.SetFontSize(20)
+	draw := func() (*image.Alpha, raster.Point) {
+		dst := image.NewAlpha(image.Rect(0, 0, 100, 100))
+		c.SetDst(dst)
+		c.SetClip(dst.Bounds())
+		p, err := c.DrawString("HH", Pt(50, 50))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dst, p
+	}
+
+	m0, p0 := draw()
+	// A quarter turn counter-clockwise advances up the page, and puts the
+	// glyphs left of the point.
+	c.SetRotation(math.Pi/2, 4)
+	m1, p1 := draw()
+	if p1.X != Pt(50, 50).X || p1.Y != Pt(50, 50).Y-(p0.X-Pt(50, 50).X) {
+		t.Errorf("rotated advance: got %v, want (%v, %v)", p1, Pt(50, 50).X, Pt(50, 50).Y-(p0.X-Pt(50, 50).X))
+	}
+	// The unrotated pixel at (50+dx, 50-dy) is at (50-dy, 49-dx) when
+	// rotated. Only the first glyph is checked, as the second one is at a
+	// sub-pixel offset, which the glyph cache quantizes differently in x and
+	// y.
+	n, diff := 0, 0
+	for dy := 0; dy < 20; dy++ {
+		for dx := 0; dx < int(p0.X-Pt(50, 50).X)>>9; dx++ {
+			a0 := int(m0.AlphaAt(50+dx, 50-dy).A)
+			a1 := int(m1.AlphaAt(50-dy, 49-dx).A)
+			if a0 != 0 {
+				n++
+			}
+			if a0-a1 > 8 || a1-a0 > 8 {
+				diff++
+			}
+		}
+	}
+	if n == 0 || diff > 0 {
+		t.Errorf("rotated glyphs: %d of %d pixels differ", diff, n)
+	}
+
+	// Returning to a previous angle draws its cached glyphs, unless the
+	// number of steps changes.
+	rotated := func() map[int]*image.Alpha {
+		m := map[int]*image.Alpha{}
+		for i, e := range c.cache {
+			if e.valid && e.rotation == 1 {
+				m[i] = e.mask
+			}
+		}
+		return m
+	}
+	masks := rotated()
+	c.SetRotation(0, 4)
+	draw()
+	c.SetRotation(math.Pi/2, 4)
+	draw()
+	if got := rotated(); len(masks) == 0 || fmt.Sprint(got) != fmt.Sprint(masks) {
+		t.Errorf("cached rotated glyphs: got %v, want %v", got, masks)
+	}
+	c.SetRotation(math.Pi/4, 8)
+	if got := rotated(); len(got) != 0 {
+		t.Errorf("cached rotated glyphs after changing steps: got %d, want 0", len(got))
+	}
+
+	// An angle that rounds to zero steps is no rotation.
+	c.SetRotation(0.001, 0)
+	if _, p := draw(); p != p0 {
+		t.Errorf("small rotation: got %v, want %v", p, p0)
+	}
+}
+
+func TestTestVectors(t *testing.T) {
+	fonts := map[string]*truetype.Font{}
+	for _, v := range TestVectors {
+		font := fonts[v.Font]
+		if font == nil {
+			font = parseTestFont(t, v.Font)
+			fonts[v.Font] = font
+		}
+		if err := v.Check(font); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestConcurrentDrawString(t *testing.T) {
+	const n = 8
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = strings.Repeat(string('a'+rune(i)), 10) + " The quick brown fox."
+	}
+	draw := func(concurrent bool) *image.Alpha {
+		dst := image.NewAlpha(image.Rect(0, 0, 300, 20*n))
+		c := newTestContext(t)
+		c.SetDst(dst)
+		c.SetClip(dst.Bounds())
+		c.SetSrc(image.Opaque)
+		// Hinting puts every glyph at a whole pixel offset, so that the
+		// result does not depend on which offset a cached glyph was first
+		// rasterized at.
+		c.SetHinting(FullHinting)
+		c.SetConcurrent(concurrent)
+		errc := make(chan error, n)
+		for i, line := range lines {
+			// Each line is drawn onto its own rows of dst.
+			f := func(i int, line string) {
+				_, err := c.DrawString(line, Pt(2, 20*i+15))
+				errc <- err
+			}
+			if concurrent {
+				go f(i, line)
+			} else {
+				f(i, line)
+			}
+		}
+		for range lines {
+			if err := <-errc; err != nil {
+				t.Fatal(err)
+			}
+		}
+		return dst
+	}
+	want := draw(false)
+	for i := 0; i < 10; i++ {
+		if got := draw(true); string(got.Pix) != string(want.Pix) {
+			t.Fatalf("iteration %d: concurrent drawing differs from sequential drawing", i)
+		}
+	}
+}
+
+func TestLayout(t *testing.T) {
+	font := parseTestFont(t, "luxisr.ttf")
+	const text = "Ta\u200ev w\u00f6rld"
+	newContext := func(dst *image.Alpha, size float64) *Context {
+		c := NewContext()
+		c.SetDst(dst)
+		c.SetClip(dst.Bounds())
+		c.SetSrc(image.Opaque)
+		c.SetFont(font)
+		c.SetFontSize(size)
+		return c
+	}
+
+	want := image.NewAlpha(image.Rect(0, 0, 200, 40))
+	wantP, err := newContext(want, 18).DrawString(text, Pt(5, 30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := image.NewAlpha(want.Bounds())
+	c := newContext(got, 18)
+	glyphs, gotP, err := c.Layout(text, Pt(5, 30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotP != wantP {
+		t.Errorf("Layout: got advanced point %v, want %v", gotP, wantP)
+	}
+	if err := c.DrawLayout(glyphs); err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Pix) != string(want.Pix) {
+		t.Errorf("DrawLayout drew differently from DrawString")
+	}
+
+	// The IDs are the runes' byte offsets, skipping the bidi control, and
+	// are the same at a different size.
+	wantIDs := []int{0, 1, 5, 6, 7, 8, 10, 11, 12}
+	small, _, err := newContext(got, 9).Layout(text, Pt(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, gs := range [][]GlyphPosition{glyphs, small} {
+		if len(gs) != len(wantIDs) {
+			t.Fatalf("got %d glyphs, want %d", len(gs), len(wantIDs))
+		}
+		for i, g := range gs {
+			if g.ID != wantIDs[i] || g.Rune != []rune(text[g.ID:])[0] {
+				t.Errorf("glyph %d: got ID %d, rune %q, want ID %d", i, g.ID, g.Rune, wantIDs[i])
+			}
+		}
+	}
+	if glyphs[1].Dot == small[1].Dot {
+		t.Errorf("glyph positions do not depend on the font size")
+	}
+
+	// Add a GSUB table whose "liga" feature ligates "Ta" into the glyph for
+	// '&', and whose "smcp" feature substitutes 'V' for 'v'.
+	u16s := func(v ...int) []byte {
+		b := make([]byte, 2*len(v))
+		for i, x := range v {
+			b[2*i], b[2*i+1] = byte(x>>8), byte(x)
+		}
+		return b
+	}
+	idx := func(r rune) int { return int(font.Index(r)) }
+	liga := u16s(
+		4, 0, 1, 8, // Ligature substitution lookup with one subtable.
+		1, 18, 1, 8, // Format, coverage offset and ligature set offsets.
+		1, 4, idx('&'), 2, idx('a'), // Ligature set.
+		1, 1, idx('T'), // Coverage.
+	)
+	smcp := u16s(
+		1, 0, 1, 8, // Single substitution lookup with one subtable.
+		2, 8, 1, idx('V'), // Format, coverage offset and substitutes.
+		1, 1, idx('v'), // Coverage.
+	)
+	featureList := append(u16s(2), "liga"...)
+	featureList = append(featureList, u16s(14)...)
+	featureList = append(featureList, "smcp"...)
+	featureList = append(featureList, u16s(20, 0, 1, 0, 0, 1, 1)...)
+	gsub := append(u16s(1, 0, 0, 10, 10+len(featureList)), featureList...)
+	gsub = append(gsub, u16s(2, 6, 6+len(liga))...)
+	gsub = append(gsub, liga...)
+	gsub = append(gsub, smcp...)
+	data, err := font.Write(&truetype.WriteOptions{Tables: map[string][]byte{"GSUB": gsub}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if font, err = ParseFont(data); err != nil {
+		t.Fatal(err)
+	}
+
+	// The default features are applied without being asked for, by Layout
+	// and DrawString alike.
+	want = image.NewAlpha(want.Bounds())
+	wantP, err = newContext(want, 18).DrawString(text, Pt(5, 30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = image.NewAlpha(want.Bounds())
+	c = newContext(got, 18)
+	glyphs, gotP, err = c.Layout(text, Pt(5, 30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotP != wantP {
+		t.Errorf("GSUB: Layout: got advanced point %v, want %v", gotP, wantP)
+	}
+	if err := c.DrawLayout(glyphs); err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Pix) != string(want.Pix) {
+		t.Errorf("GSUB: DrawLayout drew differently from DrawString")
+	}
+	if len(glyphs) != len(wantIDs)-1 {
+		t.Fatalf("GSUB: got %d glyphs, want %d", len(glyphs), len(wantIDs)-1)
+	}
+	if g := glyphs[0]; g.ID != 0 || g.Rune != 'T' || g.Index != font.Index('&') {
+		t.Errorf("GSUB: got ligature %+v, want ID 0, rune 'T', index %d", g, font.Index('&'))
+	}
+	if g := glyphs[1]; g.ID != 5 || g.Index != font.Index('v') {
+		t.Errorf("GSUB: got glyph %+v, want ID 5, index %d", g, font.Index('v'))
+	}
+
+	// Features that are given replace the default ones.
+	glyphs, _, err = c.Layout(text, Pt(5, 30), "smcp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(glyphs) != len(wantIDs) {
+		t.Fatalf("smcp: got %d glyphs, want %d", len(glyphs), len(wantIDs))
+	}
+	if got, want := glyphs[0].Index, font.Index('T'); got != want {
+		t.Errorf("smcp: glyph 0: got index %d, want %d", got, want)
+	}
+	if got, want := glyphs[2].Index, font.Index('V'); got != want {
+		t.Errorf("smcp: glyph 2: got index %d, want %d", got, want)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	font := parseTestFont(t, "luxisr.ttf")
+	c := NewContext()
+	c.SetFont(font)
+	c.SetFontSize(12)
+	m := c.Metrics()
+	for _, x := range []raster.Fix32{m.Height, m.Ascent, m.Descent} {
+		if x <= 0 || x&0xff != 0 {
+			t.Fatalf("got %+v, want positive whole pixels", m)
+		}
+	}
+	if m.Height < m.Ascent+m.Descent {
+		t.Errorf("got %+v, want Height at least Ascent+Descent", m)
+	}
+
+	c.SetHinting(FullHinting)
+	advance, ok := c.GlyphAdvance('m')
+	if !ok || advance <= 0 || advance&0xff != 0 {
+		t.Errorf("GlyphAdvance('m'): got %v, %t, want a positive whole pixel advance", advance, ok)
+	}
+	// The Context has no destination image, so DrawString only measures.
+	p, err := c.DrawString("m", Pt(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.X != advance {
+		t.Errorf("GlyphAdvance('m'): got %v, want the DrawString extent %v", advance, p.X)
+	}
+	if _, ok := c.GlyphAdvance('\u4e00'); ok {
+		t.Errorf("GlyphAdvance('\\u4e00'): got ok, want not ok")
+	}
+
+	// The advances that are read from the font's metrics, and those of glyphs
+	// that are loaded, match the advances of drawn glyphs.
+	for _, h := range []Hinting{NoHinting, FullHinting, VerticalHinting, AutoHinting} {
+		c.SetHinting(h)
+		for _, size := range []float64{7, 12, 13.5, 20} {
+			c.SetFontSize(size)
+			for r := rune(' '); r <= '~'; r++ {
+				advance, _ := c.GlyphAdvance(r)
+				p, err := c.DrawString(string(r), Pt(0, 0))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if p.X != advance {
+					t.Errorf("hinting %d, size %v: GlyphAdvance(%q): got %v, want %v", h, size, r, advance, p.X)
+				}
+			}
+		}
+	}
+	c.SetHinting(FullHinting)
+	c.SetFontSize(12)
+	if got, want := c.Kern('A', 'V'), raster.Fix32(c.font.Kern(c.scale, font.Index('A'), font.Index('V'))<<2+128)&^255; got != want || got == 0 {
+		t.Errorf("Kern('A', 'V'): got %v, want %v, non-zero", got, want)
+	}
+	advance, mask, offset, ok, err := c.GlyphMask('m', Pt(10, 20))
+	if err != nil || !ok || mask == nil {
+		t.Fatalf("GlyphMask('m'): got %v, %t, %v", mask, ok, err)
+	}
+	if want, _ := c.GlyphAdvance('m'); advance != want {
+		t.Errorf("GlyphMask('m'): got advance %v, want %v", advance, want)
+	}
+	if b := mask.Bounds().Add(offset); !b.Overlaps(image.Rect(10, 10, 20, 20)) {
+		t.Errorf("GlyphMask('m'): got bounds %v, want them above and right of (10, 20)", b)
+	}
+}
+
+func TestInkBounds(t *testing.T) {
+	dst := image.NewAlpha(image.Rect(0, 0, 200, 60))
+	c := newTestContext(t)
+	c.SetDst(dst)
+	c.SetClip(dst.Bounds())
+	c.SetSrc(image.Opaque)
+	c.SetFontSize(24)
+	const text = "Jig, quay."
+	got, err := c.InkBounds(text, Pt(10, 40))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.DrawString(text, Pt(10, 40)); err != nil {
+		t.Fatal(err)
+	}
+	if want := inkBounds(dst); got != want {
+		t.Errorf("got %v, want the drawn pixels' bounds %v", got, want)
+	}
+	if got, err := c.InkBounds("  ", Pt(10, 40)); err != nil || !got.Empty() {
+		t.Errorf("spaces: got %v, %v, want an empty rectangle", got, err)
+	}
+}
+
+func TestBudget(t *testing.T) {
+	font := parseTestFont(t, "luxisr.ttf")
+	const text = "The quick brown fox jumps over 13 lazy dogs."
+	draw := func(c *Context, size float64) []byte {
+		dst := image.NewAlpha(image.Rect(0, 0, 800, 60))
+		c.SetDst(dst)
+		c.SetClip(dst.Bounds())
+		c.SetSrc(image.Opaque)
+		c.SetFont(font)
+		c.SetFontSize(size)
+		c.SetHinting(FullHinting)
+		if _, err := c.DrawString(text, Pt(10, 40)); err != nil {
+			t.Fatal(err)
+		}
+		return dst.Pix
+	}
+	want12, want24 := draw(NewContext(), 12), draw(NewContext(), 24)
+
+	// The Contexts' rasterizer and hinter buffers use about 32 KiB, which
+	// leaves about 4 KiB for their caches.
+	b := NewBudget(36 << 10)
+	c0, c1 := NewContext(), NewContext()
+	c0.SetBudget(b)
+	c1.SetBudget(b)
+	for i := 0; i < 3; i++ {
+		if got := draw(c0, 12); !bytes.Equal(got, want12) {
+			t.Fatalf("pass %d: 12pt text differs from that drawn without a budget", i)
+		}
+		if got := draw(c1, 24); !bytes.Equal(got, want24) {
+			t.Fatalf("pass %d: 24pt text differs from that drawn without a budget", i)
+		}
+		if used := b.Used(); used <= 0 || used > b.Limit() {
+			t.Fatalf("pass %d: used %d bytes, want in (0, %d]", i, used, b.Limit())
+		}
+	}
+	c0.SetBudget(nil)
+	c1.SetBudget(nil)
+	if used := b.Used(); used != 0 {
+		t.Errorf("after removing the budget: used %d bytes, want 0", used)
+	}
+
+	// A font's caches are charged to the Budget that it is parsed with, and
+	// rebuilt after they are evicted.
+	b = NewBudget(1 << 20)
+	data, err := ioutil.ReadFile("../testdata/luxisr.ttf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := truetype.ParseWithOptions(data, &truetype.ParseOptions{Budget: b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	used := b.Used()
+	if got := string(f.Runes(f.Index('A'))); got != "A" {
+		t.Fatalf("Runes: got %q, want \"A\"", got)
+	}
+	if b.Used() <= used {
+		t.Errorf("Runes: used %d bytes, want more than %d", b.Used(), used)
+	}
+	used = b.Used()
+	g := truetype.NewGlyphBuf()
+	if err := g.Load(f, 12<<6, f.Index('A'), truetype.FullHinting); err != nil {
+		t.Fatal(err)
+	}
+	if b.Used() <= used {
+		t.Errorf("hinting: used %d bytes, want more than %d", b.Used(), used)
+	}
+	release := b.Charge(b.Limit()-budgetEntryOverhead, func() {})
+	if used := b.Used(); used != b.Limit() {
+		t.Errorf("after evicting the font's caches: used %d bytes, want %d", used, b.Limit())
+	}
+	if got := string(f.Runes(f.Index('A'))); got != "A" {
+		t.Errorf("Runes after eviction: got %q, want \"A\"", got)
+	}
+	release()
+	if err := g.Load(f, 12<<6, f.Index('B'), truetype.FullHinting); err != nil {
+		t.Fatalf("hinting after eviction: %v", err)
+	}
+}
+
+func TestPathOutline(t *testing.T) {
+	testCases := []struct {
+		d    string
+		want string
+	}{
+		{"M0 0 H100 V100 H0 Z", "[{0 0 1} {100 0 1} {100 -100 1} {0 -100 1}] [4]"},
+		{"m10,10 20,0 0,20z", "[{10 -10 1} {30 -10 1} {30 -30 1}] [3]"},
+		{"M0 0 Q50 100 100 0 T200 0", "[{0 0 1} {50 -100 0} {100 0 1} {150 100 0} {200 0 1}] [5]"},
+		{"M0 0 L10 0 10 10 M20 20 h5 v5 z", "[{0 0 1} {10 0 1} {10 -10 1} {20 -20 1} {25 -20 1} {25 -25 1}] [3 6]"},
+		{"M1.5.5-1-2", "[{2 -1 1} {-1 2 1}] [2]"},
+	}
+	for _, tc := range testCases {
+		p, err := raster.ParseSVGPath(tc.d)
+		if err != nil {
+			t.Errorf("%q: %v", tc.d, err)
+			continue
+		}
+		points, ends := PathOutline(p, 16)
+		if got := fmt.Sprint(points, ends); got != tc.want {
+			t.Errorf("%q: got %s, want %s", tc.d, got, tc.want)
+		}
+	}
+
+	// A circle made of arcs is approximated by quadratic segments whose
+	// on-curve points are on the circle.
+	p, err := raster.ParseSVGPath("M100 50 A50 50 0 0 1 0 50 A50 50 0 0 1 100 50 Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	points, ends := PathOutline(p, 16)
+	if len(ends) != 1 || len(points) < 8 {
+		t.Fatalf("circle: got %d points and %d contours, want at least 8 and 1", len(points), len(ends))
+	}
+	for _, q := range points {
+		if q.Flags&0x01 == 0 {
+			continue
+		}
+		dx, dy := float64(q.X-50), float64(q.Y+50)
+		if r := math.Sqrt(dx*dx + dy*dy); r < 49 || 51 < r {
+			t.Errorf("circle: point %v is at radius %g, want 50", q, r)
+		}
+	}
+
+	for _, d := range []string{"L1 2", "M1", "M0 0 z 1 2", "M0 0 A1 1 0 2 0 3 3", "M0 0 X"} {
+		if _, err := raster.ParseSVGPath(d); err == nil {
+			t.Errorf("%q: got nil error, want non-nil", d)
+		}
+	}
+}
+
+func TestLCD(t *testing.T) {
+	font := parseTestFont(t, "luxisr.ttf")
+	const text = "Hello, world."
+	draw := func(lcd LCDMode, hinting Hinting) (*image.RGBA, image.Rectangle) {
+		dst := image.NewRGBA(image.Rect(0, 0, 160, 30))
+		draw.Draw(dst, dst.Bounds(), image.White, image.ZP, draw.Src)
+		c := NewContext()
+		c.SetDst(dst)
+		c.SetClip(dst.Bounds())
+		c.SetSrc(image.Black)
+		c.SetFont(font)
+		c.SetFontSize(14)
+		c.SetHinting(hinting)
+		c.SetLCD(lcd)
+		ink, err := c.InkBounds(text, Pt(5, 20))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.DrawString(text, Pt(5, 20)); err != nil {
+			t.Fatal(err)
+		}
+		return dst, ink
+	}
+
+	rgb, ink := draw(LCDRGB, FullHinting)
+	fringes := 0
+	drawn := image.Rectangle{}
+	for y := 0; y < rgb.Bounds().Dy(); y++ {
+		for x := 0; x < rgb.Bounds().Dx(); x++ {
+			p := rgb.RGBAAt(x, y)
+			if p.R != p.G || p.G != p.B {
+				fringes++
+			}
+			if p != (color.RGBA{0xff, 0xff, 0xff, 0xff}) {
+				drawn = drawn.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	if fringes == 0 {
+		t.Errorf("LCDRGB: got no pixels with differing color channels")
+	}
+	if !drawn.In(ink) {
+		t.Errorf("LCDRGB: drawn pixels' bounds %v are not within the ink bounds %v", drawn, ink)
+	}
+
+	// Hinting only applies in the y direction, so FullHinting draws the
+	// same as VerticalHinting.
+	if vertical, _ := draw(LCDRGB, VerticalHinting); !bytes.Equal(rgb.Pix, vertical.Pix) {
+		t.Errorf("LCDRGB: FullHinting differs from VerticalHinting")
+	}
+
+	// BGR is RGB with the red and blue channels swapped.
+	bgr, _ := draw(LCDBGR, FullHinting)
+	for i := 0; i < len(bgr.Pix); i += 4 {
+		if bgr.Pix[i] != rgb.Pix[i+2] || bgr.Pix[i+1] != rgb.Pix[i+1] || bgr.Pix[i+2] != rgb.Pix[i] {
+			t.Fatalf("LCDBGR: pixel %d is %v, want LCDRGB's %v with red and blue swapped", i/4, bgr.Pix[i:i+4], rgb.Pix[i:i+4])
+		}
+	}
+
+	// With no destination image, drawing only measures, unless it would draw
+	// inside the clip rectangle.
+	for _, lcd := range []LCDMode{NoLCD, LCDRGB} {
+		c := NewContext()
+		c.SetFont(font)
+		c.SetLCD(lcd)
+		if _, err := c.DrawString(text, Pt(5, 20)); err != nil {
+			t.Errorf("LCD mode %v, no clip: got %v, want no error", lcd, err)
+		}
+		c.SetClip(image.Rect(0, 0, 160, 30))
+		if _, err := c.DrawString(text, Pt(5, 20)); err == nil {
+			t.Errorf("LCD mode %v, clip: got no error, want one", lcd)
+		}
+	}
+}
+
+func TestLCDFilter(t *testing.T) {
+	c := newTestContext(t)
+	c.SetClip(image.Rect(0, 0, 160, 30))
+	c.SetSrc(image.Black)
+	c.SetFontSize(14)
+	c.SetLCD(LCDRGB)
+	render := func(f LCDFilter) []byte {
+		dst := image.NewRGBA(image.Rect(0, 0, 160, 30))
+		draw.Draw(dst, dst.Bounds(), image.White, image.ZP, draw.Src)
+		c.SetDst(dst)
+		c.SetLCDFilter(f)
+		if _, err := c.DrawString("Hello, world.", Pt(5, 20)); err != nil {
+			t.Fatal(err)
+		}
+		return dst.Pix
+	}
+
+	def := render(DefaultLCDFilter)
+	if got := render(LCDFilter{}); !bytes.Equal(got, def) {
+		t.Errorf("zero LCDFilter differs from DefaultLCDFilter")
+	}
+	filters := []struct {
+		name string
+		f    LCDFilter
+	}{
+		{"light", LightLCDFilter},
+		{"legacy", LegacyLCDFilter},
+		{"custom", LCDFilter{Weights: [5]uint8{0x10, 0x40, 0x70, 0x40, 0x10}}},
+	}
+	for _, tc := range filters {
+		if got := render(tc.f); bytes.Equal(got, def) {
+			t.Errorf("%s: drew the same as DefaultLCDFilter", tc.name)
+		}
+	}
+	// Changing the filter must not reuse glyphs cached with the old one.
+	if got := render(DefaultLCDFilter); !bytes.Equal(got, def) {
+		t.Errorf("DefaultLCDFilter after other filters: drew differently")
+	}
+}
+
+func TestPreload(t *testing.T) {
+	newContext := func() *Context {
+		c := newTestContext(t)
+		c.SetClip(image.Rect(0, 0, 100, 30))
+		c.SetSrc(image.Black)
+		c.SetFontSize(14)
+		// Hinting keeps glyphs at whole pixels, so that drawing without
+		// preloading rasterizes them at the same sub-pixel offsets.
+		c.SetHinting(FullHinting)
+		return c
+	}
+	cached := func(c *Context) int {
+		n := 0
+		for _, e := range c.cache {
+			if e.valid {
+				n++
+			}
+		}
+		return n
+	}
+
+	// "Hello" has 4 distinct glyphs, and U+0030 to U+0039 has 10.
+	digits := &unicode.RangeTable{R16: []unicode.Range16{{Lo: '0', Hi: '9', Stride: 1}}}
+	c := newContext()
+	if err := c.Preload("Hello", digits); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := cached(c), 14*nXFractions*nYFractions; got != want {
+		t.Errorf("cached glyphs after Preload: got %d, want %d", got, want)
+	}
+
+	// Drawing preloaded glyphs does not rasterize them again, and draws the
+	// same as without preloading.
+	dst0 := image.NewRGBA(image.Rect(0, 0, 100, 30))
+	c.SetDst(dst0)
+	if _, err := c.DrawString("Hello 42", Pt(3, 20)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := cached(c), 15*nXFractions*nYFractions; got > want {
+		t.Errorf("cached glyphs after DrawString: got %d, want at most %d", got, want)
+	}
+	dst1 := image.NewRGBA(image.Rect(0, 0, 100, 30))
+	c1 := newContext()
+	c1.SetDst(dst1)
+	if _, err := c1.DrawString("Hello 42", Pt(3, 20)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dst0.Pix, dst1.Pix) {
+		t.Errorf("DrawString after Preload: drew differently")
+	}
+
+	if err := <-newContext().PreloadAsync("Hello"); err == nil {
+		t.Errorf("PreloadAsync on a non-concurrent Context: got nil error, want non-nil")
+	}
+	c = newContext()
+	c.SetConcurrent(true)
+	if err := <-c.PreloadAsync("Hello"); err != nil {
+		t.Fatalf("PreloadAsync: %v", err)
+	}
+	if got, want := cached(c), 4*nXFractions*nYFractions; got != want {
+		t.Errorf("cached glyphs after PreloadAsync: got %d, want %d", got, want)
+	}
+}
+
+func TestDrawStringContext(t *testing.T) {
+	newContext := func(dst *image.Alpha, o *truetype.HinterOptions) *Context {
+		c := newTestContext(t)
+		c.SetDst(dst)
+		c.SetClip(dst.Bounds())
+		c.SetSrc(image.Opaque)
+		c.SetFontSize(18)
+		c.SetHinting(FullHinting)
+		c.SetHinterOptions(o)
+		return c
+	}
+	want := image.NewAlpha(image.Rect(0, 0, 200, 40))
+	if _, err := newContext(want, nil).DrawString("Hello", Pt(5, 30)); err != nil {
+		t.Fatal(err)
+	}
+
+	// A done context draws nothing.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got := image.NewAlpha(want.Bounds())
+	if _, err := newContext(got, nil).DrawStringContext(ctx, "Hello", Pt(5, 30)); err != context.Canceled {
+		t.Errorf("done context: got error %v, want %v", err, context.Canceled)
+	}
+	if !bytes.Equal(got.Pix, make([]byte, len(got.Pix))) {
+		t.Errorf("done context: drew pixels")
+	}
+
+	// Hinting stops once the context is done, and the Context can draw
+	// normally afterwards.
+	ctx, cancel = context.WithCancel(context.Background())
+	steps := 0
+	c := newContext(got, &truetype.HinterOptions{
+		Trace: func(*truetype.TraceEvent) {
+			if steps++; steps == 100 {
+				cancel()
+			}
+		},
+	})
+	if _, err := c.DrawStringContext(ctx, "Hello", Pt(5, 30)); err != context.Canceled {
+		t.Errorf("cancelled while hinting: got error %v, want %v", err, context.Canceled)
+	}
+	got = image.NewAlpha(want.Bounds())
+	c.SetDst(got)
+	if _, err := c.DrawString("Hello", Pt(5, 30)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Pix, want.Pix) {
+		t.Errorf("drawing after cancellation differs")
+	}
+}
+
+func TestSetGasp(t *testing.T) {
+	font := parseTestFont(t, "luxisr.ttf")
+	// The font's gasp table recommends no grid-fitting at 8 ppem and below.
+	render := func(size float64, h Hinting, gasp bool) []byte {
+		dst := image.NewAlpha(image.Rect(0, 0, 100, 20))
+		c := NewContext()
+		c.SetDst(dst)
+		c.SetClip(dst.Bounds())
+		c.SetSrc(image.Opaque)
+		c.SetFont(font)
+		c.SetFontSize(size)
+		c.SetHinting(h)
+		c.SetGasp(gasp)
+		if _, err := c.DrawString("Hello", Pt(0, 15)); err != nil {
+			t.Fatal(err)
+		}
+		return dst.Pix
+	}
+	if !bytes.Equal(render(8, FullHinting, true), render(8, NoHinting, false)) {
+		t.Error("8 ppem: gasp did not turn hinting off")
+	}
+	if bytes.Equal(render(12, FullHinting, true), render(12, NoHinting, false)) {
+		t.Error("12 ppem: gasp turned hinting off")
+	}
+}
+
+func TestSetTracking(t *testing.T) {
+	font := parseTestFont(t, "luxisr.ttf")
+	// The trak table's normal track is -100 FUnits, at all sizes.
+	trak := []byte{
+		0, 1, 0, 0, 0, 0, 0, 12, 0, 0, 0, 0, // Header.
+		0, 1, 0, 1, 0, 0, 0, 28, // Track data.
+		0, 0, 0, 0, 1, 0, 0, 32, // Normal track.
+		0, 12, 0, 0, // Size of 12 points.
+		0xff, 0x9c, // Value of -100.
+	}
+	data, err := font.Write(&truetype.WriteOptions{Tables: map[string][]byte{"trak": trak}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if font, err = ParseFont(data); err != nil {
+		t.Fatal(err)
+	}
+	c := NewContext()
+	c.SetFont(font)
+	c.SetDPI(72)
+	c.SetFontSize(float64(font.FUnitsPerEm()) / 64)
+	glyphs0, p0, err := c.Layout("AV", Pt(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, mask0, _, _, err := c.GlyphMask('A', Pt(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetTracking(true)
+	// Tracking does not change the glyphs' masks, so they stay cached.
+	if _, mask1, _, _, err := c.GlyphMask('A', Pt(0, 0)); err != nil || mask1 != mask0 {
+		t.Errorf("SetTracking cleared the glyph cache")
+	}
+	glyphs1, p1, err := c.Layout("AV", Pt(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// At a scale of the font's FUnitsPerEm, 1 FUnit is 1/64 of a pixel, and
+	// each glyph's advance is tracked.
+	track := raster.Fix32(-100 << 2)
+	if got, want := glyphs1[1].Dot.X, glyphs0[1].Dot.X+track; got != want {
+		t.Errorf("second glyph: got dot %d, want %d", got, want)
+	}
+	if got, want := p1.X, p0.X+2*track; got != want {
+		t.Errorf("end: got %d, want %d", got, want)
+	}
+}
+
+func TestSetDigits(t *testing.T) {
+	font := parseTestFont(t, "luxisr.ttf")
+	// The font has no Arabic-Indic digits, so replace its cmap with a format
+	// 12 one that maps the European digits to themselves and the Arabic-Indic
+	// digits U+0660 to U+0669 to the glyphs for 'A' to 'J'.
+	var groups []byte
+	group := func(r rune, i truetype.Index) {
+		groups = append(groups,
+			0, 0, byte(r>>8), byte(r), 0, 0, byte(r>>8), byte(r),
+			0, 0, byte(i>>8), byte(i))
+	}
+	for r := '0'; r <= '9'; r++ {
+		group(r, font.Index(r))
+	}
+	for r := '٠'; r <= '٩'; r++ {
+		group(r, font.Index('A'+r-'٠'))
+	}
+	n := len(groups) / 12
+	cmap := append([]byte{
+		0, 0, 0, 1, // Version 0, 1 subtable.
+		0, 3, 0, 10, 0, 0, 0, 12, // Microsoft UCS-4, at offset 12.
+		0, 12, 0, 0, // Format 12.
+		0, 0, byte((16 + 12*n) >> 8), byte(16 + 12*n), // Length.
+		0, 0, 0, 0, // Language.
+		0, 0, 0, byte(n), // Number of groups.
+	}, groups...)
+	data, err := font.Write(&truetype.WriteOptions{Tables: map[string][]byte{"cmap": cmap}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if font, err = ParseFont(data); err != nil {
+		t.Fatal(err)
+	}
+	draw := func(s string, d Digits) ([]GlyphPosition, []byte) {
+		dst := image.NewAlpha(image.Rect(0, 0, 80, 20))
+		c := NewContext()
+		c.SetDst(dst)
+		c.SetClip(dst.Bounds())
+		c.SetSrc(image.Opaque)
+		c.SetFont(font)
+		c.SetFontSize(12)
+		c.SetDigits(d)
+		glyphs, _, err := c.Layout(s, Pt(2, 15))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.DrawString(s, Pt(2, 15)); err != nil {
+			t.Fatal(err)
+		}
+		return glyphs, dst.Pix
+	}
+	indexes := func(s string) []truetype.Index {
+		var x []truetype.Index
+		for _, r := range s {
+			x = append(x, font.Index(r))
+		}
+		return x
+	}
+
+	testCases := []struct {
+		digits Digits
+		want   string
+	}{
+		{0, "2024"},
+		{EuropeanDigits, "2024"},
+		{ArabicIndicDigits, "٢٠٢٤"},
+		// The font lacks Thai digits, so the European digits are drawn.
+		{ThaiDigits, "2024"},
+	}
+	for _, tc := range testCases {
+		glyphs, got := draw("2024", tc.digits)
+		wantIndexes := indexes(tc.want)
+		if len(glyphs) != len(wantIndexes) {
+			t.Errorf("digits %U: got %d glyphs, want %d", tc.digits, len(glyphs), len(wantIndexes))
+			continue
+		}
+		for i, g := range glyphs {
+			if g.Index != wantIndexes[i] {
+				t.Errorf("digits %U: glyph %d: got index %d, want %d", tc.digits, i, g.Index, wantIndexes[i])
+			}
+		}
+		if _, want := draw(tc.want, 0); !bytes.Equal(got, want) {
+			t.Errorf("digits %U: DrawString drew differently from %q", tc.digits, tc.want)
+		}
+	}
+}
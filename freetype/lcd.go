@@ -0,0 +1,249 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package freetype
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/lukevers/freetype-go/freetype/raster"
+)
+
+// LCDMode is the policy for rendering text for the horizontal red, green and
+// blue subpixels of an LCD screen, which triples the effective horizontal
+// resolution.
+type LCDMode int32
+
+const (
+	// NoLCD means to render grayscale anti-aliased text.
+	NoLCD LCDMode = iota
+	// LCDRGB means to render for subpixels ordered red, green, blue from
+	// left to right, which is the most common order.
+	LCDRGB
+	// LCDBGR means to render for subpixels ordered blue, green, red.
+	LCDBGR
+)
+
+// An LCDFilter is the filter applied to LCD glyph masks, which spreads each
+// subpixel's coverage onto its neighbors to reduce color fringes. Stronger
+// filtering has fewer fringes but blurrier text. The zero value means
+// DefaultLCDFilter.
+type LCDFilter struct {
+	// Weights are the weights, out of 256, of a 5-tap FIR filter, centered on
+	// the middle weight. They typically sum to 256, such as {0x10, 0x40,
+	// 0x70, 0x40, 0x10}, so that filtering does not lighten or darken text.
+	Weights [5]uint8
+	// legacy is whether the filter is the legacy intra-pixel filter, rather
+	// than a FIR filter.
+	legacy bool
+}
+
+var (
+	// DefaultLCDFilter is C Freetype's FT_LCD_FILTER_DEFAULT.
+	DefaultLCDFilter = LCDFilter{Weights: [5]uint8{0x08, 0x4d, 0x56, 0x4d, 0x08}}
+	// LightLCDFilter is C Freetype's FT_LCD_FILTER_LIGHT, which is sharper
+	// but has more color fringes than DefaultLCDFilter.
+	LightLCDFilter = LCDFilter{Weights: [5]uint8{0x00, 0x55, 0x56, 0x55, 0x00}}
+	// LegacyLCDFilter is C Freetype's FT_LCD_FILTER_LEGACY, which mixes the
+	// coverages of the three subpixels of each pixel, rather than of
+	// neighboring subpixels, as the libXft library did.
+	LegacyLCDFilter = LCDFilter{legacy: true}
+)
+
+// lcdLegacyWeights are the 16.16 fixed point weights of the legacy filter.
+// lcdLegacyWeights[i][j] is the weight of the i'th subpixel's coverage in
+// the j'th subpixel's filtered coverage.
+var lcdLegacyWeights = [3][3]uint32{
+	{65538 * 9 / 13, 65538 * 1 / 6, 65538 * 1 / 13},
+	{65538 * 3 / 13, 65538 * 4 / 6, 65538 * 3 / 13},
+	{65538 * 1 / 13, 65538 * 1 / 6, 65538 * 9 / 13},
+}
+
+// SetLCD sets the LCD rendering policy. LCD rendering rasterizes glyphs at
+// three times the horizontal resolution, filters the result and draws each
+// subpixel's coverage onto the corresponding color channel of the
+// destination image.
+//
+// Grid fitting in the x direction would defeat the extra horizontal
+// resolution, so when LCD rendering is enabled, FullHinting and
+// SubpixelHinting only hint glyphs in the y direction, as per
+// VerticalHinting.
+func (c *Context) SetLCD(m LCDMode) {
+	if c.lcd == m {
+		return
+	}
+	c.lcd = m
+	c.recalc()
+}
+
+// SetLCDFilter sets the filter applied to glyphs for LCD rendering.
+func (c *Context) SetLCDFilter(f LCDFilter) {
+	if f == (LCDFilter{}) {
+		f = DefaultLCDFilter
+	}
+	if c.lcdFilter == f {
+		return
+	}
+	c.lcdFilter = f
+	c.clearCache()
+}
+
+// glyphHinting returns the hinting policy used to load glyphs, which is
+// the Context's hinting policy adjusted for the font's gasp table and for LCD
+// rendering.
+func (c *Context) glyphHinting() Hinting {
+	if c.gaspNoHinting {
+		return NoHinting
+	}
+	if c.lcd != NoLCD && (c.hinting == FullHinting || c.hinting == SubpixelHinting) {
+		return VerticalHinting
+	}
+	return c.hinting
+}
+
+// transformGlyph rotates the glyph loaded into c.glyphBuf and, for LCD
+// rendering, scales it horizontally by three, so that one pixel of the
+// rasterized glyph is one subpixel.
+func (c *Context) transformGlyph() {
+	c.rotateGlyph()
+	if c.lcd == NoLCD {
+		return
+	}
+	g := c.glyphBuf
+	for i := range g.Point {
+		g.Point[i].X *= 3
+	}
+	g.B.XMin *= 3
+	g.B.XMax *= 3
+}
+
+// maskBounds returns the integer-pixel bounds of the mask for the glyph in
+// c.glyphBuf, after transformGlyph. For LCD rendering, the bounds include
+// the subpixels that filtering spreads coverage onto.
+func (c *Context) maskBounds(fx, fy raster.Fix32) (xmin, ymin, xmax, ymax int) {
+	if c.lcd == NoLCD {
+		return c.glyphBounds(fx, fy)
+	}
+	xmin, ymin, xmax, ymax = c.glyphBounds(3*fx, fy)
+	// A FIR filter spreads coverage by up to two subpixels.
+	xmin, xmax = xmin-2, xmax+2
+	// Round out to whole pixels, rounding xmin down even if it is negative.
+	xmin = (xmin - ((xmin%3)+3)%3) / 3
+	xmax = (xmax + 2) / 3
+	return xmin, ymin, xmax, ymax
+}
+
+// filterLCD applies the LCD filter to each row of the mask, whose pixels are
+// subpixels.
+func (c *Context) filterLCD(a *image.Alpha) {
+	f := c.lcdFilter
+	if f == (LCDFilter{}) {
+		f = DefaultLCDFilter
+	}
+	w := a.Bounds().Dx()
+	if f.legacy {
+		for y := 0; y < a.Bounds().Dy(); y++ {
+			pix := a.Pix[y*a.Stride : y*a.Stride+w]
+			for x := 0; x+3 <= w; x += 3 {
+				in := [3]uint32{uint32(pix[x]), uint32(pix[x+1]), uint32(pix[x+2])}
+				for j := range in {
+					sum := in[0]*lcdLegacyWeights[0][j] + in[1]*lcdLegacyWeights[1][j] + in[2]*lcdLegacyWeights[2][j]
+					if sum > 0xff<<16 {
+						sum = 0xff << 16
+					}
+					pix[x+j] = uint8(sum >> 16)
+				}
+			}
+		}
+		return
+	}
+	if cap(c.lcdRow) < w {
+		c.lcdRow = make([]uint8, w)
+	}
+	row := c.lcdRow[:w]
+	for y := 0; y < a.Bounds().Dy(); y++ {
+		pix := a.Pix[y*a.Stride : y*a.Stride+w]
+		copy(row, pix)
+		for x := range pix {
+			sum := int32(0)
+			for k, weight := range f.Weights {
+				if i := x + k - 2; 0 <= i && i < w {
+					sum += int32(weight) * int32(row[i])
+				}
+			}
+			if sum > 0xff<<8 {
+				sum = 0xff << 8
+			}
+			pix[x] = uint8(sum >> 8)
+		}
+	}
+}
+
+// drawLCDMask draws the source image through the given LCD glyph mask, whose
+// pixels are subpixels, at the given integer-pixel offset, onto the
+// destination image. Each color channel is composited with its own
+// subpixel's coverage.
+func (c *Context) drawLCDMask(mask *image.Alpha, offset image.Point) error {
+	b := mask.Bounds()
+	glyphRect := image.Rect(0, 0, b.Dx()/3, b.Dy()).Add(offset)
+	r := c.clip.Intersect(glyphRect)
+	if r.Empty() {
+		return nil
+	}
+	if c.dst == nil {
+		return errNilDst
+	}
+	if r = r.Intersect(c.dst.Bounds()); r.Empty() {
+		return nil
+	}
+	var sr, sg, sb, sa uint32
+	src, uniform := c.src.(*image.Uniform)
+	if uniform {
+		sr, sg, sb, sa = src.RGBA()
+	}
+	dst, rgba := c.dst.(*image.RGBA)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		i := mask.PixOffset(b.Min.X+3*(r.Min.X-glyphRect.Min.X), b.Min.Y+y-glyphRect.Min.Y)
+		for x := r.Min.X; x < r.Max.X; x, i = x+1, i+3 {
+			mr, mg, mb := uint32(mask.Pix[i]), uint32(mask.Pix[i+1]), uint32(mask.Pix[i+2])
+			if mr|mg|mb == 0 {
+				continue
+			}
+			if c.lcd == LCDBGR {
+				mr, mb = mb, mr
+			}
+			// Scale the coverages from [0, 0xff] to [0, 0xffff].
+			mr, mg, mb = mr*0x101, mg*0x101, mb*0x101
+			ma := (mr + mg + mb) / 3
+			if !uniform {
+				sr, sg, sb, sa = c.src.At(x, y).RGBA()
+			}
+			if rgba {
+				p := dst.Pix[dst.PixOffset(x, y):]
+				p[0] = uint8(lcdBlend(sr, uint32(p[0])*0x101, sa, mr) >> 8)
+				p[1] = uint8(lcdBlend(sg, uint32(p[1])*0x101, sa, mg) >> 8)
+				p[2] = uint8(lcdBlend(sb, uint32(p[2])*0x101, sa, mb) >> 8)
+				p[3] = uint8(lcdBlend(sa, uint32(p[3])*0x101, sa, ma) >> 8)
+				continue
+			}
+			dr, dg, db, da := c.dst.At(x, y).RGBA()
+			c.dst.Set(x, y, color.RGBA64{
+				uint16(lcdBlend(sr, dr, sa, mr)),
+				uint16(lcdBlend(sg, dg, sa, mg)),
+				uint16(lcdBlend(sb, db, sa, mb)),
+				uint16(lcdBlend(sa, da, sa, ma)),
+			})
+		}
+	}
+	return nil
+}
+
+// lcdBlend returns the alpha-premultiplied source channel s composited over
+// the destination channel d, with the source alpha sa and the coverage m.
+// All values are in the range [0, 0xffff].
+func lcdBlend(s, d, sa, m uint32) uint32 {
+	return uint32((uint64(s)*uint64(m) + uint64(d)*uint64(0xffff-sa*m/0xffff)) / 0xffff)
+}
@@ -0,0 +1,190 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package freetype
+
+import (
+	"context"
+	"errors"
+	"image"
+
+	"github.com/lukevers/freetype-go/freetype/raster"
+	"github.com/lukevers/freetype-go/freetype/truetype"
+)
+
+// Metrics holds the metrics of a Context's font at its current size. They
+// have the same meaning, and the same whole-pixel rounding, as the Metrics of
+// the fixed-size faces in golang.org/x/image/font/basicfont, so that text
+// drawn with a Context can be laid out by code written for those faces.
+//
+// This package does not depend on golang.org/x/image, so a Context does not
+// itself implement that module's font.Face interface. The face package adapts
+// a Context to it, so that it can be passed where a basicfont face is
+// expected. The adapter converts each raster.Fix32, a 24.8 fixed point number,
+// to a 26.6 fixed.Int26_6 by shifting it right by 2 bits, which is exact for
+// the whole-pixel metrics.
+type Metrics struct {
+	// Height is the recommended distance between consecutive baselines. It
+	// is the sum of Ascent, Descent and the font's line gap, rounded to the
+	// nearest whole pixel.
+	Height raster.Fix32
+	// Ascent is the distance from the baseline to the top of a line of text,
+	// and Descent is the distance from the baseline to the bottom. Both are
+	// positive, and are rounded up to whole pixels, so that lines of text do
+	// not overlap.
+	Ascent, Descent raster.Fix32
+	// XHeight and CapHeight are the heights of lowercase and uppercase
+	// letters, from the font's OS/2 table, rounded to the nearest whole
+	// pixel. They are zero if the table does not record them.
+	XHeight, CapHeight raster.Fix32
+}
+
+// Metrics returns the metrics of the Context's font at its current size.
+func (c *Context) Metrics() Metrics {
+	if c.font == nil {
+		return Metrics{}
+	}
+	// LineMetrics' values are 26.6 fixed point numbers.
+	ascent, descent, lineGap := c.font.LineMetrics(c.scale)
+	a := raster.Fix32((ascent+63)&^63) << 2
+	d := raster.Fix32((-descent+63)&^63) << 2
+	g := raster.Fix32((lineGap+32)&^63) << 2
+	m := Metrics{
+		Height:  a + d + g,
+		Ascent:  a,
+		Descent: d,
+	}
+	if o, ok := c.font.OS2(); ok {
+		unitsPerEm := c.font.FUnitsPerEm()
+		m.XHeight = raster.Fix32((scaleFUnits(int32(o.XHeight), c.scale, unitsPerEm)+32)&^63) << 2
+		m.CapHeight = raster.Fix32((scaleFUnits(int32(o.CapHeight), c.scale, unitsPerEm)+32)&^63) << 2
+	}
+	return m
+}
+
+// GlyphAdvance returns the advance width of the glyph for r, as for the
+// GlyphAdvance method of the faces in golang.org/x/image/font/basicfont. ok is
+// whether the font has a glyph for r. If it does not, the advance is that of
+// the font's missing glyph. When hinting, the advance is a whole number of
+// pixels, as it is for basicfont faces.
+//
+// The advance is read from the font's hmtx and hdmx tables, without loading
+// the glyph, unless the Context's hinting policy, width scale or variation may
+// change it, in which case the glyph is loaded, but not rasterized.
+func (c *Context) GlyphAdvance(r rune) (advance raster.Fix32, ok bool) {
+	if c.font == nil {
+		return 0, false
+	}
+	index := c.index(r)
+	a, err := c.glyphAdvance(index)
+	if err != nil {
+		return 0, false
+	}
+	return raster.Fix32(a) << 2, index != 0
+}
+
+// glyphAdvance returns the advance width, in 26.6 fixed point units, of the
+// glyph with the given index, as it is drawn.
+func (c *Context) glyphAdvance(index truetype.Index) (int32, error) {
+	if c.widthScale == 0 && !c.varied {
+		switch c.glyphHinting() {
+		case NoHinting:
+			return c.font.HMetric(c.scale, index).AdvanceWidth, nil
+		case FullHinting:
+			if a, ok := c.font.DeviceAdvance(c.scale, index); ok {
+				return a, nil
+			}
+		}
+	}
+	if c.concurrent {
+		c.rasterMu.Lock()
+		defer c.rasterMu.Unlock()
+	}
+	if err := c.loadGlyph(context.Background(), index, c.scale); err != nil {
+		return 0, err
+	}
+	return c.glyphBuf.AdvanceWidth, nil
+}
+
+// Kern returns the kerning between the glyphs for r0 and r1, as DrawString
+// applies it, as for the Kern method of the faces in
+// golang.org/x/image/font/basicfont. When hinting, it is a whole number of
+// pixels.
+func (c *Context) Kern(r0, r1 rune) raster.Fix32 {
+	if c.font == nil {
+		return 0
+	}
+	return c.kernWidth(c.index(r0), c.index(r1))
+}
+
+// GlyphMask returns the advance width, mask and integer-pixel offset of the
+// glyph for r, drawn with its origin at p, as DrawString draws it. The mask's
+// bounds, translated by the offset, are the pixels that the glyph covers. ok
+// is whether the font has a glyph for r. The mask is shared with the
+// Context's glyph cache, and must not be modified.
+func (c *Context) GlyphMask(r rune, p raster.Point) (advance raster.Fix32, mask *image.Alpha, offset image.Point, ok bool, err error) {
+	if c.font == nil {
+		return 0, nil, image.Point{}, false, errors.New("freetype: GlyphMask called with a nil font")
+	}
+	index := c.index(r)
+	advance, mask, offset, err = c.glyph(context.Background(), index, p)
+	return advance, mask, offset, index != 0, err
+}
+
+// InkBounds returns the smallest rectangle that contains every pixel that
+// drawing s at p would affect, as DrawString would draw it. Unlike bounds
+// derived from the glyphs' outlines, the rectangle is measured from the
+// rasterized glyphs, after hinting and anti-aliasing, so that it can be used
+// to crop rendered text, or to size a drop shadow, exactly. It is empty if s
+// has no visible glyphs.
+func (c *Context) InkBounds(s string, p raster.Point) (image.Rectangle, error) {
+	glyphs, _, err := c.Layout(s, p)
+	if err != nil {
+		return image.Rectangle{}, err
+	}
+	var r image.Rectangle
+	for _, g := range glyphs {
+		_, mask, offset, err := c.glyph(context.Background(), g.Index, g.Dot)
+		if err != nil {
+			return image.Rectangle{}, err
+		}
+		b := inkBounds(mask)
+		if c.lcd != NoLCD && !b.Empty() {
+			// An LCD glyph mask's pixels are subpixels.
+			b.Min.X, b.Max.X = b.Min.X/3, (b.Max.X+2)/3
+		}
+		r = r.Union(b.Add(offset))
+	}
+	return r, nil
+}
+
+// inkBounds returns the smallest rectangle that contains every non-zero pixel
+// of the mask.
+func inkBounds(mask *image.Alpha) image.Rectangle {
+	b := mask.Bounds()
+	r := image.Rectangle{Min: b.Max, Max: b.Min}
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		i := mask.PixOffset(b.Min.X, y)
+		for x := b.Min.X; x < b.Max.X; x, i = x+1, i+1 {
+			if mask.Pix[i] == 0 {
+				continue
+			}
+			if x < r.Min.X {
+				r.Min.X = x
+			}
+			if x >= r.Max.X {
+				r.Max.X = x + 1
+			}
+			if y < r.Min.Y {
+				r.Min.Y = y
+			}
+			r.Max.Y = y + 1
+		}
+	}
+	if r.Empty() {
+		return image.Rectangle{}
+	}
+	return r
+}
@@ -0,0 +1,89 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package freetype
+
+import (
+	"context"
+	"errors"
+	"unicode"
+
+	"github.com/lukevers/freetype-go/freetype/raster"
+	"github.com/lukevers/freetype-go/freetype/truetype"
+)
+
+// Preload rasterizes the glyphs for the runes of s and of the given range
+// tables, such as unicode.Latin, and puts them in the Context's glyph cache,
+// so that drawing them later does not have to. This makes the time taken by
+// the first frame of a UI or game that draws text more predictable. Each
+// glyph is rasterized at every cached sub-pixel position, for the Context's
+// current font, size, rotation and other settings, so changing those
+// settings after preloading discards the preloaded glyphs.
+//
+// The glyph cache holds a fixed number of glyphs, so preloading many more
+// glyphs than are drawn per frame, such as all of a CJK font's glyphs, evicts
+// earlier preloaded glyphs and is of little use.
+func (c *Context) Preload(s string, tables ...*unicode.RangeTable) error {
+	if c.font == nil {
+		return errors.New("freetype: Preload called with a nil font")
+	}
+	seen := map[truetype.Index]bool{}
+	var indexes []truetype.Index
+	add := func(r rune) {
+		if isBidiControl(r) {
+			return
+		}
+		if i := c.index(r); !seen[i] {
+			seen[i] = true
+			indexes = append(indexes, i)
+		}
+	}
+	for _, r := range s {
+		add(r)
+	}
+	for _, t := range tables {
+		for _, rr := range t.R16 {
+			for r := rune(rr.Lo); r <= rune(rr.Hi); r += rune(rr.Stride) {
+				add(r)
+			}
+		}
+		for _, rr := range t.R32 {
+			for r := rune(rr.Lo); r <= rune(rr.Hi); r += rune(rr.Stride) {
+				add(r)
+			}
+		}
+	}
+	for _, index := range indexes {
+		for tx := 0; tx < nXFractions; tx++ {
+			for ty := 0; ty < nYFractions; ty++ {
+				p := raster.Point{
+					X: raster.Fix32(tx * 256 / nXFractions),
+					Y: raster.Fix32(ty * 256 / nYFractions),
+				}
+				if _, _, _, err := c.glyph(context.Background(), index, p); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// PreloadAsync is like Preload, but preloads the glyphs on a background
+// goroutine, so that a program can carry on starting up, or draw text, in
+// the meantime. The returned channel receives Preload's result when it is
+// done. The Context must be concurrent, as per SetConcurrent, and its
+// setters must not be called until preloading is done.
+func (c *Context) PreloadAsync(s string, tables ...*unicode.RangeTable) <-chan error {
+	done := make(chan error, 1)
+	if !c.concurrent {
+		done <- errors.New("freetype: PreloadAsync called on a non-concurrent Context")
+		return done
+	}
+	go func() {
+		done <- c.Preload(s, tables...)
+	}()
+	return done
+}
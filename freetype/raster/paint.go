@@ -34,10 +34,71 @@ type PainterFunc func(ss []Span, done bool)
 // Paint just delegates the call to f.
 func (f PainterFunc) Paint(ss []Span, done bool) { f(ss, done) }
 
+// A Gamma is a transfer function between gamma encoded 8-bit values, such as
+// those of an image's pixels, and linear light. Compositing antialiased
+// coverage in linear light, rather than on the encoded values, stops light
+// text on a dark background from looking too thin.
+type Gamma struct {
+	// lin maps encoded values to linear light, with 1<<16-1 being full
+	// intensity.
+	lin [256]uint16
+}
+
+// NewGamma returns the Gamma whose linear light values are the encoded
+// values raised to the power gamma. A typical gamma is 2.2.
+func NewGamma(gamma float64) *Gamma {
+	g := new(Gamma)
+	for i := range g.lin {
+		g.lin[i] = uint16(0xffff*math.Pow(float64(i)/0xff, gamma) + 0.5)
+	}
+	return g
+}
+
+// NewSRGBGamma returns the Gamma of the sRGB color space, which is that of
+// most images and displays.
+func NewSRGBGamma() *Gamma {
+	g := new(Gamma)
+	for i := range g.lin {
+		c := float64(i) / 0xff
+		if c <= 0.04045 {
+			c /= 12.92
+		} else {
+			c = math.Pow((c+0.055)/1.055, 2.4)
+		}
+		g.lin[i] = uint16(0xffff*c + 0.5)
+	}
+	return g
+}
+
+// encode returns the encoded value nearest to the linear light value v, with
+// 1<<16-1 being full intensity.
+func (g *Gamma) encode(v uint32) uint8 {
+	if v >= uint32(g.lin[0xff]) {
+		return 0xff
+	}
+	// Find the first encoded value whose linear light value is at least v.
+	i, j := 0, 0xff
+	for i < j {
+		h := (i + j) / 2
+		if uint32(g.lin[h]) < v {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i > 0 && v-uint32(g.lin[i-1]) < uint32(g.lin[i])-v {
+		i--
+	}
+	return uint8(i)
+}
+
 // An AlphaOverPainter is a Painter that paints Spans onto an image.Alpha
 // using the Over Porter-Duff composition operator.
 type AlphaOverPainter struct {
 	Image *image.Alpha
+	// Gamma, if non-nil, is the encoding of the image's values, which are
+	// composited in linear light.
+	Gamma *Gamma
 }
 
 // Paint satisfies the Painter interface by painting ss onto an image.Alpha.
@@ -61,6 +122,15 @@ func (r AlphaOverPainter) Paint(ss []Span, done bool) {
 		}
 		base := (s.Y-r.Image.Rect.Min.Y)*r.Image.Stride - r.Image.Rect.Min.X
 		p := r.Image.Pix[base+s.X0 : base+s.X1]
+		if g := r.Gamma; g != nil {
+			const m = 1<<16 - 1
+			a := s.A >> 16
+			for i, c := range p {
+				v := uint32(g.lin[c])
+				p[i] = g.encode((v*m + (m-v)*a) / m)
+			}
+			continue
+		}
 		a := int(s.A >> 24)
 		for i, c := range p {
 			v := int(c)
@@ -71,13 +141,16 @@ func (r AlphaOverPainter) Paint(ss []Span, done bool) {
 
 // NewAlphaOverPainter creates a new AlphaOverPainter for the given image.
 func NewAlphaOverPainter(m *image.Alpha) AlphaOverPainter {
-	return AlphaOverPainter{m}
+	return AlphaOverPainter{Image: m}
 }
 
 // An AlphaSrcPainter is a Painter that paints Spans onto an image.Alpha
 // using the Src Porter-Duff composition operator.
 type AlphaSrcPainter struct {
 	Image *image.Alpha
+	// Gamma, if non-nil, is the encoding of the image's values, which are
+	// painted as the encoding of the Spans' linear light alpha values.
+	Gamma *Gamma
 }
 
 // Paint satisfies the Painter interface by painting ss onto an image.Alpha.
@@ -102,6 +175,9 @@ func (r AlphaSrcPainter) Paint(ss []Span, done bool) {
 		base := (s.Y-r.Image.Rect.Min.Y)*r.Image.Stride - r.Image.Rect.Min.X
 		p := r.Image.Pix[base+s.X0 : base+s.X1]
 		color := uint8(s.A >> 24)
+		if r.Gamma != nil {
+			color = r.Gamma.encode(s.A >> 16)
+		}
 		for i := range p {
 			p[i] = color
 		}
@@ -110,7 +186,7 @@ func (r AlphaSrcPainter) Paint(ss []Span, done bool) {
 
 // NewAlphaSrcPainter creates a new AlphaSrcPainter for the given image.
 func NewAlphaSrcPainter(m *image.Alpha) AlphaSrcPainter {
-	return AlphaSrcPainter{m}
+	return AlphaSrcPainter{Image: m}
 }
 
 type RGBAPainter struct {
@@ -118,6 +194,11 @@ type RGBAPainter struct {
 	Image *image.RGBA
 	// The Porter-Duff composition operator.
 	Op draw.Op
+	// Gamma, if non-nil, is the encoding of the image's and the color's red,
+	// green and blue values, which are composited in linear light. Colors are
+	// alpha-premultiplied, so this is exact for an opaque color painted onto
+	// an opaque image.
+	Gamma *Gamma
 	// The 16-bit color to paint the spans.
 	cr, cg, cb, ca uint32
 }
@@ -146,7 +227,9 @@ func (r *RGBAPainter) Paint(ss []Span, done bool) {
 		const m = 1<<16 - 1
 		i0 := (s.Y-r.Image.Rect.Min.Y)*r.Image.Stride + (s.X0-r.Image.Rect.Min.X)*4
 		i1 := i0 + (s.X1-s.X0)*4
-		if r.Op == draw.Over {
+		if r.Gamma != nil {
+			r.paintGamma(i0, i1, ma)
+		} else if r.Op == draw.Over {
 			for i := i0; i < i1; i += 4 {
 				dr := uint32(r.Image.Pix[i+0])
 				dg := uint32(r.Image.Pix[i+1])
@@ -169,6 +252,37 @@ func (r *RGBAPainter) Paint(ss []Span, done bool) {
 	}
 }
 
+// paintGamma paints the pixels from r.Image.Pix[i0:i1] with the alpha ma,
+// compositing their red, green and blue values in linear light.
+func (r *RGBAPainter) paintGamma(i0, i1 int, ma uint32) {
+	const m = 1<<16 - 1
+	g := r.Gamma
+	cr := uint64(g.lin[r.cr>>8]) * uint64(ma) / m
+	cg := uint64(g.lin[r.cg>>8]) * uint64(ma) / m
+	cb := uint64(g.lin[r.cb>>8]) * uint64(ma) / m
+	ca := r.ca * ma / m
+	if r.Op != draw.Over {
+		for i := i0; i < i1; i += 4 {
+			r.Image.Pix[i+0] = g.encode(uint32(cr))
+			r.Image.Pix[i+1] = g.encode(uint32(cg))
+			r.Image.Pix[i+2] = g.encode(uint32(cb))
+			r.Image.Pix[i+3] = uint8(ca >> 8)
+		}
+		return
+	}
+	a := uint64(m - ca)
+	for i := i0; i < i1; i += 4 {
+		dr := uint64(g.lin[r.Image.Pix[i+0]])
+		dg := uint64(g.lin[r.Image.Pix[i+1]])
+		db := uint64(g.lin[r.Image.Pix[i+2]])
+		da := uint32(r.Image.Pix[i+3])
+		r.Image.Pix[i+0] = g.encode(uint32(dr*a/m + cr))
+		r.Image.Pix[i+1] = g.encode(uint32(dg*a/m + cg))
+		r.Image.Pix[i+2] = g.encode(uint32(db*a/m + cb))
+		r.Image.Pix[i+3] = uint8((da*uint32(a)*0x101 + r.ca*ma) / m >> 8)
+	}
+}
+
 // SetColor sets the color to paint the spans.
 func (r *RGBAPainter) SetColor(c color.Color) {
 	r.cr, r.cg, r.cb, r.ca = c.RGBA()
@@ -290,3 +404,56 @@ func NewGammaCorrectionPainter(p Painter, gamma float64) *GammaCorrectionPainter
 	g.SetGamma(gamma)
 	return g
 }
+
+// An OffsetPainter wraps another Painter, translating each Span by Offset.
+// It lets a shape rasterized at one position be painted at another, such as
+// into a tile of a larger image, without a temporary image. The wrapped
+// Painter clips the translated Spans to its image's bounds, which need not
+// have a zero minimum point, as is the case for an image returned by a
+// SubImage method.
+type OffsetPainter struct {
+	// The wrapped Painter.
+	Painter Painter
+	// Offset is added to each Span's co-ordinates.
+	Offset image.Point
+}
+
+// Paint delegates to the wrapped Painter after translating each Span.
+func (o OffsetPainter) Paint(ss []Span, done bool) {
+	if o.Offset != (image.Point{}) {
+		for i := range ss {
+			ss[i].Y += o.Offset.Y
+			ss[i].X0 += o.Offset.X
+			ss[i].X1 += o.Offset.X
+		}
+	}
+	o.Painter.Paint(ss, done)
+}
+
+// A CoverageClampPainter wraps another Painter, clamping each non-zero Span's
+// alpha value to the range [Min, Max], with fully opaque == 1<<32-1. A
+// non-zero Min ensures that every pixel touched by a shape is painted, which
+// darkens thin stems and hairlines of small text. A non-zero Max less than
+// fully opaque lightens heavy text. A zero Max means no maximum, so that the
+// zero value leaves alpha values unchanged.
+type CoverageClampPainter struct {
+	// The wrapped Painter.
+	Painter Painter
+	// The minimum and maximum alpha values for non-zero coverage.
+	Min, Max uint32
+}
+
+// Paint delegates to the wrapped Painter after clamping each Span's alpha.
+func (c CoverageClampPainter) Paint(ss []Span, done bool) {
+	for i, s := range ss {
+		if s.A == 0 {
+			continue
+		}
+		if s.A < c.Min {
+			ss[i].A = c.Min
+		} else if c.Max != 0 && s.A > c.Max {
+			ss[i].A = c.Max
+		}
+	}
+	c.Painter.Paint(ss, done)
+}
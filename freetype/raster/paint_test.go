@@ -0,0 +1,105 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package raster
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+)
+
+func TestGamma(t *testing.T) {
+	// paint paints a half covered pixel with p, and returns the pixel of m.
+	paint := func(p Painter, m image.Image) color.Color {
+		p.Paint([]Span{{Y: 0, X0: 0, X1: 1, A: 1 << 31}}, true)
+		return m.At(0, 0)
+	}
+	srgb := NewSRGBGamma()
+	testCases := []struct {
+		desc  string
+		gamma *Gamma
+		// want is the alpha painted by the alpha painters onto zero, and the
+		// red painted by an RGBAPainter in white onto black.
+		want uint8
+	}{
+		{"no gamma", nil, 0x80},
+		{"gamma 1", NewGamma(1), 0x80},
+		// Half the linear light is brighter once encoded.
+		{"gamma 2.2", NewGamma(2.2), 0xba},
+		{"sRGB", srgb, 0xbc},
+	}
+	for _, tc := range testCases {
+		a := image.NewAlpha(image.Rect(0, 0, 1, 1))
+		src := NewAlphaSrcPainter(a)
+		src.Gamma = tc.gamma
+		if got := paint(src, a).(color.Alpha).A; got != tc.want {
+			t.Errorf("%s: AlphaSrcPainter: got %#x, want %#x", tc.desc, got, tc.want)
+		}
+		a = image.NewAlpha(image.Rect(0, 0, 1, 1))
+		over := NewAlphaOverPainter(a)
+		over.Gamma = tc.gamma
+		if got := paint(over, a).(color.Alpha).A; got != tc.want {
+			t.Errorf("%s: AlphaOverPainter: got %#x, want %#x", tc.desc, got, tc.want)
+		}
+		for _, op := range []draw.Op{draw.Over, draw.Src} {
+			m := image.NewRGBA(image.Rect(0, 0, 1, 1))
+			draw.Draw(m, m.Bounds(), image.Black, image.ZP, draw.Src)
+			rgba := NewRGBAPainter(m)
+			rgba.Op, rgba.Gamma = op, tc.gamma
+			rgba.SetColor(color.White)
+			if got := paint(rgba, m).(color.RGBA).R; got != tc.want {
+				t.Errorf("%s: RGBAPainter op %v: got %#x, want %#x", tc.desc, op, got, tc.want)
+			}
+		}
+	}
+
+	// Painting an opaque color in linear light leaves the color unchanged.
+	m := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	rgba := NewRGBAPainter(m)
+	rgba.Gamma = srgb
+	want := color.RGBA{0x12, 0x80, 0xfe, 0xff}
+	rgba.SetColor(want)
+	rgba.Paint([]Span{{Y: 0, X0: 0, X1: 1, A: 1<<32 - 1}}, true)
+	if got := m.RGBAAt(0, 0); got != want {
+		t.Errorf("opaque color: got %v, want %v", got, want)
+	}
+}
+
+func TestCoverageClampPainter(t *testing.T) {
+	testCases := []struct {
+		min, max uint32
+		want     []uint32
+	}{
+		{0, 0, []uint32{0, 1, 1 << 31, 1<<32 - 1}},
+		{1 << 30, 0, []uint32{0, 1 << 30, 1 << 31, 1<<32 - 1}},
+		{0, 1 << 30, []uint32{0, 1, 1 << 30, 1 << 30}},
+		{1 << 30, 1 << 30, []uint32{0, 1 << 30, 1 << 30, 1 << 30}},
+	}
+	for _, tc := range testCases {
+		var got []uint32
+		p := CoverageClampPainter{
+			Painter: PainterFunc(func(ss []Span, done bool) {
+				for _, s := range ss {
+					got = append(got, s.A)
+				}
+			}),
+			Min: tc.min,
+			Max: tc.max,
+		}
+		p.Paint([]Span{{A: 0}, {A: 1}, {A: 1 << 31}, {A: 1<<32 - 1}}, true)
+		if len(got) != len(tc.want) {
+			t.Errorf("min %#x, max %#x: got %#x, want %#x", tc.min, tc.max, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("min %#x, max %#x: got %#x, want %#x", tc.min, tc.max, got, tc.want)
+				break
+			}
+		}
+	}
+}
@@ -16,6 +16,7 @@
 package raster
 
 import (
+	"context"
 	"strconv"
 )
 
@@ -27,18 +28,41 @@ type cell struct {
 	next        int
 }
 
+// A FillRule is the rule for which parts of a Rasterizer's curves are inside
+// them, and so painted, where curves overlap or a curve intersects itself.
+type FillRule int
+
+const (
+	// EvenOdd paints the parts that are inside an odd number of curves, so
+	// that overlapping curves cut holes in each other.
+	EvenOdd FillRule = iota
+	// NonZero paints the parts whose winding number is non-zero: the number
+	// of curves around them, counting those drawn in one direction as +1 and
+	// those drawn in the other as -1. Overlapping curves in the same
+	// direction are painted once, as TrueType glyphs and strokes need.
+	NonZero
+)
+
 type Rasterizer struct {
 	// If false, the default behavior is to use the even-odd winding fill
-	// rule during Rasterize.
+	// rule during Rasterize and RasterizeContext. RasterizeFillRule ignores
+	// it.
 	UseNonZeroWinding bool
 	// An offset (in pixels) to the painted spans.
 	Dx, Dy int
+	// Tolerance is how far, in 26.6 fixed point units (i.e. 64 per pixel),
+	// the line segments that approximate a quadratic or cubic segment may be
+	// from it. Curvier segments are subdivided more, and a smaller Tolerance
+	// subdivides them more, trading speed for quality. Zero means to use a
+	// default that depends on the bounds: 2 up to 24 pixels, 4 up to 120
+	// pixels and 8 beyond, as the C Freetype implementation does. A Tolerance
+	// of more than 1<<20, or 16384 pixels, is treated as 1<<20.
+	Tolerance int
 
 	// The width of the Rasterizer. The height is implicit in len(cellIndex).
 	width int
-	// splitScaleN is the scaling factor used to determine how many times
-	// to decompose a quadratic or cubic segment into a linear approximation.
-	splitScale2, splitScale3 int
+	// defaultTolerance is the Tolerance used when Tolerance is zero.
+	defaultTolerance int
 
 	// The current pen position.
 	a Point
@@ -302,7 +326,8 @@ func (r *Rasterizer) Add1(b Point) {
 func (r *Rasterizer) Add2(b, c Point) {
 	// Calculate nSplit (the number of recursive decompositions) based on how `curvy' it is.
 	// Specifically, how much the middle point b deviates from (a+c)/2.
-	dev := maxAbs(r.a.X-2*b.X+c.X, r.a.Y-2*b.Y+c.Y) / Fix32(r.splitScale2)
+	ss2, _ := r.splitScales()
+	dev := maxAbs(r.a.X-2*b.X+c.X, r.a.Y-2*b.Y+c.Y) / ss2
 	nsplit := 0
 	for dev > 0 {
 		dev /= 4
@@ -354,11 +379,32 @@ func (r *Rasterizer) Add2(b, c Point) {
 	}
 }
 
+// maxTolerance is the largest Tolerance, in 26.6 fixed point units.
+const maxTolerance = 1 << 20
+
+// splitScales returns the scaling factors used to determine how many times
+// to decompose a quadratic or cubic segment into a linear approximation. Each
+// decomposition quarters a quadratic segment's deviation from its chord, and
+// the final two-line approximation is within 1/16th of the scale of it.
+func (r *Rasterizer) splitScales() (ss2, ss3 Fix32) {
+	t := r.Tolerance
+	if t <= 0 {
+		t = r.defaultTolerance
+	} else if t > maxTolerance {
+		// Larger tolerances would overflow the Fix32 scales.
+		t = maxTolerance
+	}
+	// The scale is 16 times the tolerance, and a 26.6 tolerance is 4 times
+	// as many 24.8 units.
+	return Fix32(64 * t), Fix32(32 * t)
+}
+
 // Add3 adds a cubic segment to the current curve.
 func (r *Rasterizer) Add3(b, c, d Point) {
 	// Calculate nSplit (the number of recursive decompositions) based on how `curvy' it is.
-	dev2 := maxAbs(r.a.X-3*(b.X+c.X)+d.X, r.a.Y-3*(b.Y+c.Y)+d.Y) / Fix32(r.splitScale2)
-	dev3 := maxAbs(r.a.X-2*b.X+d.X, r.a.Y-2*b.Y+d.Y) / Fix32(r.splitScale3)
+	ss2, ss3 := r.splitScales()
+	dev2 := maxAbs(r.a.X-3*(b.X+c.X)+d.X, r.a.Y-3*(b.Y+c.Y)+d.Y) / ss2
+	dev3 := maxAbs(r.a.X-2*b.X+d.X, r.a.Y-2*b.Y+d.Y) / ss3
 	nsplit := 0
 	for dev2 > 0 || dev3 > 0 {
 		dev2 /= 8
@@ -449,9 +495,9 @@ func (r *Rasterizer) AddStroke(q Path, width Fix32, cr Capper, jr Joiner) {
 
 // Converts an area value to a uint32 alpha value. A completely filled pixel
 // corresponds to an area of 256*256*2, and an alpha of 1<<32-1. The
-// conversion of area values greater than this depends on the winding rule:
+// conversion of area values greater than this depends on the fill rule:
 // even-odd or non-zero.
-func (r *Rasterizer) areaToAlpha(area int) uint32 {
+func areaToAlpha(area int, rule FillRule) uint32 {
 	// The C Freetype implementation (version 2.3.12) does "alpha := area>>1" without
 	// the +1. Round-to-nearest gives a more symmetric result than round-down.
 	// The C implementation also returns 8-bit alpha, not 32-bit alpha.
@@ -460,7 +506,7 @@ func (r *Rasterizer) areaToAlpha(area int) uint32 {
 		a = -a
 	}
 	alpha := uint32(a)
-	if r.UseNonZeroWinding {
+	if rule == NonZero {
 		if alpha > 0xffff {
 			alpha = 0xffff
 		}
@@ -481,13 +527,50 @@ func (r *Rasterizer) areaToAlpha(area int) uint32 {
 // have non-zero width (and 0 <= X0 < X1 <= r.width) and non-zero A, except
 // for the final Span, which has Y, X0, X1 and A all equal to zero.
 func (r *Rasterizer) Rasterize(p Painter) {
+	r.rasterize(nil, p, r.fillRule())
+}
+
+// RasterizeContext is like Rasterize, but stops with ctx's error, such as
+// context.DeadlineExceeded, once ctx is done. If so, the final Span is passed
+// to p early, and p will have been given only some of the Spans.
+func (r *Rasterizer) RasterizeContext(ctx context.Context, p Painter) error {
+	return r.rasterize(ctx, p, r.fillRule())
+}
+
+// RasterizeFillRule is like Rasterize, but fills r's curves with the given
+// rule, regardless of r.UseNonZeroWinding. For example, a glyph whose
+// contours overlap is filled with NonZero, and an SVG path is filled with the
+// rule of its fill-rule property.
+func (r *Rasterizer) RasterizeFillRule(p Painter, rule FillRule) {
+	r.rasterize(nil, p, rule)
+}
+
+// fillRule returns the fill rule selected by r.UseNonZeroWinding.
+func (r *Rasterizer) fillRule() FillRule {
+	if r.UseNonZeroWinding {
+		return NonZero
+	}
+	return EvenOdd
+}
+
+// rasterize implements Rasterize, RasterizeContext and RasterizeFillRule. ctx
+// may be nil.
+func (r *Rasterizer) rasterize(ctx context.Context, p Painter, rule FillRule) error {
+	// rowsPerCheck is how many rows are rasterized between checks of ctx.
+	const rowsPerCheck = 16
 	r.saveCell()
 	s := 0
 	for yi := 0; yi < len(r.cellIndex); yi++ {
+		if ctx != nil && yi%rowsPerCheck == 0 {
+			if err := ctx.Err(); err != nil {
+				p.Paint(r.spanBuf[:s], true)
+				return err
+			}
+		}
 		xi, cover := 0, 0
 		for c := r.cellIndex[yi]; c != -1; c = r.cell[c].next {
 			if cover != 0 && r.cell[c].xi > xi {
-				alpha := r.areaToAlpha(cover * 256 * 2)
+				alpha := areaToAlpha(cover*256*2, rule)
 				if alpha != 0 {
 					xi0, xi1 := xi, r.cell[c].xi
 					if xi0 < 0 {
@@ -503,7 +586,7 @@ func (r *Rasterizer) Rasterize(p Painter) {
 				}
 			}
 			cover += r.cell[c].cover
-			alpha := r.areaToAlpha(cover*256*2 - r.cell[c].area)
+			alpha := areaToAlpha(cover*256*2-r.cell[c].area, rule)
 			xi = r.cell[c].xi + 1
 			if alpha != 0 {
 				xi0, xi1 := r.cell[c].xi, xi
@@ -525,6 +608,7 @@ func (r *Rasterizer) Rasterize(p Painter) {
 		}
 	}
 	p.Paint(r.spanBuf[:s], true)
+	return nil
 }
 
 // Clear cancels any previous calls to r.Start or r.AddXxx.
@@ -549,19 +633,18 @@ func (r *Rasterizer) SetBounds(width, height int) {
 	if height < 0 {
 		height = 0
 	}
-	// Use the same ssN heuristic as the C Freetype implementation.
-	// The C implementation uses the values 32, 16, but those are in
-	// 26.6 fixed point units, and we use 24.8 fixed point everywhere.
-	ss2, ss3 := 128, 64
+	// Use the same split scale heuristic as the C Freetype implementation,
+	// whose values of 32 and 16 for quadratic and cubic segments are a
+	// tolerance of 2.
+	t := 2
 	if width > 24 || height > 24 {
-		ss2, ss3 = 2*ss2, 2*ss3
+		t *= 2
 		if width > 120 || height > 120 {
-			ss2, ss3 = 2*ss2, 2*ss3
+			t *= 2
 		}
 	}
 	r.width = width
-	r.splitScale2 = ss2
-	r.splitScale3 = ss3
+	r.defaultTolerance = t
 	r.cell = r.cellBuf[:0]
 	if height > len(r.cellIndexBuf) {
 		r.cellIndex = make([]int, height)
@@ -571,6 +654,20 @@ func (r *Rasterizer) SetBounds(width, height int) {
 	r.Clear()
 }
 
+// BufferSize returns the approximate number of bytes used by the buffers that
+// r has grown, beyond those in the Rasterizer itself, to hold the cells of
+// large or complex shapes.
+func (r *Rasterizer) BufferSize() int {
+	n := 0
+	if cap(r.cell) > len(r.cellBuf) {
+		n += 32 * cap(r.cell)
+	}
+	if cap(r.cellIndex) > len(r.cellIndexBuf) {
+		n += 8 * cap(r.cellIndex)
+	}
+	return n
+}
+
 // NewRasterizer creates a new Rasterizer with the given bounds.
 func NewRasterizer(width, height int) *Rasterizer {
 	r := new(Rasterizer)
@@ -0,0 +1,112 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package raster
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"testing"
+)
+
+// pt returns the Point for the pixel co-ordinates (x, y).
+func pt(x, y int) Point {
+	return Point{Fix32(x << 8), Fix32(y << 8)}
+}
+
+func TestFillRule(t *testing.T) {
+	// fill returns the alpha at each of the given points of the path d,
+	// rasterized with the given fill rule.
+	fill := func(d string, rule FillRule, points ...image.Point) string {
+		p, err := ParseSVGPath(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := NewRasterizer(80, 80)
+		r.AddPath(p)
+		a := image.NewAlpha(image.Rect(0, 0, 80, 80))
+		r.RasterizeFillRule(NewAlphaSrcPainter(a), rule)
+		var alphas []uint8
+		for _, q := range points {
+			alphas = append(alphas, a.AlphaAt(q.X, q.Y).A)
+		}
+		return fmt.Sprint(alphas)
+	}
+	testCases := []struct {
+		desc             string
+		d                string
+		evenOdd, nonZero string
+	}{
+		{
+			"overlapping squares in the same direction",
+			"M10 10 H50 V50 H10 Z M30 30 H70 V70 H30 Z",
+			"[255 0 255]", "[255 255 255]",
+		},
+		{
+			"overlapping squares in opposite directions",
+			"M10 10 H50 V50 H10 Z M30 30 V70 H70 V30 Z",
+			"[255 0 255]", "[255 0 255]",
+		},
+		{
+			"three coincident squares",
+			"M10 10 H50 V50 H10 Z M10 10 H50 V50 H10 Z M10 10 H50 V50 H10 Z",
+			"[255 255 0]", "[255 255 0]",
+		},
+	}
+	points := []image.Point{{20, 20}, {40, 40}, {60, 60}}
+	for _, tc := range testCases {
+		if got := fill(tc.d, EvenOdd, points...); got != tc.evenOdd {
+			t.Errorf("%s, EvenOdd: got %s, want %s", tc.desc, got, tc.evenOdd)
+		}
+		if got := fill(tc.d, NonZero, points...); got != tc.nonZero {
+			t.Errorf("%s, NonZero: got %s, want %s", tc.desc, got, tc.nonZero)
+		}
+	}
+
+	// A pentagram's center has a winding number of 2, and so is only filled
+	// by NonZero, but its points are filled by both.
+	star := "M40 5 L63 75 L3 30 L77 30 L17 75 Z"
+	center, point := image.Point{40, 40}, image.Point{40, 25}
+	if got, want := fill(star, EvenOdd, center, point), "[0 255]"; got != want {
+		t.Errorf("pentagram, EvenOdd: got %s, want %s", got, want)
+	}
+	if got, want := fill(star, NonZero, center, point), "[255 255]"; got != want {
+		t.Errorf("pentagram, NonZero: got %s, want %s", got, want)
+	}
+}
+
+func TestTolerance(t *testing.T) {
+	// The quadratic segment from (0, 0) to (80, 0) is 40 pixels high at its
+	// middle and 30 pixels high a quarter of the way along, where its two
+	// line approximation is 20 pixels high.
+	inside := func(tolerance int) bool {
+		r := NewRasterizer(80, 80)
+		r.Tolerance = tolerance
+		r.Start(pt(0, 0))
+		r.Add2(pt(40, 80), pt(80, 0))
+		r.Add1(pt(0, 0))
+		a := image.NewAlpha(image.Rect(0, 0, 80, 80))
+		r.Rasterize(NewAlphaSrcPainter(a))
+		return a.AlphaAt(20, 25).A == 0xff
+	}
+	testCases := []struct {
+		tolerance int
+		want      bool
+	}{
+		{0, true},
+		{1, true},
+		{64, true},
+		{16 * 64, false},
+		// Huge tolerances are clamped, rather than overflowing.
+		{1 << 26, false},
+		{math.MaxInt32, false},
+	}
+	for _, tc := range testCases {
+		if got := inside(tc.tolerance); got != tc.want {
+			t.Errorf("tolerance %d: got %t, want %t", tc.tolerance, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package raster
+
+// kappa is the distance, as a 24.8 fixed point fraction of the radius, from
+// the end points of a cubic Bézier approximation of a quarter circle to its
+// control points. It is 4*(sqrt(2)-1)/3, or approximately 0.5523.
+const kappa = 141
+
+// mulKappa returns r multiplied by kappa.
+func mulKappa(r Fix32) Fix32 {
+	return Fix32(int64(r) * kappa >> 8)
+}
+
+// The rectangles and ellipses below are closed curves that are all traced in
+// the same direction, so that overlapping shapes add to rather than cancel out
+// each other's coverage. They can be filled by a Rasterizer, or outlined by
+// AddStroke.
+
+// AddRect adds the closed curve of the rectangle with the given minimum and
+// maximum corners.
+func (p *Path) AddRect(min, max Point) {
+	p.Start(min)
+	p.Add1(Point{max.X, min.Y})
+	p.Add1(max)
+	p.Add1(Point{min.X, max.Y})
+	p.Add1(min)
+}
+
+// AddRoundedRect adds the closed curve of the rectangle with the given
+// minimum and maximum corners and with corners rounded to the given radius.
+// The radius is reduced, if necessary, to half of the rectangle's width or
+// height, and a non-positive radius adds a rectangle with square corners.
+func (p *Path) AddRoundedRect(min, max Point, r Fix32) {
+	if w := (max.X - min.X) / 2; r > w {
+		r = w
+	}
+	if h := (max.Y - min.Y) / 2; r > h {
+		r = h
+	}
+	if r <= 0 {
+		p.AddRect(min, max)
+		return
+	}
+	k := r - mulKappa(r)
+	p.Start(Point{min.X + r, min.Y})
+	p.Add1(Point{max.X - r, min.Y})
+	p.Add3(Point{max.X - k, min.Y}, Point{max.X, min.Y + k}, Point{max.X, min.Y + r})
+	p.Add1(Point{max.X, max.Y - r})
+	p.Add3(Point{max.X, max.Y - k}, Point{max.X - k, max.Y}, Point{max.X - r, max.Y})
+	p.Add1(Point{min.X + r, max.Y})
+	p.Add3(Point{min.X + k, max.Y}, Point{min.X, max.Y - k}, Point{min.X, max.Y - r})
+	p.Add1(Point{min.X, min.Y + r})
+	p.Add3(Point{min.X, min.Y + k}, Point{min.X + k, min.Y}, Point{min.X + r, min.Y})
+}
+
+// AddCircle adds the closed curve of the circle with the given center and
+// radius.
+func (p *Path) AddCircle(c Point, r Fix32) {
+	p.AddEllipse(c, r, r)
+}
+
+// AddEllipse adds the closed curve of the axis-aligned ellipse with the given
+// center and horizontal and vertical radii. The ellipse is approximated by
+// four cubic segments.
+func (p *Path) AddEllipse(c Point, rx, ry Fix32) {
+	kx, ky := mulKappa(rx), mulKappa(ry)
+	p.Start(Point{c.X + rx, c.Y})
+	p.Add3(Point{c.X + rx, c.Y + ky}, Point{c.X + kx, c.Y + ry}, Point{c.X, c.Y + ry})
+	p.Add3(Point{c.X - kx, c.Y + ry}, Point{c.X - rx, c.Y + ky}, Point{c.X - rx, c.Y})
+	p.Add3(Point{c.X - rx, c.Y - ky}, Point{c.X - kx, c.Y - ry}, Point{c.X, c.Y - ry})
+	p.Add3(Point{c.X + kx, c.Y - ry}, Point{c.X + rx, c.Y - ky}, Point{c.X + rx, c.Y})
+}
+
+// AddPolygon adds the closed curve through the given vertices, which is
+// closed by a linear segment from the last vertex back to the first.
+func (p *Path) AddPolygon(vertices ...Point) {
+	if len(vertices) == 0 {
+		return
+	}
+	p.AddPolyline(vertices...)
+	p.Add1(vertices[0])
+}
+
+// AddPolyline adds the open curve of linear segments through the given
+// points. Unlike the shapes above, it is not closed, and is typically
+// stroked, such as for a chart's axes, rather than filled.
+func (p *Path) AddPolyline(points ...Point) {
+	if len(points) == 0 {
+		return
+	}
+	p.Start(points[0])
+	for _, q := range points[1:] {
+		p.Add1(q)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package raster
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"testing"
+)
+
+func TestShapes(t *testing.T) {
+	// fill rasterizes the shapes added by add, and returns their area, the
+	// sum of the pixels' coverage, and the alpha at each of the given points.
+	fill := func(add func(p *Path), points ...image.Point) (float64, string) {
+		var p Path
+		add(&p)
+		r := NewRasterizer(80, 80)
+		r.AddPath(p)
+		a := image.NewAlpha(image.Rect(0, 0, 80, 80))
+		r.RasterizeFillRule(NewAlphaSrcPainter(a), NonZero)
+		area := 0.0
+		for _, x := range a.Pix {
+			area += float64(x) / 0xff
+		}
+		var alphas []uint8
+		for _, q := range points {
+			alphas = append(alphas, a.AlphaAt(q.X, q.Y).A)
+		}
+		return area, fmt.Sprint(alphas)
+	}
+	testCases := []struct {
+		desc   string
+		add    func(p *Path)
+		area   float64
+		points []image.Point
+		want   string
+	}{
+		{
+			"rect",
+			func(p *Path) { p.AddRect(pt(10, 10), pt(50, 30)) },
+			800,
+			[]image.Point{{10, 10}, {49, 29}, {30, 20}, {9, 20}, {50, 20}, {30, 30}},
+			"[255 255 255 0 0 0]",
+		},
+		{
+			// The corners lose (4 - π) * r * r of the rectangle's area.
+			"rounded rect",
+			func(p *Path) { p.AddRoundedRect(pt(10, 10), pt(70, 50), 10<<8) },
+			2400 - (4-math.Pi)*100,
+			[]image.Point{{10, 10}, {69, 49}, {12, 40}, {40, 10}, {40, 30}},
+			"[0 0 255 255 255]",
+		},
+		{
+			"rounded rect with a non-positive radius",
+			func(p *Path) { p.AddRoundedRect(pt(10, 10), pt(50, 30), -1) },
+			800,
+			[]image.Point{{10, 10}, {49, 29}},
+			"[255 255]",
+		},
+		{
+			// The radius is reduced to half of the height, making a circle.
+			"rounded rect with a large radius",
+			func(p *Path) { p.AddRoundedRect(pt(20, 20), pt(60, 60), 100<<8) },
+			math.Pi * 400,
+			[]image.Point{{20, 20}, {40, 40}, {40, 21}},
+			"[0 255 255]",
+		},
+		{
+			"circle",
+			func(p *Path) { p.AddCircle(pt(40, 40), 20<<8) },
+			math.Pi * 400,
+			[]image.Point{{40, 40}, {40, 21}, {24, 24}, {40, 61}},
+			"[255 255 0 0]",
+		},
+		{
+			"ellipse",
+			func(p *Path) { p.AddEllipse(pt(40, 40), 30<<8, 10<<8) },
+			math.Pi * 300,
+			[]image.Point{{40, 40}, {12, 40}, {40, 31}, {40, 25}},
+			"[255 255 255 0]",
+		},
+		{
+			"polygon",
+			func(p *Path) { p.AddPolygon(pt(10, 10), pt(70, 10), pt(10, 70)) },
+			1800,
+			[]image.Point{{20, 20}, {11, 65}, {60, 60}},
+			"[255 255 0]",
+		},
+		{
+			"empty polygon",
+			func(p *Path) { p.AddPolygon() },
+			0,
+			nil,
+			"[]",
+		},
+		{
+			// The shapes are traced in the same direction, so that their
+			// overlap is filled with the NonZero rule. A negative area is
+			// not checked.
+			"overlapping shapes",
+			func(p *Path) {
+				p.AddRect(pt(10, 10), pt(50, 50))
+				p.AddCircle(pt(50, 50), 15<<8)
+				p.AddPolygon(pt(40, 40), pt(70, 40), pt(70, 70))
+			},
+			-1,
+			[]image.Point{{45, 45}, {55, 45}, {20, 20}, {20, 60}},
+			"[255 255 255 0]",
+		},
+	}
+	for _, tc := range testCases {
+		area, got := fill(tc.add, tc.points...)
+		if got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.desc, got, tc.want)
+		}
+		if tc.area >= 0 && math.Abs(area-tc.area) > tc.area/100 {
+			t.Errorf("%s: got area %.1f, want %.1f", tc.desc, area, tc.area)
+		}
+	}
+}
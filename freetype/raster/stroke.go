@@ -93,6 +93,36 @@ func bevelJoiner(lhs, rhs Adder, haflWidth Fix32, pivot, n0, n1 Point) {
 	rhs.Add1(pivot.Sub(n1))
 }
 
+// MiterJoiner returns a Joiner that adds miter joins to a stroked path,
+// extending the outer edges of the two segments until they meet. If the miter
+// length, from the join's inner corner to its outer corner, would be more than
+// limit times the stroke width, the join is beveled instead. This is the
+// stroke-miterlimit of SVG and CSS, whose default is 4, and the miter limit
+// of PDF, whose default is 10. A limit of less than 1 always bevels.
+func MiterJoiner(limit float64) Joiner {
+	return JoinerFunc(func(lhs, rhs Adder, halfWidth Fix32, pivot, n0, n1 Point) {
+		// h is the midpoint of the two normals, and points from the pivot to
+		// the outer corner. The ratio of the miter length to the stroke width
+		// is the ratio of the half-width to the length of h.
+		h := midpoint(n0, n1)
+		hh, uu := float64(h.Dot(h)), float64(halfWidth)*float64(halfWidth)
+		if hh == 0 || uu > limit*limit*hh {
+			bevelJoiner(lhs, rhs, halfWidth, pivot, n0, n1)
+			return
+		}
+		tip := Point{Fix32(float64(h.X) * uu / hh), Fix32(float64(h.Y) * uu / hh)}
+		if n0.Rot90CW().Dot(n1) >= 0 {
+			lhs.Add1(pivot.Add(tip))
+			lhs.Add1(pivot.Add(n1))
+			rhs.Add1(pivot.Sub(n1))
+		} else {
+			lhs.Add1(pivot.Add(n1))
+			rhs.Add1(pivot.Sub(tip))
+			rhs.Add1(pivot.Sub(n1))
+		}
+	})
+}
+
 // addArc adds a circular arc from pivot+n0 to pivot+n1 to p. The shorter of
 // the two possible arcs is taken, i.e. the one spanning <= 180 degrees.
 // The two vectors n0 and n1 must be of equal length.
@@ -239,6 +269,8 @@ type stroker struct {
 	// a is the most recent segment point. anorm is the segment normal of
 	// length u at that point.
 	a, anorm Point
+	// startNorm is the normal of length u at the curve's start point.
+	startNorm Point
 }
 
 // addNonCurvy2 adds a quadratic segment to the stroker, where the segment
@@ -311,6 +343,7 @@ func (k *stroker) Add1(b Point) {
 	if len(k.r) == 0 {
 		k.p.Start(k.a.Add(bnorm))
 		k.r.Start(k.a.Sub(bnorm))
+		k.startNorm = bnorm
 	} else {
 		k.jr.Join(k.p, &k.r, k.u, k.a, k.anorm, bnorm)
 	}
@@ -327,6 +360,7 @@ func (k *stroker) Add2(b, c Point) {
 	if len(k.r) == 0 {
 		k.p.Start(k.a.Add(abnorm))
 		k.r.Start(k.a.Sub(abnorm))
+		k.startNorm = abnorm
 	} else {
 		k.jr.Join(k.p, &k.r, k.u, k.a, k.anorm, abnorm)
 	}
@@ -390,9 +424,14 @@ func (k *stroker) Add2(b, c Point) {
 	k.addNonCurvy2(mbc, c)
 }
 
-// Add3 adds a cubic segment to the stroker.
+// Add3 adds a cubic segment to the stroker, approximated by quadratic
+// segments to within 1/16th of a pixel.
 func (k *stroker) Add3(b, c, d Point) {
-	panic("freetype/raster: stroke unimplemented for cubic segments")
+	var q Path
+	q.addCubicAsQuadratics(k.a, b, c, d, 16, 0)
+	for i := 0; i < len(q); i += 6 {
+		k.Add2(Point{q[i+1], q[i+2]}, Point{q[i+3], q[i+4]})
+	}
 }
 
 // stroke adds the stroked Path q to p, where q consists of exactly one curve.
@@ -421,8 +460,14 @@ func (k *stroker) stroke(q Path) {
 	if len(k.r) == 0 {
 		return
 	}
-	// TODO(nigeltao): if q is a closed curve then we should join the first and
-	// last segments instead of capping them.
+	if q.firstPoint() == q.lastPoint() {
+		// A closed curve is joined, rather than capped, at its start point,
+		// and its two sides are separate closed contours.
+		k.jr.Join(k.p, &k.r, k.u, k.a, k.anorm, k.startNorm)
+		k.p.Start(k.r.lastPoint())
+		addPathReversed(k.p, k.r)
+		return
+	}
 	k.cr.Cap(k.p, k.u, q.lastPoint(), k.anorm.Neg())
 	addPathReversed(k.p, k.r)
 	pivot := q.firstPoint()
@@ -430,8 +475,14 @@ func (k *stroker) stroke(q Path) {
 }
 
 // Stroke adds q stroked with the given width to p. The result is typically
-// self-intersecting and should be rasterized with UseNonZeroWinding.
+// self-intersecting and should be rasterized with the NonZero fill rule.
 // cr and jr may be nil, which defaults to a RoundCapper or RoundJoiner.
+//
+// A curve that ends at its start point, such as a closed SVG subpath or a
+// Rect, is joined there rather than capped. A Path does not record whether a
+// curve was explicitly closed, so unlike in SVG, CSS and PDF, which only join
+// a subpath that is closed, such as by SVG's Z command, an open curve that
+// happens to end at its start point is also joined.
 func Stroke(p Adder, q Path, width Fix32, cr Capper, jr Joiner) {
 	if len(q) == 0 {
 		return
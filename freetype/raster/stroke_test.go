@@ -0,0 +1,60 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package raster
+
+import (
+	"fmt"
+	"image"
+	"testing"
+)
+
+func TestStroke(t *testing.T) {
+	// stroke returns the alpha at each of the given points of the path d,
+	// stroked 10 pixels wide.
+	stroke := func(d string, cr Capper, jr Joiner, points ...image.Point) string {
+		p, err := ParseSVGPath(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := NewRasterizer(80, 80)
+		r.AddStroke(p, 10<<8, cr, jr)
+		a := image.NewAlpha(image.Rect(0, 0, 80, 80))
+		r.RasterizeFillRule(NewAlphaSrcPainter(a), NonZero)
+		var alphas []uint8
+		for _, q := range points {
+			alphas = append(alphas, a.AlphaAt(q.X, q.Y).A)
+		}
+		return fmt.Sprint(alphas)
+	}
+	// The path turns a right angle at (40, 40), whose outer corner is at
+	// (45, 45), and its ends are at (10, 40) and (40, 10).
+	const corner = "M10 40 H40 V10"
+	testCases := []struct {
+		desc   string
+		d      string
+		cr     Capper
+		jr     Joiner
+		points []image.Point
+		want   string
+	}{
+		{"miter join", corner, nil, MiterJoiner(4), []image.Point{{44, 44}, {41, 41}}, "[255 255]"},
+		// The miter length of a right angle is √2 times the stroke width.
+		{"miter join beyond its limit", corner, nil, MiterJoiner(1.4), []image.Point{{44, 44}, {41, 41}}, "[0 255]"},
+		{"bevel join", corner, nil, BevelJoiner, []image.Point{{44, 44}, {41, 41}}, "[0 255]"},
+		{"round join", corner, nil, RoundJoiner, []image.Point{{44, 44}, {41, 41}}, "[0 255]"},
+		{"butt caps", corner, ButtCapper, nil, []image.Point{{7, 40}, {40, 7}, {12, 40}}, "[0 0 255]"},
+		{"square caps", corner, SquareCapper, nil, []image.Point{{7, 44}, {44, 7}, {12, 40}}, "[255 255 255]"},
+		{"round caps", corner, RoundCapper, nil, []image.Point{{7, 40}, {6, 45}}, "[255 0]"},
+		// A closed curve is joined at its start point, and is a ring.
+		{"closed curve", "M10 10 H50 V50 H10 Z", nil, MiterJoiner(4), []image.Point{{6, 6}, {53, 53}, {30, 30}}, "[255 255 0]"},
+		{"cubic curve", "M10 40 C10 10 70 10 70 40", ButtCapper, nil, []image.Point{{10, 38}, {40, 17}, {70, 38}, {40, 40}}, "[255 255 255 0]"},
+	}
+	for _, tc := range testCases {
+		if got := stroke(tc.d, tc.cr, tc.jr, tc.points...); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.desc, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,431 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package raster
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ParseSVGPath parses SVG path data, the value of a path element's "d"
+// attribute, such as "M 10 10 h 20 v 20 z". The syntax is documented at
+// http://www.w3.org/TR/SVG11/paths.html#PathData. One SVG user unit is one
+// unit of the Path, which is one pixel when the Path is rasterized. Both
+// SVG and a Rasterizer have the Y-axis growing downwards.
+//
+// Elliptical arcs are approximated by cubic segments. Closed subpaths end
+// with a linear segment back to their start point, if they are not already
+// there.
+func ParseSVGPath(d string) (Path, error) {
+	var p Path
+	if err := p.AddSVGPath(d); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// AddSVGPath adds the curves given by SVG path data to p, as per
+// ParseSVGPath. If the data is invalid, p is unchanged.
+func (p *Path) AddSVGPath(d string) error {
+	s := svgParser{data: d, path: *p}
+	if err := s.parse(); err != nil {
+		return err
+	}
+	*p = s.path
+	return nil
+}
+
+// svgParser is the state of parsing SVG path data.
+type svgParser struct {
+	data string
+	i    int
+	path Path
+	// x, y is the current point and x0, y0 is the current subpath's start
+	// point. started is whether there has been a moveto command, and open is
+	// whether a subpath has been started and not closed.
+	x, y, x0, y0  float64
+	started, open bool
+	// cx, cy is the last control point of the previous segment, if it was a
+	// cubic (for 'S') or quadratic (for 'T') segment.
+	cx, cy   float64
+	lastKind byte
+}
+
+// svgFix converts an SVG co-ordinate to 24.8 fixed point, rounding to
+// nearest.
+func svgFix(x float64) Fix32 {
+	return Fix32(math.Floor(x*256 + 0.5))
+}
+
+func (s *svgParser) point(x, y float64) Point {
+	return Point{svgFix(x), svgFix(y)}
+}
+
+func (s *svgParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("freetype/raster: bad SVG path data at offset %d: %s", s.i, fmt.Sprintf(format, args...))
+}
+
+// skipSpace skips white space.
+func (s *svgParser) skipSpace() {
+	for s.i < len(s.data) {
+		switch s.data[s.i] {
+		case ' ', '\t', '\n', '\r', '\f':
+			s.i++
+		default:
+			return
+		}
+	}
+}
+
+// skipSeparator skips white space and at most one comma.
+func (s *svgParser) skipSeparator() {
+	s.skipSpace()
+	if s.i < len(s.data) && s.data[s.i] == ',' {
+		s.i++
+		s.skipSpace()
+	}
+}
+
+// number parses a number, followed by an optional separator.
+func (s *svgParser) number() (float64, error) {
+	s.skipSpace()
+	start, digits := s.i, 0
+	isDigit := func() bool {
+		return s.i < len(s.data) && '0' <= s.data[s.i] && s.data[s.i] <= '9'
+	}
+	if s.i < len(s.data) && (s.data[s.i] == '-' || s.data[s.i] == '+') {
+		s.i++
+	}
+	for ; isDigit(); s.i++ {
+		digits++
+	}
+	if s.i < len(s.data) && s.data[s.i] == '.' {
+		for s.i++; isDigit(); s.i++ {
+			digits++
+		}
+	}
+	if digits == 0 {
+		s.i = start
+		return 0, s.errorf("expected a number")
+	}
+	if s.i < len(s.data) && (s.data[s.i] == 'e' || s.data[s.i] == 'E') {
+		j := s.i + 1
+		if j < len(s.data) && (s.data[j] == '-' || s.data[j] == '+') {
+			j++
+		}
+		if j < len(s.data) && '0' <= s.data[j] && s.data[j] <= '9' {
+			for s.i = j; isDigit(); s.i++ {
+			}
+		}
+	}
+	text := s.data[start:s.i]
+	x, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		s.i = start
+		return 0, s.errorf("bad number %q", text)
+	}
+	s.skipSeparator()
+	return x, nil
+}
+
+// numbers parses len(dst) numbers into dst.
+func (s *svgParser) numbers(dst []float64) error {
+	for i := range dst {
+		x, err := s.number()
+		if err != nil {
+			return err
+		}
+		dst[i] = x
+	}
+	return nil
+}
+
+// flag parses an arc flag, which is a single '0' or '1' that need not be
+// followed by a separator.
+func (s *svgParser) flag() (bool, error) {
+	s.skipSpace()
+	if s.i == len(s.data) || (s.data[s.i] != '0' && s.data[s.i] != '1') {
+		return false, s.errorf("expected a flag")
+	}
+	f := s.data[s.i] == '1'
+	s.i++
+	s.skipSeparator()
+	return f, nil
+}
+
+// startSegment starts a new subpath at the current point, if a segment is
+// added after a subpath was closed.
+func (s *svgParser) startSegment() {
+	if !s.open {
+		s.path.Start(s.point(s.x, s.y))
+		s.open = true
+	}
+}
+
+func (s *svgParser) parse() error {
+	var cmd byte
+	for {
+		s.skipSpace()
+		if s.i == len(s.data) {
+			return nil
+		}
+		if c := s.data[s.i]; ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') {
+			cmd = c
+			s.i++
+		} else if cmd == 0 || cmd == 'z' || cmd == 'Z' {
+			return s.errorf("expected a command")
+		}
+		// Relative commands are lower case.
+		rel, ox, oy := cmd >= 'a', 0.0, 0.0
+		if rel {
+			ox, oy = s.x, s.y
+		}
+		if cmd != 'M' && cmd != 'm' && !s.started {
+			return s.errorf("path data must start with a moveto command")
+		}
+		var a [7]float64
+		kind := byte(0)
+		switch cmd {
+		case 'M', 'm':
+			if err := s.numbers(a[:2]); err != nil {
+				return err
+			}
+			s.x, s.y = ox+a[0], oy+a[1]
+			s.x0, s.y0 = s.x, s.y
+			s.path.Start(s.point(s.x, s.y))
+			s.started, s.open = true, true
+			// Subsequent pairs of co-ordinates are implicit lineto commands.
+			if rel {
+				cmd = 'l'
+			} else {
+				cmd = 'L'
+			}
+		case 'Z', 'z':
+			if s.open && (s.x != s.x0 || s.y != s.y0) {
+				s.path.Add1(s.point(s.x0, s.y0))
+			}
+			s.x, s.y, s.open = s.x0, s.y0, false
+		case 'L', 'l':
+			if err := s.numbers(a[:2]); err != nil {
+				return err
+			}
+			s.startSegment()
+			s.x, s.y = ox+a[0], oy+a[1]
+			s.path.Add1(s.point(s.x, s.y))
+		case 'H', 'h':
+			if err := s.numbers(a[:1]); err != nil {
+				return err
+			}
+			s.startSegment()
+			s.x = ox + a[0]
+			s.path.Add1(s.point(s.x, s.y))
+		case 'V', 'v':
+			if err := s.numbers(a[:1]); err != nil {
+				return err
+			}
+			s.startSegment()
+			s.y = oy + a[0]
+			s.path.Add1(s.point(s.x, s.y))
+		case 'C', 'c', 'S', 's':
+			smooth := cmd == 'S' || cmd == 's'
+			n := 6
+			if smooth {
+				n = 4
+				// The first control point is the reflection of the previous
+				// segment's second control point, or the current point.
+				a[0], a[1] = s.x-ox, s.y-oy
+				if s.lastKind == 'c' {
+					a[0], a[1] = 2*s.x-s.cx-ox, 2*s.y-s.cy-oy
+				}
+			}
+			if err := s.numbers(a[6-n : 6]); err != nil {
+				return err
+			}
+			s.startSegment()
+			s.path.Add3(s.point(ox+a[0], oy+a[1]), s.point(ox+a[2], oy+a[3]), s.point(ox+a[4], oy+a[5]))
+			s.cx, s.cy = ox+a[2], oy+a[3]
+			s.x, s.y = ox+a[4], oy+a[5]
+			kind = 'c'
+		case 'Q', 'q', 'T', 't':
+			smooth := cmd == 'T' || cmd == 't'
+			n := 4
+			if smooth {
+				n = 2
+				a[0], a[1] = s.x-ox, s.y-oy
+				if s.lastKind == 'q' {
+					a[0], a[1] = 2*s.x-s.cx-ox, 2*s.y-s.cy-oy
+				}
+			}
+			if err := s.numbers(a[4-n : 4]); err != nil {
+				return err
+			}
+			s.startSegment()
+			s.path.Add2(s.point(ox+a[0], oy+a[1]), s.point(ox+a[2], oy+a[3]))
+			s.cx, s.cy = ox+a[0], oy+a[1]
+			s.x, s.y = ox+a[2], oy+a[3]
+			kind = 'q'
+		case 'A', 'a':
+			if err := s.numbers(a[:3]); err != nil {
+				return err
+			}
+			large, err := s.flag()
+			if err != nil {
+				return err
+			}
+			sweep, err := s.flag()
+			if err != nil {
+				return err
+			}
+			if err := s.numbers(a[3:5]); err != nil {
+				return err
+			}
+			s.startSegment()
+			s.arc(a[0], a[1], a[2], large, sweep, ox+a[3], oy+a[4])
+		default:
+			return s.errorf("unknown command %q", cmd)
+		}
+		s.lastKind = kind
+	}
+}
+
+// arc adds an elliptical arc from the current point to (x, y), as per
+// http://www.w3.org/TR/SVG11/implnote.html#ArcImplementationNotes, and sets
+// the current point to (x, y).
+func (s *svgParser) arc(rx, ry, phi float64, large, sweep bool, x, y float64) {
+	x1, y1 := s.x, s.y
+	s.x, s.y = x, y
+	if x1 == x && y1 == y {
+		return
+	}
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 {
+		s.path.Add1(s.point(x, y))
+		return
+	}
+	sinPhi, cosPhi := math.Sincos(phi * math.Pi / 180)
+
+	// Compute the center (cx, cy), as per section F.6.5 of the notes.
+	dx, dy := (x1-x)/2, (y1-y)/2
+	x1p := cosPhi*dx + sinPhi*dy
+	y1p := -sinPhi*dx + cosPhi*dy
+	// Scale up radii that are too small, as per section F.6.6.
+	if l := x1p*x1p/(rx*rx) + y1p*y1p/(ry*ry); l > 1 {
+		l = math.Sqrt(l)
+		rx, ry = rx*l, ry*l
+	}
+	num := rx*rx*ry*ry - rx*rx*y1p*y1p - ry*ry*x1p*x1p
+	den := rx*rx*y1p*y1p + ry*ry*x1p*x1p
+	k := 0.0
+	if num > 0 && den > 0 {
+		k = math.Sqrt(num / den)
+	}
+	if large == sweep {
+		k = -k
+	}
+	cxp, cyp := k*rx*y1p/ry, -k*ry*x1p/rx
+	cx := cosPhi*cxp - sinPhi*cyp + (x1+x)/2
+	cy := sinPhi*cxp + cosPhi*cyp + (y1+y)/2
+
+	// Compute the start angle and the angle swept.
+	angle := func(ux, uy, vx, vy float64) float64 {
+		return math.Atan2(ux*vy-uy*vx, ux*vx+uy*vy)
+	}
+	theta := angle(1, 0, (x1p-cxp)/rx, (y1p-cyp)/ry)
+	delta := angle((x1p-cxp)/rx, (y1p-cyp)/ry, (-x1p-cxp)/rx, (-y1p-cyp)/ry)
+	if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	} else if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	}
+
+	// Approximate the arc by cubic segments of at most 90 degrees each.
+	n := int(math.Ceil(math.Abs(delta) / (math.Pi / 2)))
+	d := delta / float64(n)
+	kappa := 4.0 / 3 * math.Tan(d/4)
+	// ellipse returns the point at angle t on the ellipse, and the
+	// derivative there.
+	ellipse := func(t float64) (px, py, tx, ty float64) {
+		sin, cos := math.Sincos(t)
+		ex, ey := rx*cos, ry*sin
+		tx0, ty0 := -rx*sin, ry*cos
+		return cx + cosPhi*ex - sinPhi*ey, cy + sinPhi*ex + cosPhi*ey,
+			cosPhi*tx0 - sinPhi*ty0, sinPhi*tx0 + cosPhi*ty0
+	}
+	px, py, tx, ty := ellipse(theta)
+	for i := 1; i <= n; i++ {
+		qx, qy, ux, uy := ellipse(theta + float64(i)*d)
+		if i == n {
+			// Avoid rounding errors at the end point.
+			qx, qy = x, y
+		}
+		s.path.Add3(
+			s.point(px+kappa*tx, py+kappa*ty),
+			s.point(qx-kappa*ux, qy-kappa*uy),
+			s.point(qx, qy),
+		)
+		px, py, tx, ty = qx, qy, ux, uy
+	}
+}
+
+// Quadratic returns p with each cubic segment replaced by one or more
+// quadratic segments, which approximate it to within the given tolerance.
+// The result has only linear and quadratic segments, like TrueType glyph
+// outlines.
+func (p Path) Quadratic(tolerance Fix32) Path {
+	if tolerance < 1 {
+		tolerance = 1
+	}
+	q := make(Path, 0, len(p))
+	var last Point
+	for i := 0; i < len(p); {
+		switch p[i] {
+		case 0:
+			last = Point{p[i+1], p[i+2]}
+			q.Start(last)
+			i += 4
+		case 1:
+			last = Point{p[i+1], p[i+2]}
+			q.Add1(last)
+			i += 4
+		case 2:
+			last = Point{p[i+3], p[i+4]}
+			q.Add2(Point{p[i+1], p[i+2]}, last)
+			i += 6
+		case 3:
+			b, c, d := Point{p[i+1], p[i+2]}, Point{p[i+3], p[i+4]}, Point{p[i+5], p[i+6]}
+			q.addCubicAsQuadratics(last, b, c, d, tolerance, 0)
+			last = d
+			i += 8
+		default:
+			panic("freetype/raster: bad path")
+		}
+	}
+	return q
+}
+
+// addCubicAsQuadratics adds quadratic segments that approximate the cubic
+// segment from a to d with control points b and c, splitting it in halves
+// until each half is within the tolerance.
+func (p *Path) addCubicAsQuadratics(a, b, c, d Point, tolerance Fix32, depth int) {
+	// The distance between the cubic and the quadratic with the control point
+	// (3*(b+c) - a - d) / 4 is at most √3/36 times |d - 3*c + 3*b - a|.
+	e := d.Sub(c.Mul(3 << 8)).Add(b.Mul(3 << 8)).Sub(a)
+	if depth >= 16 || Fix32(int64(e.Len())*481/10000) <= tolerance {
+		ctrl := b.Add(c).Mul(3 << 8).Sub(a).Sub(d)
+		p.Add2(Point{ctrl.X / 4, ctrl.Y / 4}, d)
+		return
+	}
+	// Split the cubic at t = 0.5, by de Casteljau's algorithm.
+	mid := func(u, v Point) Point {
+		return Point{(u.X + v.X) / 2, (u.Y + v.Y) / 2}
+	}
+	ab, bc, cd := mid(a, b), mid(b, c), mid(c, d)
+	abc, bcd := mid(ab, bc), mid(bc, cd)
+	m := mid(abc, bcd)
+	p.addCubicAsQuadratics(a, ab, abc, m, tolerance, depth+1)
+	p.addCubicAsQuadratics(m, bcd, cd, d, tolerance, depth+1)
+}
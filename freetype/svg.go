@@ -0,0 +1,63 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package freetype
+
+import (
+	"github.com/lukevers/freetype-go/freetype/raster"
+	"github.com/lukevers/freetype-go/freetype/truetype"
+)
+
+// PathOutline converts a Path, such as one parsed by raster.ParseSVGPath,
+// into the points and contour end indexes of a TrueType glyph outline, in the
+// same form as a truetype.GlyphBuf's Point and End. Cubic segments are
+// approximated by quadratic ones to within the given tolerance.
+//
+// The points are the Path's co-ordinates rounded to integers, such as FUnits
+// for an SVG path drawn on a grid of FUnits, with the Y-axis negated, as the
+// Path's Y-axis grows downwards and a glyph's grows upwards. Quadratic
+// control points are off-curve points. Each contour is implicitly closed, as
+// for TrueType, and so a Path's final segment back to its start point is
+// dropped.
+func PathOutline(p raster.Path, tolerance raster.Fix32) (points []truetype.Point, ends []int) {
+	p = p.Quadratic(tolerance)
+	point := func(x, y raster.Fix32, on bool) truetype.Point {
+		q := truetype.Point{X: int32(x+128) >> 8, Y: -(int32(y+128) >> 8)}
+		if on {
+			q.Flags = 0x01
+		}
+		return q
+	}
+	endContour := func() {
+		start := 0
+		if len(ends) > 0 {
+			start = ends[len(ends)-1]
+		}
+		if n := len(points); n-start > 1 && points[n-1] == points[start] {
+			points = points[:n-1]
+		}
+		if len(points) > start {
+			ends = append(ends, len(points))
+		}
+	}
+	for i := 0; i < len(p); {
+		switch p[i] {
+		case 0:
+			endContour()
+			points = append(points, point(p[i+1], p[i+2], true))
+			i += 4
+		case 1:
+			points = append(points, point(p[i+1], p[i+2], true))
+			i += 4
+		case 2:
+			points = append(points, point(p[i+1], p[i+2], false), point(p[i+3], p[i+4], true))
+			i += 6
+		default:
+			panic("freetype: bad path")
+		}
+	}
+	endContour()
+	return points, ends
+}
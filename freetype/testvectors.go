@@ -0,0 +1,126 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package freetype
+
+import (
+	"fmt"
+	"hash/fnv"
+	"image"
+
+	"github.com/lukevers/freetype-go/freetype/raster"
+	"github.com/lukevers/freetype-go/freetype/truetype"
+)
+
+// A TestVector is the expected result of drawing a string with one of the
+// freely redistributable Luxi fonts in this repository's testdata directory.
+// Programs that embed this package can check the TestVectors against their
+// copy of the package, such as after an upgrade, to verify that it renders
+// text identically.
+//
+// The vectors use the Luxi fonts rather than a public-domain font because the
+// Luxi fonts are the only fonts that this repository distributes, so every
+// copy of the package's source has them. Their license permits redistributing
+// them unmodified, which is all that checking the vectors needs. A vector for
+// a font from elsewhere would depend on the exact version of that font's
+// file.
+type TestVector struct {
+	// Font is the font's file name in the testdata directory, such as
+	// "luxisr.ttf".
+	Font string
+	// Size is the font size in points, at 72 DPI, and Hinting is the
+	// hinting policy.
+	Size    float64
+	Hinting Hinting
+	// Text is the string drawn.
+	Text string
+	// Advance is the text extent returned by DrawString, and Hash is the
+	// 64-bit FNV-1a hash of the pixels of the image.Alpha drawn on.
+	Advance raster.Fix32
+	Hash    uint64
+}
+
+// testVectorText is the text of the TestVectors.
+const testVectorText = "The quick brown fox jumps over 13 lazy dogs."
+
+// TestVectors are the test vectors for each Luxi font at a number of sizes
+// and hinting policies.
+var TestVectors = []TestVector{
+	{"luxisr.ttf", 9, NoHinting, testVectorText, 46456, 0x66fc9b43decf59b9},
+	{"luxisr.ttf", 9, FullHinting, testVectorText, 49408, 0x8ea768df130ee9e1},
+	{"luxisr.ttf", 9, SubpixelHinting, testVectorText, 49408, 0x0b9eaa4e6bd8614f},
+	{"luxisr.ttf", 12, NoHinting, testVectorText, 61964, 0xc4eae36a05c4c099},
+	{"luxisr.ttf", 12, FullHinting, testVectorText, 63232, 0xfdfbd154b1592b3a},
+	{"luxisr.ttf", 12, SubpixelHinting, testVectorText, 63232, 0x56d3ca9a46c52544},
+	{"luxisr.ttf", 16, NoHinting, testVectorText, 82688, 0xcc632dc80611fa8d},
+	{"luxisr.ttf", 16, FullHinting, testVectorText, 82688, 0x4afa9c0c576c19c0},
+	{"luxisr.ttf", 16, SubpixelHinting, testVectorText, 82688, 0x2ebf0b95b31a5afd},
+	{"luxisr.ttf", 24, NoHinting, testVectorText, 123940, 0x66057f950d6b8f60},
+	{"luxisr.ttf", 24, FullHinting, testVectorText, 123392, 0x32ea15156037bf89},
+	{"luxisr.ttf", 24, SubpixelHinting, testVectorText, 123392, 0x22d9c68cf9be0ed1},
+	{"luxirr.ttf", 9, NoHinting, testVectorText, 42708, 0x88f10f4fe95032cd},
+	{"luxirr.ttf", 9, FullHinting, testVectorText, 45824, 0x9947cb822b881591},
+	{"luxirr.ttf", 9, SubpixelHinting, testVectorText, 45824, 0x6f71975fd19ffc85},
+	{"luxirr.ttf", 12, NoHinting, testVectorText, 56932, 0x8ce894a93ccd408f},
+	{"luxirr.ttf", 12, FullHinting, testVectorText, 56576, 0x8f7eab7e7c2087df},
+	{"luxirr.ttf", 12, SubpixelHinting, testVectorText, 56576, 0x787ab6cd3466aac3},
+	{"luxirr.ttf", 16, NoHinting, testVectorText, 75928, 0x77c06b0cac85668e},
+	{"luxirr.ttf", 16, FullHinting, testVectorText, 75520, 0x724757219a840c86},
+	{"luxirr.ttf", 16, SubpixelHinting, testVectorText, 75520, 0x7669b402fd978e2c},
+	{"luxirr.ttf", 24, NoHinting, testVectorText, 113864, 0xc0ab3969cdaaa451},
+	{"luxirr.ttf", 24, FullHinting, testVectorText, 114176, 0x2d68845113c2cb99},
+	{"luxirr.ttf", 24, SubpixelHinting, testVectorText, 114176, 0x12dfaaf13ecaf5ba},
+	{"luximr.ttf", 9, NoHinting, testVectorText, 60896, 0x24256128bd26c720},
+	{"luximr.ttf", 9, FullHinting, testVectorText, 56320, 0xa8b73a8cc9f0cadb},
+	{"luximr.ttf", 9, SubpixelHinting, testVectorText, 56320, 0x9a830fac9895024c},
+	{"luximr.ttf", 12, NoHinting, testVectorText, 81136, 0x0ae5b7213ee94134},
+	{"luximr.ttf", 12, FullHinting, testVectorText, 78848, 0x98aea6a97f481069},
+	{"luximr.ttf", 12, SubpixelHinting, testVectorText, 78848, 0xf6f02bea947cc703},
+	{"luximr.ttf", 16, NoHinting, testVectorText, 108240, 0x8d0e53f34454790e},
+	{"luximr.ttf", 16, FullHinting, testVectorText, 112640, 0x94da42a3c38d25e2},
+	{"luximr.ttf", 16, SubpixelHinting, testVectorText, 112640, 0x9f2ea1c1f47cd9a2},
+	{"luximr.ttf", 24, NoHinting, testVectorText, 162272, 0x2e1b0096b1ed8fab},
+	{"luximr.ttf", 24, FullHinting, testVectorText, 157696, 0x6579828c67d16a5b},
+	{"luximr.ttf", 24, SubpixelHinting, testVectorText, 157696, 0x066d645d861e4bc4},
+}
+
+// Draw draws v's text with the given font, which must be the font named by
+// v.Font, and returns the text extent and the hash of the drawn pixels. The
+// text is drawn in opaque black on a transparent image.Alpha that is 32 times
+// the font size wide and twice the font size tall, starting at half the font
+// size from the left and on a baseline at one and a half times the font size
+// from the top.
+func (v *TestVector) Draw(font *truetype.Font) (advance raster.Fix32, hash uint64, err error) {
+	s := int(v.Size)
+	dst := image.NewAlpha(image.Rect(0, 0, 32*s, 2*s))
+	c := NewContext()
+	c.SetDst(dst)
+	c.SetClip(dst.Bounds())
+	c.SetSrc(image.Opaque)
+	c.SetFont(font)
+	c.SetFontSize(v.Size)
+	c.SetHinting(v.Hinting)
+	p, err := c.DrawString(v.Text, Pt(s/2, 3*s/2))
+	if err != nil {
+		return 0, 0, err
+	}
+	h := fnv.New64a()
+	h.Write(dst.Pix)
+	return p.X - Pt(s/2, 0).X, h.Sum64(), nil
+}
+
+// Check draws v's text with the given font, which must be the font named by
+// v.Font, and returns an error if the result differs from that expected.
+func (v *TestVector) Check(font *truetype.Font) error {
+	advance, hash, err := v.Draw(font)
+	if err != nil {
+		return err
+	}
+	if advance != v.Advance || hash != v.Hash {
+		return fmt.Errorf("freetype: test vector %s %gpt hinting=%d: got advance %d, hash %#016x, want %d, %#016x",
+			v.Font, v.Size, v.Hinting, advance, hash, v.Advance, v.Hash)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// This file implements the parts of Apple Advanced Typography tables, such as
+// kerx and morx, that are common to several of them: lookup tables and
+// extended state tables. They are documented at
+// https://developer.apple.com/fonts/TrueType-Reference-Manual/RM06/Chap6Tables.html
+//
+// As for the OpenType layout tables, an invalid AAT table is not an error.
+
+// deletedGlyph is the glyph index that AAT tables use for a deleted glyph,
+// such as a component of a ligature.
+const deletedGlyph = 0xffff
+
+// aatLookup returns the value for the glyph from the AAT lookup table b, in a
+// font with nGlyph glyphs. ok is whether the table has a value for the glyph.
+func aatLookup(b []byte, g Index, nGlyph int) (value uint16, ok bool) {
+	if len(b) < 2 {
+		return 0, false
+	}
+	switch u16(b, 0) {
+	case 0:
+		// Format 0 is an array of values for every glyph.
+		if int(g) >= nGlyph || len(b) < 4+2*int(g) {
+			return 0, false
+		}
+		return u16(b, 2+2*int(g)), true
+	case 2, 4, 6:
+		// Formats 2 and 4 are segments of glyphs, sorted by their last glyph,
+		// and format 6 is single glyphs, sorted by glyph. Each is preceded by
+		// a binary search header, of which only the unit size and number of
+		// units are used.
+		if len(b) < 12 {
+			return 0, false
+		}
+		size, n := int(u16(b, 2)), int(u16(b, 4))
+		if size < 4 || len(b) < 12+size*n {
+			return 0, false
+		}
+		format := u16(b, 0)
+		if format != 6 && size < 6 {
+			return 0, false
+		}
+		lo, hi := 0, n
+		for lo < hi {
+			i := (lo + hi) / 2
+			if Index(u16(b, 12+size*i)) < g {
+				lo = i + 1
+			} else {
+				hi = i
+			}
+		}
+		if lo == n {
+			return 0, false
+		}
+		x := 12 + size*lo
+		if format == 6 {
+			if Index(u16(b, x)) != g {
+				return 0, false
+			}
+			return u16(b, x+2), true
+		}
+		first := Index(u16(b, x+2))
+		if g < first {
+			return 0, false
+		}
+		if format == 2 {
+			return u16(b, x+4), true
+		}
+		// Format 4's segments have the offset of an array of values.
+		y := int(u16(b, x+4)) + 2*int(g-first)
+		if len(b) < y+2 {
+			return 0, false
+		}
+		return u16(b, y), true
+	case 8:
+		// Format 8 is an array of values for a range of glyphs.
+		if len(b) < 6 {
+			return 0, false
+		}
+		first, n := Index(u16(b, 2)), int(u16(b, 4))
+		if g < first || int(g-first) >= n || len(b) < 6+2*n {
+			return 0, false
+		}
+		return u16(b, 6+2*int(g-first)), true
+	case 10:
+		// Format 10 is like format 8, but with values of 1 or 2 bytes.
+		if len(b) < 8 {
+			return 0, false
+		}
+		size, first, n := int(u16(b, 2)), Index(u16(b, 4)), int(u16(b, 6))
+		if size != 1 && size != 2 || g < first || int(g-first) >= n || len(b) < 8+size*n {
+			return 0, false
+		}
+		x := 8 + size*int(g-first)
+		if size == 1 {
+			return uint16(b[x]), true
+		}
+		return u16(b, x), true
+	}
+	return 0, false
+}
+
+// The classes that every extended state table has.
+const (
+	classEndOfText   = 0
+	classOutOfBounds = 1
+	classDeleted     = 2
+)
+
+// Flags of an extended state table's entries.
+const (
+	// stateDontAdvance means to process the current glyph again, in the new
+	// state, rather than moving to the next glyph.
+	stateDontAdvance = 0x4000
+)
+
+// stateTable is an AAT extended state table, which drives a state machine
+// over a run of glyphs. Each entry of its entry table has a new state, flags
+// and data that depends on the kind of table.
+type stateTable struct {
+	nClasses  int
+	entrySize int
+	nGlyph    int
+	// classes is the lookup table of the glyphs' classes, and states and
+	// entries are the state array and entry table, each sliced from its
+	// start to the end of the table.
+	classes, states, entries []byte
+}
+
+// parseStateTable parses the extended state table at the start of b, whose
+// entries are entrySize bytes, for a font with nGlyph glyphs.
+func parseStateTable(b []byte, entrySize, nGlyph int) (t stateTable, ok bool) {
+	if len(b) < 16 {
+		return stateTable{}, false
+	}
+	nClasses := u32(b, 0)
+	classes, states, entries := u32(b, 4), u32(b, 8), u32(b, 12)
+	if nClasses < 4 || nClasses > 0xffff ||
+		classes >= uint32(len(b)) || states >= uint32(len(b)) || entries >= uint32(len(b)) {
+		return stateTable{}, false
+	}
+	return stateTable{
+		nClasses:  int(nClasses),
+		entrySize: entrySize,
+		nGlyph:    nGlyph,
+		classes:   b[classes:],
+		states:    b[states:],
+		entries:   b[entries:],
+	}, true
+}
+
+// class returns the glyph's class.
+func (t *stateTable) class(g Index) int {
+	if g == deletedGlyph {
+		return classDeleted
+	}
+	c, ok := aatLookup(t.classes, g, t.nGlyph)
+	if !ok || int(c) >= t.nClasses {
+		return classOutOfBounds
+	}
+	return int(c)
+}
+
+// run runs the state machine over glyphs, calling action with each position
+// and the flags and data of its entry. The position is len(glyphs) for the
+// end of the text. action may change the glyphs, but not their number.
+func (t *stateTable) run(glyphs []Index, action func(i int, flags uint16, data []byte)) {
+	// The number of steps is limited, as entries that do not advance could
+	// otherwise loop forever.
+	maxSteps := 8*len(glyphs) + 64
+	state := 0
+	for i, step := 0, 0; step < maxSteps; step++ {
+		class := classEndOfText
+		if i < len(glyphs) {
+			class = t.class(glyphs[i])
+		}
+		x := 2 * (state*t.nClasses + class)
+		if len(t.states) < x+2 {
+			return
+		}
+		y := t.entrySize * int(u16(t.states, x))
+		if len(t.entries) < y+t.entrySize {
+			return
+		}
+		e := t.entries[y : y+t.entrySize]
+		flags := u16(e, 2)
+		action(i, flags, e[4:])
+		state = int(u16(e, 0))
+		if i == len(glyphs) {
+			return
+		}
+		if flags&stateDontAdvance == 0 {
+			i++
+		}
+	}
+}
@@ -0,0 +1,358 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// This file implements an autohinter, which hints glyphs in the y direction
+// without using the font's hinting instructions. It works in three steps,
+// loosely following C Freetype's autohinter:
+//
+// First, the font's blue zones are measured from reference glyphs. Each zone
+// is a height, such as the baseline or the x-height, and its overshoot, which
+// is how far round glyphs such as 'o' extend past flat glyphs such as 'x'.
+//
+// Second, a glyph's horizontal edges are detected, and are snapped to the
+// blue zones or paired up into stems, whose widths are rounded to whole
+// pixels.
+//
+// Third, the remaining points are interpolated between the edges' points, in
+// the same way as the IUP instruction.
+
+// blueZone is a blue zone, in the scaled 26.6 co-ordinates of the glyphs.
+type blueZone struct {
+	// ref is the height of flat glyphs, and overshoot is that of round ones.
+	ref, overshoot int32
+	// top is whether the zone is at the top of glyphs, such as the x-height,
+	// rather than the bottom, such as the baseline.
+	top bool
+}
+
+// blueZoneRefs are the reference runes for the blue zones: the flat and round
+// glyphs whose tops or bottoms define each zone.
+var blueZoneRefs = []struct {
+	flat, round rune
+	top         bool
+}{
+	{'x', 'o', false}, // The baseline.
+	{'x', 'o', true},  // The x-height.
+	{'H', 'O', true},  // The cap height.
+	{'d', 'd', true},  // The ascender.
+	{'p', 'p', false}, // The descender.
+}
+
+// autohinter is the autohinter's state for a font, scale and variation.
+type autohinter struct {
+	font   *Font
+	scale  int32
+	coords []int16
+	blues  []blueZone
+	// ref loads the reference glyphs of the blue zones.
+	ref *GlyphBuf
+	// edges, y and touched are scratch buffers.
+	edges   []autoEdge
+	y       []int32
+	touched []bool
+}
+
+// autoEdge is a horizontal edge of a glyph, made of one or more nearly
+// horizontal segments.
+type autoEdge struct {
+	// y is the edge's height, and target is where it is snapped to.
+	y, target int32
+	// xMin and xMax are the edge's horizontal extent.
+	xMin, xMax int32
+	// dir is +1 if the outline runs in the +x direction along the edge, and
+	// -1 otherwise. For TrueType's clockwise outlines, the filled area is
+	// below a +1 edge and above a -1 edge.
+	dir int
+	// done is whether target has been set.
+	done bool
+	// points are the indexes of the edge's points.
+	points []int
+}
+
+// init measures f's blue zones at the given scale and variation co-ordinates,
+// if they were not measured for the last call to init.
+func (a *autohinter) init(f *Font, scale int32, coords []int16) {
+	if a.font == f && a.scale == scale && equalCoords(a.coords, coords) {
+		return
+	}
+	a.font, a.scale, a.blues = f, scale, a.blues[:0]
+	a.coords = append(a.coords[:0], coords...)
+	if a.ref == nil {
+		a.ref = &GlyphBuf{}
+	}
+	ref := a.ref
+	ref.SetVariation(coords)
+	measure := func(r rune, top bool) (y int32, ok bool) {
+		i := f.Index(r)
+		if i == 0 {
+			return 0, false
+		}
+		if err := ref.Load(f, scale, i, NoHinting); err != nil || len(ref.Point) == 0 {
+			return 0, false
+		}
+		if top {
+			return ref.B.YMax, true
+		}
+		return ref.B.YMin, true
+	}
+	for _, z := range blueZoneRefs {
+		flat, ok := measure(z.flat, z.top)
+		if !ok {
+			continue
+		}
+		round, ok := measure(z.round, z.top)
+		if !ok {
+			round = flat
+		}
+		if z.flat == 'x' && !z.top {
+			// The baseline is at zero by definition.
+			flat = 0
+		}
+		a.blues = append(a.blues, blueZone{flat, round, z.top})
+	}
+}
+
+// equalCoords returns whether a and b are the same variation co-ordinates.
+func equalCoords(a, b []int16) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// snapBlue returns where an edge at height y, and running in direction dir,
+// is snapped to by the blue zones, if it is in one.
+func (a *autohinter) snapBlue(y int32, dir int) (target int32, ok bool) {
+	fuzz := a.scale / 40
+	if fuzz < 8 {
+		fuzz = 8
+	}
+	for _, z := range a.blues {
+		// Top zones apply to the tops of glyphs' filled areas, which are +1
+		// edges, and bottom zones to their bottoms.
+		if z.top != (dir > 0) {
+			continue
+		}
+		lo, hi := z.ref, z.overshoot
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		if y < lo-fuzz || hi+fuzz < y {
+			continue
+		}
+		target = (z.ref + 32) &^ 63
+		// As per C Freetype, overshoots of less than half a pixel are
+		// suppressed, so that round and flat glyphs line up at small sizes.
+		if abs32(y-z.overshoot) < abs32(y-z.ref) {
+			if d := z.overshoot - z.ref; abs32(d) >= 32 {
+				target += (d + 32) &^ 63
+			}
+		}
+		return target, true
+	}
+	return 0, false
+}
+
+// hint hints the points of a glyph in the y direction. ends are the
+// exclusive end indexes of each contour.
+func (a *autohinter) hint(points []Point, ends []int) {
+	a.findEdges(points, ends)
+	if len(a.edges) == 0 {
+		return
+	}
+	edges := a.edges
+
+	// Snap edges to the blue zones.
+	for i := range edges {
+		if t, ok := a.snapBlue(edges[i].y, edges[i].dir); ok {
+			edges[i].target, edges[i].done = t, true
+		}
+	}
+
+	// Pair each bottom edge with the nearest overlapping top edge above it,
+	// to form a stem, and keep the stem's width a whole number of pixels.
+	maxStem := a.scale / 4
+	for i := range edges {
+		lo := &edges[i]
+		if lo.dir > 0 {
+			continue
+		}
+		var hi *autoEdge
+		for j := range edges {
+			e := &edges[j]
+			if e.dir < 0 || e.y <= lo.y || e.y-lo.y > maxStem || e.xMax <= lo.xMin || lo.xMax <= e.xMin {
+				continue
+			}
+			if hi == nil || e.y < hi.y {
+				hi = e
+			}
+		}
+		if hi == nil || (lo.done && hi.done) {
+			continue
+		}
+		w := hi.y - lo.y
+		wr := (w + 32) &^ 63
+		if wr < 64 {
+			wr = 64
+		}
+		switch {
+		case hi.done:
+			lo.target = hi.target - wr
+		case lo.done:
+			hi.target = lo.target + wr
+		default:
+			// Center the rounded stem on the original one.
+			lo.target = (lo.y - (wr-w)/2 + 32) &^ 63
+			hi.target = lo.target + wr
+		}
+		lo.done, hi.done = true, true
+	}
+
+	// Round any remaining edges, and move the edges' points.
+	a.y = a.y[:0]
+	a.touched = a.touched[:0]
+	for _, p := range points {
+		a.y = append(a.y, p.Y)
+		a.touched = append(a.touched, false)
+	}
+	for i := range edges {
+		e := &edges[i]
+		if !e.done {
+			e.target = (e.y + 32) &^ 63
+		}
+		d := e.target - e.y
+		for _, j := range e.points {
+			if !a.touched[j] {
+				points[j].Y = a.y[j] + d
+				a.touched[j] = true
+			}
+		}
+	}
+
+	// Interpolate the untouched points.
+	start := 0
+	for _, end := range ends {
+		a.interpolate(points, start, end)
+		start = end
+	}
+}
+
+// findEdges sets a.edges to the horizontal edges of a glyph.
+func (a *autohinter) findEdges(points []Point, ends []int) {
+	a.edges = a.edges[:0]
+	// minLength is the minimum length of a horizontal segment.
+	minLength := a.scale / 64
+	start := 0
+	for _, end := range ends {
+		for i := start; i < end; i++ {
+			j := i + 1
+			if j == end {
+				j = start
+			}
+			p, q := points[i], points[j]
+			dx, dy := q.X-p.X, q.Y-p.Y
+			if abs32(dx) < minLength || 8*abs32(dy) > abs32(dx) {
+				continue
+			}
+			dir := 1
+			if dx < 0 {
+				dir = -1
+			}
+			y := (p.Y + q.Y) / 2
+			xMin, xMax := p.X, q.X
+			if xMin > xMax {
+				xMin, xMax = xMax, xMin
+			}
+			a.addSegment(y, xMin, xMax, dir, i, j)
+		}
+		start = end
+	}
+}
+
+// addSegment adds a horizontal segment between points i and j to a.edges,
+// merging it with an existing edge at nearly the same height and running in
+// the same direction.
+func (a *autohinter) addSegment(y, xMin, xMax int32, dir int, i, j int) {
+	for k := range a.edges {
+		e := &a.edges[k]
+		if e.dir != dir || abs32(e.y-y) > 8 {
+			continue
+		}
+		if xMin < e.xMin {
+			e.xMin = xMin
+		}
+		if xMax > e.xMax {
+			e.xMax = xMax
+		}
+		e.points = append(e.points, i, j)
+		return
+	}
+	if len(a.edges) < cap(a.edges) {
+		a.edges = a.edges[:len(a.edges)+1]
+		e := &a.edges[len(a.edges)-1]
+		*e = autoEdge{y: y, xMin: xMin, xMax: xMax, dir: dir, points: append(e.points[:0], i, j)}
+		return
+	}
+	a.edges = append(a.edges, autoEdge{y: y, xMin: xMin, xMax: xMax, dir: dir, points: []int{i, j}})
+}
+
+// interpolate moves the untouched points of the contour points[start:end] in
+// the y direction, by interpolating between or shifting with the touched
+// points on either side, as per the IUP instruction.
+func (a *autohinter) interpolate(points []Point, start, end int) {
+	first := -1
+	for i := start; i < end; i++ {
+		if a.touched[i] {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return
+	}
+	prev := first
+	for k := 1; k <= end-start; k++ {
+		i := start + (first-start+k)%(end-start)
+		if !a.touched[i] {
+			continue
+		}
+		for j := prev + 1; ; j++ {
+			if j == end {
+				j = start
+			}
+			if j == i {
+				break
+			}
+			a.interpolatePoint(points, j, prev, i)
+		}
+		prev = i
+	}
+}
+
+// interpolatePoint moves the untouched point j between the touched points
+// p and q.
+func (a *autohinter) interpolatePoint(points []Point, j, p, q int) {
+	y, y0, y1 := a.y[j], a.y[p], a.y[q]
+	d0, d1 := points[p].Y-y0, points[q].Y-y1
+	if y0 > y1 {
+		y0, y1, d0, d1 = y1, y0, d1, d0
+	}
+	switch {
+	case y <= y0:
+		points[j].Y = y + d0
+	case y >= y1:
+		points[j].Y = y + d1
+	default:
+		t0, t1 := y0+d0, y1+d1
+		points[j].Y = t0 + int32(int64(y-y0)*int64(t1-t0)/int64(y1-y0))
+	}
+}
@@ -0,0 +1,856 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// This file implements a Brotli decompressor, as WOFF2 data needs one and the
+// standard library has none. The format is documented at
+// https://tools.ietf.org/html/rfc7932
+
+// decodeBrotli returns the decompressed Brotli data b. It returns an error if
+// the data is invalid or decompresses to more than maxLen bytes.
+func decodeBrotli(b []byte, maxLen int) ([]byte, error) {
+	d := brotliDecoder{r: brotliReader{b: b}, maxLen: maxLen}
+	if err := d.decode(); err != nil {
+		return nil, err
+	}
+	return d.out, nil
+}
+
+var (
+	errBrotli        = FormatError{Reason: "bad Brotli data"}
+	errBrotliTooLong = FormatError{Reason: "Brotli data decompresses to more than the expected length"}
+)
+
+// brotliReader reads the bits of Brotli data, least significant bit first.
+// Reading past the end of the data sets short, and returns zeroes.
+type brotliReader struct {
+	b     []byte
+	i     int
+	v     uint64
+	n     uint
+	short bool
+}
+
+func (r *brotliReader) fill() {
+	for r.n <= 56 && r.i < len(r.b) {
+		r.v |= uint64(r.b[r.i]) << r.n
+		r.i++
+		r.n += 8
+	}
+}
+
+// peek returns the next n bits, for n <= 24, without consuming them.
+func (r *brotliReader) peek(n uint) int {
+	if r.n < n {
+		r.fill()
+	}
+	return int(r.v & (1<<n - 1))
+}
+
+func (r *brotliReader) skip(n uint) {
+	if r.n < n {
+		r.fill()
+		if r.n < n {
+			r.v, r.n, r.short = 0, 0, true
+			return
+		}
+	}
+	r.v >>= n
+	r.n -= n
+}
+
+// read returns the next n bits, for n <= 24.
+func (r *brotliReader) read(n uint) int {
+	x := r.peek(n)
+	r.skip(n)
+	return x
+}
+
+// align skips to the next byte boundary, reporting whether the skipped bits
+// were zero.
+func (r *brotliReader) align() bool {
+	return r.read(r.n%8) == 0
+}
+
+// readBytes reads len(p) bytes from a byte boundary.
+func (r *brotliReader) readBytes(p []byte) {
+	for ; len(p) > 0 && r.n > 0; p = p[1:] {
+		p[0] = byte(r.v)
+		r.v >>= 8
+		r.n -= 8
+	}
+	if len(p) > len(r.b)-r.i {
+		r.short = true
+		return
+	}
+	r.i += copy(p, r.b[r.i:])
+}
+
+// skipBytes skips n bytes from a byte boundary.
+func (r *brotliReader) skipBytes(n int) {
+	for ; n > 0 && r.n > 0; n-- {
+		r.v >>= 8
+		r.n -= 8
+	}
+	if n > len(r.b)-r.i {
+		r.short = true
+		return
+	}
+	r.i += n
+}
+
+// readVarLenUint8 reads a number from 0 to 255 encoded as in RFC 7932
+// section 9.2.
+func (r *brotliReader) readVarLenUint8() int {
+	if r.read(1) == 0 {
+		return 0
+	}
+	n := uint(r.read(3))
+	if n == 0 {
+		return 1
+	}
+	return 1<<n + r.read(n)
+}
+
+// brotliRootBits is the number of bits indexing a brotliCode's root table.
+const brotliRootBits = 8
+
+// brotliCode is a prefix code's lookup table. The low 16 bits of an entry are
+// a symbol and the next 8 its code length. Codes longer than brotliRootBits
+// have root entries with the 1<<24 bit set, whose low 16 bits are the offset
+// of a second-level table and next 8 the number of bits indexing it.
+type brotliCode []uint32
+
+// decode reads a symbol coded by c.
+func (c brotliCode) decode(r *brotliReader) int {
+	x := r.peek(15)
+	e := c[x&(1<<brotliRootBits-1)]
+	if e&(1<<24) != 0 {
+		n := e >> 16 & 0xff
+		e = c[int(e&0xffff)+x>>brotliRootBits&(1<<n-1)]
+	}
+	r.skip(uint(e >> 16 & 0xff))
+	return int(e & 0xffff)
+}
+
+// newBrotliCode returns the canonical prefix code with the given code lengths
+// by symbol, of at most 15 bits. It returns nil if the code is incomplete or
+// oversubscribed.
+func newBrotliCode(lengths []uint8) brotliCode {
+	var count, next [16]int
+	space := 1 << 15
+	for _, l := range lengths {
+		if l != 0 {
+			count[l]++
+			space -= 1 << 15 >> l
+		}
+	}
+	if space != 0 {
+		return nil
+	}
+	for l, code := 1, 0; l < 16; l++ {
+		code = (code + count[l-1]) << 1
+		next[l] = code
+	}
+
+	// Reverse each code, as the bits are read least significant first, and
+	// find the longest code sharing each root entry.
+	codes := make([]int, len(lengths))
+	var longest [1 << brotliRootBits]uint8
+	for s, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		code, rev := next[l], 0
+		next[l]++
+		for i := uint8(0); i < l; i++ {
+			rev = rev<<1 | code>>i&1
+		}
+		codes[s] = rev
+		if root := rev & (1<<brotliRootBits - 1); longest[root] < l {
+			longest[root] = l
+		}
+	}
+
+	c := make(brotliCode, 1<<brotliRootBits)
+	for root, l := range longest {
+		if l > brotliRootBits {
+			n := uint32(l - brotliRootBits)
+			c[root] = 1<<24 | n<<16 | uint32(len(c))
+			c = append(c, make(brotliCode, 1<<n)...)
+		}
+	}
+	for s, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		e, rev := uint32(l)<<16|uint32(s), codes[s]
+		if l <= brotliRootBits {
+			for i := rev; i < 1<<brotliRootBits; i += 1 << l {
+				c[i] = e
+			}
+			continue
+		}
+		sub := c[rev&(1<<brotliRootBits-1)]
+		n := int(sub >> 16 & 0xff)
+		table := c[sub&0xffff:]
+		for i := rev >> brotliRootBits; i < 1<<uint(n); i += 1 << (l - brotliRootBits) {
+			table[i] = e
+		}
+	}
+	return c
+}
+
+// brotliCodeLengthOrder is the order of the code length code's lengths.
+var brotliCodeLengthOrder = [18]uint8{1, 2, 3, 4, 0, 5, 17, 6, 16, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// brotliCodeLengthLengths and brotliCodeLengthValues decode the fixed prefix
+// code of the code length code's lengths, indexed by its next 4 bits.
+var (
+	brotliCodeLengthLengths = [16]uint8{2, 2, 2, 3, 2, 2, 2, 4, 2, 2, 2, 3, 2, 2, 2, 4}
+	brotliCodeLengthValues  = [16]uint8{0, 4, 3, 2, 0, 4, 3, 1, 0, 4, 3, 2, 0, 4, 3, 5}
+)
+
+// readCode reads a prefix code of the given alphabet size, as in RFC 7932
+// section 3.
+func (r *brotliReader) readCode(alphabetSize int) brotliCode {
+	hskip := r.read(2)
+	lengths := make([]uint8, alphabetSize)
+	if hskip == 1 {
+		// A simple prefix code.
+		var symbols [4]int
+		n := r.read(2) + 1
+		bits := uint(0)
+		for (alphabetSize-1)>>bits != 0 {
+			bits++
+		}
+		for i := 0; i < n; i++ {
+			symbols[i] = r.read(bits)
+			if symbols[i] >= alphabetSize {
+				return nil
+			}
+			for j := 0; j < i; j++ {
+				if symbols[i] == symbols[j] {
+					return nil
+				}
+			}
+		}
+		switch n {
+		case 1:
+			// The only symbol takes no bits.
+			c := make(brotliCode, 1<<brotliRootBits)
+			for i := range c {
+				c[i] = uint32(symbols[0])
+			}
+			return c
+		case 2:
+			lengths[symbols[0]], lengths[symbols[1]] = 1, 1
+		case 3:
+			lengths[symbols[0]], lengths[symbols[1]], lengths[symbols[2]] = 1, 2, 2
+		case 4:
+			if r.read(1) == 0 {
+				lengths[symbols[0]], lengths[symbols[1]], lengths[symbols[2]], lengths[symbols[3]] = 2, 2, 2, 2
+			} else {
+				lengths[symbols[0]], lengths[symbols[1]], lengths[symbols[2]], lengths[symbols[3]] = 1, 2, 3, 3
+			}
+		}
+		return newBrotliCode(lengths)
+	}
+
+	// A complex prefix code, whose code lengths are themselves prefix coded.
+	var clLengths [18]uint8
+	space, n, last := 32, 0, 0
+	for i := hskip; i < len(clLengths) && space > 0; i++ {
+		x := r.peek(4)
+		r.skip(uint(brotliCodeLengthLengths[x]))
+		l := brotliCodeLengthValues[x]
+		clLengths[brotliCodeLengthOrder[i]] = l
+		if l != 0 {
+			space -= 32 >> l
+			n++
+			last = int(brotliCodeLengthOrder[i])
+		}
+	}
+	var clCode brotliCode
+	switch {
+	case n == 1:
+		clCode = make(brotliCode, 1<<brotliRootBits)
+		for i := range clCode {
+			clCode[i] = uint32(last)
+		}
+	case space == 0:
+		clCode = newBrotliCode(clLengths[:])
+	}
+	if clCode == nil {
+		return nil
+	}
+
+	// Symbols 16 and 17 repeat the previous non-zero length, and zero. Their
+	// repeat counts accumulate when they are repeated.
+	space = 1 << 15
+	prev, repeat, repeatLength := uint8(8), 0, uint8(0)
+	for s := 0; s < alphabetSize && space > 0; {
+		if r.short {
+			return nil
+		}
+		l := uint8(clCode.decode(r))
+		if l < 16 {
+			repeat = 0
+			lengths[s] = l
+			s++
+			if l != 0 {
+				prev = l
+				space -= 1 << 15 >> l
+			}
+			continue
+		}
+		extra, newLength := uint(2), prev
+		if l == 17 {
+			extra, newLength = 3, 0
+		}
+		if repeatLength != newLength {
+			repeat, repeatLength = 0, newLength
+		}
+		old := repeat
+		if repeat > 0 {
+			repeat = (repeat - 2) << extra
+		}
+		repeat += r.read(extra) + 3
+		delta := repeat - old
+		if s+delta > alphabetSize {
+			return nil
+		}
+		for i := 0; i < delta; i++ {
+			lengths[s] = newLength
+			s++
+		}
+		if newLength != 0 {
+			space -= delta << 15 >> newLength
+		}
+	}
+	if space != 0 {
+		return nil
+	}
+	return newBrotliCode(lengths)
+}
+
+// readContextMap reads a context map of the given size, as in RFC 7932
+// section 7.3, whose values are less than n.
+func (r *brotliReader) readContextMap(size, n int) []uint8 {
+	m := make([]uint8, size)
+	if n < 2 {
+		return m
+	}
+	rleMax := 0
+	if r.read(1) == 1 {
+		rleMax = r.read(4) + 1
+	}
+	c := r.readCode(n + rleMax)
+	if c == nil {
+		return nil
+	}
+	for i := 0; i < size; {
+		if r.short {
+			return nil
+		}
+		switch x := c.decode(r); {
+		case x == 0:
+			m[i] = 0
+			i++
+		case x <= rleMax:
+			// A run of zeroes, which make already holds.
+			i += 1<<uint(x) + r.read(uint(x))
+			if i > size {
+				return nil
+			}
+		default:
+			m[i] = uint8(x - rleMax)
+			i++
+		}
+	}
+	if r.read(1) == 1 {
+		// Undo the move-to-front transform.
+		var mtf [256]uint8
+		for i := range mtf {
+			mtf[i] = uint8(i)
+		}
+		for i, x := range m {
+			v := mtf[x]
+			m[i] = v
+			copy(mtf[1:int(x)+1], mtf[:x])
+			mtf[0] = v
+		}
+	}
+	return m
+}
+
+// brotliBlockLengths are the base and number of extra bits of each block
+// length code.
+var brotliBlockLengths = [26]struct {
+	base  int
+	extra uint8
+}{
+	{1, 2}, {5, 2}, {9, 2}, {13, 2}, {17, 3}, {25, 3}, {33, 3}, {41, 3},
+	{49, 4}, {65, 4}, {81, 4}, {97, 4}, {113, 5}, {145, 5}, {177, 5}, {209, 5},
+	{241, 6}, {305, 6}, {369, 7}, {497, 8}, {753, 9}, {1265, 10}, {2289, 11}, {4337, 12},
+	{8433, 13}, {16625, 24},
+}
+
+// brotliBlocks are the block types and block counts of one of a meta-block's
+// three categories: literals, insert-and-copy commands and distances.
+type brotliBlocks struct {
+	n            int
+	types        brotliCode
+	lengths      brotliCode
+	typ, prevTyp int
+	// left is the number of symbols left in the current block.
+	left int
+}
+
+func (r *brotliReader) readBlocks(b *brotliBlocks) bool {
+	*b = brotliBlocks{n: r.readVarLenUint8() + 1, prevTyp: 1, left: 1 << 30}
+	if b.n < 2 {
+		return true
+	}
+	b.types = r.readCode(b.n + 2)
+	b.lengths = r.readCode(len(brotliBlockLengths))
+	if b.types == nil || b.lengths == nil {
+		return false
+	}
+	b.left = r.readBlockLength(b.lengths)
+	return true
+}
+
+func (r *brotliReader) readBlockLength(c brotliCode) int {
+	l := brotliBlockLengths[c.decode(r)]
+	return l.base + r.read(uint(l.extra))
+}
+
+// next starts the next symbol of b's category, switching block types if the
+// current block is done.
+func (r *brotliReader) next(b *brotliBlocks) {
+	if b.left == 0 {
+		typ := b.types.decode(r)
+		switch typ {
+		case 0:
+			typ = b.prevTyp
+		case 1:
+			typ = b.typ + 1
+		default:
+			typ -= 2
+		}
+		if typ >= b.n {
+			typ -= b.n
+		}
+		b.prevTyp, b.typ = b.typ, typ
+		b.left = r.readBlockLength(b.lengths)
+	}
+	b.left--
+}
+
+// brotliInsertLengths and brotliCopyLengths are the base and number of extra
+// bits of each insert and copy length code.
+var (
+	brotliInsertLengths = [24]struct {
+		base  int
+		extra uint8
+	}{
+		{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0}, {5, 0}, {6, 1}, {8, 1},
+		{10, 2}, {14, 2}, {18, 3}, {26, 3}, {34, 4}, {50, 4}, {66, 5}, {98, 5},
+		{130, 6}, {194, 7}, {322, 8}, {578, 9}, {1090, 10}, {2114, 12}, {6210, 14}, {22594, 24},
+	}
+	brotliCopyLengths = [24]struct {
+		base  int
+		extra uint8
+	}{
+		{2, 0}, {3, 0}, {4, 0}, {5, 0}, {6, 0}, {7, 0}, {8, 0}, {9, 0},
+		{10, 1}, {12, 1}, {14, 2}, {18, 2}, {22, 3}, {30, 3}, {38, 4}, {54, 4},
+		{70, 5}, {102, 5}, {134, 6}, {198, 7}, {326, 8}, {582, 9}, {1094, 10}, {2118, 24},
+	}
+)
+
+// brotliInsertCells and brotliCopyCells are the first insert and copy length
+// codes of each block of 64 insert-and-copy length codes.
+var (
+	brotliInsertCells = [11]uint8{0, 0, 0, 0, 8, 8, 0, 16, 8, 16, 16}
+	brotliCopyCells   = [11]uint8{0, 8, 0, 8, 0, 8, 16, 0, 16, 8, 16}
+)
+
+// brotliShortDistances are the offsets of distance codes 4 to 15 from the
+// last distance, for codes 4 to 9, and the second to last, for 10 to 15.
+var brotliShortDistances = [12]int{-1, 1, -2, 2, -3, 3, -1, 1, -2, 2, -3, 3}
+
+// brotliDecoder decodes Brotli data.
+type brotliDecoder struct {
+	r      brotliReader
+	out    []byte
+	maxLen int
+	window int
+	// dists are the last four distances, the last at dists[nDists%4] and the
+	// one before it at dists[(nDists-1)%4].
+	dists  [4]int
+	nDists int
+}
+
+func (d *brotliDecoder) decode() error {
+	r := &d.r
+	wbits := 16
+	if r.read(1) == 1 {
+		if n := r.read(3); n != 0 {
+			wbits = 17 + n
+		} else if n = r.read(3); n == 0 {
+			wbits = 17
+		} else if n == 1 {
+			return errBrotli
+		} else {
+			wbits = 8 + n
+		}
+	}
+	d.window = 1<<uint(wbits) - 16
+	d.dists, d.nDists = [4]int{4, 16, 15, 11}, 0
+
+	for {
+		last := r.read(1) == 1
+		if last && r.read(1) == 1 {
+			break
+		}
+		nibbles := r.read(2) + 4
+		if nibbles == 7 {
+			// Metadata, which is skipped.
+			if r.read(1) != 0 {
+				return errBrotli
+			}
+			nBytes, skip := r.read(2), 0
+			for i := 0; i < nBytes; i++ {
+				x := r.read(8)
+				if i == nBytes-1 && nBytes > 1 && x == 0 {
+					return errBrotli
+				}
+				skip |= x << uint(8*i)
+			}
+			if nBytes > 0 {
+				skip++
+			}
+			if !r.align() {
+				return errBrotli
+			}
+			r.skipBytes(skip)
+			if last {
+				break
+			}
+			continue
+		}
+		mlen := 0
+		for i := 0; i < nibbles; i++ {
+			x := r.read(4)
+			if i == nibbles-1 && nibbles > 4 && x == 0 {
+				return errBrotli
+			}
+			mlen |= x << uint(4*i)
+		}
+		mlen++
+		if mlen > d.maxLen-len(d.out) {
+			return errBrotliTooLong
+		}
+		if !last && r.read(1) == 1 {
+			// An uncompressed meta-block.
+			if !r.align() {
+				return errBrotli
+			}
+			n := len(d.out)
+			d.out = append(d.out, make([]byte, mlen)...)
+			r.readBytes(d.out[n:])
+		} else if err := d.decodeMetaBlock(mlen); err != nil {
+			return err
+		}
+		if r.short {
+			return errBrotli
+		}
+		if last {
+			break
+		}
+	}
+	if r.short {
+		return errBrotli
+	}
+	return nil
+}
+
+// decodeMetaBlock decodes a compressed meta-block of mlen bytes, whose
+// header has been read up to its block types.
+func (d *brotliDecoder) decodeMetaBlock(mlen int) error {
+	r := &d.r
+	var literals, commands, distances brotliBlocks
+	if !r.readBlocks(&literals) || !r.readBlocks(&commands) || !r.readBlocks(&distances) {
+		return errBrotli
+	}
+	postfix := uint(r.read(2))
+	direct := r.read(4) << postfix
+	modes := make([]uint8, literals.n)
+	for i := range modes {
+		modes[i] = uint8(r.read(2))
+	}
+	nLiteralCodes := r.readVarLenUint8() + 1
+	literalMap := r.readContextMap(64*literals.n, nLiteralCodes)
+	nDistanceCodes := r.readVarLenUint8() + 1
+	distanceMap := r.readContextMap(4*distances.n, nDistanceCodes)
+	if literalMap == nil || distanceMap == nil {
+		return errBrotli
+	}
+	literalCodes := make([]brotliCode, nLiteralCodes)
+	for i := range literalCodes {
+		if literalCodes[i] = r.readCode(256); literalCodes[i] == nil {
+			return errBrotli
+		}
+	}
+	commandCodes := make([]brotliCode, commands.n)
+	for i := range commandCodes {
+		if commandCodes[i] = r.readCode(704); commandCodes[i] == nil {
+			return errBrotli
+		}
+	}
+	distanceCodes := make([]brotliCode, nDistanceCodes)
+	for i := range distanceCodes {
+		if distanceCodes[i] = r.readCode(16 + direct + 48<<postfix); distanceCodes[i] == nil {
+			return errBrotli
+		}
+	}
+
+	end := len(d.out) + mlen
+	for len(d.out) < end {
+		if r.short {
+			return errBrotli
+		}
+		r.next(&commands)
+		cmd := commandCodes[commands.typ].decode(r)
+		cell := cmd >> 6
+		il := brotliInsertLengths[int(brotliInsertCells[cell])+cmd>>3&7]
+		cl := brotliCopyLengths[int(brotliCopyCells[cell])+cmd&7]
+		insertLen := il.base + r.read(uint(il.extra))
+		copyLen := cl.base + r.read(uint(cl.extra))
+		if insertLen > end-len(d.out) {
+			return errBrotli
+		}
+		for i := 0; i < insertLen; i++ {
+			r.next(&literals)
+			var p1, p2 byte
+			if n := len(d.out); n > 1 {
+				p1, p2 = d.out[n-1], d.out[n-2]
+			} else if n > 0 {
+				p1 = d.out[n-1]
+			}
+			ctx := brotliContext(modes[literals.typ], p1, p2)
+			code := literalCodes[literalMap[64*literals.typ+ctx]]
+			d.out = append(d.out, byte(code.decode(r)))
+		}
+		if len(d.out) == end {
+			// The meta-block ends after the command's literals.
+			break
+		}
+
+		dist, code := d.dists[d.nDists&3], 0
+		if cmd >= 128 {
+			r.next(&distances)
+			ctx := 3
+			if copyLen <= 4 {
+				ctx = copyLen - 2
+			}
+			code = distanceCodes[distanceMap[4*distances.typ+ctx]].decode(r)
+			if dist = d.readDistance(code, postfix, direct); dist <= 0 {
+				return errBrotli
+			}
+		}
+
+		maxDist := d.window
+		if len(d.out) < maxDist {
+			maxDist = len(d.out)
+		}
+		if dist > maxDist {
+			// A reference to the static dictionary.
+			if copyLen < 4 || copyLen > 24 {
+				return errBrotli
+			}
+			bits := brotliDictionarySizeBits[copyLen]
+			id := dist - maxDist - 1
+			index, transform := id&(1<<bits-1), id>>bits
+			if transform >= len(brotliTransforms) {
+				return errBrotli
+			}
+			i := brotliDictionaryOffsets[copyLen] + index*copyLen
+			d.out = brotliAppendWord(d.out, brotliDictionary[i:i+copyLen], transform)
+			if len(d.out) > end {
+				return errBrotli
+			}
+			continue
+		}
+		if copyLen > end-len(d.out) {
+			return errBrotli
+		}
+		if code != 0 {
+			d.nDists++
+			d.dists[d.nDists&3] = dist
+		}
+		// The source and destination may overlap, so copy byte by byte.
+		for i := len(d.out) - dist; copyLen > 0; copyLen-- {
+			d.out = append(d.out, d.out[i])
+			i++
+		}
+	}
+	return nil
+}
+
+// readDistance returns the distance coded by the given distance code, reading
+// its extra bits. The distance is not positive if the code is invalid.
+func (d *brotliDecoder) readDistance(code int, postfix uint, direct int) int {
+	switch {
+	case code < 4:
+		return d.dists[(d.nDists-code)&3]
+	case code < 10:
+		return d.dists[d.nDists&3] + brotliShortDistances[code-4]
+	case code < 16:
+		return d.dists[(d.nDists-1)&3] + brotliShortDistances[code-4]
+	case code < 16+direct:
+		return code - 15
+	}
+	x := code - direct - 16
+	bits := 1 + uint(x)>>(postfix+1)
+	high, low := x>>postfix, x&(1<<postfix-1)
+	offset := (2+high&1)<<bits - 4
+	return (offset+d.r.read(bits))<<postfix + low + direct + 1
+}
+
+// brotliContext returns the context ID, from 0 to 63, of a literal following
+// the bytes p2 and p1 in the given context mode: LSB6, MSB6, UTF8 or Signed.
+func brotliContext(mode uint8, p1, p2 byte) int {
+	switch mode {
+	case 0:
+		return int(p1 & 0x3f)
+	case 1:
+		return int(p1 >> 2)
+	case 2:
+		return int(brotliUTF8Context1[p1] | brotliUTF8Context2[p2])
+	}
+	return int(brotliSignedContext[p1]<<3 | brotliSignedContext[p2])
+}
+
+// brotliUTF8Context1 and brotliUTF8Context2 give the UTF8 context mode's
+// context IDs, by the last byte and the byte before it.
+var brotliUTF8Context1 = [256]uint8{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 0, 4, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	8, 12, 16, 12, 12, 20, 12, 16, 24, 28, 12, 12, 32, 12, 36, 12,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 32, 32, 24, 40, 28, 12,
+	12, 48, 52, 52, 52, 48, 52, 52, 52, 48, 52, 52, 52, 52, 52, 48,
+	52, 52, 52, 52, 52, 48, 52, 52, 52, 52, 52, 24, 12, 28, 12, 12,
+	12, 56, 60, 60, 60, 56, 60, 60, 60, 56, 60, 60, 60, 60, 60, 56,
+	60, 60, 60, 60, 60, 56, 60, 60, 60, 60, 60, 24, 12, 28, 12, 0,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+}
+
+var brotliUTF8Context2 = [256]uint8{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 1, 1, 1, 1, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+}
+
+// brotliSignedContext is the Signed context mode's context ID by byte.
+var brotliSignedContext = [256]uint8{
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7,
+}
+
+// brotliDictionarySizeBits and brotliDictionaryOffsets are the base 2
+// logarithm of the number of dictionary words of each length, and the offset
+// of the first of them in brotliDictionary.
+var (
+	brotliDictionarySizeBits = [25]uint{
+		0, 0, 0, 0, 10, 10, 11, 11, 10, 10, 10, 10, 10,
+		9, 9, 8, 7, 7, 8, 7, 7, 6, 6, 5, 5,
+	}
+	brotliDictionaryOffsets = [25]int{
+		0, 0, 0, 0, 0, 4096, 9216, 21504, 35840, 44032, 53248, 63488, 74752,
+		87040, 93696, 100864, 104704, 106752, 108928, 113536, 115968, 118528, 119872, 121280, 122016,
+	}
+)
+
+// brotliAppendWord appends the dictionary word w, transformed by the given
+// transform, to b. The transform types are 0 for the identity, 1 to 9 to omit
+// that many last bytes, 10 and 11 to uppercase the first and every character,
+// and 12 to 20 to omit 1 to 9 first bytes.
+func brotliAppendWord(b []byte, w string, transform int) []byte {
+	t := brotliTransforms[transform]
+	b = append(b, t.prefix...)
+	switch n := int(t.typ); {
+	case n >= 1 && n <= 9:
+		if n > len(w) {
+			n = len(w)
+		}
+		w = w[:len(w)-n]
+	case n >= 12 && n <= 20:
+		if n -= 11; n > len(w) {
+			n = len(w)
+		}
+		w = w[n:]
+	}
+	i := len(b)
+	b = append(b, w...)
+	for upper := t.typ == 10 || t.typ == 11; upper && i < len(b); upper = t.typ == 11 {
+		// Uppercasing is only approximate for non-ASCII characters, and
+		// modifies the second byte of a 2-byte UTF-8 sequence and the third
+		// of a 3-byte one.
+		p, step := b[i:], 3
+		switch {
+		case p[0] < 0xc0:
+			if 'a' <= p[0] && p[0] <= 'z' {
+				p[0] ^= 32
+			}
+			step = 1
+		case p[0] < 0xe0:
+			if len(p) > 1 {
+				p[1] ^= 32
+			}
+			step = 2
+		case len(p) > 2:
+			p[2] ^= 5
+		}
+		i += step
+	}
+	return append(b, t.suffix...)
+}
@@ -0,0 +1,2700 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// brotliTransforms are the transforms of RFC 7932 Appendix B, by transform ID.
+// A transform's word is the dictionary word after its elementary transform,
+// one of those listed in brotliAppendWord, between its prefix and suffix.
+var brotliTransforms = [...]struct {
+	prefix string
+	typ    uint8
+	suffix string
+}{
+	{"", 0, ""},
+	{"", 0, " "},
+	{" ", 0, " "},
+	{"", 12, ""},
+	{"", 10, " "},
+	{"", 0, " the "},
+	{" ", 0, ""},
+	{"s ", 0, " "},
+	{"", 0, " of "},
+	{"", 10, ""},
+	{"", 0, " and "},
+	{"", 13, ""},
+	{"", 1, ""},
+	{", ", 0, " "},
+	{"", 0, ", "},
+	{" ", 10, " "},
+	{"", 0, " in "},
+	{"", 0, " to "},
+	{"e ", 0, " "},
+	{"", 0, "\""},
+	{"", 0, "."},
+	{"", 0, "\">"},
+	{"", 0, "\x0a"},
+	{"", 3, ""},
+	{"", 0, "]"},
+	{"", 0, " for "},
+	{"", 14, ""},
+	{"", 2, ""},
+	{"", 0, " a "},
+	{"", 0, " that "},
+	{" ", 10, ""},
+	{"", 0, ". "},
+	{".", 0, ""},
+	{" ", 0, ", "},
+	{"", 15, ""},
+	{"", 0, " with "},
+	{"", 0, "'"},
+	{"", 0, " from "},
+	{"", 0, " by "},
+	{"", 16, ""},
+	{"", 17, ""},
+	{" the ", 0, ""},
+	{"", 4, ""},
+	{"", 0, ". The "},
+	{"", 11, ""},
+	{"", 0, " on "},
+	{"", 0, " as "},
+	{"", 0, " is "},
+	{"", 7, ""},
+	{"", 1, "ing "},
+	{"", 0, "\x0a\x09"},
+	{"", 0, ":"},
+	{" ", 0, ". "},
+	{"", 0, "ed "},
+	{"", 20, ""},
+	{"", 18, ""},
+	{"", 6, ""},
+	{"", 0, "("},
+	{"", 10, ", "},
+	{"", 8, ""},
+	{"", 0, " at "},
+	{"", 0, "ly "},
+	{" the ", 0, " of "},
+	{"", 5, ""},
+	{"", 9, ""},
+	{" ", 10, ", "},
+	{"", 10, "\""},
+	{".", 0, "("},
+	{"", 11, " "},
+	{"", 10, "\">"},
+	{"", 0, "=\""},
+	{" ", 0, "."},
+	{".com/", 0, ""},
+	{" the ", 0, " of the "},
+	{"", 10, "'"},
+	{"", 0, ". This "},
+	{"", 0, ","},
+	{".", 0, " "},
+	{"", 10, "("},
+	{"", 10, "."},
+	{"", 0, " not "},
+	{" ", 0, "=\""},
+	{"", 0, "er "},
+	{" ", 11, " "},
+	{"", 0, "al "},
+	{" ", 11, ""},
+	{"", 0, "='"},
+	{"", 11, "\""},
+	{"", 10, ". "},
+	{" ", 0, "("},
+	{"", 0, "ful "},
+	{" ", 10, ". "},
+	{"", 0, "ive "},
+	{"", 0, "less "},
+	{"", 11, "'"},
+	{"", 0, "est "},
+	{" ", 10, "."},
+	{"", 11, "\">"},
+	{" ", 0, "='"},
+	{"", 10, ","},
+	{"", 0, "ize "},
+	{"", 11, "."},
+	{"\xc2\xa0", 0, ""},
+	{" ", 0, ","},
+	{"", 10, "=\""},
+	{"", 11, "=\""},
+	{"", 0, "ous "},
+	{"", 11, ", "},
+	{"", 10, "='"},
+	{" ", 10, ","},
+	{" ", 11, "=\""},
+	{" ", 11, ", "},
+	{"", 11, ","},
+	{"", 11, "("},
+	{"", 11, ". "},
+	{" ", 11, "."},
+	{"", 11, "='"},
+	{" ", 11, ". "},
+	{" ", 10, "=\""},
+	{" ", 11, "='"},
+	{" ", 10, "='"},
+}
+
+// brotliDictionary is the static dictionary of RFC 7932 Appendix A. Its words
+// of each length from 4 to 24 are stored together, starting at
+// brotliDictionaryOffsets[length].
+const brotliDictionary = "" +
+	"timedownlifeleftbackcodedatashowonlysitecityopen" +
+	"justlikefreeworktextyearoverbodyloveformbookplay" +
+	"livelinehelphomesidemorewordlongthemviewfindpage" +
+	"daysfullheadtermeachareafromtruemarkableuponhigh" +
+	"datelandnewsevennextcasebothpostusedmadehandhere" +
+	"whatnameLinkblogsizebaseheldmakemainuser') +hold" +
+	"endswithNewsreadweresigntakehavegameseencallpath" +
+	"wellplusmenufilmpartjointhislistgoodneedwayswest" +
+	"jobsmindalsologorichuseslastteamarmyfoodkingwill" +
+	"eastwardbestfirePageknowaway.pngmovethanloadgive" +
+	"selfnotemuchfeedmanyrockicononcelookhidediedHome" +
+	"rulehostajaxinfoclublawslesshalfsomesuchzone100%" +
+	"onescareTimeracebluefourweekfacehopegavehardlost" +
+	"whenparkkeptpassshiproomHTMLplanTypedonesavekeep" +
+	"flaglinksoldfivetookratetownjumpthusdarkcardfile" +
+	"fearstaykillthatfallautoever.comtalkshopvotedeep" +
+	"moderestturnbornbandfellroseurl(skinrolecomeacts" +
+	"agesmeetgold.jpgitemvaryfeltthensenddropViewcopy" +
+	"1.0\"</a>stopelseliestourpack.gifpastcss?graymean" +
+	"&gt;rideshotlatesaidroadvar feeljohnrickportfast" +
+	"'UA-dead</b>poorbilltypeU.S.woodmust2px;Inforank" +
+	"widewantwalllead[0];paulwavesure$('#waitmassarms" +
+	"goesgainlangpaid!-- lockunitrootwalkfirmwifexml\"" +
+	"songtest20pxkindrowstoolfontmailsafestarmapscore" +
+	"rainflowbabyspansays4px;6px;artsfootrealwikiheat" +
+	"steptriporg/lakeweaktoldFormcastfansbankveryruns" +
+	"julytask1px;goalgrewslowedgeid=\"sets5px;.js?40px" +
+	"if (soonseatnonetubezerosentreedfactintogiftharm" +
+	"18pxcamehillboldzoomvoideasyringfillpeakinitcost" +
+	"3px;jacktagsbitsrolleditknewnear<!--growJSONduty" +
+	"Namesaleyou lotspainjazzcoldeyesfishwww.risktabs" +
+	"prev10pxrise25pxBlueding300,ballfordearnwildbox." +
+	"fairlackverspairjunetechif(!pickevil$(\"#warmlord" +
+	"doespull,000ideadrawhugespotfundburnhrefcellkeys" +
+	"tickhourlossfuel12pxsuitdealRSS\"agedgreyGET\"ease" +
+	"aimsgirlaids8px;navygridtips#999warsladycars); }" +
+	"php?helltallwhomzh:\xe5*/\x0d\x0a 100hall.\x0a\x0aA7px;pushchat" +
+	"0px;crew*/</hash75pxflatrare && tellcampontolaid" +
+	"missskiptentfinemalegetsplot400,\x0d\x0a\x0d\x0acoolfeet.php" +
+	"<br>ericmostguidbelldeschairmathatom/img&#82luck" +
+	"cent000;tinygonehtmlselldrugFREEnodenick?id=lose" +
+	"nullvastwindRSS wearrelybeensamedukenasacapewish" +
+	"gulfT23:hitsslotgatekickblurthey15px''););\">msie" +
+	"winsbirdsortbetaseekT18:ordstreemall60pxfarm\xe2\x80\x99s" +
+	"boys[0].');\"POSTbearkids);}}marytend(UK)quadzh:\xe6" +
+	"-siz----prop');\x0dliftT19:viceandydebt>RSSpoolneck" +
+	"blowT16:doorevalT17:letsfailoralpollnovacolsgene" +
+	" \xe2\x80\x94softrometillross<h3>pourfadepink<tr>mini)|!(" +
+	"minezh:\xe8barshear00);milk -->ironfreddiskwentsoil" +
+	"puts/js/holyT22:ISBNT20:adamsees<h2>json', 'cont" +
+	"T21: RSSloopasiamoon</p>soulLINEfortcartT14:<h1>" +
+	"80px!--<9px;T04:mike:46ZniceinchYorkricezh:\xe4'));" +
+	"puremageparatonebond:37Z_of_']);000,zh:\xe7tankyard" +
+	"bowlbush:56ZJava30px\x0a|}\x0a%C3%:34ZjeffEXPIcashvisa" +
+	"golfsnowzh:\xe9quer.csssickmeatmin.binddellhirepics" +
+	"rent:36ZHTTP-201fotowolfEND xbox:54ZBODYdick;\x0a}\x0a" +
+	"exit:35Zvarsbeat'});diet999;anne}}</[i].Langkm\xc2\xb2" +
+	"wiretoysaddssealalex;\x0a\x09}echonine.org005)tonyjews" +
+	"sandlegsroof000) 200winegeardogsbootgarycutstyle" +
+	"temption.xmlcockgang$('.50pxPh.Dmiscalanloandesk" +
+	"mileryanunixdisc);}\x0adustclip).\x0a\x0a70px-200DVDs7]><" +
+	"tapedemoi++)wageeurophiloptsholeFAQsasin-26Tlabs" +
+	"petsURL bulkcook;}\x0d\x0aHEAD[0])abbrjuan(198leshtwin" +
+	"</i>sonyguysfuckpipe|-\x0a!002)ndow[1];[];\x0aLog salt" +
+	"\x0d\x0a\x09\x09bangtrimbath){\x0d\x0a00px\x0a});ko:\xecfeesad>\x0ds:// [];" +
+	"tollplug(){\x0a{\x0d\x0a .js'200pdualboat.JPG);\x0a}quot);\x0a\x0a" +
+	"');\x0a\x0d\x0a}\x0d2014201520162017201820192020202120222023" +
+	"202420252026202720282029203020312032203320342035" +
+	"203620372013201220112010200920082007200620052004" +
+	"200320022001200019991998199719961995199419931992" +
+	"199119901989198819871986198519841983198219811980" +
+	"197919781977197619751974197319721971197019691968" +
+	"196719661965196419631962196119601959195819571956" +
+	"19551954195319521951195010001024139400009999como" +
+	"m\xc3\xa1sesteestaperotodohacecadaa\xc3\xb1obiend\xc3\xadaas\xc3\xadvida" +
+	"casootroforosolootracualdijosidograntipotemadebe" +
+	"algoqu\xc3\xa9estonadatrespococasabajotodasinoaguapues" +
+	"unosantediceluisellamayozonaamorpisoobraclicello" +
+	"dioshoracasi\xd0\xb7\xd0\xb0\xd0\xbd\xd0\xb0\xd0\xbe\xd0\xbc\xd1\x80\xd0\xb0\xd1\x80\xd1\x83\xd1\x82\xd0\xb0\xd0\xbd\xd0\xb5\xd0\xbf\xd0\xbe\xd0\xbe\xd1\x82" +
+	"\xd0\xb8\xd0\xb7\xd0\xbd\xd0\xbe\xd0\xb4\xd0\xbe\xd1\x82\xd0\xbe\xd0\xb6\xd0\xb5\xd0\xbe\xd0\xbd\xd0\xb8\xd1\x85\xd0\x9d\xd0\xb0\xd0\xb5\xd0\xb5\xd0\xb1\xd1\x8b\xd0\xbc\xd1\x8b\xd0\x92\xd1\x8b" +
+	"\xd1\x81\xd0\xbe\xd0\xb2\xd1\x8b\xd0\xb2\xd0\xbe\xd0\x9d\xd0\xbe\xd0\xbe\xd0\xb1\xd0\x9f\xd0\xbe\xd0\xbb\xd0\xb8\xd0\xbd\xd0\xb8\xd0\xa0\xd0\xa4\xd0\x9d\xd0\xb5\xd0\x9c\xd1\x8b\xd1\x82\xd1\x8b" +
+	"\xd0\x9e\xd0\xbd\xd0\xb8\xd0\xbc\xd0\xb4\xd0\xb0\xd0\x97\xd0\xb0\xd0\x94\xd0\xb0\xd0\x9d\xd1\x83\xd0\x9e\xd0\xb1\xd1\x82\xd0\xb5\xd0\x98\xd0\xb7\xd0\xb5\xd0\xb9\xd0\xbd\xd1\x83\xd0\xbc\xd0\xbc" +
+	"\xd0\xa2\xd1\x8b\xd1\x83\xd0\xb6\xd9\x81\xd9\x8a\xd8\xa3\xd9\x86\xd9\x85\xd8\xa7\xd9\x85\xd8\xb9\xd9\x83\xd9\x84\xd8\xa3\xd9\x88\xd8\xb1\xd8\xaf\xd9\x8a\xd8\xa7\xd9\x81\xd9\x89\xd9\x87\xd9\x88" +
+	"\xd9\x84\xd9\x85\xd9\x84\xd9\x83\xd8\xa7\xd9\x88\xd9\x84\xd9\x87\xd8\xa8\xd8\xb3\xd8\xa7\xd9\x84\xd8\xa5\xd9\x86\xd9\x87\xd9\x8a\xd8\xa3\xd9\x8a\xd9\x82\xd8\xaf\xd9\x87\xd9\x84\xd8\xab\xd9\x85" +
+	"\xd8\xa8\xd9\x87\xd9\x84\xd9\x88\xd9\x84\xd9\x8a\xd8\xa8\xd9\x84\xd8\xa7\xd9\x8a\xd8\xa8\xd9\x83\xd8\xb4\xd9\x8a\xd8\xa7\xd9\x85\xd8\xa3\xd9\x85\xd9\x86\xd8\xaa\xd8\xa8\xd9\x8a\xd9\x84\xd9\x86" +
+	"\xd8\xad\xd8\xa8\xd9\x87\xd9\x85\xd9\x85\xd8\xb4\xd9\x88\xd8\xb4firstvideolightworldmediawhitecl" +
+	"oseblackrightsmallbooksplacemusicfieldorderpoint" +
+	"valueleveltableboardhousegroupworksyearsstatetod" +
+	"aywaterstartstyledeathpowerphonenighterrorinputa" +
+	"bouttermstitletoolseventlocaltimeslargewordsgame" +
+	"sshortspacefocusclearmodelblockguideradiosharewo" +
+	"menagainmoneyimagenamesyounglineslatercolorgreen" +
+	"front&amp;watchforcepricerulesbeginaftervisitiss" +
+	"ueareasbelowindextotalhourslabelprintpressbuiltl" +
+	"inksspeedstudytradefoundsenseundershownformsrang" +
+	"eaddedstillmovedtakenaboveflashfixedoftenothervi" +
+	"ewschecklegalriveritemsquickshapehumanexistgoing" +
+	"moviethirdbasicpeacestagewidthloginideaswrotepag" +
+	"esusersdrivestorebreaksouthvoicesitesmonthwhereb" +
+	"uildwhichearthforumthreesportpartyClicklowerlive" +
+	"sclasslayerentrystoryusagesoundcourtyour birthpo" +
+	"puptypesapplyImagebeinguppernoteseveryshowsmeans" +
+	"extramatchtrackknownearlybegansuperpapernorthlea" +
+	"rngivennamedendedTermspartsGroupbrandusingwomanf" +
+	"alsereadyaudiotakeswhile.com/livedcasesdailychil" +
+	"dgreatjudgethoseunitsneverbroadcoastcoverapplefi" +
+	"lescyclesceneplansclickwritequeenpieceemailframe" +
+	"olderphotolimitcachecivilscaleenterthemetheretou" +
+	"chboundroyalaskedwholesincestock namefaithhearte" +
+	"mptyofferscopeownedmightalbumthinkbloodarraymajo" +
+	"rtrustcanonunioncountvalidstoneStyleLoginhappyoc" +
+	"curleft:freshquitefilmsgradeneedsurbanfightbasis" +
+	"hoverauto;route.htmlmixedfinalYour slidetopicbro" +
+	"wnalonedrawnsplitreachRightdatesmarchquotegoodsL" +
+	"inksdoubtasyncthumballowchiefyouthnovel10px;serv" +
+	"euntilhandsCheckSpacequeryjamesequaltwice0,000St" +
+	"artpanelsongsroundeightshiftworthpostsleadsweeks" +
+	"avoidthesemilesplanesmartalphaplantmarksratespla" +
+	"ysclaimsalestextsstarswrong</h3>thing.org/multih" +
+	"eardPowerstandtokensolid(thisbringshipsstafftrie" +
+	"dcallsfullyfactsagentThis //-->adminegyptEvent15" +
+	"px;Emailtrue\"crossspentblogsbox\">notedleavechina" +
+	"sizesguest</h4>robotheavytrue,sevengrandcrimesig" +
+	"nsawaredancephase><!--en_US&#39;200px_namelatine" +
+	"njoyajax.ationsmithU.S. holdspeterindianav\">chai" +
+	"nscorecomesdoingpriorShare1990sromanlistsjapanfa" +
+	"llstrialowneragree</h2>abusealertopera\"-//Wcards" +
+	"hillsteamsPhototruthclean.php?saintmetallouismea" +
+	"ntproofbriefrow\">genretrucklooksValueFrame.net/-" +
+	"->\x0a<try {\x0avar makescostsplainadultquesttrainlabo" +
+	"rhelpscausemagicmotortheir250pxleaststepsCountco" +
+	"uldglasssidesfundshotelawardmouthmovesparisgives" +
+	"dutchtexasfruitnull,||[];top\">\x0a<!--POST\"ocean<br" +
+	"/>floorspeakdepth sizebankscatchchart20px;alignd" +
+	"ealswould50px;url=\"parksmouseMost ...</amongbrai" +
+	"nbody none;basedcarrydraftreferpage_home.meterde" +
+	"laydreamprovejoint</tr>drugs<!-- aprilidealallen" +
+	"exactforthcodeslogicView seemsblankports (200sav" +
+	"ed_linkgoalsgrantgreekhomesringsrated30px;whosep" +
+	"arse();\" Blocklinuxjonespixel');\">);if(-leftdavi" +
+	"dhorseFocusraiseboxesTrackement</em>bar\">.src=to" +
+	"weralt=\"cablehenry24px;setupitalysharpminortaste" +
+	"wantsthis.resetwheelgirls/css/100%;clubsstuffbib" +
+	"levotes 1000korea});\x0d\x0abandsqueue= {};80px;cking{" +
+	"\x0d\x0a\x09\x09aheadclockirishlike ratiostatsForm\"yahoo)[0]" +
+	";Aboutfinds</h1>debugtasksURL =cells})();12px;pr" +
+	"imetellsturns0x600.jpg\"spainbeachtaxesmicroangel" +
+	"--></giftssteve-linkbody.});\x0a\x09mount (199FAQ</rog" +
+	"erfrankClass28px;feeds<h1><scotttests22px;drink)" +
+	" || lewisshall#039; for lovedwaste00px;ja:\xe3\x82simo" +
+	"n<fontreplymeetsuntercheaptightBrand) != dresscl" +
+	"ipsroomsonkeymobilmain.Name platefunnytreescom/\"" +
+	"1.jpgwmodeparamSTARTleft idden, 201);\x0a}\x0aform.vir" +
+	"uschairtransworstPagesitionpatch<!--\x0ao-cacfirmst" +
+	"ours,000 asiani++){adobe')[0]id=10both;menu .2.m" +
+	"i.png\"kevincoachChildbruce2.jpgURL)+.jpg|suitesl" +
+	"iceharry120\" sweettr>\x0d\x0aname=diegopage swiss-->\x0a\x0a" +
+	"#fff;\">Log.com\"treatsheet) && 14px;sleepntentfil" +
+	"edja:\xe3\x83id=\"cName\"worseshots-box-delta\x0a&lt;bears:" +
+	"48Z<data-rural</a> spendbakershops= \"\";php\">ctio" +
+	"n13px;brianhellosize=o=%2F joinmaybe<img img\">, " +
+	"fjsimg\" \")[0]MTopBType\"newlyDanskczechtrailknows" +
+	"</h5>faq\">zh-cn10);\x0a-1\");type=bluestrulydavis.js" +
+	"';>\x0d\x0a<!steel you h2>\x0d\x0aform jesus100% menu.\x0d\x0a\x09\x0d\x0aw" +
+	"alesrisksumentddingb-likteachgif\" vegasdanskeest" +
+	"ishqipsuomisobredesdeentretodospuedea\xc3\xb1osest\xc3\xa1ti" +
+	"enehastaotrospartedondenuevohacerformamismomejor" +
+	"mundoaqu\xc3\xadd\xc3\xadass\xc3\xb3loayudafechatodastantomenosdat" +
+	"osotrassitiomuchoahoralugarmayorestoshorastenera" +
+	"ntesfotosestaspa\xc3\xadsnuevasaludforosmedioquienmese" +
+	"spoderchileser\xc3\xa1vecesdecirjos\xc3\xa9estarventagrupohe" +
+	"choellostengoamigocosasnivelgentemismaairesjulio" +
+	"temashaciafavorjuniolibrepuntobuenoautorabrilbue" +
+	"natextomarzosaberlistaluegoc\xc3\xb3moenerojuegoper\xc3\xbah" +
+	"aberestoynuncamujervalorfueralibrogustaigualvoto" +
+	"scasosgu\xc3\xadapuedosomosavisousteddebennochebuscafa" +
+	"ltaeurosseriedichocursoclavecasasle\xc3\xb3nplazolargo" +
+	"obrasvistaapoyojuntotratavistocrearcampohemoscin" +
+	"cocargopisosordenhacen\xc3\xa1readiscopedrocercapuedap" +
+	"apelmenor\xc3\xbatilclarojorgecalleponertardenadiemarc" +
+	"asigueellassiglocochemotosmadreclaserestoni\xc3\xb1oqu" +
+	"edapasarbancohijosviajepablo\xc3\xa9stevienereinodejar" +
+	"fondocanalnorteletracausatomarmanoslunesautosvil" +
+	"lavendopesartipostengamarcollevapadreunidovamosz" +
+	"onasambosbandamariaabusomuchasubirriojavivirgrad" +
+	"ochicaall\xc3\xadjovendichaestantalessalirsuelopesosfi" +
+	"nesllamabusco\xc3\xa9stalleganegroplazahumorpagarjunta" +
+	"dobleislasbolsaba\xc3\xb1ohablalucha\xc3\x81readicenjugarnot" +
+	"asvalleall\xc3\xa1cargadolorabajoest\xc3\xa9gustomentemariof" +
+	"irmacostofichaplatahogarartesleyesaquelmuseobase" +
+	"spocosmitadcielochicomiedoganarsantoetapadebespl" +
+	"ayaredessietecortecoreadudasdeseoviejodeseaaguas" +
+	"&quot;domaincommonstatuseventsmastersystemaction" +
+	"bannerremovescrollupdateglobalmediumfilternumber" +
+	"changeresultpublicscreenchoosenormaltravelissues" +
+	"sourcetargetspringmodulemobileswitchphotosborder" +
+	"regionitselfsocialactivecolumnrecordfollowtitle>" +
+	"eitherlengthfamilyfriendlayoutauthorcreatereview" +
+	"summerserverplayedplayerexpandpolicyformatdouble" +
+	"pointsseriespersonlivingdesignmonthsforcesunique" +
+	"weightpeopleenergynaturesearchfigurehavingcustom" +
+	"offsetletterwindowsubmitrendergroupsuploadhealth" +
+	"methodvideosschoolfutureshadowdebatevaluesObject" +
+	"othersrightsleaguechromesimplenoticesharedending" +
+	"seasonreportonlinesquarebuttonimagesenablemoving" +
+	"latestwinterFranceperiodstrongrepeatLondondetail" +
+	"formeddemandsecurepassedtoggleplacesdevicestatic" +
+	"citiesstreamyellowattackstreetflighthiddeninfo\">" +
+	"openedusefulvalleycausesleadersecretseconddamage" +
+	"sportsexceptratingsignedthingseffectfieldsstates" +
+	"officevisualeditorvolumeReportmuseummoviesparent" +
+	"accessmostlymother\" id=\"marketgroundchancesurvey" +
+	"beforesymbolmomentspeechmotioninsidematterCenter" +
+	"objectexistsmiddleEuropegrowthlegacymannerenough" +
+	"careeransweroriginportalclientselectrandomclosed" +
+	"topicscomingfatheroptionsimplyraisedescapechosen" +
+	"churchdefinereasoncorneroutputmemoryiframepolice" +
+	"modelsNumberduringoffersstyleskilledlistedcalled" +
+	"silvermargindeletebetterbrowselimitsGlobalsingle" +
+	"widgetcenterbudgetnowrapcreditclaimsenginesafety" +
+	"choicespirit-stylespreadmakingneededrussiaplease" +
+	"extentScriptbrokenallowschargedividefactormember" +
+	"-basedtheoryconfigaroundworkedhelpedChurchimpact" +
+	"shouldalwayslogo\" bottomlist\">){var prefixorange" +
+	"Header.push(couplegardenbridgelaunchReviewtaking" +
+	"visionlittledatingButtonbeautythemesforgotSearch" +
+	"anchoralmostloadedChangereturnstringreloadMobile" +
+	"incomesupplySourceordersviewed&nbsp;courseAbout " +
+	"island<html cookiename=\"amazonmodernadvicein</a>" +
+	": The dialoghousesBEGIN Mexicostartscentreheight" +
+	"addingIslandassetsEmpireSchooleffortdirectnearly" +
+	"manualSelect.\x0a\x0aOnejoinedmenu\">Philipawardshandle" +
+	"importOfficeregardskillsnationSportsdegreeweekly" +
+	" (e.g.behinddoctorloggedunited</b></beginsplants" +
+	"assistartistissued300px|canadaagencyschemeremain" +
+	"Brazilsamplelogo\">beyond-scaleacceptservedmarine" +
+	"Footercamera</h1>\x0a_form\"leavesstress\" />\x0d\x0a.gif\" " +
+	"onloadloaderOxfordsistersurvivlistenfemaleDesign" +
+	"size=\"appealtext\">levelsthankshigherforcedanimal" +
+	"anyoneAfricaagreedrecentPeople<br />wonderprices" +
+	"turned|| {};main\">inlinesundaywrap\">failedcensus" +
+	"minutebeaconquotes150px|estateremoteemail\"linked" +
+	"right;signalformal1.htmlsignupprincefloat:.png\" " +
+	"forum.AccesspaperssoundsextendHeightsliderUTF-8\"" +
+	"&amp; Before. WithstudioownersmanageprofitjQuery" +
+	"annualparamsboughtfamousgooglelongeri++) {israel" +
+	"sayingdecidehome\">headerensurebranchpiecesblock;" +
+	"statedtop\"><racingresize--&gt;pacitysexualbureau" +
+	".jpg\" 10,000obtaintitlesamount, Inc.comedymenu\" " +
+	"lyricstoday.indeedcounty_logo.FamilylookedMarket" +
+	"lse ifPlayerturkey);var forestgivingerrorsDomain" +
+	"}else{insertBlog</footerlogin.fasteragents<body " +
+	"10px 0pragmafridayjuniordollarplacedcoversplugin" +
+	"5,000 page\">boston.test(avatartested_countforums" +
+	"schemaindex,filledsharesreaderalert(appearSubmit" +
+	"line\">body\">\x0a* TheThoughseeingjerseyNews</verify" +
+	"expertinjurywidth=CookieSTART across_imagethread" +
+	"nativepocketbox\">\x0aSystem Davidcancertablesproved" +
+	"April reallydriveritem\">more\">boardscolorscampus" +
+	"first || [];media.guitarfinishwidth:showedOther " +
+	".php\" assumelayerswilsonstoresreliefswedenCustom" +
+	"easily your String\x0a\x0aWhiltaylorclear:resortfrench" +
+	"though\") + \"<body>buyingbrandsMembername\">opping" +
+	"sector5px;\">vspacepostermajor coffeemartinmature" +
+	"happen</nav>kansaslink\">Images=falsewhile hspace" +
+	"0&amp; \x0a\x0aIn  powerPolski-colorjordanBottomStart " +
+	"-count2.htmlnews\">01.jpgOnline-rightmillersenior" +
+	"ISBN 00,000 guidesvalue)ectionrepair.xml\"  right" +
+	"s.html-blockregExp:hoverwithinvirginphones</tr>\x0d" +
+	"using \x0a\x09var >');\x0a\x09</td>\x0a</tr>\x0abahasabrasilgalego" +
+	"magyarpolskisrpski\xd8\xb1\xd8\xaf\xd9\x88\xe4\xb8\xad\xe6\x96\x87\xe7\xae\x80\xe4\xbd\x93\xe7\xb9\x81\xe9\xab\x94\xe4\xbf\xa1\xe6\x81\xaf" +
+	"\xe4\xb8\xad\xe5\x9b\xbd\xe6\x88\x91\xe4\xbb\xac\xe4\xb8\x80\xe4\xb8\xaa\xe5\x85\xac\xe5\x8f\xb8\xe7\xae\xa1\xe7\x90\x86\xe8\xae\xba\xe5\x9d\x9b\xe5\x8f\xaf\xe4\xbb\xa5\xe6\x9c\x8d\xe5\x8a\xa1" +
+	"\xe6\x97\xb6\xe9\x97\xb4\xe4\xb8\xaa\xe4\xba\xba\xe4\xba\xa7\xe5\x93\x81\xe8\x87\xaa\xe5\xb7\xb1\xe4\xbc\x81\xe4\xb8\x9a\xe6\x9f\xa5\xe7\x9c\x8b\xe5\xb7\xa5\xe4\xbd\x9c\xe8\x81\x94\xe7\xb3\xbb" +
+	"\xe6\xb2\xa1\xe6\x9c\x89\xe7\xbd\x91\xe7\xab\x99\xe6\x89\x80\xe6\x9c\x89\xe8\xaf\x84\xe8\xae\xba\xe4\xb8\xad\xe5\xbf\x83\xe6\x96\x87\xe7\xab\xa0\xe7\x94\xa8\xe6\x88\xb7\xe9\xa6\x96\xe9\xa1\xb5" +
+	"\xe4\xbd\x9c\xe8\x80\x85\xe6\x8a\x80\xe6\x9c\xaf\xe9\x97\xae\xe9\xa2\x98\xe7\x9b\xb8\xe5\x85\xb3\xe4\xb8\x8b\xe8\xbd\xbd\xe6\x90\x9c\xe7\xb4\xa2\xe4\xbd\xbf\xe7\x94\xa8\xe8\xbd\xaf\xe4\xbb\xb6" +
+	"\xe5\x9c\xa8\xe7\xba\xbf\xe4\xb8\xbb\xe9\xa2\x98\xe8\xb5\x84\xe6\x96\x99\xe8\xa7\x86\xe9\xa2\x91\xe5\x9b\x9e\xe5\xa4\x8d\xe6\xb3\xa8\xe5\x86\x8c\xe7\xbd\x91\xe7\xbb\x9c\xe6\x94\xb6\xe8\x97\x8f" +
+	"\xe5\x86\x85\xe5\xae\xb9\xe6\x8e\xa8\xe8\x8d\x90\xe5\xb8\x82\xe5\x9c\xba\xe6\xb6\x88\xe6\x81\xaf\xe7\xa9\xba\xe9\x97\xb4\xe5\x8f\x91\xe5\xb8\x83\xe4\xbb\x80\xe4\xb9\x88\xe5\xa5\xbd\xe5\x8f\x8b" +
+	"\xe7\x94\x9f\xe6\xb4\xbb\xe5\x9b\xbe\xe7\x89\x87\xe5\x8f\x91\xe5\xb1\x95\xe5\xa6\x82\xe6\x9e\x9c\xe6\x89\x8b\xe6\x9c\xba\xe6\x96\xb0\xe9\x97\xbb\xe6\x9c\x80\xe6\x96\xb0\xe6\x96\xb9\xe5\xbc\x8f" +
+	"\xe5\x8c\x97\xe4\xba\xac\xe6\x8f\x90\xe4\xbe\x9b\xe5\x85\xb3\xe4\xba\x8e\xe6\x9b\xb4\xe5\xa4\x9a\xe8\xbf\x99\xe4\xb8\xaa\xe7\xb3\xbb\xe7\xbb\x9f\xe7\x9f\xa5\xe9\x81\x93\xe6\xb8\xb8\xe6\x88\x8f" +
+	"\xe5\xb9\xbf\xe5\x91\x8a\xe5\x85\xb6\xe4\xbb\x96\xe5\x8f\x91\xe8\xa1\xa8\xe5\xae\x89\xe5\x85\xa8\xe7\xac\xac\xe4\xb8\x80\xe4\xbc\x9a\xe5\x91\x98\xe8\xbf\x9b\xe8\xa1\x8c\xe7\x82\xb9\xe5\x87\xbb" +
+	"\xe7\x89\x88\xe6\x9d\x83\xe7\x94\xb5\xe5\xad\x90\xe4\xb8\x96\xe7\x95\x8c\xe8\xae\xbe\xe8\xae\xa1\xe5\x85\x8d\xe8\xb4\xb9\xe6\x95\x99\xe8\x82\xb2\xe5\x8a\xa0\xe5\x85\xa5\xe6\xb4\xbb\xe5\x8a\xa8" +
+	"\xe4\xbb\x96\xe4\xbb\xac\xe5\x95\x86\xe5\x93\x81\xe5\x8d\x9a\xe5\xae\xa2\xe7\x8e\xb0\xe5\x9c\xa8\xe4\xb8\x8a\xe6\xb5\xb7\xe5\xa6\x82\xe4\xbd\x95\xe5\xb7\xb2\xe7\xbb\x8f\xe7\x95\x99\xe8\xa8\x80" +
+	"\xe8\xaf\xa6\xe7\xbb\x86\xe7\xa4\xbe\xe5\x8c\xba\xe7\x99\xbb\xe5\xbd\x95\xe6\x9c\xac\xe7\xab\x99\xe9\x9c\x80\xe8\xa6\x81\xe4\xbb\xb7\xe6\xa0\xbc\xe6\x94\xaf\xe6\x8c\x81\xe5\x9b\xbd\xe9\x99\x85" +
+	"\xe9\x93\xbe\xe6\x8e\xa5\xe5\x9b\xbd\xe5\xae\xb6\xe5\xbb\xba\xe8\xae\xbe\xe6\x9c\x8b\xe5\x8f\x8b\xe9\x98\x85\xe8\xaf\xbb\xe6\xb3\x95\xe5\xbe\x8b\xe4\xbd\x8d\xe7\xbd\xae\xe7\xbb\x8f\xe6\xb5\x8e" +
+	"\xe9\x80\x89\xe6\x8b\xa9\xe8\xbf\x99\xe6\xa0\xb7\xe5\xbd\x93\xe5\x89\x8d\xe5\x88\x86\xe7\xb1\xbb\xe6\x8e\x92\xe8\xa1\x8c\xe5\x9b\xa0\xe4\xb8\xba\xe4\xba\xa4\xe6\x98\x93\xe6\x9c\x80\xe5\x90\x8e" +
+	"\xe9\x9f\xb3\xe4\xb9\x90\xe4\xb8\x8d\xe8\x83\xbd\xe9\x80\x9a\xe8\xbf\x87\xe8\xa1\x8c\xe4\xb8\x9a\xe7\xa7\x91\xe6\x8a\x80\xe5\x8f\xaf\xe8\x83\xbd\xe8\xae\xbe\xe5\xa4\x87\xe5\x90\x88\xe4\xbd\x9c" +
+	"\xe5\xa4\xa7\xe5\xae\xb6\xe7\xa4\xbe\xe4\xbc\x9a\xe7\xa0\x94\xe7\xa9\xb6\xe4\xb8\x93\xe4\xb8\x9a\xe5\x85\xa8\xe9\x83\xa8\xe9\xa1\xb9\xe7\x9b\xae\xe8\xbf\x99\xe9\x87\x8c\xe8\xbf\x98\xe6\x98\xaf" +
+	"\xe5\xbc\x80\xe5\xa7\x8b\xe6\x83\x85\xe5\x86\xb5\xe7\x94\xb5\xe8\x84\x91\xe6\x96\x87\xe4\xbb\xb6\xe5\x93\x81\xe7\x89\x8c\xe5\xb8\xae\xe5\x8a\xa9\xe6\x96\x87\xe5\x8c\x96\xe8\xb5\x84\xe6\xba\x90" +
+	"\xe5\xa4\xa7\xe5\xad\xa6\xe5\xad\xa6\xe4\xb9\xa0\xe5\x9c\xb0\xe5\x9d\x80\xe6\xb5\x8f\xe8\xa7\x88\xe6\x8a\x95\xe8\xb5\x84\xe5\xb7\xa5\xe7\xa8\x8b\xe8\xa6\x81\xe6\xb1\x82\xe6\x80\x8e\xe4\xb9\x88" +
+	"\xe6\x97\xb6\xe5\x80\x99\xe5\x8a\x9f\xe8\x83\xbd\xe4\xb8\xbb\xe8\xa6\x81\xe7\x9b\xae\xe5\x89\x8d\xe8\xb5\x84\xe8\xae\xaf\xe5\x9f\x8e\xe5\xb8\x82\xe6\x96\xb9\xe6\xb3\x95\xe7\x94\xb5\xe5\xbd\xb1" +
+	"\xe6\x8b\x9b\xe8\x81\x98\xe5\xa3\xb0\xe6\x98\x8e\xe4\xbb\xbb\xe4\xbd\x95\xe5\x81\xa5\xe5\xba\xb7\xe6\x95\xb0\xe6\x8d\xae\xe7\xbe\x8e\xe5\x9b\xbd\xe6\xb1\xbd\xe8\xbd\xa6\xe4\xbb\x8b\xe7\xbb\x8d" +
+	"\xe4\xbd\x86\xe6\x98\xaf\xe4\xba\xa4\xe6\xb5\x81\xe7\x94\x9f\xe4\xba\xa7\xe6\x89\x80\xe4\xbb\xa5\xe7\x94\xb5\xe8\xaf\x9d\xe6\x98\xbe\xe7\xa4\xba\xe4\xb8\x80\xe4\xba\x9b\xe5\x8d\x95\xe4\xbd\x8d" +
+	"\xe4\xba\xba\xe5\x91\x98\xe5\x88\x86\xe6\x9e\x90\xe5\x9c\xb0\xe5\x9b\xbe\xe6\x97\x85\xe6\xb8\xb8\xe5\xb7\xa5\xe5\x85\xb7\xe5\xad\xa6\xe7\x94\x9f\xe7\xb3\xbb\xe5\x88\x97\xe7\xbd\x91\xe5\x8f\x8b" +
+	"\xe5\xb8\x96\xe5\xad\x90\xe5\xaf\x86\xe7\xa0\x81\xe9\xa2\x91\xe9\x81\x93\xe6\x8e\xa7\xe5\x88\xb6\xe5\x9c\xb0\xe5\x8c\xba\xe5\x9f\xba\xe6\x9c\xac\xe5\x85\xa8\xe5\x9b\xbd\xe7\xbd\x91\xe4\xb8\x8a" +
+	"\xe9\x87\x8d\xe8\xa6\x81\xe7\xac\xac\xe4\xba\x8c\xe5\x96\x9c\xe6\xac\xa2\xe8\xbf\x9b\xe5\x85\xa5\xe5\x8f\x8b\xe6\x83\x85\xe8\xbf\x99\xe4\xba\x9b\xe8\x80\x83\xe8\xaf\x95\xe5\x8f\x91\xe7\x8e\xb0" +
+	"\xe5\x9f\xb9\xe8\xae\xad\xe4\xbb\xa5\xe4\xb8\x8a\xe6\x94\xbf\xe5\xba\x9c\xe6\x88\x90\xe4\xb8\xba\xe7\x8e\xaf\xe5\xa2\x83\xe9\xa6\x99\xe6\xb8\xaf\xe5\x90\x8c\xe6\x97\xb6\xe5\xa8\xb1\xe4\xb9\x90" +
+	"\xe5\x8f\x91\xe9\x80\x81\xe4\xb8\x80\xe5\xae\x9a\xe5\xbc\x80\xe5\x8f\x91\xe4\xbd\x9c\xe5\x93\x81\xe6\xa0\x87\xe5\x87\x86\xe6\xac\xa2\xe8\xbf\x8e\xe8\xa7\xa3\xe5\x86\xb3\xe5\x9c\xb0\xe6\x96\xb9" +
+	"\xe4\xb8\x80\xe4\xb8\x8b\xe4\xbb\xa5\xe5\x8f\x8a\xe8\xb4\xa3\xe4\xbb\xbb\xe6\x88\x96\xe8\x80\x85\xe5\xae\xa2\xe6\x88\xb7\xe4\xbb\xa3\xe8\xa1\xa8\xe7\xa7\xaf\xe5\x88\x86\xe5\xa5\xb3\xe4\xba\xba" +
+	"\xe6\x95\xb0\xe7\xa0\x81\xe9\x94\x80\xe5\x94\xae\xe5\x87\xba\xe7\x8e\xb0\xe7\xa6\xbb\xe7\xba\xbf\xe5\xba\x94\xe7\x94\xa8\xe5\x88\x97\xe8\xa1\xa8\xe4\xb8\x8d\xe5\x90\x8c\xe7\xbc\x96\xe8\xbe\x91" +
+	"\xe7\xbb\x9f\xe8\xae\xa1\xe6\x9f\xa5\xe8\xaf\xa2\xe4\xb8\x8d\xe8\xa6\x81\xe6\x9c\x89\xe5\x85\xb3\xe6\x9c\xba\xe6\x9e\x84\xe5\xbe\x88\xe5\xa4\x9a\xe6\x92\xad\xe6\x94\xbe\xe7\xbb\x84\xe7\xbb\x87" +
+	"\xe6\x94\xbf\xe7\xad\x96\xe7\x9b\xb4\xe6\x8e\xa5\xe8\x83\xbd\xe5\x8a\x9b\xe6\x9d\xa5\xe6\xba\x90\xe6\x99\x82\xe9\x96\x93\xe7\x9c\x8b\xe5\x88\xb0\xe7\x83\xad\xe9\x97\xa8\xe5\x85\xb3\xe9\x94\xae" +
+	"\xe4\xb8\x93\xe5\x8c\xba\xe9\x9d\x9e\xe5\xb8\xb8\xe8\x8b\xb1\xe8\xaf\xad\xe7\x99\xbe\xe5\xba\xa6\xe5\xb8\x8c\xe6\x9c\x9b\xe7\xbe\x8e\xe5\xa5\xb3\xe6\xaf\x94\xe8\xbe\x83\xe7\x9f\xa5\xe8\xaf\x86" +
+	"\xe8\xa7\x84\xe5\xae\x9a\xe5\xbb\xba\xe8\xae\xae\xe9\x83\xa8\xe9\x97\xa8\xe6\x84\x8f\xe8\xa7\x81\xe7\xb2\xbe\xe5\xbd\xa9\xe6\x97\xa5\xe6\x9c\xac\xe6\x8f\x90\xe9\xab\x98\xe5\x8f\x91\xe8\xa8\x80" +
+	"\xe6\x96\xb9\xe9\x9d\xa2\xe5\x9f\xba\xe9\x87\x91\xe5\xa4\x84\xe7\x90\x86\xe6\x9d\x83\xe9\x99\x90\xe5\xbd\xb1\xe7\x89\x87\xe9\x93\xb6\xe8\xa1\x8c\xe8\xbf\x98\xe6\x9c\x89\xe5\x88\x86\xe4\xba\xab" +
+	"\xe7\x89\xa9\xe5\x93\x81\xe7\xbb\x8f\xe8\x90\xa5\xe6\xb7\xbb\xe5\x8a\xa0\xe4\xb8\x93\xe5\xae\xb6\xe8\xbf\x99\xe7\xa7\x8d\xe8\xaf\x9d\xe9\xa2\x98\xe8\xb5\xb7\xe6\x9d\xa5\xe4\xb8\x9a\xe5\x8a\xa1" +
+	"\xe5\x85\xac\xe5\x91\x8a\xe8\xae\xb0\xe5\xbd\x95\xe7\xae\x80\xe4\xbb\x8b\xe8\xb4\xa8\xe9\x87\x8f\xe7\x94\xb7\xe4\xba\xba\xe5\xbd\xb1\xe5\x93\x8d\xe5\xbc\x95\xe7\x94\xa8\xe6\x8a\xa5\xe5\x91\x8a" +
+	"\xe9\x83\xa8\xe5\x88\x86\xe5\xbf\xab\xe9\x80\x9f\xe5\x92\xa8\xe8\xaf\xa2\xe6\x97\xb6\xe5\xb0\x9a\xe6\xb3\xa8\xe6\x84\x8f\xe7\x94\xb3\xe8\xaf\xb7\xe5\xad\xa6\xe6\xa0\xa1\xe5\xba\x94\xe8\xaf\xa5" +
+	"\xe5\x8e\x86\xe5\x8f\xb2\xe5\x8f\xaa\xe6\x98\xaf\xe8\xbf\x94\xe5\x9b\x9e\xe8\xb4\xad\xe4\xb9\xb0\xe5\x90\x8d\xe7\xa7\xb0\xe4\xb8\xba\xe4\xba\x86\xe6\x88\x90\xe5\x8a\x9f\xe8\xaf\xb4\xe6\x98\x8e" +
+	"\xe4\xbe\x9b\xe5\xba\x94\xe5\xad\xa9\xe5\xad\x90\xe4\xb8\x93\xe9\xa2\x98\xe7\xa8\x8b\xe5\xba\x8f\xe4\xb8\x80\xe8\x88\xac\xe6\x9c\x83\xe5\x93\xa1\xe5\x8f\xaa\xe6\x9c\x89\xe5\x85\xb6\xe5\xae\x83" +
+	"\xe4\xbf\x9d\xe6\x8a\xa4\xe8\x80\x8c\xe4\xb8\x94\xe4\xbb\x8a\xe5\xa4\xa9\xe7\xaa\x97\xe5\x8f\xa3\xe5\x8a\xa8\xe6\x80\x81\xe7\x8a\xb6\xe6\x80\x81\xe7\x89\xb9\xe5\x88\xab\xe8\xae\xa4\xe4\xb8\xba" +
+	"\xe5\xbf\x85\xe9\xa1\xbb\xe6\x9b\xb4\xe6\x96\xb0\xe5\xb0\x8f\xe8\xaf\xb4\xe6\x88\x91\xe5\x80\x91\xe4\xbd\x9c\xe4\xb8\xba\xe5\xaa\x92\xe4\xbd\x93\xe5\x8c\x85\xe6\x8b\xac\xe9\x82\xa3\xe4\xb9\x88" +
+	"\xe4\xb8\x80\xe6\xa0\xb7\xe5\x9b\xbd\xe5\x86\x85\xe6\x98\xaf\xe5\x90\xa6\xe6\xa0\xb9\xe6\x8d\xae\xe7\x94\xb5\xe8\xa7\x86\xe5\xad\xa6\xe9\x99\xa2\xe5\x85\xb7\xe6\x9c\x89\xe8\xbf\x87\xe7\xa8\x8b" +
+	"\xe7\x94\xb1\xe4\xba\x8e\xe4\xba\xba\xe6\x89\x8d\xe5\x87\xba\xe6\x9d\xa5\xe4\xb8\x8d\xe8\xbf\x87\xe6\xad\xa3\xe5\x9c\xa8\xe6\x98\x8e\xe6\x98\x9f\xe6\x95\x85\xe4\xba\x8b\xe5\x85\xb3\xe7\xb3\xbb" +
+	"\xe6\xa0\x87\xe9\xa2\x98\xe5\x95\x86\xe5\x8a\xa1\xe8\xbe\x93\xe5\x85\xa5\xe4\xb8\x80\xe7\x9b\xb4\xe5\x9f\xba\xe7\xa1\x80\xe6\x95\x99\xe5\xad\xa6\xe4\xba\x86\xe8\xa7\xa3\xe5\xbb\xba\xe7\xad\x91" +
+	"\xe7\xbb\x93\xe6\x9e\x9c\xe5\x85\xa8\xe7\x90\x83\xe9\x80\x9a\xe7\x9f\xa5\xe8\xae\xa1\xe5\x88\x92\xe5\xaf\xb9\xe4\xba\x8e\xe8\x89\xba\xe6\x9c\xaf\xe7\x9b\xb8\xe5\x86\x8c\xe5\x8f\x91\xe7\x94\x9f" +
+	"\xe7\x9c\x9f\xe7\x9a\x84\xe5\xbb\xba\xe7\xab\x8b\xe7\xad\x89\xe7\xba\xa7\xe7\xb1\xbb\xe5\x9e\x8b\xe7\xbb\x8f\xe9\xaa\x8c\xe5\xae\x9e\xe7\x8e\xb0\xe5\x88\xb6\xe4\xbd\x9c\xe6\x9d\xa5\xe8\x87\xaa" +
+	"\xe6\xa0\x87\xe7\xad\xbe\xe4\xbb\xa5\xe4\xb8\x8b\xe5\x8e\x9f\xe5\x88\x9b\xe6\x97\xa0\xe6\xb3\x95\xe5\x85\xb6\xe4\xb8\xad\xe5\x80\x8b\xe4\xba\xba\xe4\xb8\x80\xe5\x88\x87\xe6\x8c\x87\xe5\x8d\x97" +
+	"\xe5\x85\xb3\xe9\x97\xad\xe9\x9b\x86\xe5\x9b\xa2\xe7\xac\xac\xe4\xb8\x89\xe5\x85\xb3\xe6\xb3\xa8\xe5\x9b\xa0\xe6\xad\xa4\xe7\x85\xa7\xe7\x89\x87\xe6\xb7\xb1\xe5\x9c\xb3\xe5\x95\x86\xe4\xb8\x9a" +
+	"\xe5\xb9\xbf\xe5\xb7\x9e\xe6\x97\xa5\xe6\x9c\x9f\xe9\xab\x98\xe7\xba\xa7\xe6\x9c\x80\xe8\xbf\x91\xe7\xbb\xbc\xe5\x90\x88\xe8\xa1\xa8\xe7\xa4\xba\xe4\xb8\x93\xe8\xbe\x91\xe8\xa1\x8c\xe4\xb8\xba" +
+	"\xe4\xba\xa4\xe9\x80\x9a\xe8\xaf\x84\xe4\xbb\xb7\xe8\xa7\x89\xe5\xbe\x97\xe7\xb2\xbe\xe5\x8d\x8e\xe5\xae\xb6\xe5\xba\xad\xe5\xae\x8c\xe6\x88\x90\xe6\x84\x9f\xe8\xa7\x89\xe5\xae\x89\xe8\xa3\x85" +
+	"\xe5\xbe\x97\xe5\x88\xb0\xe9\x82\xae\xe4\xbb\xb6\xe5\x88\xb6\xe5\xba\xa6\xe9\xa3\x9f\xe5\x93\x81\xe8\x99\xbd\xe7\x84\xb6\xe8\xbd\xac\xe8\xbd\xbd\xe6\x8a\xa5\xe4\xbb\xb7\xe8\xae\xb0\xe8\x80\x85" +
+	"\xe6\x96\xb9\xe6\xa1\x88\xe8\xa1\x8c\xe6\x94\xbf\xe4\xba\xba\xe6\xb0\x91\xe7\x94\xa8\xe5\x93\x81\xe4\xb8\x9c\xe8\xa5\xbf\xe6\x8f\x90\xe5\x87\xba\xe9\x85\x92\xe5\xba\x97\xe7\x84\xb6\xe5\x90\x8e" +
+	"\xe4\xbb\x98\xe6\xac\xbe\xe7\x83\xad\xe7\x82\xb9\xe4\xbb\xa5\xe5\x89\x8d\xe5\xae\x8c\xe5\x85\xa8\xe5\x8f\x91\xe5\xb8\x96\xe8\xae\xbe\xe7\xbd\xae\xe9\xa2\x86\xe5\xaf\xbc\xe5\xb7\xa5\xe4\xb8\x9a" +
+	"\xe5\x8c\xbb\xe9\x99\xa2\xe7\x9c\x8b\xe7\x9c\x8b\xe7\xbb\x8f\xe5\x85\xb8\xe5\x8e\x9f\xe5\x9b\xa0\xe5\xb9\xb3\xe5\x8f\xb0\xe5\x90\x84\xe7\xa7\x8d\xe5\xa2\x9e\xe5\x8a\xa0\xe6\x9d\x90\xe6\x96\x99" +
+	"\xe6\x96\xb0\xe5\xa2\x9e\xe4\xb9\x8b\xe5\x90\x8e\xe8\x81\x8c\xe4\xb8\x9a\xe6\x95\x88\xe6\x9e\x9c\xe4\xbb\x8a\xe5\xb9\xb4\xe8\xae\xba\xe6\x96\x87\xe6\x88\x91\xe5\x9b\xbd\xe5\x91\x8a\xe8\xaf\x89" +
+	"\xe7\x89\x88\xe4\xb8\xbb\xe4\xbf\xae\xe6\x94\xb9\xe5\x8f\x82\xe4\xb8\x8e\xe6\x89\x93\xe5\x8d\xb0\xe5\xbf\xab\xe4\xb9\x90\xe6\x9c\xba\xe6\xa2\xb0\xe8\xa7\x82\xe7\x82\xb9\xe5\xad\x98\xe5\x9c\xa8" +
+	"\xe7\xb2\xbe\xe7\xa5\x9e\xe8\x8e\xb7\xe5\xbe\x97\xe5\x88\xa9\xe7\x94\xa8\xe7\xbb\xa7\xe7\xbb\xad\xe4\xbd\xa0\xe4\xbb\xac\xe8\xbf\x99\xe4\xb9\x88\xe6\xa8\xa1\xe5\xbc\x8f\xe8\xaf\xad\xe8\xa8\x80" +
+	"\xe8\x83\xbd\xe5\xa4\x9f\xe9\x9b\x85\xe8\x99\x8e\xe6\x93\x8d\xe4\xbd\x9c\xe9\xa3\x8e\xe6\xa0\xbc\xe4\xb8\x80\xe8\xb5\xb7\xe7\xa7\x91\xe5\xad\xa6\xe4\xbd\x93\xe8\x82\xb2\xe7\x9f\xad\xe4\xbf\xa1" +
+	"\xe6\x9d\xa1\xe4\xbb\xb6\xe6\xb2\xbb\xe7\x96\x97\xe8\xbf\x90\xe5\x8a\xa8\xe4\xba\xa7\xe4\xb8\x9a\xe4\xbc\x9a\xe8\xae\xae\xe5\xaf\xbc\xe8\x88\xaa\xe5\x85\x88\xe7\x94\x9f\xe8\x81\x94\xe7\x9b\x9f" +
+	"\xe5\x8f\xaf\xe6\x98\xaf\xe5\x95\x8f\xe9\xa1\x8c\xe7\xbb\x93\xe6\x9e\x84\xe4\xbd\x9c\xe7\x94\xa8\xe8\xb0\x83\xe6\x9f\xa5\xe8\xb3\x87\xe6\x96\x99\xe8\x87\xaa\xe5\x8a\xa8\xe8\xb4\x9f\xe8\xb4\xa3" +
+	"\xe5\x86\x9c\xe4\xb8\x9a\xe8\xae\xbf\xe9\x97\xae\xe5\xae\x9e\xe6\x96\xbd\xe6\x8e\xa5\xe5\x8f\x97\xe8\xae\xa8\xe8\xae\xba\xe9\x82\xa3\xe4\xb8\xaa\xe5\x8f\x8d\xe9\xa6\x88\xe5\x8a\xa0\xe5\xbc\xba" +
+	"\xe5\xa5\xb3\xe6\x80\xa7\xe8\x8c\x83\xe5\x9b\xb4\xe6\x9c\x8d\xe5\x8b\x99\xe4\xbc\x91\xe9\x97\xb2\xe4\xbb\x8a\xe6\x97\xa5\xe5\xae\xa2\xe6\x9c\x8d\xe8\xa7\x80\xe7\x9c\x8b\xe5\x8f\x82\xe5\x8a\xa0" +
+	"\xe7\x9a\x84\xe8\xaf\x9d\xe4\xb8\x80\xe7\x82\xb9\xe4\xbf\x9d\xe8\xaf\x81\xe5\x9b\xbe\xe4\xb9\xa6\xe6\x9c\x89\xe6\x95\x88\xe6\xb5\x8b\xe8\xaf\x95\xe7\xa7\xbb\xe5\x8a\xa8\xe6\x89\x8d\xe8\x83\xbd" +
+	"\xe5\x86\xb3\xe5\xae\x9a\xe8\x82\xa1\xe7\xa5\xa8\xe4\xb8\x8d\xe6\x96\xad\xe9\x9c\x80\xe6\xb1\x82\xe4\xb8\x8d\xe5\xbe\x97\xe5\x8a\x9e\xe6\xb3\x95\xe4\xb9\x8b\xe9\x97\xb4\xe9\x87\x87\xe7\x94\xa8" +
+	"\xe8\x90\xa5\xe9\x94\x80\xe6\x8a\x95\xe8\xaf\x89\xe7\x9b\xae\xe6\xa0\x87\xe7\x88\xb1\xe6\x83\x85\xe6\x91\x84\xe5\xbd\xb1\xe6\x9c\x89\xe4\xba\x9b\xe8\xa4\x87\xe8\xa3\xbd\xe6\x96\x87\xe5\xad\xa6" +
+	"\xe6\x9c\xba\xe4\xbc\x9a\xe6\x95\xb0\xe5\xad\x97\xe8\xa3\x85\xe4\xbf\xae\xe8\xb4\xad\xe7\x89\xa9\xe5\x86\x9c\xe6\x9d\x91\xe5\x85\xa8\xe9\x9d\xa2\xe7\xb2\xbe\xe5\x93\x81\xe5\x85\xb6\xe5\xae\x9e" +
+	"\xe4\xba\x8b\xe6\x83\x85\xe6\xb0\xb4\xe5\xb9\xb3\xe6\x8f\x90\xe7\xa4\xba\xe4\xb8\x8a\xe5\xb8\x82\xe8\xb0\xa2\xe8\xb0\xa2\xe6\x99\xae\xe9\x80\x9a\xe6\x95\x99\xe5\xb8\x88\xe4\xb8\x8a\xe4\xbc\xa0" +
+	"\xe7\xb1\xbb\xe5\x88\xab\xe6\xad\x8c\xe6\x9b\xb2\xe6\x8b\xa5\xe6\x9c\x89\xe5\x88\x9b\xe6\x96\xb0\xe9\x85\x8d\xe4\xbb\xb6\xe5\x8f\xaa\xe8\xa6\x81\xe6\x97\xb6\xe4\xbb\xa3\xe8\xb3\x87\xe8\xa8\x8a" +
+	"\xe8\xbe\xbe\xe5\x88\xb0\xe4\xba\xba\xe7\x94\x9f\xe8\xae\xa2\xe9\x98\x85\xe8\x80\x81\xe5\xb8\x88\xe5\xb1\x95\xe7\xa4\xba\xe5\xbf\x83\xe7\x90\x86\xe8\xb4\xb4\xe5\xad\x90\xe7\xb6\xb2\xe7\xab\x99" +
+	"\xe4\xb8\xbb\xe9\xa1\x8c\xe8\x87\xaa\xe7\x84\xb6\xe7\xba\xa7\xe5\x88\xab\xe7\xae\x80\xe5\x8d\x95\xe6\x94\xb9\xe9\x9d\xa9\xe9\x82\xa3\xe4\xba\x9b\xe6\x9d\xa5\xe8\xaf\xb4\xe6\x89\x93\xe5\xbc\x80" +
+	"\xe4\xbb\xa3\xe7\xa0\x81\xe5\x88\xa0\xe9\x99\xa4\xe8\xaf\x81\xe5\x88\xb8\xe8\x8a\x82\xe7\x9b\xae\xe9\x87\x8d\xe7\x82\xb9\xe6\xac\xa1\xe6\x95\xb8\xe5\xa4\x9a\xe5\xb0\x91\xe8\xa7\x84\xe5\x88\x92" +
+	"\xe8\xb5\x84\xe9\x87\x91\xe6\x89\xbe\xe5\x88\xb0\xe4\xbb\xa5\xe5\x90\x8e\xe5\xa4\xa7\xe5\x85\xa8\xe4\xb8\xbb\xe9\xa1\xb5\xe6\x9c\x80\xe4\xbd\xb3\xe5\x9b\x9e\xe7\xad\x94\xe5\xa4\xa9\xe4\xb8\x8b" +
+	"\xe4\xbf\x9d\xe9\x9a\x9c\xe7\x8e\xb0\xe4\xbb\xa3\xe6\xa3\x80\xe6\x9f\xa5\xe6\x8a\x95\xe7\xa5\xa8\xe5\xb0\x8f\xe6\x97\xb6\xe6\xb2\x92\xe6\x9c\x89\xe6\xad\xa3\xe5\xb8\xb8\xe7\x94\x9a\xe8\x87\xb3" +
+	"\xe4\xbb\xa3\xe7\x90\x86\xe7\x9b\xae\xe5\xbd\x95\xe5\x85\xac\xe5\xbc\x80\xe5\xa4\x8d\xe5\x88\xb6\xe9\x87\x91\xe8\x9e\x8d\xe5\xb9\xb8\xe7\xa6\x8f\xe7\x89\x88\xe6\x9c\xac\xe5\xbd\xa2\xe6\x88\x90" +
+	"\xe5\x87\x86\xe5\xa4\x87\xe8\xa1\x8c\xe6\x83\x85\xe5\x9b\x9e\xe5\x88\xb0\xe6\x80\x9d\xe6\x83\xb3\xe6\x80\x8e\xe6\xa0\xb7\xe5\x8d\x8f\xe8\xae\xae\xe8\xae\xa4\xe8\xaf\x81\xe6\x9c\x80\xe5\xa5\xbd" +
+	"\xe4\xba\xa7\xe7\x94\x9f\xe6\x8c\x89\xe7\x85\xa7\xe6\x9c\x8d\xe8\xa3\x85\xe5\xb9\xbf\xe4\xb8\x9c\xe5\x8a\xa8\xe6\xbc\xab\xe9\x87\x87\xe8\xb4\xad\xe6\x96\xb0\xe6\x89\x8b\xe7\xbb\x84\xe5\x9b\xbe" +
+	"\xe9\x9d\xa2\xe6\x9d\xbf\xe5\x8f\x82\xe8\x80\x83\xe6\x94\xbf\xe6\xb2\xbb\xe5\xae\xb9\xe6\x98\x93\xe5\xa4\xa9\xe5\x9c\xb0\xe5\x8a\xaa\xe5\x8a\x9b\xe4\xba\xba\xe4\xbb\xac\xe5\x8d\x87\xe7\xba\xa7" +
+	"\xe9\x80\x9f\xe5\xba\xa6\xe4\xba\xba\xe7\x89\xa9\xe8\xb0\x83\xe6\x95\xb4\xe6\xb5\x81\xe8\xa1\x8c\xe9\x80\xa0\xe6\x88\x90\xe6\x96\x87\xe5\xad\x97\xe9\x9f\xa9\xe5\x9b\xbd\xe8\xb4\xb8\xe6\x98\x93" +
+	"\xe5\xbc\x80\xe5\xb1\x95\xe7\x9b\xb8\xe9\x97\x9c\xe8\xa1\xa8\xe7\x8e\xb0\xe5\xbd\xb1\xe8\xa7\x86\xe5\xa6\x82\xe6\xad\xa4\xe7\xbe\x8e\xe5\xae\xb9\xe5\xa4\xa7\xe5\xb0\x8f\xe6\x8a\xa5\xe9\x81\x93" +
+	"\xe6\x9d\xa1\xe6\xac\xbe\xe5\xbf\x83\xe6\x83\x85\xe8\xae\xb8\xe5\xa4\x9a\xe6\xb3\x95\xe8\xa7\x84\xe5\xae\xb6\xe5\xb1\x85\xe4\xb9\xa6\xe5\xba\x97\xe8\xbf\x9e\xe6\x8e\xa5\xe7\xab\x8b\xe5\x8d\xb3" +
+	"\xe4\xb8\xbe\xe6\x8a\xa5\xe6\x8a\x80\xe5\xb7\xa7\xe5\xa5\xa5\xe8\xbf\x90\xe7\x99\xbb\xe5\x85\xa5\xe4\xbb\xa5\xe6\x9d\xa5\xe7\x90\x86\xe8\xae\xba\xe4\xba\x8b\xe4\xbb\xb6\xe8\x87\xaa\xe7\x94\xb1" +
+	"\xe4\xb8\xad\xe5\x8d\x8e\xe5\x8a\x9e\xe5\x85\xac\xe5\xa6\x88\xe5\xa6\x88\xe7\x9c\x9f\xe6\xad\xa3\xe4\xb8\x8d\xe9\x94\x99\xe5\x85\xa8\xe6\x96\x87\xe5\x90\x88\xe5\x90\x8c\xe4\xbb\xb7\xe5\x80\xbc" +
+	"\xe5\x88\xab\xe4\xba\xba\xe7\x9b\x91\xe7\x9d\xa3\xe5\x85\xb7\xe4\xbd\x93\xe4\xb8\x96\xe7\xba\xaa\xe5\x9b\xa2\xe9\x98\x9f\xe5\x88\x9b\xe4\xb8\x9a\xe6\x89\xbf\xe6\x8b\x85\xe5\xa2\x9e\xe9\x95\xbf" +
+	"\xe6\x9c\x89\xe4\xba\xba\xe4\xbf\x9d\xe6\x8c\x81\xe5\x95\x86\xe5\xae\xb6\xe7\xbb\xb4\xe4\xbf\xae\xe5\x8f\xb0\xe6\xb9\xbe\xe5\xb7\xa6\xe5\x8f\xb3\xe8\x82\xa1\xe4\xbb\xbd\xe7\xad\x94\xe6\xa1\x88" +
+	"\xe5\xae\x9e\xe9\x99\x85\xe7\x94\xb5\xe4\xbf\xa1\xe7\xbb\x8f\xe7\x90\x86\xe7\x94\x9f\xe5\x91\xbd\xe5\xae\xa3\xe4\xbc\xa0\xe4\xbb\xbb\xe5\x8a\xa1\xe6\xad\xa3\xe5\xbc\x8f\xe7\x89\xb9\xe8\x89\xb2" +
+	"\xe4\xb8\x8b\xe6\x9d\xa5\xe5\x8d\x8f\xe4\xbc\x9a\xe5\x8f\xaa\xe8\x83\xbd\xe5\xbd\x93\xe7\x84\xb6\xe9\x87\x8d\xe6\x96\xb0\xe5\x85\xa7\xe5\xae\xb9\xe6\x8c\x87\xe5\xaf\xbc\xe8\xbf\x90\xe8\xa1\x8c" +
+	"\xe6\x97\xa5\xe5\xbf\x97\xe8\xb3\xa3\xe5\xae\xb6\xe8\xb6\x85\xe8\xbf\x87\xe5\x9c\x9f\xe5\x9c\xb0\xe6\xb5\x99\xe6\xb1\x9f\xe6\x94\xaf\xe4\xbb\x98\xe6\x8e\xa8\xe5\x87\xba\xe7\xab\x99\xe9\x95\xbf" +
+	"\xe6\x9d\xad\xe5\xb7\x9e\xe6\x89\xa7\xe8\xa1\x8c\xe5\x88\xb6\xe9\x80\xa0\xe4\xb9\x8b\xe4\xb8\x80\xe6\x8e\xa8\xe5\xb9\xbf\xe7\x8e\xb0\xe5\x9c\xba\xe6\x8f\x8f\xe8\xbf\xb0\xe5\x8f\x98\xe5\x8c\x96" +
+	"\xe4\xbc\xa0\xe7\xbb\x9f\xe6\xad\x8c\xe6\x89\x8b\xe4\xbf\x9d\xe9\x99\xa9\xe8\xaf\xbe\xe7\xa8\x8b\xe5\x8c\xbb\xe7\x96\x97\xe7\xbb\x8f\xe8\xbf\x87\xe8\xbf\x87\xe5\x8e\xbb\xe4\xb9\x8b\xe5\x89\x8d" +
+	"\xe6\x94\xb6\xe5\x85\xa5\xe5\xb9\xb4\xe5\xba\xa6\xe6\x9d\x82\xe5\xbf\x97\xe7\xbe\x8e\xe4\xb8\xbd\xe6\x9c\x80\xe9\xab\x98\xe7\x99\xbb\xe9\x99\x86\xe6\x9c\xaa\xe6\x9d\xa5\xe5\x8a\xa0\xe5\xb7\xa5" +
+	"\xe5\x85\x8d\xe8\xb4\xa3\xe6\x95\x99\xe7\xa8\x8b\xe7\x89\x88\xe5\x9d\x97\xe8\xba\xab\xe4\xbd\x93\xe9\x87\x8d\xe5\xba\x86\xe5\x87\xba\xe5\x94\xae\xe6\x88\x90\xe6\x9c\xac\xe5\xbd\xa2\xe5\xbc\x8f" +
+	"\xe5\x9c\x9f\xe8\xb1\x86\xe5\x87\xba\xe5\x83\xb9\xe4\xb8\x9c\xe6\x96\xb9\xe9\x82\xae\xe7\xae\xb1\xe5\x8d\x97\xe4\xba\xac\xe6\xb1\x82\xe8\x81\x8c\xe5\x8f\x96\xe5\xbe\x97\xe8\x81\x8c\xe4\xbd\x8d" +
+	"\xe7\x9b\xb8\xe4\xbf\xa1\xe9\xa1\xb5\xe9\x9d\xa2\xe5\x88\x86\xe9\x92\x9f\xe7\xbd\x91\xe9\xa1\xb5\xe7\xa1\xae\xe5\xae\x9a\xe5\x9b\xbe\xe4\xbe\x8b\xe7\xbd\x91\xe5\x9d\x80\xe7\xa7\xaf\xe6\x9e\x81" +
+	"\xe9\x94\x99\xe8\xaf\xaf\xe7\x9b\xae\xe7\x9a\x84\xe5\xae\x9d\xe8\xb4\x9d\xe6\x9c\xba\xe5\x85\xb3\xe9\xa3\x8e\xe9\x99\xa9\xe6\x8e\x88\xe6\x9d\x83\xe7\x97\x85\xe6\xaf\x92\xe5\xae\xa0\xe7\x89\xa9" +
+	"\xe9\x99\xa4\xe4\xba\x86\xe8\xa9\x95\xe8\xab\x96\xe7\x96\xbe\xe7\x97\x85\xe5\x8f\x8a\xe6\x97\xb6\xe6\xb1\x82\xe8\xb4\xad\xe7\xab\x99\xe7\x82\xb9\xe5\x84\xbf\xe7\xab\xa5\xe6\xaf\x8f\xe5\xa4\xa9" +
+	"\xe4\xb8\xad\xe5\xa4\xae\xe8\xae\xa4\xe8\xaf\x86\xe6\xaf\x8f\xe4\xb8\xaa\xe5\xa4\xa9\xe6\xb4\xa5\xe5\xad\x97\xe4\xbd\x93\xe5\x8f\xb0\xe7\x81\xa3\xe7\xbb\xb4\xe6\x8a\xa4\xe6\x9c\xac\xe9\xa1\xb5" +
+	"\xe4\xb8\xaa\xe6\x80\xa7\xe5\xae\x98\xe6\x96\xb9\xe5\xb8\xb8\xe8\xa7\x81\xe7\x9b\xb8\xe6\x9c\xba\xe6\x88\x98\xe7\x95\xa5\xe5\xba\x94\xe5\xbd\x93\xe5\xbe\x8b\xe5\xb8\x88\xe6\x96\xb9\xe4\xbe\xbf" +
+	"\xe6\xa0\xa1\xe5\x9b\xad\xe8\x82\xa1\xe5\xb8\x82\xe6\x88\xbf\xe5\xb1\x8b\xe6\xa0\x8f\xe7\x9b\xae\xe5\x91\x98\xe5\xb7\xa5\xe5\xaf\xbc\xe8\x87\xb4\xe7\xaa\x81\xe7\x84\xb6\xe9\x81\x93\xe5\x85\xb7" +
+	"\xe6\x9c\xac\xe7\xbd\x91\xe7\xbb\x93\xe5\x90\x88\xe6\xa1\xa3\xe6\xa1\x88\xe5\x8a\xb3\xe5\x8a\xa8\xe5\x8f\xa6\xe5\xa4\x96\xe7\xbe\x8e\xe5\x85\x83\xe5\xbc\x95\xe8\xb5\xb7\xe6\x94\xb9\xe5\x8f\x98" +
+	"\xe7\xac\xac\xe5\x9b\x9b\xe4\xbc\x9a\xe8\xae\xa1\xe8\xaa\xaa\xe6\x98\x8e\xe9\x9a\x90\xe7\xa7\x81\xe5\xae\x9d\xe5\xae\x9d\xe8\xa7\x84\xe8\x8c\x83\xe6\xb6\x88\xe8\xb4\xb9\xe5\x85\xb1\xe5\x90\x8c" +
+	"\xe5\xbf\x98\xe8\xae\xb0\xe4\xbd\x93\xe7\xb3\xbb\xe5\xb8\xa6\xe6\x9d\xa5\xe5\x90\x8d\xe5\xad\x97\xe7\x99\xbc\xe8\xa1\xa8\xe5\xbc\x80\xe6\x94\xbe\xe5\x8a\xa0\xe7\x9b\x9f\xe5\x8f\x97\xe5\x88\xb0" +
+	"\xe4\xba\x8c\xe6\x89\x8b\xe5\xa4\xa7\xe9\x87\x8f\xe6\x88\x90\xe4\xba\xba\xe6\x95\xb0\xe9\x87\x8f\xe5\x85\xb1\xe4\xba\xab\xe5\x8c\xba\xe5\x9f\x9f\xe5\xa5\xb3\xe5\xad\xa9\xe5\x8e\x9f\xe5\x88\x99" +
+	"\xe6\x89\x80\xe5\x9c\xa8\xe7\xbb\x93\xe6\x9d\x9f\xe9\x80\x9a\xe4\xbf\xa1\xe8\xb6\x85\xe7\xba\xa7\xe9\x85\x8d\xe7\xbd\xae\xe5\xbd\x93\xe6\x97\xb6\xe4\xbc\x98\xe7\xa7\x80\xe6\x80\xa7\xe6\x84\x9f" +
+	"\xe6\x88\xbf\xe4\xba\xa7\xe9\x81\x8a\xe6\x88\xb2\xe5\x87\xba\xe5\x8f\xa3\xe6\x8f\x90\xe4\xba\xa4\xe5\xb0\xb1\xe4\xb8\x9a\xe4\xbf\x9d\xe5\x81\xa5\xe7\xa8\x8b\xe5\xba\xa6\xe5\x8f\x82\xe6\x95\xb0" +
+	"\xe4\xba\x8b\xe4\xb8\x9a\xe6\x95\xb4\xe4\xb8\xaa\xe5\xb1\xb1\xe4\xb8\x9c\xe6\x83\x85\xe6\x84\x9f\xe7\x89\xb9\xe6\xae\x8a\xe5\x88\x86\xe9\xa1\x9e\xe6\x90\x9c\xe5\xb0\x8b\xe5\xb1\x9e\xe4\xba\x8e" +
+	"\xe9\x97\xa8\xe6\x88\xb7\xe8\xb4\xa2\xe5\x8a\xa1\xe5\xa3\xb0\xe9\x9f\xb3\xe5\x8f\x8a\xe5\x85\xb6\xe8\xb4\xa2\xe7\xbb\x8f\xe5\x9d\x9a\xe6\x8c\x81\xe5\xb9\xb2\xe9\x83\xa8\xe6\x88\x90\xe7\xab\x8b" +
+	"\xe5\x88\xa9\xe7\x9b\x8a\xe8\x80\x83\xe8\x99\x91\xe6\x88\x90\xe9\x83\xbd\xe5\x8c\x85\xe8\xa3\x85\xe7\x94\xa8\xe6\x88\xb6\xe6\xaf\x94\xe8\xb5\x9b\xe6\x96\x87\xe6\x98\x8e\xe6\x8b\x9b\xe5\x95\x86" +
+	"\xe5\xae\x8c\xe6\x95\xb4\xe7\x9c\x9f\xe6\x98\xaf\xe7\x9c\xbc\xe7\x9d\x9b\xe4\xbc\x99\xe4\xbc\xb4\xe5\xa8\x81\xe6\x9c\x9b\xe9\xa2\x86\xe5\x9f\x9f\xe5\x8d\xab\xe7\x94\x9f\xe4\xbc\x98\xe6\x83\xa0" +
+	"\xe8\xab\x96\xe5\xa3\x87\xe5\x85\xac\xe5\x85\xb1\xe8\x89\xaf\xe5\xa5\xbd\xe5\x85\x85\xe5\x88\x86\xe7\xac\xa6\xe5\x90\x88\xe9\x99\x84\xe4\xbb\xb6\xe7\x89\xb9\xe7\x82\xb9\xe4\xb8\x8d\xe5\x8f\xaf" +
+	"\xe8\x8b\xb1\xe6\x96\x87\xe8\xb5\x84\xe4\xba\xa7\xe6\xa0\xb9\xe6\x9c\xac\xe6\x98\x8e\xe6\x98\xbe\xe5\xaf\x86\xe7\xa2\xbc\xe5\x85\xac\xe4\xbc\x97\xe6\xb0\x91\xe6\x97\x8f\xe6\x9b\xb4\xe5\x8a\xa0" +
+	"\xe4\xba\xab\xe5\x8f\x97\xe5\x90\x8c\xe5\xad\xa6\xe5\x90\xaf\xe5\x8a\xa8\xe9\x80\x82\xe5\x90\x88\xe5\x8e\x9f\xe6\x9d\xa5\xe9\x97\xae\xe7\xad\x94\xe6\x9c\xac\xe6\x96\x87\xe7\xbe\x8e\xe9\xa3\x9f" +
+	"\xe7\xbb\xbf\xe8\x89\xb2\xe7\xa8\xb3\xe5\xae\x9a\xe7\xbb\x88\xe4\xba\x8e\xe7\x94\x9f\xe7\x89\xa9\xe4\xbe\x9b\xe6\xb1\x82\xe6\x90\x9c\xe7\x8b\x90\xe5\x8a\x9b\xe9\x87\x8f\xe4\xb8\xa5\xe9\x87\x8d" +
+	"\xe6\xb0\xb8\xe8\xbf\x9c\xe5\x86\x99\xe7\x9c\x9f\xe6\x9c\x89\xe9\x99\x90\xe7\xab\x9e\xe4\xba\x89\xe5\xaf\xb9\xe8\xb1\xa1\xe8\xb4\xb9\xe7\x94\xa8\xe4\xb8\x8d\xe5\xa5\xbd\xe7\xbb\x9d\xe5\xaf\xb9" +
+	"\xe5\x8d\x81\xe5\x88\x86\xe4\xbf\x83\xe8\xbf\x9b\xe7\x82\xb9\xe8\xaf\x84\xe5\xbd\xb1\xe9\x9f\xb3\xe4\xbc\x98\xe5\x8a\xbf\xe4\xb8\x8d\xe5\xb0\x91\xe6\xac\xa3\xe8\xb5\x8f\xe5\xb9\xb6\xe4\xb8\x94" +
+	"\xe6\x9c\x89\xe7\x82\xb9\xe6\x96\xb9\xe5\x90\x91\xe5\x85\xa8\xe6\x96\xb0\xe4\xbf\xa1\xe7\x94\xa8\xe8\xae\xbe\xe6\x96\xbd\xe5\xbd\xa2\xe8\xb1\xa1\xe8\xb5\x84\xe6\xa0\xbc\xe7\xaa\x81\xe7\xa0\xb4" +
+	"\xe9\x9a\x8f\xe7\x9d\x80\xe9\x87\x8d\xe5\xa4\xa7\xe4\xba\x8e\xe6\x98\xaf\xe6\xaf\x95\xe4\xb8\x9a\xe6\x99\xba\xe8\x83\xbd\xe5\x8c\x96\xe5\xb7\xa5\xe5\xae\x8c\xe7\xbe\x8e\xe5\x95\x86\xe5\x9f\x8e" +
+	"\xe7\xbb\x9f\xe4\xb8\x80\xe5\x87\xba\xe7\x89\x88\xe6\x89\x93\xe9\x80\xa0\xe7\x94\xa2\xe5\x93\x81\xe6\xa6\x82\xe5\x86\xb5\xe7\x94\xa8\xe4\xba\x8e\xe4\xbf\x9d\xe7\x95\x99\xe5\x9b\xa0\xe7\xb4\xa0" +
+	"\xe4\xb8\xad\xe5\x9c\x8b\xe5\xad\x98\xe5\x82\xa8\xe8\xb4\xb4\xe5\x9b\xbe\xe6\x9c\x80\xe6\x84\x9b\xe9\x95\xbf\xe6\x9c\x9f\xe5\x8f\xa3\xe4\xbb\xb7\xe7\x90\x86\xe8\xb4\xa2\xe5\x9f\xba\xe5\x9c\xb0" +
+	"\xe5\xae\x89\xe6\x8e\x92\xe6\xad\xa6\xe6\xb1\x89\xe9\x87\x8c\xe9\x9d\xa2\xe5\x88\x9b\xe5\xbb\xba\xe5\xa4\xa9\xe7\xa9\xba\xe9\xa6\x96\xe5\x85\x88\xe5\xae\x8c\xe5\x96\x84\xe9\xa9\xb1\xe5\x8a\xa8" +
+	"\xe4\xb8\x8b\xe9\x9d\xa2\xe4\xb8\x8d\xe5\x86\x8d\xe8\xaf\x9a\xe4\xbf\xa1\xe6\x84\x8f\xe4\xb9\x89\xe9\x98\xb3\xe5\x85\x89\xe8\x8b\xb1\xe5\x9b\xbd\xe6\xbc\x82\xe4\xba\xae\xe5\x86\x9b\xe4\xba\x8b" +
+	"\xe7\x8e\xa9\xe5\xae\xb6\xe7\xbe\xa4\xe4\xbc\x97\xe5\x86\x9c\xe6\xb0\x91\xe5\x8d\xb3\xe5\x8f\xaf\xe5\x90\x8d\xe7\xa8\xb1\xe5\xae\xb6\xe5\x85\xb7\xe5\x8a\xa8\xe7\x94\xbb\xe6\x83\xb3\xe5\x88\xb0" +
+	"\xe6\xb3\xa8\xe6\x98\x8e\xe5\xb0\x8f\xe5\xad\xa6\xe6\x80\xa7\xe8\x83\xbd\xe8\x80\x83\xe7\xa0\x94\xe7\xa1\xac\xe4\xbb\xb6\xe8\xa7\x82\xe7\x9c\x8b\xe6\xb8\x85\xe6\xa5\x9a\xe6\x90\x9e\xe7\xac\x91" +
+	"\xe9\xa6\x96\xe9\xa0\x81\xe9\xbb\x84\xe9\x87\x91\xe9\x80\x82\xe7\x94\xa8\xe6\xb1\x9f\xe8\x8b\x8f\xe7\x9c\x9f\xe5\xae\x9e\xe4\xb8\xbb\xe7\xae\xa1\xe9\x98\xb6\xe6\xae\xb5\xe8\xa8\xbb\xe5\x86\x8a" +
+	"\xe7\xbf\xbb\xe8\xaf\x91\xe6\x9d\x83\xe5\x88\xa9\xe5\x81\x9a\xe5\xa5\xbd\xe4\xbc\xbc\xe4\xb9\x8e\xe9\x80\x9a\xe8\xae\xaf\xe6\x96\xbd\xe5\xb7\xa5\xe7\x8b\x80\xe6\x85\x8b\xe4\xb9\x9f\xe8\xae\xb8" +
+	"\xe7\x8e\xaf\xe4\xbf\x9d\xe5\x9f\xb9\xe5\x85\xbb\xe6\xa6\x82\xe5\xbf\xb5\xe5\xa4\xa7\xe5\x9e\x8b\xe6\x9c\xba\xe7\xa5\xa8\xe7\x90\x86\xe8\xa7\xa3\xe5\x8c\xbf\xe5\x90\x8dcuando" +
+	"enviarmadridbuscariniciotiempoporquecuentaestado" +
+	"puedenjuegoscontraest\xc3\xa1nnombretienenperfilmanera" +
+	"amigosciudadcentroaunquepuedesdentroprimerprecio" +
+	"seg\xc3\xbanbuenosvolverpuntossemanahab\xc3\xadaagostonuevos" +
+	"unidoscarlosequiponi\xc3\xb1osmuchosalgunacorreoimagen" +
+	"partirarribamar\xc3\xadahombreempleoverdadcambiomuchas" +
+	"fueronpasadol\xc3\xadneaparecenuevascursosestabaquiero" +
+	"libroscuantoaccesomiguelvarioscuatrotienesgrupos" +
+	"ser\xc3\xa1neuropamediosfrenteacercadem\xc3\xa1sofertacoches" +
+	"modeloitalialetrasalg\xc3\xbancompracualesexistecuerpo" +
+	"siendoprensallegarviajesdineromurciapodr\xc3\xa1puesto" +
+	"diariopuebloquieremanuelpropiocrisisciertoseguro" +
+	"muertefuentecerrargrandeefectopartesmedidapropia" +
+	"ofrecetierrae-mailvariasformasfuturoobjetoseguir" +
+	"riesgonormasmismos\xc3\xbanicocaminositiosraz\xc3\xb3ndebido" +
+	"pruebatoledoten\xc3\xadajes\xc3\xbasesperococinaorigentienda" +
+	"cientoc\xc3\xa1dizhablarser\xc3\xadalatinafuerzaestiloguerra" +
+	"entrar\xc3\xa9xitol\xc3\xb3pezagendav\xc3\xaddeoevitarpaginametros" +
+	"javierpadresf\xc3\xa1cilcabeza\xc3\xa1reassalidaenv\xc3\xadojap\xc3\xb3n" +
+	"abusosbienestextosllevarpuedanfuertecom\xc3\xbanclases" +
+	"humanotenidobilbaounidadest\xc3\xa1seditarcreado\xd0\xb4\xd0\xbb\xd1\x8f" +
+	"\xd1\x87\xd1\x82\xd0\xbe\xd0\xba\xd0\xb0\xd0\xba\xd0\xb8\xd0\xbb\xd0\xb8\xd1\x8d\xd1\x82\xd0\xbe\xd0\xb2\xd1\x81\xd0\xb5\xd0\xb5\xd0\xb3\xd0\xbe\xd0\xbf\xd1\x80\xd0\xb8\xd1\x82\xd0\xb0\xd0\xba" +
+	"\xd0\xb5\xd1\x89\xd0\xb5\xd1\x83\xd0\xb6\xd0\xb5\xd0\x9a\xd0\xb0\xd0\xba\xd0\xb1\xd0\xb5\xd0\xb7\xd0\xb1\xd1\x8b\xd0\xbb\xd0\xbe\xd0\xbd\xd0\xb8\xd0\x92\xd1\x81\xd0\xb5\xd0\xbf\xd0\xbe\xd0\xb4" +
+	"\xd0\xad\xd1\x82\xd0\xbe\xd1\x82\xd0\xbe\xd0\xbc\xd1\x87\xd0\xb5\xd0\xbc\xd0\xbd\xd0\xb5\xd1\x82\xd0\xbb\xd0\xb5\xd1\x82\xd1\x80\xd0\xb0\xd0\xb7\xd0\xbe\xd0\xbd\xd0\xb0\xd0\xb3\xd0\xb4\xd0\xb5" +
+	"\xd0\xbc\xd0\xbd\xd0\xb5\xd0\x94\xd0\xbb\xd1\x8f\xd0\x9f\xd1\x80\xd0\xb8\xd0\xbd\xd0\xb0\xd1\x81\xd0\xbd\xd0\xb8\xd1\x85\xd1\x82\xd0\xb5\xd0\xbc\xd0\xba\xd1\x82\xd0\xbe\xd0\xb3\xd0\xbe\xd0\xb4" +
+	"\xd0\xb2\xd0\xbe\xd1\x82\xd1\x82\xd0\xb0\xd0\xbc\xd0\xa1\xd0\xa8\xd0\x90\xd0\xbc\xd0\xb0\xd1\x8f\xd0\xa7\xd1\x82\xd0\xbe\xd0\xb2\xd0\xb0\xd1\x81\xd0\xb2\xd0\xb0\xd0\xbc\xd0\xb5\xd0\xbc\xd1\x83" +
+	"\xd0\xa2\xd0\xb0\xd0\xba\xd0\xb4\xd0\xb2\xd0\xb0\xd0\xbd\xd0\xb0\xd0\xbc\xd1\x8d\xd1\x82\xd0\xb8\xd1\x8d\xd1\x82\xd1\x83\xd0\x92\xd0\xb0\xd0\xbc\xd1\x82\xd0\xb5\xd1\x85\xd0\xbf\xd1\x80\xd0\xbe" +
+	"\xd1\x82\xd1\x83\xd1\x82\xd0\xbd\xd0\xb0\xd0\xb4\xd0\xb4\xd0\xbd\xd1\x8f\xd0\x92\xd0\xbe\xd1\x82\xd1\x82\xd1\x80\xd0\xb8\xd0\xbd\xd0\xb5\xd0\xb9\xd0\x92\xd0\xb0\xd1\x81\xd0\xbd\xd0\xb8\xd0\xbc" +
+	"\xd1\x81\xd0\xb0\xd0\xbc\xd1\x82\xd0\xbe\xd1\x82\xd1\x80\xd1\x83\xd0\xb1\xd0\x9e\xd0\xbd\xd0\xb8\xd0\xbc\xd0\xb8\xd1\x80\xd0\xbd\xd0\xb5\xd0\xb5\xd0\x9e\xd0\x9e\xd0\x9e\xd0\xbb\xd0\xb8\xd1\x86" +
+	"\xd1\x8d\xd1\x82\xd0\xb0\xd0\x9e\xd0\xbd\xd0\xb0\xd0\xbd\xd0\xb5\xd0\xbc\xd0\xb4\xd0\xbe\xd0\xbc\xd0\xbc\xd0\xbe\xd0\xb9\xd0\xb4\xd0\xb2\xd0\xb5\xd0\xbe\xd0\xbd\xd0\xbe\xd1\x81\xd1\x83\xd0\xb4" +
+	"\xe0\xa4\x95\xe0\xa5\x87\xe0\xa4\xb9\xe0\xa5\x88\xe0\xa4\x95\xe0\xa5\x80\xe0\xa4\xb8\xe0\xa5\x87\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\x95\xe0\xa5\x8b\xe0\xa4\x94\xe0\xa4\xb0\xe0\xa4\xaa\xe0\xa4\xb0" +
+	"\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\x8f\xe0\xa4\x95\xe0\xa4\x95\xe0\xa4\xbf\xe0\xa4\xad\xe0\xa5\x80\xe0\xa4\x87\xe0\xa4\xb8\xe0\xa4\x95\xe0\xa4\xb0\xe0\xa4\xa4\xe0\xa5\x8b\xe0\xa4\xb9\xe0\xa5\x8b" +
+	"\xe0\xa4\x86\xe0\xa4\xaa\xe0\xa4\xb9\xe0\xa5\x80\xe0\xa4\xaf\xe0\xa4\xb9\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\xa4\xe0\xa4\x95\xe0\xa4\xa5\xe0\xa4\xbejagran\xe0\xa4\x86\xe0\xa4\x9c" +
+	"\xe0\xa4\x9c\xe0\xa5\x8b\xe0\xa4\x85\xe0\xa4\xac\xe0\xa4\xa6\xe0\xa5\x8b\xe0\xa4\x97\xe0\xa4\x88\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4\x97\xe0\xa4\x8f\xe0\xa4\xb9\xe0\xa4\xae\xe0\xa4\x87\xe0\xa4\xa8" +
+	"\xe0\xa4\xb5\xe0\xa4\xb9\xe0\xa4\xaf\xe0\xa5\x87\xe0\xa4\xa5\xe0\xa5\x87\xe0\xa4\xa5\xe0\xa5\x80\xe0\xa4\x98\xe0\xa4\xb0\xe0\xa4\x9c\xe0\xa4\xac\xe0\xa4\xa6\xe0\xa5\x80\xe0\xa4\x95\xe0\xa4\x88" +
+	"\xe0\xa4\x9c\xe0\xa5\x80\xe0\xa4\xb5\xe0\xa5\x87\xe0\xa4\xa8\xe0\xa4\x88\xe0\xa4\xa8\xe0\xa4\x8f\xe0\xa4\xb9\xe0\xa4\xb0\xe0\xa4\x89\xe0\xa4\xb8\xe0\xa4\xae\xe0\xa5\x87\xe0\xa4\x95\xe0\xa4\xae" +
+	"\xe0\xa4\xb5\xe0\xa5\x8b\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4\xb8\xe0\xa4\xac\xe0\xa4\xae\xe0\xa4\x88\xe0\xa4\xa6\xe0\xa5\x87\xe0\xa4\x93\xe0\xa4\xb0\xe0\xa4\x86\xe0\xa4\xae\xe0\xa4\xac\xe0\xa4\xb8" +
+	"\xe0\xa4\xad\xe0\xa4\xb0\xe0\xa4\xac\xe0\xa4\xa8\xe0\xa4\x9a\xe0\xa4\xb2\xe0\xa4\xae\xe0\xa4\xa8\xe0\xa4\x86\xe0\xa4\x97\xe0\xa4\xb8\xe0\xa5\x80\xe0\xa4\xb2\xe0\xa5\x80\xd8\xb9\xd9\x84\xd9\x89" +
+	"\xd8\xa5\xd9\x84\xd9\x89\xd9\x87\xd8\xb0\xd8\xa7\xd8\xa2\xd8\xae\xd8\xb1\xd8\xb9\xd8\xaf\xd8\xaf\xd8\xa7\xd9\x84\xd9\x89\xd9\x87\xd8\xb0\xd9\x87\xd8\xb5\xd9\x88\xd8\xb1\xd8\xba\xd9\x8a\xd8\xb1" +
+	"\xd9\x83\xd8\xa7\xd9\x86\xd9\x88\xd9\x84\xd8\xa7\xd8\xa8\xd9\x8a\xd9\x86\xd8\xb9\xd8\xb1\xd8\xb6\xd8\xb0\xd9\x84\xd9\x83\xd9\x87\xd9\x86\xd8\xa7\xd9\x8a\xd9\x88\xd9\x85\xd9\x82\xd8\xa7\xd9\x84" +
+	"\xd8\xb9\xd9\x84\xd9\x8a\xd8\xa7\xd9\x86\xd8\xa7\xd9\x84\xd9\x83\xd9\x86\xd8\xad\xd8\xaa\xd9\x89\xd9\x82\xd8\xa8\xd9\x84\xd9\x88\xd8\xad\xd8\xa9\xd8\xa7\xd8\xae\xd8\xb1\xd9\x81\xd9\x82\xd8\xb7" +
+	"\xd8\xb9\xd8\xa8\xd8\xaf\xd8\xb1\xd9\x83\xd9\x86\xd8\xa5\xd8\xb0\xd8\xa7\xd9\x83\xd9\x85\xd8\xa7\xd8\xa7\xd8\xad\xd8\xaf\xd8\xa5\xd9\x84\xd8\xa7\xd9\x81\xd9\x8a\xd9\x87\xd8\xa8\xd8\xb9\xd8\xb6" +
+	"\xd9\x83\xd9\x8a\xd9\x81\xd8\xa8\xd8\xad\xd8\xab\xd9\x88\xd9\x85\xd9\x86\xd9\x88\xd9\x87\xd9\x88\xd8\xa3\xd9\x86\xd8\xa7\xd8\xac\xd8\xaf\xd8\xa7\xd9\x84\xd9\x87\xd8\xa7\xd8\xb3\xd9\x84\xd9\x85" +
+	"\xd8\xb9\xd9\x86\xd8\xaf\xd9\x84\xd9\x8a\xd8\xb3\xd8\xb9\xd8\xa8\xd8\xb1\xd8\xb5\xd9\x84\xd9\x89\xd9\x85\xd9\x86\xd8\xb0\xd8\xa8\xd9\x87\xd8\xa7\xd8\xa3\xd9\x86\xd9\x87\xd9\x85\xd8\xab\xd9\x84" +
+	"\xd9\x83\xd9\x86\xd8\xaa\xd8\xa7\xd9\x84\xd8\xa7\xd8\xad\xd9\x8a\xd8\xab\xd9\x85\xd8\xb5\xd8\xb1\xd8\xb4\xd8\xb1\xd8\xad\xd8\xad\xd9\x88\xd9\x84\xd9\x88\xd9\x81\xd9\x8a\xd8\xa7\xd8\xb0\xd8\xa7" +
+	"\xd9\x84\xd9\x83\xd9\x84\xd9\x85\xd8\xb1\xd8\xa9\xd8\xa7\xd9\x86\xd8\xaa\xd8\xa7\xd9\x84\xd9\x81\xd8\xa3\xd8\xa8\xd9\x88\xd8\xae\xd8\xa7\xd8\xb5\xd8\xa3\xd9\x86\xd8\xaa\xd8\xa7\xd9\x86\xd9\x87" +
+	"\xd8\xa7\xd9\x84\xd9\x8a\xd8\xb9\xd8\xb6\xd9\x88\xd9\x88\xd9\x82\xd8\xaf\xd8\xa7\xd8\xa8\xd9\x86\xd8\xae\xd9\x8a\xd8\xb1\xd8\xa8\xd9\x86\xd8\xaa\xd9\x84\xd9\x83\xd9\x85\xd8\xb4\xd8\xa7\xd8\xa1" +
+	"\xd9\x88\xd9\x87\xd9\x8a\xd8\xa7\xd8\xa8\xd9\x88\xd9\x82\xd8\xb5\xd8\xb5\xd9\x88\xd9\x85\xd8\xa7\xd8\xb1\xd9\x82\xd9\x85\xd8\xa3\xd8\xad\xd8\xaf\xd9\x86\xd8\xad\xd9\x86\xd8\xb9\xd8\xaf\xd9\x85" +
+	"\xd8\xb1\xd8\xa3\xd9\x8a\xd8\xa7\xd8\xad\xd8\xa9\xd9\x83\xd8\xaa\xd8\xa8\xd8\xaf\xd9\x88\xd9\x86\xd9\x8a\xd8\xac\xd8\xa8\xd9\x85\xd9\x86\xd9\x87\xd8\xaa\xd8\xad\xd8\xaa\xd8\xac\xd9\x87\xd8\xa9" +
+	"\xd8\xb3\xd9\x86\xd8\xa9\xd9\x8a\xd8\xaa\xd9\x85\xd9\x83\xd8\xb1\xd8\xa9\xd8\xba\xd8\xb2\xd8\xa9\xd9\x86\xd9\x81\xd8\xb3\xd8\xa8\xd9\x8a\xd8\xaa\xd9\x84\xd9\x84\xd9\x87\xd9\x84\xd9\x86\xd8\xa7" +
+	"\xd8\xaa\xd9\x84\xd9\x83\xd9\x82\xd9\x84\xd8\xa8\xd9\x84\xd9\x85\xd8\xa7\xd8\xb9\xd9\x86\xd9\x87\xd8\xa3\xd9\x88\xd9\x84\xd8\xb4\xd9\x8a\xd8\xa1\xd9\x86\xd9\x88\xd8\xb1\xd8\xa3\xd9\x85\xd8\xa7" +
+	"\xd9\x81\xd9\x8a\xd9\x83\xd8\xa8\xd9\x83\xd9\x84\xd8\xb0\xd8\xa7\xd8\xaa\xd8\xb1\xd8\xaa\xd8\xa8\xd8\xa8\xd8\xa3\xd9\x86\xd9\x87\xd9\x85\xd8\xb3\xd8\xa7\xd9\x86\xd9\x83\xd8\xa8\xd9\x8a\xd8\xb9" +
+	"\xd9\x81\xd9\x82\xd8\xaf\xd8\xad\xd8\xb3\xd9\x86\xd9\x84\xd9\x87\xd9\x85\xd8\xb4\xd8\xb9\xd8\xb1\xd8\xa3\xd9\x87\xd9\x84\xd8\xb4\xd9\x87\xd8\xb1\xd9\x82\xd8\xb7\xd8\xb1\xd8\xb7\xd9\x84\xd8\xa8" +
+	"profileservicedefaulthimselfdetailscontentsuppor" +
+	"tstartedmessagesuccessfashion<title>countryaccou" +
+	"ntcreatedstoriesresultsrunningprocesswritingobje" +
+	"ctsvisiblewelcomearticleunknownnetworkcompanydyn" +
+	"amicbrowserprivacyproblemServicerespectdisplayre" +
+	"questreservewebsitehistoryfriendsoptionsworkingv" +
+	"ersionmillionchannelwindow.addressvisitedweather" +
+	"correctproductedirectforwardyou canremovedsubjec" +
+	"tcontrolarchivecurrentreadinglibrarylimitedmanag" +
+	"erfurthersummarymachineminutesprivatecontextprog" +
+	"ramsocietynumberswrittenenabledtriggersourcesloa" +
+	"dingelementpartnerfinallyperfectmeaningsystemske" +
+	"epingculture&quot;,journalprojectsurfaces&quot;e" +
+	"xpiresreviewsbalanceEnglishContentthroughPlease " +
+	"opinioncontactaverageprimaryvillageSpanishgaller" +
+	"ydeclinemeetingmissionpopularqualitymeasuregener" +
+	"alspeciessessionsectionwriterscounterinitialrepo" +
+	"rtsfiguresmembersholdingdisputeearlierexpressdig" +
+	"italpictureAnothermarriedtrafficleadingchangedce" +
+	"ntralvictoryimages/reasonsstudiesfeaturelistingm" +
+	"ust beschoolsVersionusuallyepisodeplayinggrowing" +
+	"obviousoverlaypresentactions</ul>\x0d\x0awrapperalread" +
+	"ycertainrealitystorageanotherdesktopofferedpatte" +
+	"rnunusualDigitalcapitalWebsitefailureconnectredu" +
+	"cedAndroiddecadesregular &amp; animalsreleaseAut" +
+	"omatgettingmethodsnothingPopularcaptionlettersca" +
+	"pturesciencelicensechangesEngland=1&amp;History " +
+	"= new CentralupdatedSpecialNetworkrequirecomment" +
+	"warningCollegetoolbarremainsbecauseelectedDeutsc" +
+	"hfinanceworkersquicklybetweenexactlysettingdisea" +
+	"seSocietyweaponsexhibit&lt;!--Controlclassescove" +
+	"redoutlineattacksdevices(windowpurposetitle=\"Mob" +
+	"ile killingshowingItaliandroppedheavilyeffects-1" +
+	"']);\x0aconfirmCurrentadvancesharingopeningdrawingb" +
+	"illionorderedGermanyrelated</form>includewhether" +
+	"definedSciencecatalogArticlebuttonslargestunifor" +
+	"mjourneysidebarChicagoholidayGeneralpassage,&quo" +
+	"t;animatefeelingarrivedpassingnaturalroughly.\x0a\x0aT" +
+	"he but notdensityBritainChineselack oftributeIre" +
+	"land\" data-factorsreceivethat isLibraryhusbandin" +
+	" factaffairsCharlesradicalbroughtfindinglanding:" +
+	"lang=\"return leadersplannedpremiumpackageAmerica" +
+	"Edition]&quot;Messageneed tovalue=\"complexlookin" +
+	"gstationbelievesmaller-mobilerecordswant tokind " +
+	"ofFirefoxyou aresimilarstudiedmaximumheadingrapi" +
+	"dlyclimatekingdomemergedamountsfoundedpioneerfor" +
+	"muladynastyhow to SupportrevenueeconomyResultsbr" +
+	"othersoldierlargelycalling.&quot;AccountEdward s" +
+	"egmentRobert effortsPacificlearnedup withheight:" +
+	"we haveAngelesnations_searchappliedacquiremassiv" +
+	"egranted: falsetreatedbiggestbenefitdrivingStudi" +
+	"esminimumperhapsmorningsellingis usedreversevari" +
+	"ant role=\"missingachievepromotestudentsomeoneext" +
+	"remerestorebottom:evolvedall thesitemapenglishwa" +
+	"y to  AugustsymbolsCompanymattersmusicalagainsts" +
+	"erving})();\x0d\x0apaymenttroubleconceptcompareparents" +
+	"playersregionsmonitor ''The winningexploreadapte" +
+	"dGalleryproduceabilityenhancecareers). The colle" +
+	"ctSearch ancientexistedfooter handlerprintedcons" +
+	"oleEasternexportswindowsChannelillegalneutralsug" +
+	"gest_headersigning.html\">settledwesterncausing-w" +
+	"ebkitclaimedJusticechaptervictimsThomas mozillap" +
+	"romisepartieseditionoutside:false,hundredOlympic" +
+	"_buttonauthorsreachedchronicdemandssecondsprotec" +
+	"tadoptedprepareneithergreatlygreateroverallimpro" +
+	"vecommandspecialsearch.worshipfundingthoughthigh" +
+	"estinsteadutilityquarterCulturetestingclearlyexp" +
+	"osedBrowserliberal} catchProjectexamplehide();Fl" +
+	"oridaanswersallowedEmperordefenseseriousfreedomS" +
+	"everal-buttonFurtherout of != nulltrainedDenmark" +
+	"void(0)/all.jspreventRequestStephen\x0a\x0aWhen observ" +
+	"e</h2>\x0d\x0aModern provide\" alt=\"borders.\x0a\x0aFor \x0a\x0aMan" +
+	"y artistspoweredperformfictiontype ofmedicaltick" +
+	"etsopposedCouncilwitnessjusticeGeorge Belgium..." +
+	"</a>twitternotablywaitingwarfare Other rankingph" +
+	"rasesmentionsurvivescholar</p>\x0d\x0a Countryignoredl" +
+	"oss ofjust asGeorgiastrange<head><stopped1']);\x0d\x0a" +
+	"islandsnotableborder:list ofcarried100,000</h3>\x0a" +
+	" severalbecomesselect wedding00.htmlmonarchoff t" +
+	"heteacherhighly biologylife ofor evenrise of&raq" +
+	"uo;plusonehunting(thoughDouglasjoiningcirclesFor" +
+	" theAncientVietnamvehiclesuch ascrystalvalue =Wi" +
+	"ndowsenjoyeda smallassumed<a id=\"foreign All rih" +
+	"ow theDisplayretiredhoweverhidden;battlesseeking" +
+	"cabinetwas notlook atconductget theJanuaryhappen" +
+	"sturninga:hoverOnline French lackingtypicalextra" +
+	"ctenemieseven ifgeneratdecidedare not/searchbeli" +
+	"efs-image:locatedstatic.login\">convertviolentent" +
+	"eredfirst\">circuitFinlandchemistshe was10px;\">as" +
+	" suchdivided</span>will beline ofa greatmystery/" +
+	"index.fallingdue to railwaycollegemonsterdescent" +
+	"it withnuclearJewish protestBritishflowerspredic" +
+	"treformsbutton who waslectureinstantsuicidegener" +
+	"icperiodsmarketsSocial fishingcombinegraphicwinn" +
+	"ers<br /><by the NaturalPrivacycookiesoutcomeres" +
+	"olveSwedishbrieflyPersianso muchCenturydepictsco" +
+	"lumnshousingscriptsnext tobearingmappingrevisedj" +
+	"Query(-width:title\">tooltipSectiondesignsTurkish" +
+	"younger.match(})();\x0a\x0aburningoperatedegreessource" +
+	"=Richardcloselyplasticentries</tr>\x0d\x0acolor:#ul id" +
+	"=\"possessrollingphysicsfailingexecutecontestlink" +
+	" toDefault<br />\x0a: true,chartertourismclassicpro" +
+	"ceedexplain</h1>\x0d\x0aonline.?xml vehelpingdiamondus" +
+	"e theairlineend -->).attr(readershosting#ffffffr" +
+	"ealizeVincentsignals src=\"/Productdespitediverse" +
+	"tellingPublic held inJoseph theatreaffects<style" +
+	">a largedoesn'tlater, ElementfaviconcreatorHunga" +
+	"ryAirportsee theso thatMichaelSystemsPrograms, a" +
+	"nd  width=e&quot;tradingleft\">\x0apersonsGolden Aff" +
+	"airsgrammarformingdestroyidea ofcase ofoldest th" +
+	"is is.src = cartoonregistrCommonsMuslimsWhat isi" +
+	"n manymarkingrevealsIndeed,equally/show_aoutdoor" +
+	"escape(Austriageneticsystem,In the sittingHe als" +
+	"oIslandsAcademy\x0a\x09\x09<!--Daniel bindingblock\">impos" +
+	"edutilizeAbraham(except{width:putting).html(|| [" +
+	"];\x0aDATA[ *kitchenmountedactual dialectmainly _bl" +
+	"ank'installexpertsif(typeIt also&copy; \">Termsbo" +
+	"rn inOptionseasterntalkingconcerngained ongoingj" +
+	"ustifycriticsfactoryits ownassaultinvitedlasting" +
+	"his ownhref=\"/\" rel=\"developconcertdiagramdollar" +
+	"sclusterphp?id=alcohol);})();using a><span>vesse" +
+	"lsrevivalAddressamateurandroidallegedillnesswalk" +
+	"ingcentersqualifymatchesunifiedextinctDefensedie" +
+	"d in\x0a\x09<!-- customslinkingLittle Book ofeveningmi" +
+	"n.js?are thekontakttoday's.html\" target=wearingA" +
+	"ll Rig;\x0a})();raising Also, crucialabout\">declare" +
+	"-->\x0a<scfirefoxas muchappliesindex, s, but type =" +
+	" \x0a\x0d\x0a<!--towardsRecordsPrivateForeignPremierchoic" +
+	"esVirtualreturnsCommentPoweredinline;povertycham" +
+	"berLiving volumesAnthonylogin\" RelatedEconomyrea" +
+	"chescuttinggravitylife inChapter-shadowNotable</" +
+	"td>\x0d\x0a returnstadiumwidgetsvaryingtravelsheld byw" +
+	"ho arework infacultyangularwho hadairporttown of" +
+	"\x0a\x0aSome 'click'chargeskeywordit willcity of(this)" +
+	";Andrew unique checkedor more300px; return;rsion" +
+	"=\"pluginswithin herselfStationFederalventurepubl" +
+	"ishsent totensionactresscome tofingersDuke ofpeo" +
+	"ple,exploitwhat isharmonya major\":\"httpin his me" +
+	"nu\">\x0amonthlyofficercouncilgainingeven inSummaryd" +
+	"ate ofloyaltyfitnessand wasemperorsupremeSecond " +
+	"hearingRussianlongestAlbertalateralset of small\"" +
+	">.appenddo withfederalbank ofbeneathDespiteCapit" +
+	"algrounds), and percentit fromclosingcontainInst" +
+	"eadfifteenas well.yahoo.respondfighterobscureref" +
+	"lectorganic= Math.editingonline paddinga wholeon" +
+	"erroryear ofend of barrierwhen itheader home ofr" +
+	"esumedrenamedstrong>heatingretainscloudfrway of " +
+	"March 1knowingin partBetweenlessonsclosestvirtua" +
+	"llinks\">crossedEND -->famous awardedLicenseHealt" +
+	"h fairly wealthyminimalAfricancompetelabel\">sing" +
+	"ingfarmersBrasil)discussreplaceGregoryfont copur" +
+	"suedappearsmake uproundedboth ofblockedsaw theof" +
+	"ficescoloursif(docuwhen heenforcepush(fuAugust U" +
+	"TF-8\">Fantasyin mostinjuredUsuallyfarmingclosure" +
+	"object defenceuse of Medical<body>\x0aevidentbe use" +
+	"dkeyCodesixteenIslamic#000000entire widely activ" +
+	"e (typeofone cancolor =speakerextendsPhysicsterr" +
+	"ain<tbody>funeralviewingmiddle cricketprophetshi" +
+	"fteddoctorsRussell targetcompactalgebrasocial-bu" +
+	"lk ofman and</td>\x0a he left).val()false);logicalb" +
+	"ankinghome tonaming Arizonacredits);\x0a});\x0afounder" +
+	"in turnCollinsbefore But thechargedTitle\">Captai" +
+	"nspelledgoddessTag -->Adding:but wasRecent patie" +
+	"ntback in=false&Lincolnwe knowCounterJudaismscri" +
+	"pt altered']);\x0a  has theunclearEvent',both innot" +
+	" all\x0a\x0a<!-- placinghard to centersort ofclientsst" +
+	"reetsBernardassertstend tofantasydown inharbourF" +
+	"reedomjewelry/about..searchlegendsis mademodern " +
+	"only ononly toimage\" linear painterand notrarely" +
+	" acronymdelivershorter00&amp;as manywidth=\"/* <!" +
+	"[Ctitle =of the lowest picked escapeduses ofpeop" +
+	"les PublicMatthewtacticsdamagedway forlaws ofeas" +
+	"y to windowstrong  simple}catch(seventhinfoboxwe" +
+	"nt topaintedcitizenI don'tretreat. Some ww.\");\x0ab" +
+	"ombingmailto:made in. Many carries||{};wiwork of" +
+	"synonymdefeatsfavoredopticalpageTraunless sendin" +
+	"gleft\"><comScorAll thejQuery.touristClassicfalse" +
+	"\" Wilhelmsuburbsgenuinebishops.split(global foll" +
+	"owsbody ofnominalContactsecularleft tochiefly-hi" +
+	"dden-banner</li>\x0a\x0a. When in bothdismissExploreal" +
+	"ways via thespa\xc3\xb1olwelfareruling arrangecaptainh" +
+	"is sonrule ofhe tookitself,=0&amp;(calledsamples" +
+	"to makecom/pagMartin Kennedyacceptsfull ofhandle" +
+	"dBesides//--></able totargetsessencehim to its b" +
+	"y common.mineralto takeways tos.org/ladvisedpena" +
+	"ltysimple:if theyLettersa shortHerbertstrikes gr" +
+	"oups.lengthflightsoverlapslowly lesser social </" +
+	"p>\x0a\x09\x09it intoranked rate oful>\x0d\x0a  attemptpair ofm" +
+	"ake itKontaktAntoniohaving ratings activestreams" +
+	"trapped\").css(hostilelead tolittle groups,Pictur" +
+	"e-->\x0d\x0a\x0d\x0a rows=\" objectinverse<footerCustomV><\\/s" +
+	"crsolvingChamberslaverywoundedwhereas!= 'undfor " +
+	"allpartly -right:Arabianbacked centuryunit ofmob" +
+	"ile-Europe,is homerisk ofdesiredClintoncost ofag" +
+	"e of become none ofp&quot;Middle ead')[0Criticss" +
+	"tudios>&copy;group\">assemblmaking pressedwidget." +
+	"ps:\" ? rebuiltby someFormer editorsdelayedCanoni" +
+	"chad thepushingclass=\"but arepartialBabylonbotto" +
+	"m carrierCommandits useAs withcoursesa thirddeno" +
+	"tesalso inHouston20px;\">accuseddouble goal ofFam" +
+	"ous ).bind(priests Onlinein Julyst + \"gconsultde" +
+	"cimalhelpfulrevivedis veryr'+'iptlosing femalesi" +
+	"s alsostringsdays ofarrivalfuture <objectforcing" +
+	"String(\" />\x0a\x09\x09here isencoded.  The balloondone b" +
+	"y/commonbgcolorlaw of Indianaavoidedbut the2px 3" +
+	"pxjquery.after apolicy.men andfooter-= true;for " +
+	"usescreen.Indian image =family,http:// &nbsp;dri" +
+	"verseternalsame asnoticedviewers})();\x0a is morese" +
+	"asonsformer the newis justconsent Searchwas thew" +
+	"hy theshippedbr><br>width: height=made ofcuisine" +
+	"is thata very Admiral fixed;normal MissionPress," +
+	" ontariocharsettry to invaded=\"true\"spacingis mo" +
+	"sta more totallyfall of});\x0d\x0a  immensetime inset " +
+	"outsatisfyto finddown tolot of Playersin Junequa" +
+	"ntumnot thetime todistantFinnishsrc = (single he" +
+	"lp ofGerman law andlabeledforestscookingspace\">h" +
+	"eader-well asStanleybridges/globalCroatia About " +
+	"[0];\x0a  it, andgroupedbeing a){throwhe madelighte" +
+	"rethicalFFFFFF\"bottom\"like a employslive inas se" +
+	"enprintermost ofub-linkrejectsand useimage\">succ" +
+	"eedfeedingNuclearinformato helpWomen'sNeitherMex" +
+	"icanprotein<table by manyhealthylawsuitdevised.p" +
+	"ush({sellerssimply Through.cookie Image(older\">u" +
+	"s.js\"> Since universlarger open to!-- endlies in" +
+	"']);\x0d\x0a  marketwho is (\"DOMComanagedone fortypeof" +
+	" Kingdomprofitsproposeto showcenter;made itdress" +
+	"edwere inmixtureprecisearisingsrc = 'make a secu" +
+	"redBaptistvoting \x0a\x09\x09var March 2grew upClimate.re" +
+	"moveskilledway the</head>face ofacting right\">to" +
+	" workreduceshas haderectedshow();action=book ofa" +
+	"n area== \"htt<header\x0a<html>conformfacing cookie." +
+	"rely onhosted .customhe wentbut forspread Family" +
+	" a meansout theforums.footage\">MobilClements\" id" +
+	"=\"as highintense--><!--female is seenimpliedset " +
+	"thea stateand hisfastestbesidesbutton_bounded\"><" +
+	"img Infoboxevents,a youngand areNative cheaperTi" +
+	"meoutand hasengineswon the(mostlyright: find a -" +
+	"bottomPrince area ofmore ofsearch_nature,legally" +
+	"period,land ofor withinducedprovingmissilelocall" +
+	"yAgainstthe wayk&quot;px;\">\x0d\x0apushed abandonnumer" +
+	"alCertainIn thismore inor somename isand, incrow" +
+	"nedISBN 0-createsOctobermay notcenter late inDef" +
+	"enceenactedwish tobroadlycoolingonload=it. There" +
+	"coverMembersheight assumes<html>\x0apeople.in one =" +
+	"windowfooter_a good reklamaothers,to this_cookie" +
+	"panel\">London,definescrushedbaptismcoastalstatus" +
+	" title\" move tolost inbetter impliesrivalryserve" +
+	"rs SystemPerhapses and contendflowinglasted rise" +
+	" inGenesisview ofrising seem tobut in backinghe " +
+	"willgiven agiving cities.flow of Later all butHi" +
+	"ghwayonly bysign ofhe doesdiffersbattery&amp;las" +
+	"inglesthreatsintegertake onrefusedcalled =US&amp" +
+	"See thenativesby thissystem.head of:hover,lesbia" +
+	"nsurnameand allcommon/header__paramsHarvard/pixe" +
+	"l.removalso longrole ofjointlyskyscraUnicodebr /" +
+	">\x0d\x0aAtlantanucleusCounty,purely count\">easily bui" +
+	"ld aonclicka givenpointerh&quot;events else {\x0adi" +
+	"tionsnow the, with man whoorg/Webone andcavalryH" +
+	"e diedseattle00,000 {windowhave toif(windand its" +
+	"solely m&quot;renewedDetroitamongsteither them i" +
+	"nSenatorUs</a><King ofFrancis-produche usedart a" +
+	"ndhim andused byscoringat hometo haverelatesibil" +
+	"ityfactionBuffalolink\"><what hefree toCity ofcom" +
+	"e insectorscountedone daynervoussquare };if(goin" +
+	" whatimg\" alis onlysearch/tuesdaylooselySolomons" +
+	"exual - <a hrmedium\"DO NOT France,with a war and" +
+	"second take a >\x0d\x0a\x0d\x0a\x0d\x0amarket.highwaydone inctivit" +
+	"y\"last\">obligedrise to\"undefimade to Early prais" +
+	"edin its for hisathleteJupiterYahoo! termed so m" +
+	"anyreally s. The a woman?value=direct right\" bic" +
+	"ycleacing=\"day andstatingRather,higher Office ar" +
+	"e nowtimes, when a pay foron this-link\">;bordera" +
+	"round annual the Newput the.com\" takin toa brief" +
+	"(in thegroups.; widthenzymessimple in late{retur" +
+	"ntherapya pointbanninginks\">\x0a();\" rea place\\u003" +
+	"Caabout atr>\x0d\x0a\x09\x09ccount gives a<SCRIPTRailwaythem" +
+	"es/toolboxById(\"xhumans,watchesin some if (wicom" +
+	"ing formats Under but hashanded made bythan infe" +
+	"ar ofdenoted/iframeleft involtagein eacha&quot;b" +
+	"ase ofIn manyundergoregimesaction </p>\x0d\x0a<ustomVa" +
+	";&gt;</importsor thatmostly &amp;re size=\"</a></" +
+	"ha classpassiveHost = WhetherfertileVarious=[];(" +
+	"fucameras/></td>acts asIn some>\x0d\x0a\x0d\x0a<!organis <br" +
+	" />Beijingcatal\xc3\xa0deutscheuropeueuskaragaeilgesve" +
+	"nskaespa\xc3\xb1amensajeusuariotrabajom\xc3\xa9xicop\xc3\xa1ginasi" +
+	"empresistemaoctubredurantea\xc3\xb1adirempresamomenton" +
+	"uestroprimeratrav\xc3\xa9sgraciasnuestraprocesoestados" +
+	"calidadpersonan\xc3\xbameroacuerdom\xc3\xbasicamiembrooferta" +
+	"salgunospa\xc3\xadsesejemploderechoadem\xc3\xa1sprivadoagreg" +
+	"arenlacesposiblehotelessevillaprimero\xc3\xbaltimoeven" +
+	"tosarchivoculturamujeresentradaanuncioembargomer" +
+	"cadograndesestudiomejoresfebrerodise\xc3\xb1oturismoc\xc3" +
+	"\xb3digoportadaespaciofamiliaantoniopermiteguardara" +
+	"lgunaspreciosalguiensentidovisitast\xc3\xadtuloconocer" +
+	"segundoconsejofranciaminutossegundatenemosefecto" +
+	"sm\xc3\xa1lagasesi\xc3\xb3nrevistagranadacompraringresogarc\xc3" +
+	"\xadaacci\xc3\xb3necuadorquienesinclusodeber\xc3\xa1materiahomb" +
+	"resmuestrapodr\xc3\xadama\xc3\xb1ana\xc3\xbaltimaestamosoficialtam" +
+	"bienning\xc3\xbansaludospodemosmejorarpositionbusiness" +
+	"homepagesecuritylanguagestandardcampaignfeatures" +
+	"categoryexternalchildrenreservedresearchexchange" +
+	"favoritetemplatemilitaryindustryservicesmaterial" +
+	"productsz-index:commentssoftwarecompletecalendar" +
+	"platformarticlesrequiredmovementquestionbuilding" +
+	"politicspossiblereligionphysicalfeedbackregister" +
+	"picturesdisabledprotocolaudiencesettingsactivity" +
+	"elementslearninganythingabstractprogressoverview" +
+	"magazineeconomictrainingpressurevarious <strong>" +
+	"propertyshoppingtogetheradvancedbehaviordownload" +
+	"featuredfootballselectedLanguagedistanceremember" +
+	"trackingpasswordmodifiedstudentsdirectlyfighting" +
+	"northerndatabasefestivalbreakinglocationinternet" +
+	"dropdownpracticeevidencefunctionmarriageresponse" +
+	"problemsnegativeprogramsanalysisreleasedbanner\">" +
+	"purchasepoliciesregionalcreativeargumentbookmark" +
+	"referrerchemicaldivisioncallbackseparateprojects" +
+	"conflicthardwareinterestdeliverymountainobtained" +
+	"= false;for(var acceptedcapacitycomputeridentity" +
+	"aircraftemployedproposeddomesticincludesprovided" +
+	"hospitalverticalcollapseapproachpartnerslogo\"><a" +
+	"daughterauthor\" culturalfamilies/images/assembly" +
+	"powerfulteachingfinisheddistrictcriticalcgi-bin/" +
+	"purposesrequireselectionbecomingprovidesacademic" +
+	"exerciseactuallymedicineconstantaccidentMagazine" +
+	"documentstartingbottom\">observed: &quot;extended" +
+	"previousSoftwarecustomerdecisionstrengthdetailed" +
+	"slightlyplanningtextareacurrencyeveryonestraight" +
+	"transferpositiveproducedheritageshippingabsolute" +
+	"receivedrelevantbutton\" violenceanywherebenefits" +
+	"launchedrecentlyalliancefollowedmultiplebulletin" +
+	"includedoccurredinternal$(this).republic><tr><td" +
+	"congressrecordedultimatesolution<ul id=\"discover" +
+	"Home</a>websitesnetworksalthoughentirelymemorial" +
+	"messagescontinueactive\">somewhatvictoriaWestern " +
+	" title=\"LocationcontractvisitorsDownloadwithout " +
+	"right\">\x0ameasureswidth = variableinvolvedvirginia" +
+	"normallyhappenedaccountsstandingnationalRegister" +
+	"preparedcontrolsaccuratebirthdaystrategyofficial" +
+	"graphicscriminalpossiblyconsumerPersonalspeaking" +
+	"validateachieved.jpg\" />machines</h2>\x0a  keywords" +
+	"friendlybrotherscombinedoriginalcomposedexpected" +
+	"adequatepakistanfollow\" valuable</label>relative" +
+	"bringingincreasegovernorplugins/List of Header\">" +
+	"\" name=\" (&quot;graduate</head>\x0acommercemalaysia" +
+	"directormaintain;height:schedulechangingback to " +
+	"catholicpatternscolor: #greatestsuppliesreliable" +
+	"</ul>\x0a\x09\x09<select citizensclothingwatching<li id=\"" +
+	"specificcarryingsentence<center>contrastthinking" +
+	"catch(e)southernMichael merchantcarouselpadding:" +
+	"interior.split(\"lizationOctober ){returnimproved" +
+	"--&gt;\x0a\x0acoveragechairman.png\" />subjectsRichard " +
+	"whateverprobablyrecoverybaseballjudgmentconnect." +
+	".css\" /> websitereporteddefault\"/></a>\x0d\x0aelectric" +
+	"scotlandcreationquantity. ISBN 0did not instance" +
+	"-search-\" lang=\"speakersComputercontainsarchives" +
+	"ministerreactiondiscountItalianocriteriastrongly" +
+	": 'http:'script'coveringofferingappearedBritish " +
+	"identifyFacebooknumerousvehiclesconcernsAmerican" +
+	"handlingdiv id=\"William provider_contentaccuracy" +
+	"section andersonflexibleCategorylawrence<script>" +
+	"layout=\"approved maximumheader\"></table>Services" +
+	"hamiltoncurrent canadianchannels/themes//article" +
+	"optionalportugalvalue=\"\"intervalwirelessentitled" +
+	"agenciesSearch\" measuredthousandspending&hellip;" +
+	"new Date\" size=\"pageNamemiddle\" \" /></a>hidden\">" +
+	"sequencepersonaloverflowopinionsillinoislinks\">\x0a" +
+	"\x09<title>versionssaturdayterminalitempropengineer" +
+	"sectionsdesignerproposal=\"false\"Espa\xc3\xb1olreleases" +
+	"submit\" er&quot;additionsymptomsorientedresource" +
+	"right\"><pleasurestationshistory.leaving  border=" +
+	"contentscenter\">.\x0a\x0aSome directedsuitablebulgaria" +
+	".show();designedGeneral conceptsExampleswilliams" +
+	"Original\"><span>search\">operatorrequestsa &quot;" +
+	"allowingDocumentrevision. \x0a\x0aThe yourselfContact " +
+	"michiganEnglish columbiapriorityprintingdrinking" +
+	"facilityreturnedContent officersRussian generate" +
+	"-8859-1\"indicatefamiliar qualitymargin:0 content" +
+	"viewportcontacts-title\">portable.length eligible" +
+	"involvesatlanticonload=\"default.suppliedpayments" +
+	"glossary\x0a\x0aAfter guidance</td><tdencodingmiddle\">" +
+	"came to displaysscottishjonathanmajoritywidgets." +
+	"clinicalthailandteachers<head>\x0a\x09affectedsupports" +
+	"pointer;toString</small>oklahomawill be investor" +
+	"0\" alt=\"holidaysResourcelicensed (which . After " +
+	"considervisitingexplorerprimary search\" android\"" +
+	"quickly meetingsestimate;return ;color:# height=" +
+	"approval, &quot; checked.min.js\"magnetic></a></h" +
+	"forecast. While thursdaydvertise&eacute;hasClass" +
+	"evaluateorderingexistingpatients Online colorado" +
+	"Options\"campbell<!-- end</span><<br />\x0d\x0a_popups|" +
+	"sciences,&quot; quality Windows assignedheight: " +
+	"<b classle&quot; value=\" Companyexamples<iframe " +
+	"believespresentsmarshallpart of properly).\x0a\x0aThe " +
+	"taxonomymuch of </span>\x0a\" data-srtugu\xc3\xaasscrollTo" +
+	" project<head>\x0d\x0aattorneyemphasissponsorsfancybox" +
+	"world's wildlifechecked=sessionsprogrammpx;font-" +
+	" Projectjournalsbelievedvacationthompsonlighting" +
+	"and the special border=0checking</tbody><button " +
+	"Completeclearfix\x0a<head>\x0aarticle <sectionfindings" +
+	"role in popular  Octoberwebsite exposureused to " +
+	" changesoperatedclickingenteringcommandsinformed" +
+	" numbers  </div>creatingonSubmitmarylandcolleges" +
+	"analyticlistingscontact.loggedInadvisorysiblings" +
+	"content\"s&quot;)s. This packagescheckboxsuggests" +
+	"pregnanttomorrowspacing=icon.pngjapanesecodebase" +
+	"button\">gamblingsuch as , while </span> missouri" +
+	"sportingtop:1px .</span>tensionswidth=\"2lazyload" +
+	"novemberused in height=\"cript\">\x0a&nbsp;</<tr><td " +
+	"height:2/productcountry include footer\" &lt;!-- " +
+	"title\"></jquery.</form>\x0a(\xe7\xae\x80\xe4\xbd\x93)(\xe7\xb9\x81\xe9\xab\x94)hrvatski" +
+	"italianorom\xc3\xa2n\xc4\x83t\xc3\xbcrk\xc3\xa7e\xd8\xa7\xd8\xb1\xd8\xaf\xd9\x88tambi\xc3\xa9nnoticias" +
+	"mensajespersonasderechosnacionalserviciocontacto" +
+	"usuariosprogramagobiernoempresasanunciosvalencia" +
+	"colombiadespu\xc3\xa9sdeportesproyectoproductop\xc3\xbablico" +
+	"nosotroshistoriapresentemillonesmediantepregunta" +
+	"anteriorrecursosproblemasantiagonuestrosopini\xc3\xb3n" +
+	"imprimirmientrasam\xc3\xa9ricavendedorsociedadrespecto" +
+	"realizarregistropalabrasinter\xc3\xa9sentoncesespecial" +
+	"miembrosrealidadc\xc3\xb3rdobazaragozap\xc3\xa1ginassociales" +
+	"bloqueargesti\xc3\xb3nalquilersistemascienciascompleto" +
+	"versi\xc3\xb3ncompletaestudiosp\xc3\xbablicaobjetivoalicante" +
+	"buscadorcantidadentradasaccionesarchivossuperior" +
+	"mayor\xc3\xadaalemaniafunci\xc3\xb3n\xc3\xbaltimoshaciendoaquellos" +
+	"edici\xc3\xb3nfernandoambientefacebooknuestrasclientes" +
+	"procesosbastantepresentareportarcongresopublicar" +
+	"comerciocontratoj\xc3\xb3venesdistritot\xc3\xa9cnicaconjunto" +
+	"energ\xc3\xadatrabajarasturiasrecienteutilizarbolet\xc3\xadn" +
+	"salvadorcorrectatrabajosprimerosnegocioslibertad" +
+	"detallespantallapr\xc3\xb3ximoalmer\xc3\xadaanimalesqui\xc3\xa9nes" +
+	"coraz\xc3\xb3nsecci\xc3\xb3nbuscandoopcionesexteriorconcepto" +
+	"todav\xc3\xadagaler\xc3\xadaescribirmedicinalicenciaconsulta" +
+	"aspectoscr\xc3\xadticad\xc3\xb3laresjusticiadeber\xc3\xa1nper\xc3\xadodo" +
+	"necesitamantenerpeque\xc3\xb1orecibidatribunaltenerife" +
+	"canci\xc3\xb3ncanariasdescargadiversosmallorcarequiere" +
+	"t\xc3\xa9cnicodeber\xc3\xadaviviendafinanzasadelantefunciona" +
+	"consejosdif\xc3\xadcilciudadesantiguasavanzadat\xc3\xa9rmino" +
+	"unidadess\xc3\xa1nchezcampa\xc3\xb1asoftonicrevistascontiene" +
+	"sectoresmomentosfacultadcr\xc3\xa9ditodiversassupuesto" +
+	"factoressegundospeque\xc3\xb1a\xd0\xb3\xd0\xbe\xd0\xb4\xd0\xb0\xd0\xb5\xd1\x81\xd0\xbb\xd0\xb8\xd0\xb5\xd1\x81\xd1\x82\xd1\x8c" +
+	"\xd0\xb1\xd1\x8b\xd0\xbb\xd0\xbe\xd0\xb1\xd1\x8b\xd1\x82\xd1\x8c\xd1\x8d\xd1\x82\xd0\xbe\xd0\xbc\xd0\x95\xd1\x81\xd0\xbb\xd0\xb8\xd1\x82\xd0\xbe\xd0\xb3\xd0\xbe\xd0\xbc\xd0\xb5\xd0\xbd\xd1\x8f" +
+	"\xd0\xb2\xd1\x81\xd0\xb5\xd1\x85\xd1\x8d\xd1\x82\xd0\xbe\xd0\xb9\xd0\xb4\xd0\xb0\xd0\xb6\xd0\xb5\xd0\xb1\xd1\x8b\xd0\xbb\xd0\xb8\xd0\xb3\xd0\xbe\xd0\xb4\xd1\x83\xd0\xb4\xd0\xb5\xd0\xbd\xd1\x8c" +
+	"\xd1\x8d\xd1\x82\xd0\xbe\xd1\x82\xd0\xb1\xd1\x8b\xd0\xbb\xd0\xb0\xd1\x81\xd0\xb5\xd0\xb1\xd1\x8f\xd0\xbe\xd0\xb4\xd0\xb8\xd0\xbd\xd1\x81\xd0\xb5\xd0\xb1\xd0\xb5\xd0\xbd\xd0\xb0\xd0\xb4\xd0\xbe" +
+	"\xd1\x81\xd0\xb0\xd0\xb9\xd1\x82\xd1\x84\xd0\xbe\xd1\x82\xd0\xbe\xd0\xbd\xd0\xb5\xd0\xb3\xd0\xbe\xd1\x81\xd0\xb2\xd0\xbe\xd0\xb8\xd1\x81\xd0\xb2\xd0\xbe\xd0\xb9\xd0\xb8\xd0\xb3\xd1\x80\xd1\x8b" +
+	"\xd1\x82\xd0\xbe\xd0\xb6\xd0\xb5\xd0\xb2\xd1\x81\xd0\xb5\xd0\xbc\xd1\x81\xd0\xb2\xd0\xbe\xd1\x8e\xd0\xbb\xd0\xb8\xd1\x88\xd1\x8c\xd1\x8d\xd1\x82\xd0\xb8\xd1\x85\xd0\xbf\xd0\xbe\xd0\xba\xd0\xb0" +
+	"\xd0\xb4\xd0\xbd\xd0\xb5\xd0\xb9\xd0\xb4\xd0\xbe\xd0\xbc\xd0\xb0\xd0\xbc\xd0\xb8\xd1\x80\xd0\xb0\xd0\xbb\xd0\xb8\xd0\xb1\xd0\xbe\xd1\x82\xd0\xb5\xd0\xbc\xd1\x83\xd1\x85\xd0\xbe\xd1\x82\xd1\x8f" +
+	"\xd0\xb4\xd0\xb2\xd1\x83\xd1\x85\xd1\x81\xd0\xb5\xd1\x82\xd0\xb8\xd0\xbb\xd1\x8e\xd0\xb4\xd0\xb8\xd0\xb4\xd0\xb5\xd0\xbb\xd0\xbe\xd0\xbc\xd0\xb8\xd1\x80\xd0\xb5\xd1\x82\xd0\xb5\xd0\xb1\xd1\x8f" +
+	"\xd1\x81\xd0\xb2\xd0\xbe\xd0\xb5\xd0\xb2\xd0\xb8\xd0\xb4\xd0\xb5\xd1\x87\xd0\xb5\xd0\xb3\xd0\xbe\xd1\x8d\xd1\x82\xd0\xb8\xd0\xbc\xd1\x81\xd1\x87\xd0\xb5\xd1\x82\xd1\x82\xd0\xb5\xd0\xbc\xd1\x8b" +
+	"\xd1\x86\xd0\xb5\xd0\xbd\xd1\x8b\xd1\x81\xd1\x82\xd0\xb0\xd0\xbb\xd0\xb2\xd0\xb5\xd0\xb4\xd1\x8c\xd1\x82\xd0\xb5\xd0\xbc\xd0\xb5\xd0\xb2\xd0\xbe\xd0\xb4\xd1\x8b\xd1\x82\xd0\xb5\xd0\xb1\xd0\xb5" +
+	"\xd0\xb2\xd1\x8b\xd1\x88\xd0\xb5\xd0\xbd\xd0\xb0\xd0\xbc\xd0\xb8\xd1\x82\xd0\xb8\xd0\xbf\xd0\xb0\xd1\x82\xd0\xbe\xd0\xbc\xd1\x83\xd0\xbf\xd1\x80\xd0\xb0\xd0\xb2\xd0\xbb\xd0\xb8\xd1\x86\xd0\xb0" +
+	"\xd0\xbe\xd0\xb4\xd0\xbd\xd0\xb0\xd0\xb3\xd0\xbe\xd0\xb4\xd1\x8b\xd0\xb7\xd0\xbd\xd0\xb0\xd1\x8e\xd0\xbc\xd0\xbe\xd0\xb3\xd1\x83\xd0\xb4\xd1\x80\xd1\x83\xd0\xb3\xd0\xb2\xd1\x81\xd0\xb5\xd0\xb9" +
+	"\xd0\xb8\xd0\xb4\xd0\xb5\xd1\x82\xd0\xba\xd0\xb8\xd0\xbd\xd0\xbe\xd0\xbe\xd0\xb4\xd0\xbd\xd0\xbe\xd0\xb4\xd0\xb5\xd0\xbb\xd0\xb0\xd0\xb4\xd0\xb5\xd0\xbb\xd0\xb5\xd1\x81\xd1\x80\xd0\xbe\xd0\xba" +
+	"\xd0\xb8\xd1\x8e\xd0\xbd\xd1\x8f\xd0\xb2\xd0\xb5\xd1\x81\xd1\x8c\xd0\x95\xd1\x81\xd1\x82\xd1\x8c\xd1\x80\xd0\xb0\xd0\xb7\xd0\xb0\xd0\xbd\xd0\xb0\xd1\x88\xd0\xb8\xd8\xa7\xd9\x84\xd9\x84\xd9\x87" +
+	"\xd8\xa7\xd9\x84\xd8\xaa\xd9\x8a\xd8\xac\xd9\x85\xd9\x8a\xd8\xb9\xd8\xae\xd8\xa7\xd8\xb5\xd8\xa9\xd8\xa7\xd9\x84\xd8\xb0\xd9\x8a\xd8\xb9\xd9\x84\xd9\x8a\xd9\x87\xd8\xac\xd8\xaf\xd9\x8a\xd8\xaf" +
+	"\xd8\xa7\xd9\x84\xd8\xa2\xd9\x86\xd8\xa7\xd9\x84\xd8\xb1\xd8\xaf\xd8\xaa\xd8\xad\xd9\x83\xd9\x85\xd8\xb5\xd9\x81\xd8\xad\xd8\xa9\xd9\x83\xd8\xa7\xd9\x86\xd8\xaa\xd8\xa7\xd9\x84\xd9\x84\xd9\x8a" +
+	"\xd9\x8a\xd9\x83\xd9\x88\xd9\x86\xd8\xb4\xd8\xa8\xd9\x83\xd8\xa9\xd9\x81\xd9\x8a\xd9\x87\xd8\xa7\xd8\xa8\xd9\x86\xd8\xa7\xd8\xaa\xd8\xad\xd9\x88\xd8\xa7\xd8\xa1\xd8\xa3\xd9\x83\xd8\xab\xd8\xb1" +
+	"\xd8\xae\xd9\x84\xd8\xa7\xd9\x84\xd8\xa7\xd9\x84\xd8\xad\xd8\xa8\xd8\xaf\xd9\x84\xd9\x8a\xd9\x84\xd8\xaf\xd8\xb1\xd9\x88\xd8\xb3\xd8\xa7\xd8\xb6\xd8\xba\xd8\xb7\xd8\xaa\xd9\x83\xd9\x88\xd9\x86" +
+	"\xd9\x87\xd9\x86\xd8\xa7\xd9\x83\xd8\xb3\xd8\xa7\xd8\xad\xd8\xa9\xd9\x86\xd8\xa7\xd8\xaf\xd9\x8a\xd8\xa7\xd9\x84\xd8\xb7\xd8\xa8\xd8\xb9\xd9\x84\xd9\x8a\xd9\x83\xd8\xb4\xd9\x83\xd8\xb1\xd8\xa7" +
+	"\xd9\x8a\xd9\x85\xd9\x83\xd9\x86\xd9\x85\xd9\x86\xd9\x87\xd8\xa7\xd8\xb4\xd8\xb1\xd9\x83\xd8\xa9\xd8\xb1\xd8\xa6\xd9\x8a\xd8\xb3\xd9\x86\xd8\xb4\xd9\x8a\xd8\xb7\xd9\x85\xd8\xa7\xd8\xb0\xd8\xa7" +
+	"\xd8\xa7\xd9\x84\xd9\x81\xd9\x86\xd8\xb4\xd8\xa8\xd8\xa7\xd8\xa8\xd8\xaa\xd8\xb9\xd8\xa8\xd8\xb1\xd8\xb1\xd8\xad\xd9\x85\xd8\xa9\xd9\x83\xd8\xa7\xd9\x81\xd8\xa9\xd9\x8a\xd9\x82\xd9\x88\xd9\x84" +
+	"\xd9\x85\xd8\xb1\xd9\x83\xd8\xb2\xd9\x83\xd9\x84\xd9\x85\xd8\xa9\xd8\xa3\xd8\xad\xd9\x85\xd8\xaf\xd9\x82\xd9\x84\xd8\xa8\xd9\x8a\xd9\x8a\xd8\xb9\xd9\x86\xd9\x8a\xd8\xb5\xd9\x88\xd8\xb1\xd8\xa9" +
+	"\xd8\xb7\xd8\xb1\xd9\x8a\xd9\x82\xd8\xb4\xd8\xa7\xd8\xb1\xd9\x83\xd8\xac\xd9\x88\xd8\xa7\xd9\x84\xd8\xa3\xd8\xae\xd8\xb1\xd9\x89\xd9\x85\xd8\xb9\xd9\x86\xd8\xa7\xd8\xa7\xd8\xa8\xd8\xad\xd8\xab" +
+	"\xd8\xb9\xd8\xb1\xd9\x88\xd8\xb6\xd8\xa8\xd8\xb4\xd9\x83\xd9\x84\xd9\x85\xd8\xb3\xd8\xac\xd9\x84\xd8\xa8\xd9\x86\xd8\xa7\xd9\x86\xd8\xae\xd8\xa7\xd9\x84\xd8\xaf\xd9\x83\xd8\xaa\xd8\xa7\xd8\xa8" +
+	"\xd9\x83\xd9\x84\xd9\x8a\xd8\xa9\xd8\xa8\xd8\xaf\xd9\x88\xd9\x86\xd8\xa3\xd9\x8a\xd8\xb6\xd8\xa7\xd9\x8a\xd9\x88\xd8\xac\xd8\xaf\xd9\x81\xd8\xb1\xd9\x8a\xd9\x82\xd9\x83\xd8\xaa\xd8\xa8\xd8\xaa" +
+	"\xd8\xa3\xd9\x81\xd8\xb6\xd9\x84\xd9\x85\xd8\xb7\xd8\xa8\xd8\xae\xd8\xa7\xd9\x83\xd8\xab\xd8\xb1\xd8\xa8\xd8\xa7\xd8\xb1\xd9\x83\xd8\xa7\xd9\x81\xd8\xb6\xd9\x84\xd8\xa7\xd8\xad\xd9\x84\xd9\x89" +
+	"\xd9\x86\xd9\x81\xd8\xb3\xd9\x87\xd8\xa3\xd9\x8a\xd8\xa7\xd9\x85\xd8\xb1\xd8\xaf\xd9\x88\xd8\xaf\xd8\xa3\xd9\x86\xd9\x87\xd8\xa7\xd8\xaf\xd9\x8a\xd9\x86\xd8\xa7\xd8\xa7\xd9\x84\xd8\xa7\xd9\x86" +
+	"\xd9\x85\xd8\xb9\xd8\xb1\xd8\xb6\xd8\xaa\xd8\xb9\xd9\x84\xd9\x85\xd8\xaf\xd8\xa7\xd8\xae\xd9\x84\xd9\x85\xd9\x85\xd9\x83\xd9\x86\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x01\x00\x01\x00\x01\x00" +
+	"\x02\x00\x02\x00\x02\x00\x02\x00\x04\x00\x04\x00\x04\x00\x04\x00\x00\x01\x02\x03\x04\x05\x06\x07\x07\x06\x05\x04\x03\x02\x01\x00\x08\x09\x0a\x0b\x0c\x0d\x0e\x0f\x0f\x0e\x0d\x0c\x0b\x0a\x09\x08" +
+	"\x10\x11\x12\x13\x14\x15\x16\x17\x17\x16\x15\x14\x13\x12\x11\x10\x18\x19\x1a\x1b\x1c\x1d\x1e\x1f\x1f\x1e\x1d\x1c\x1b\x1a\x19\x18\xff\xff\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\xff\xff" +
+	"\x01\x00\x00\x00\x02\x00\x00\x00\x02\x00\x00\x00\x01\x00\x00\x00\x01\x00\x00\x00\x03\x00\x00\x00\xff\xff\x00\x01\x00\x00\x00\x01\x00\x00\xff\xff\x00\x01\x00\x00\x00\x08\x00\x08\x00\x08\x00\x08" +
+	"\x00\x00\x00\x01\x00\x02\x00\x03\x00\x04\x00\x05\x00\x06\x00\x07resourcescountriesquestionsequip" +
+	"mentcommunityavailablehighlightDTD/xhtmlmarketin" +
+	"gknowledgesomethingcontainerdirectionsubscribead" +
+	"vertisecharacter\" value=\"</select>Australia\" cla" +
+	"ss=\"situationauthorityfollowingprimarilyoperatio" +
+	"nchallengedevelopedanonymousfunction functionsco" +
+	"mpaniesstructureagreement\" title=\"potentialeduca" +
+	"tionargumentssecondarycopyrightlanguagesexclusiv" +
+	"econdition</form>\x0d\x0astatementattentionBiography} " +
+	"else {\x0asolutionswhen the Analyticstemplatesdange" +
+	"roussatellitedocumentspublisherimportantprototyp" +
+	"einfluence&raquo;</effectivegenerallytransformbe" +
+	"autifultransportorganizedpublishedprominentuntil" +
+	" thethumbnailNational .focus();over the migratio" +
+	"nannouncedfooter\">\x0aexceptionless thanexpensivefo" +
+	"rmationframeworkterritoryndicationcurrentlyclass" +
+	"NamecriticismtraditionelsewhereAlexanderappointe" +
+	"dmaterialsbroadcastmentionedaffiliate</option>tr" +
+	"eatmentdifferent/default.Presidentonclick=\"biogr" +
+	"aphyotherwisepermanentFran\xc3\xa7aisHollywoodexpansio" +
+	"nstandards</style>\x0areductionDecember preferredCa" +
+	"mbridgeopponentsBusiness confusion>\x0a<title>prese" +
+	"ntedexplaineddoes not worldwideinterfaceposition" +
+	"snewspaper</table>\x0amountainslike the essentialfi" +
+	"nancialselectionaction=\"/abandonedEducationparse" +
+	"Int(stabilityunable to</title>\x0arelationsNote tha" +
+	"tefficientperformedtwo yearsSince thethereforewr" +
+	"apper\">alternateincreasedBattle ofperceivedtryin" +
+	"g tonecessaryportrayedelectionsElizabeth</iframe" +
+	">discoveryinsurances.length;legendaryGeographyca" +
+	"ndidatecorporatesometimesservices.inherited</str" +
+	"ong>CommunityreligiouslocationsCommitteebuilding" +
+	"sthe worldno longerbeginningreferencecannot befr" +
+	"equencytypicallyinto the relative;recordingpresi" +
+	"dentinitiallytechniquethe otherit can beexistenc" +
+	"eunderlinethis timetelephoneitemscopepracticesad" +
+	"vantage);return For otherprovidingdemocracyboth " +
+	"the extensivesufferingsupportedcomputers functio" +
+	"npracticalsaid thatit may beEnglish</from the sc" +
+	"heduleddownloads</label>\x0asuspectedmargin: 0spiri" +
+	"tual</head>\x0a\x0amicrosoftgraduallydiscussedhe becam" +
+	"eexecutivejquery.jshouseholdconfirmedpurchasedli" +
+	"terallydestroyedup to thevariationremainingit is" +
+	" notcenturiesJapanese among thecompletedalgorith" +
+	"minterestsrebellionundefinedencourageresizablein" +
+	"volvingsensitiveuniversalprovision(althoughfeatu" +
+	"ringconducted), which continued-header\">February" +
+	" numerous overflow:componentfragmentsexcellentco" +
+	"lspan=\"technicalnear the Advanced source ofexpre" +
+	"ssedHong Kong Facebookmultiple mechanismelevatio" +
+	"noffensive</form>\x0a\x09sponsoreddocument.or &quot;th" +
+	"ere arethose whomovementsprocessesdifficultsubmi" +
+	"ttedrecommendconvincedpromoting\" width=\".replace" +
+	"(classicalcoalitionhis firstdecisionsassistantin" +
+	"dicatedevolution-wrapper\"enough toalong thedeliv" +
+	"ered-->\x0d\x0a<!--American protectedNovember </style>" +
+	"<furnitureInternet  onblur=\"suspendedrecipientba" +
+	"sed on Moreover,abolishedcollectedwere madeemoti" +
+	"onalemergencynarrativeadvocatespx;bordercommitte" +
+	"ddir=\"ltr\"employeesresearch. selectedsuccessorcu" +
+	"stomersdisplayedSeptemberaddClass(Facebook sugge" +
+	"stedand lateroperatingelaborateSometimesInstitut" +
+	"ecertainlyinstalledfollowersJerusalemthey haveco" +
+	"mputinggeneratedprovincesguaranteearbitraryrecog" +
+	"nizewanted topx;width:theory ofbehaviourWhile th" +
+	"eestimatedbegan to it becamemagnitudemust havemo" +
+	"re thanDirectoryextensionsecretarynaturallyoccur" +
+	"ringvariablesgiven theplatform.</label><failed t" +
+	"ocompoundskinds of societiesalongside --&gt;\x0a\x0aso" +
+	"uthwestthe rightradiationmay have unescape(spoke" +
+	"n in\" href=\"/programmeonly the come fromdirector" +
+	"yburied ina similarthey were</font></Norwegiansp" +
+	"ecifiedproducingpassenger(new Datetemporaryficti" +
+	"onalAfter theequationsdownload.regularlydevelope" +
+	"rabove thelinked tophenomenaperiod oftooltip\">su" +
+	"bstanceautomaticaspect ofAmong theconnectedestim" +
+	"atesAir Forcesystem ofobjectiveimmediatemaking i" +
+	"tpaintingsconqueredare stillproceduregrowth ofhe" +
+	"aded byEuropean divisionsmoleculesfranchiseinten" +
+	"tionattractedchildhoodalso useddedicatedsingapor" +
+	"edegree offather ofconflicts</a></p>\x0acame fromwe" +
+	"re usednote thatreceivingExecutiveeven moreacces" +
+	"s tocommanderPoliticalmusiciansdeliciousprisoner" +
+	"sadvent ofUTF-8\" /><![CDATA[\">ContactSouthern bg" +
+	"color=\"series of. It was in Europepermittedvalid" +
+	"ate.appearingofficialsseriously-languageinitiate" +
+	"dextendinglong-terminflationsuch thatgetCookiema" +
+	"rked by</button>implementbut it isincreasesdown " +
+	"the requiringdependent-->\x0a<!-- interviewWith the" +
+	" copies ofconsensuswas builtVenezuela(formerlyth" +
+	"e statepersonnelstrategicfavour ofinventionWikip" +
+	"ediacontinentvirtuallywhich wasprincipleComplete" +
+	" identicalshow thatprimitiveaway frommolecularpr" +
+	"eciselydissolvedUnder theversion=\">&nbsp;</It is" +
+	" the This is will haveorganismssome timeFriedric" +
+	"hwas firstthe only fact thatform id=\"precedingTe" +
+	"chnicalphysicistoccurs innavigatorsection\">span " +
+	"id=\"sought tobelow thesurviving}</style>his deat" +
+	"has in thecaused bypartiallyexisting using thewa" +
+	"s givena list oflevels ofnotion ofOfficial dismi" +
+	"ssedscientistresemblesduplicateexplosiverecovere" +
+	"dall othergalleries{padding:people ofregion ofad" +
+	"dressesassociateimg alt=\"in modernshould bemetho" +
+	"d ofreportingtimestampneeded tothe Greatregardin" +
+	"gseemed toviewed asimpact onidea thatthe Worldhe" +
+	"ight ofexpandingThese arecurrent\">carefullymaint" +
+	"ainscharge ofClassicaladdressedpredictedownershi" +
+	"p<div id=\"right\">\x0d\x0aresidenceleave thecontent\">ar" +
+	"e often  })();\x0d\x0aprobably Professor-button\" respo" +
+	"ndedsays thathad to beplaced inHungarianstatus o" +
+	"fserves asUniversalexecutionaggregatefor whichin" +
+	"fectionagreed tohowever, popular\">placed onconst" +
+	"ructelectoralsymbol ofincludingreturn toarchitec" +
+	"tChristianprevious living ineasier toprofessor\x0a&" +
+	"lt;!-- effect ofanalyticswas takenwhere thetook " +
+	"overbelief inAfrikaansas far aspreventedwork wit" +
+	"ha special<fieldsetChristmasRetrieved\x0a\x0aIn the ba" +
+	"ck intonortheastmagazines><strong>committeegover" +
+	"ninggroups ofstored inestablisha generalits firs" +
+	"ttheir ownpopulatedan objectCaribbeanallow thedi" +
+	"strictswisconsinlocation.; width: inhabitedSocia" +
+	"listJanuary 1</footer>similarlychoice ofthe same" +
+	" specific business The first.length; desire tode" +
+	"al withsince theuserAgentconceivedindex.phpas &q" +
+	"uot;engage inrecently,few yearswere also\x0a<head>\x0a" +
+	"<edited byare knowncities inaccesskeycondemnedal" +
+	"so haveservices,family ofSchool ofconvertednatur" +
+	"e of languageministers</object>there is a popula" +
+	"rsequencesadvocatedThey wereany otherlocation=en" +
+	"ter themuch morereflectedwas namedoriginal a typ" +
+	"icalwhen theyengineerscould notresidentswednesda" +
+	"ythe third productsJanuary 2what theya certainre" +
+	"actionsprocessorafter histhe last contained\"></d" +
+	"iv>\x0a</a></td>depend onsearch\">\x0apieces ofcompetin" +
+	"gReferencetennesseewhich has version=</span> <</" +
+	"header>gives thehistorianvalue=\"\">padding:0view " +
+	"thattogether,the most was foundsubset ofattack o" +
+	"nchildren,points ofpersonal position:allegedlyCl" +
+	"evelandwas laterand afterare givenwas stillscrol" +
+	"lingdesign ofmakes themuch lessAmericans.\x0a\x0aAfter" +
+	" , but theMuseum oflouisiana(from theminnesotapa" +
+	"rticlesa processDominicanvolume ofreturningdefen" +
+	"sive00px|righmade frommouseover\" style=\"states o" +
+	"f(which iscontinuesFranciscobuilding without awi" +
+	"th somewho woulda form ofa part ofbefore itknown" +
+	" as  Serviceslocation and oftenmeasuringand it i" +
+	"spaperbackvalues of\x0d\x0a<title>= window.determineer" +
+	"&quot; played byand early</center>from thisthe t" +
+	"hreepower andof &quot;innerHTML<a href=\"y:inline" +
+	";Church ofthe eventvery highofficial -height: co" +
+	"ntent=\"/cgi-bin/to createafrikaansesperantofran\xc3" +
+	"\xa7aislatvie\xc5\xa1ulietuvi\xc5\xb3\xc4\x8ce\xc5\xa1tina\xc4\x8de\xc5\xa1tina\xe0\xb9\x84\xe0\xb8\x97\xe0\xb8" +
+	"\xa2\xe6\x97\xa5\xe6\x9c\xac\xe8\xaa\x9e\xe7\xae\x80\xe4\xbd\x93\xe5\xad\x97\xe7\xb9\x81\xe9\xab\x94\xe5\xad\x97\xed\x95\x9c\xea\xb5\xad\xec\x96\xb4\xe4\xb8\xba\xe4\xbb\x80\xe4\xb9\x88\xe8\xae" +
+	"\xa1\xe7\xae\x97\xe6\x9c\xba\xe7\xac\x94\xe8\xae\xb0\xe6\x9c\xac\xe8\xa8\x8e\xe8\xab\x96\xe5\x8d\x80\xe6\x9c\x8d\xe5\x8a\xa1\xe5\x99\xa8\xe4\xba\x92\xe8\x81\x94\xe7\xbd\x91\xe6\x88\xbf\xe5\x9c" +
+	"\xb0\xe4\xba\xa7\xe4\xbf\xb1\xe4\xb9\x90\xe9\x83\xa8\xe5\x87\xba\xe7\x89\x88\xe7\xa4\xbe\xe6\x8e\x92\xe8\xa1\x8c\xe6\xa6\x9c\xe9\x83\xa8\xe8\x90\xbd\xe6\xa0\xbc\xe8\xbf\x9b\xe4\xb8\x80\xe6\xad" +
+	"\xa5\xe6\x94\xaf\xe4\xbb\x98\xe5\xae\x9d\xe9\xaa\x8c\xe8\xaf\x81\xe7\xa0\x81\xe5\xa7\x94\xe5\x91\x98\xe4\xbc\x9a\xe6\x95\xb0\xe6\x8d\xae\xe5\xba\x93\xe6\xb6\x88\xe8\xb4\xb9\xe8\x80\x85\xe5\x8a" +
+	"\x9e\xe5\x85\xac\xe5\xae\xa4\xe8\xae\xa8\xe8\xae\xba\xe5\x8c\xba\xe6\xb7\xb1\xe5\x9c\xb3\xe5\xb8\x82\xe6\x92\xad\xe6\x94\xbe\xe5\x99\xa8\xe5\x8c\x97\xe4\xba\xac\xe5\xb8\x82\xe5\xa4\xa7\xe5\xad" +
+	"\xa6\xe7\x94\x9f\xe8\xb6\x8a\xe6\x9d\xa5\xe8\xb6\x8a\xe7\xae\xa1\xe7\x90\x86\xe5\x91\x98\xe4\xbf\xa1\xe6\x81\xaf\xe7\xbd\x91serviciosart\xc3\xadcul" +
+	"oargentinabarcelonacualquierpublicadoproductospo" +
+	"l\xc3\xadticarespuestawikipediasiguienteb\xc3\xbasquedacomun" +
+	"idadseguridadprincipalpreguntascontenidoresponde" +
+	"rvenezuelaproblemasdiciembrerelaci\xc3\xb3nnoviembresi" +
+	"milaresproyectosprogramasinstitutoactividadencue" +
+	"ntraeconom\xc3\xadaim\xc3\xa1genescontactardescargarnecesari" +
+	"oatenci\xc3\xb3ntel\xc3\xa9fonocomisi\xc3\xb3ncancionescapacidaden" +
+	"contraran\xc3\xa1lisisfavoritost\xc3\xa9rminosprovinciaetiqu" +
+	"etaselementosfuncionesresultadocar\xc3\xa1cterpropieda" +
+	"dprincipionecesidadmunicipalcreaci\xc3\xb3ndescargaspr" +
+	"esenciacomercialopinionesejercicioeditorialsalam" +
+	"ancagonz\xc3\xa1lezdocumentopel\xc3\xadcularecientesgenerale" +
+	"starragonapr\xc3\xa1cticanovedadespropuestapacientest\xc3" +
+	"\xa9cnicasobjetivoscontactos\xe0\xa4\xae\xe0\xa5\x87\xe0\xa4\x82\xe0\xa4\xb2\xe0\xa4\xbf\xe0\xa4\x8f\xe0\xa4\xb9\xe0\xa5" +
+	"\x88\xe0\xa4\x82\xe0\xa4\x97\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa4\xbe\xe0\xa4\xa5\xe0\xa4\x8f\xe0\xa4\xb5\xe0\xa4\x82\xe0\xa4\xb0\xe0\xa4\xb9\xe0\xa5\x87\xe0\xa4\x95\xe0\xa5\x8b\xe0\xa4" +
+	"\x88\xe0\xa4\x95\xe0\xa5\x81\xe0\xa4\x9b\xe0\xa4\xb0\xe0\xa4\xb9\xe0\xa4\xbe\xe0\xa4\xac\xe0\xa4\xbe\xe0\xa4\xa6\xe0\xa4\x95\xe0\xa4\xb9\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa4\xad\xe0\xa5\x80\xe0\xa4" +
+	"\xb9\xe0\xa5\x81\xe0\xa4\x8f\xe0\xa4\xb0\xe0\xa4\xb9\xe0\xa5\x80\xe0\xa4\xae\xe0\xa5\x88\xe0\xa4\x82\xe0\xa4\xa6\xe0\xa4\xbf\xe0\xa4\xa8\xe0\xa4\xac\xe0\xa4\xbe\xe0\xa4\xa4diplo" +
+	"docs\xe0\xa4\xb8\xe0\xa4\xae\xe0\xa4\xaf\xe0\xa4\xb0\xe0\xa5\x82\xe0\xa4\xaa\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\xae\xe0\xa4\xaa\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\xab\xe0\xa4\xbf\xe0\xa4" +
+	"\xb0\xe0\xa4\x94\xe0\xa4\xb8\xe0\xa4\xa4\xe0\xa4\xa4\xe0\xa4\xb0\xe0\xa4\xb9\xe0\xa4\xb2\xe0\xa5\x8b\xe0\xa4\x97\xe0\xa4\xb9\xe0\xa5\x81\xe0\xa4\x86\xe0\xa4\xac\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4" +
+	"\xa6\xe0\xa5\x87\xe0\xa4\xb6\xe0\xa4\xb9\xe0\xa5\x81\xe0\xa4\x88\xe0\xa4\x96\xe0\xa5\x87\xe0\xa4\xb2\xe0\xa4\xaf\xe0\xa4\xa6\xe0\xa4\xbf\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xae\xe0\xa4\xb5\xe0\xa5" +
+	"\x87\xe0\xa4\xac\xe0\xa4\xa4\xe0\xa5\x80\xe0\xa4\xa8\xe0\xa4\xac\xe0\xa5\x80\xe0\xa4\x9a\xe0\xa4\xae\xe0\xa5\x8c\xe0\xa4\xa4\xe0\xa4\xb8\xe0\xa4\xbe\xe0\xa4\xb2\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4" +
+	"\x96\xe0\xa4\x9c\xe0\xa5\x89\xe0\xa4\xac\xe0\xa4\xae\xe0\xa4\xa6\xe0\xa4\xa6\xe0\xa4\xa4\xe0\xa4\xa5\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa4\xb9\xe0\xa5\x80\xe0\xa4\xb6\xe0\xa4\xb9\xe0\xa4\xb0\xe0\xa4" +
+	"\x85\xe0\xa4\xb2\xe0\xa4\x97\xe0\xa4\x95\xe0\xa4\xad\xe0\xa5\x80\xe0\xa4\xa8\xe0\xa4\x97\xe0\xa4\xb0\xe0\xa4\xaa\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\xa4\xe0\xa4\x95\xe0\xa4" +
+	"\xbf\xe0\xa4\x8f\xe0\xa4\x89\xe0\xa4\xb8\xe0\xa5\x87\xe0\xa4\x97\xe0\xa4\xaf\xe0\xa5\x80\xe0\xa4\xb9\xe0\xa5\x82\xe0\xa4\x81\xe0\xa4\x86\xe0\xa4\x97\xe0\xa5\x87\xe0\xa4\x9f\xe0\xa5\x80\xe0\xa4" +
+	"\xae\xe0\xa4\x96\xe0\xa5\x8b\xe0\xa4\x9c\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\x85\xe0\xa4\xad\xe0\xa5\x80\xe0\xa4\x97\xe0\xa4\xaf\xe0\xa5\x87\xe0\xa4\xa4\xe0\xa5\x81\xe0\xa4\xae\xe0\xa4" +
+	"\xb5\xe0\xa5\x8b\xe0\xa4\x9f\xe0\xa4\xa6\xe0\xa5\x87\xe0\xa4\x82\xe0\xa4\x85\xe0\xa4\x97\xe0\xa4\xb0\xe0\xa4\x90\xe0\xa4\xb8\xe0\xa5\x87\xe0\xa4\xae\xe0\xa5\x87\xe0\xa4\xb2\xe0\xa4\xb2\xe0\xa4" +
+	"\x97\xe0\xa4\xbe\xe0\xa4\xb9\xe0\xa4\xbe\xe0\xa4\xb2\xe0\xa4\x8a\xe0\xa4\xaa\xe0\xa4\xb0\xe0\xa4\x9a\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\x90\xe0\xa4\xb8\xe0\xa4\xbe\xe0\xa4\xa6\xe0\xa5\x87\xe0\xa4" +
+	"\xb0\xe0\xa4\x9c\xe0\xa4\xbf\xe0\xa4\xb8\xe0\xa4\xa6\xe0\xa4\xbf\xe0\xa4\xb2\xe0\xa4\xac\xe0\xa4\x82\xe0\xa4\xa6\xe0\xa4\xac\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\xb9\xe0\xa5\x82\xe0\xa4\x82\xe0\xa4" +
+	"\xb2\xe0\xa4\xbe\xe0\xa4\x96\xe0\xa4\x9c\xe0\xa5\x80\xe0\xa4\xa4\xe0\xa4\xac\xe0\xa4\x9f\xe0\xa4\xa8\xe0\xa4\xae\xe0\xa4\xbf\xe0\xa4\xb2\xe0\xa4\x87\xe0\xa4\xb8\xe0\xa5\x87\xe0\xa4\x86\xe0\xa4" +
+	"\xa8\xe0\xa5\x87\xe0\xa4\xa8\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\x95\xe0\xa5\x81\xe0\xa4\xb2\xe0\xa4\xb2\xe0\xa5\x89\xe0\xa4\x97\xe0\xa4\xad\xe0\xa4\xbe\xe0\xa4\x97\xe0\xa4\xb0\xe0\xa5\x87\xe0\xa4" +
+	"\xb2\xe0\xa4\x9c\xe0\xa4\x97\xe0\xa4\xb9\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\xae\xe0\xa4\xb2\xe0\xa4\x97\xe0\xa5\x87\xe0\xa4\xaa\xe0\xa5\x87\xe0\xa4\x9c\xe0\xa4\xb9\xe0\xa4\xbe\xe0\xa4\xa5\xe0\xa4" +
+	"\x87\xe0\xa4\xb8\xe0\xa5\x80\xe0\xa4\xb8\xe0\xa4\xb9\xe0\xa5\x80\xe0\xa4\x95\xe0\xa4\xb2\xe0\xa4\xbe\xe0\xa4\xa0\xe0\xa5\x80\xe0\xa4\x95\xe0\xa4\xb9\xe0\xa4\xbe\xe0\xa4\x81\xe0\xa4\xa6\xe0\xa5" +
+	"\x82\xe0\xa4\xb0\xe0\xa4\xa4\xe0\xa4\xb9\xe0\xa4\xa4\xe0\xa4\xb8\xe0\xa4\xbe\xe0\xa4\xa4\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\xa6\xe0\xa4\x86\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\xaa\xe0\xa4\xbe\xe0\xa4" +
+	"\x95\xe0\xa4\x95\xe0\xa5\x8c\xe0\xa4\xa8\xe0\xa4\xb6\xe0\xa4\xbe\xe0\xa4\xae\xe0\xa4\xa6\xe0\xa5\x87\xe0\xa4\x96\xe0\xa4\xaf\xe0\xa4\xb9\xe0\xa5\x80\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\xaf\xe0\xa4" +
+	"\x96\xe0\xa5\x81\xe0\xa4\xa6\xe0\xa4\xb2\xe0\xa4\x97\xe0\xa5\x80categoriesexperience</title>\x0d\x0aCo" +
+	"pyright javascriptconditionseverything<p class=\"" +
+	"technologybackground<a class=\"management&copy; 2" +
+	"01javaScriptcharactersbreadcrumbthemselveshorizo" +
+	"ntalgovernmentCaliforniaactivitiesdiscoveredNavi" +
+	"gationtransitionconnectionnavigationappearance</" +
+	"title><mcheckbox\" techniquesprotectionapparently" +
+	"as well asunt', 'UA-resolutionoperationstelevisi" +
+	"ontranslatedWashingtonnavigator. = window.impres" +
+	"sion&lt;br&gt;literaturepopulationbgcolor=\"#espe" +
+	"cially content=\"productionnewsletterpropertiesde" +
+	"finitionleadershipTechnologyParliamentcomparison" +
+	"ul class=\".indexOf(\"conclusiondiscussioncomponen" +
+	"tsbiologicalRevolution_containerunderstoodnoscri" +
+	"pt><permissioneach otheratmosphere onfocus=\"<for" +
+	"m id=\"processingthis.valuegenerationConferencesu" +
+	"bsequentwell-knownvariationsreputationphenomenon" +
+	"disciplinelogo.png\" (document,boundariesexpressi" +
+	"onsettlementBackgroundout of theenterprise(\"http" +
+	"s:\" unescape(\"password\" democratic<a href=\"/wrap" +
+	"per\">\x0amembershiplinguisticpx;paddingphilosophyas" +
+	"sistanceuniversityfacilitiesrecognizedpreference" +
+	"if (typeofmaintainedvocabularyhypothesis.submit(" +
+	");&amp;nbsp;annotationbehind theFoundationpublis" +
+	"her\"assumptionintroducedcorruptionscientistsexpl" +
+	"icitlyinstead ofdimensions onClick=\"consideredde" +
+	"partmentoccupationsoon afterinvestmentpronounced" +
+	"identifiedexperimentManagementgeographic\" height" +
+	"=\"link rel=\".replace(/depressionconferencepunish" +
+	"menteliminatedresistanceadaptationoppositionwell" +
+	" knownsupplementdeterminedh1 class=\"0px;marginme" +
+	"chanicalstatisticscelebratedGovernment\x0a\x0aDuring t" +
+	"developersartificialequivalentoriginatedCommissi" +
+	"onattachment<span id=\"there wereNederlandsbeyond" +
+	" theregisteredjournalistfrequentlyall of thelang" +
+	"=\"en\" </style>\x0d\x0aabsolute; supportingextremely ma" +
+	"instream</strong> popularityemployment</table>\x0d\x0a" +
+	" colspan=\"</form>\x0a  conversionabout the </p></di" +
+	"v>integrated\" lang=\"enPortuguesesubstituteindivi" +
+	"dualimpossiblemultimediaalmost allpx solid #apar" +
+	"t fromsubject toin Englishcriticizedexcept forgu" +
+	"idelinesoriginallyremarkablethe secondh2 class=\"" +
+	"<a title=\"(includingparametersprohibited= \"http:" +
+	"//dictionaryperceptionrevolutionfoundationpx;hei" +
+	"ght:successfulsupportersmillenniumhis fatherthe " +
+	"&quot;no-repeat;commercialindustrialencouragedam" +
+	"ount of unofficialefficiencyReferencescoordinate" +
+	"disclaimerexpeditiondevelopingcalculatedsimplifi" +
+	"edlegitimatesubstring(0\" class=\"completelyillust" +
+	"ratefive yearsinstrumentPublishing1\" class=\"psyc" +
+	"hologyconfidencenumber of absence offocused onjo" +
+	"ined thestructurespreviously></iframe>once again" +
+	"but ratherimmigrantsof course,a group ofLiteratu" +
+	"reUnlike the</a>&nbsp;\x0afunction it was theConven" +
+	"tionautomobileProtestantaggressiveafter the Simi" +
+	"larly,\" /></div>collection\x0d\x0afunctionvisibilityth" +
+	"e use ofvolunteersattractionunder the threatened" +
+	"*<![CDATA[importancein generalthe latter</form>\x0a" +
+	"</.indexOf('i = 0; i <differencedevoted totradit" +
+	"ionssearch forultimatelytournamentattributesso-c" +
+	"alled }\x0a</style>evaluationemphasizedaccessible</" +
+	"section>successionalong withMeanwhile,industries" +
+	"</a><br />has becomeaspects ofTelevisionsufficie" +
+	"ntbasketballboth sidescontinuingan article<img a" +
+	"lt=\"adventureshis mothermanchesterprinciplespart" +
+	"icularcommentaryeffects ofdecided to\"><strong>pu" +
+	"blishersJournal ofdifficultyfacilitateacceptable" +
+	"style.css\"\x09function innovation>Copyrightsituatio" +
+	"nswould havebusinessesDictionarystatementsoften " +
+	"usedpersistentin Januarycomprising</title>\x0a\x09dipl" +
+	"omaticcontainingperformingextensionsmay not beco" +
+	"ncept of onclick=\"It is alsofinancial making the" +
+	"Luxembourgadditionalare calledengaged in\"script\"" +
+	");but it waselectroniconsubmit=\"\x0a<!-- End electr" +
+	"icalofficiallysuggestiontop of theunlike theAust" +
+	"ralianOriginallyreferences\x0a</head>\x0d\x0arecognisedin" +
+	"itializelimited toAlexandriaretirementAdventures" +
+	"four years\x0a\x0a&lt;!-- increasingdecorationh3 class" +
+	"=\"origins ofobligationregulationclassified(funct" +
+	"ion(advantagesbeing the historians<base hrefrepe" +
+	"atedlywilling tocomparabledesignatednominationfu" +
+	"nctionalinside therevelationend of thes for the " +
+	"authorizedrefused totake placeautonomouscompromi" +
+	"sepolitical restauranttwo of theFebruary 2qualit" +
+	"y ofswfobject.understandnearly allwritten byinte" +
+	"rviews\" width=\"1withdrawalfloat:leftis usuallyca" +
+	"ndidatesnewspapersmysteriousDepartmentbest known" +
+	"parliamentsuppressedconvenientremembereddifferen" +
+	"t systematichas led topropagandacontrolledinflue" +
+	"ncesceremonialproclaimedProtectionli class=\"Scie" +
+	"ntificclass=\"no-trademarksmore than widespreadLi" +
+	"berationtook placeday of theas long asimprisoned" +
+	"Additional\x0a<head>\x0a<mLaboratoryNovember 2exceptio" +
+	"nsIndustrialvariety offloat: lefDuring theassess" +
+	"menthave been deals withStatisticsoccurrence/ul>" +
+	"</div>clearfix\">the publicmany yearswhich wereov" +
+	"er time,synonymouscontent\">\x0apresumablyhis family" +
+	"userAgent.unexpectedincluding challengeda minori" +
+	"tyundefined\"belongs totaken fromin Octoberpositi" +
+	"on: said to bereligious Federation rowspan=\"only" +
+	" a fewmeant thatled to the-->\x0d\x0a<div <fieldset>Ar" +
+	"chbishop class=\"nobeing usedapproachesprivileges" +
+	"noscript>\x0aresults inmay be theEaster eggmechanis" +
+	"msreasonablePopulationCollectionselected\">noscri" +
+	"pt>\x0d/index.phparrival of-jssdk'));managed toinco" +
+	"mpletecasualtiescompletionChristiansSeptember ar" +
+	"ithmeticproceduresmight haveProductionit appears" +
+	"Philosophyfriendshipleading togiving thetoward t" +
+	"heguaranteeddocumentedcolor:#000video gamecommis" +
+	"sionreflectingchange theassociatedsans-serifonke" +
+	"ypress; padding:He was theunderlyingtypically , " +
+	"and the srcElementsuccessivesince the should be " +
+	"networkingaccountinguse of thelower thanshows th" +
+	"at</span>\x0a\x09\x09complaintscontinuousquantitiesastron" +
+	"omerhe did notdue to itsapplied toan averageeffo" +
+	"rts tothe futureattempt toTherefore,capabilityRe" +
+	"publicanwas formedElectronickilometerschallenges" +
+	"publishingthe formerindigenousdirectionssubsidia" +
+	"ryconspiracydetails ofand in theaffordablesubsta" +
+	"ncesreason forconventionitemtype=\"absolutelysupp" +
+	"osedlyremained aattractivetravellingseparatelyfo" +
+	"cuses onelementaryapplicablefound thatstylesheet" +
+	"manuscriptstands for no-repeat(sometimesCommerci" +
+	"alin Americaundertakenquarter ofan exampleperson" +
+	"allyindex.php?</button>\x0apercentagebest-knowncrea" +
+	"ting a\" dir=\"ltrLieutenant\x0a<div id=\"they wouldab" +
+	"ility ofmade up ofnoted thatclear thatargue that" +
+	"to anotherchildren'spurpose offormulatedbased up" +
+	"onthe regionsubject ofpassengerspossession.\x0a\x0aIn " +
+	"the Before theafterwardscurrently across thescie" +
+	"ntificcommunity.capitalismin Germanyright-wingth" +
+	"e systemSociety ofpoliticiandirection:went on to" +
+	"removal of New York apartmentsindicationduring t" +
+	"heunless thehistoricalhad been adefinitiveingred" +
+	"ientattendanceCenter forprominencereadyStatestra" +
+	"tegiesbut in theas part ofconstituteclaim thatla" +
+	"boratorycompatiblefailure of, such as began with" +
+	"using the to providefeature offrom which/\" class" +
+	"=\"geologicalseveral ofdeliberateimportant holds " +
+	"thating&quot; valign=topthe Germanoutside ofnego" +
+	"tiatedhis careerseparationid=\"searchwas calledth" +
+	"e fourthrecreationother thanpreventionwhile the " +
+	"education,connectingaccuratelywere builtwas kill" +
+	"edagreementsmuch more Due to thewidth: 100some o" +
+	"therKingdom ofthe entirefamous forto connectobje" +
+	"ctivesthe Frenchpeople andfeatured\">is said tost" +
+	"ructuralreferendummost oftena separate->\x0a<div id" +
+	" Official worldwide.aria-labelthe planetand it w" +
+	"asd\" value=\"looking atbeneficialare in themonito" +
+	"ringreportedlythe modernworking onallowed towher" +
+	"e the innovative</a></div>soundtracksearchFormte" +
+	"nd to beinput id=\"opening ofrestrictedadopted by" +
+	"addressingtheologianmethods ofvariant ofChristia" +
+	"n very largeautomotiveby far therange frompursui" +
+	"t offollow thebrought toin Englandagree thataccu" +
+	"sed ofcomes frompreventingdiv style=his or hertr" +
+	"emendousfreedom ofconcerning0 1em 1em;Basketball" +
+	"/style.cssan earliereven after/\" title=\".com/ind" +
+	"extaking thepittsburghcontent\">\x0d<script>(fturned" +
+	" outhaving the</span>\x0d\x0a occasionalbecause itstar" +
+	"ted tophysically></div>\x0a  created byCurrently, b" +
+	"gcolor=\"tabindex=\"disastrousAnalytics also has a" +
+	"><div id=\"</style>\x0a<called forsinger and.src = \"" +
+	"//violationsthis pointconstantlyis locatedrecord" +
+	"ingsd from thenederlandsportugu\xc3\xaas\xd7\xa2\xd7\x91\xd7\xa8\xd7\x99\xd7\xaa\xd9\x81\xd8\xa7" +
+	"\xd8\xb1\xd8\xb3\xdb\x8cdesarrollocomentarioeducaci\xc3\xb3nseptiembrere" +
+	"gistradodirecci\xc3\xb3nubicaci\xc3\xb3npublicidadrespuestas" +
+	"resultadosimportantereservadosart\xc3\xadculosdiferent" +
+	"essiguientesrep\xc3\xbablicasituaci\xc3\xb3nministerioprivac" +
+	"idaddirectorioformaci\xc3\xb3npoblaci\xc3\xb3npresidentecont" +
+	"enidosaccesoriostechnoratipersonalescategor\xc3\xadaes" +
+	"pecialesdisponibleactualidadreferenciavalladolid" +
+	"bibliotecarelacionescalendariopol\xc3\xadticasanterior" +
+	"esdocumentosnaturalezamaterialesdiferenciaecon\xc3\xb3" +
+	"micatransporterodr\xc3\xadguezparticiparencuentrandisc" +
+	"usi\xc3\xb3nestructurafundaci\xc3\xb3nfrecuentespermanenteto" +
+	"talmente\xd0\xbc\xd0\xbe\xd0\xb6\xd0\xbd\xd0\xbe\xd0\xb1\xd1\x83\xd0\xb4\xd0\xb5\xd1\x82\xd0\xbc\xd0\xbe\xd0\xb6\xd0\xb5\xd1\x82\xd0\xb2\xd1\x80\xd0\xb5\xd0\xbc\xd1\x8f" +
+	"\xd1\x82\xd0\xb0\xd0\xba\xd0\xb6\xd0\xb5\xd1\x87\xd1\x82\xd0\xbe\xd0\xb1\xd1\x8b\xd0\xb1\xd0\xbe\xd0\xbb\xd0\xb5\xd0\xb5\xd0\xbe\xd1\x87\xd0\xb5\xd0\xbd\xd1\x8c\xd1\x8d\xd1\x82\xd0\xbe\xd0\xb3" +
+	"\xd0\xbe\xd0\xba\xd0\xbe\xd0\xb3\xd0\xb4\xd0\xb0\xd0\xbf\xd0\xbe\xd1\x81\xd0\xbb\xd0\xb5\xd0\xb2\xd1\x81\xd0\xb5\xd0\xb3\xd0\xbe\xd1\x81\xd0\xb0\xd0\xb9\xd1\x82\xd0\xb5\xd1\x87\xd0\xb5\xd1\x80" +
+	"\xd0\xb5\xd0\xb7\xd0\xbc\xd0\xbe\xd0\xb3\xd1\x83\xd1\x82\xd1\x81\xd0\xb0\xd0\xb9\xd1\x82\xd0\xb0\xd0\xb6\xd0\xb8\xd0\xb7\xd0\xbd\xd0\xb8\xd0\xbc\xd0\xb5\xd0\xb6\xd0\xb4\xd1\x83\xd0\xb1\xd1\x83" +
+	"\xd0\xb4\xd1\x83\xd1\x82\xd0\x9f\xd0\xbe\xd0\xb8\xd1\x81\xd0\xba\xd0\xb7\xd0\xb4\xd0\xb5\xd1\x81\xd1\x8c\xd0\xb2\xd0\xb8\xd0\xb4\xd0\xb5\xd0\xbe\xd1\x81\xd0\xb2\xd1\x8f\xd0\xb7\xd0\xb8\xd0\xbd" +
+	"\xd1\x83\xd0\xb6\xd0\xbd\xd0\xbe\xd1\x81\xd0\xb2\xd0\xbe\xd0\xb5\xd0\xb9\xd0\xbb\xd1\x8e\xd0\xb4\xd0\xb5\xd0\xb9\xd0\xbf\xd0\xbe\xd1\x80\xd0\xbd\xd0\xbe\xd0\xbc\xd0\xbd\xd0\xbe\xd0\xb3\xd0\xbe" +
+	"\xd0\xb4\xd0\xb5\xd1\x82\xd0\xb5\xd0\xb9\xd1\x81\xd0\xb2\xd0\xbe\xd0\xb8\xd1\x85\xd0\xbf\xd1\x80\xd0\xb0\xd0\xb2\xd0\xb0\xd1\x82\xd0\xb0\xd0\xba\xd0\xbe\xd0\xb9\xd0\xbc\xd0\xb5\xd1\x81\xd1\x82" +
+	"\xd0\xbe\xd0\xb8\xd0\xbc\xd0\xb5\xd0\xb5\xd1\x82\xd0\xb6\xd0\xb8\xd0\xb7\xd0\xbd\xd1\x8c\xd0\xbe\xd0\xb4\xd0\xbd\xd0\xbe\xd0\xb9\xd0\xbb\xd1\x83\xd1\x87\xd1\x88\xd0\xb5\xd0\xbf\xd0\xb5\xd1\x80" +
+	"\xd0\xb5\xd0\xb4\xd1\x87\xd0\xb0\xd1\x81\xd1\x82\xd0\xb8\xd1\x87\xd0\xb0\xd1\x81\xd1\x82\xd1\x8c\xd1\x80\xd0\xb0\xd0\xb1\xd0\xbe\xd1\x82\xd0\xbd\xd0\xbe\xd0\xb2\xd1\x8b\xd1\x85\xd0\xbf\xd1\x80" +
+	"\xd0\xb0\xd0\xb2\xd0\xbe\xd1\x81\xd0\xbe\xd0\xb1\xd0\xbe\xd0\xb9\xd0\xbf\xd0\xbe\xd1\x82\xd0\xbe\xd0\xbc\xd0\xbc\xd0\xb5\xd0\xbd\xd0\xb5\xd0\xb5\xd1\x87\xd0\xb8\xd1\x81\xd0\xbb\xd0\xb5\xd0\xbd" +
+	"\xd0\xbe\xd0\xb2\xd1\x8b\xd0\xb5\xd1\x83\xd1\x81\xd0\xbb\xd1\x83\xd0\xb3\xd0\xbe\xd0\xba\xd0\xbe\xd0\xbb\xd0\xbe\xd0\xbd\xd0\xb0\xd0\xb7\xd0\xb0\xd0\xb4\xd1\x82\xd0\xb0\xd0\xba\xd0\xbe\xd0\xb5" +
+	"\xd1\x82\xd0\xbe\xd0\xb3\xd0\xb4\xd0\xb0\xd0\xbf\xd0\xbe\xd1\x87\xd1\x82\xd0\xb8\xd0\x9f\xd0\xbe\xd1\x81\xd0\xbb\xd0\xb5\xd1\x82\xd0\xb0\xd0\xba\xd0\xb8\xd0\xb5\xd0\xbd\xd0\xbe\xd0\xb2\xd1\x8b" +
+	"\xd0\xb9\xd1\x81\xd1\x82\xd0\xbe\xd0\xb8\xd1\x82\xd1\x82\xd0\xb0\xd0\xba\xd0\xb8\xd1\x85\xd1\x81\xd1\x80\xd0\xb0\xd0\xb7\xd1\x83\xd0\xa1\xd0\xb0\xd0\xbd\xd0\xba\xd1\x82\xd1\x84\xd0\xbe\xd1\x80" +
+	"\xd1\x83\xd0\xbc\xd0\x9a\xd0\xbe\xd0\xb3\xd0\xb4\xd0\xb0\xd0\xba\xd0\xbd\xd0\xb8\xd0\xb3\xd0\xb8\xd1\x81\xd0\xbb\xd0\xbe\xd0\xb2\xd0\xb0\xd0\xbd\xd0\xb0\xd1\x88\xd0\xb5\xd0\xb9\xd0\xbd\xd0\xb0" +
+	"\xd0\xb9\xd1\x82\xd0\xb8\xd1\x81\xd0\xb2\xd0\xbe\xd0\xb8\xd0\xbc\xd1\x81\xd0\xb2\xd1\x8f\xd0\xb7\xd1\x8c\xd0\xbb\xd1\x8e\xd0\xb1\xd0\xbe\xd0\xb9\xd1\x87\xd0\xb0\xd1\x81\xd1\x82\xd0\xbe\xd1\x81" +
+	"\xd1\x80\xd0\xb5\xd0\xb4\xd0\xb8\xd0\x9a\xd1\x80\xd0\xbe\xd0\xbc\xd0\xb5\xd0\xa4\xd0\xbe\xd1\x80\xd1\x83\xd0\xbc\xd1\x80\xd1\x8b\xd0\xbd\xd0\xba\xd0\xb5\xd1\x81\xd1\x82\xd0\xb0\xd0\xbb\xd0\xb8" +
+	"\xd0\xbf\xd0\xbe\xd0\xb8\xd1\x81\xd0\xba\xd1\x82\xd1\x8b\xd1\x81\xd1\x8f\xd1\x87\xd0\xbc\xd0\xb5\xd1\x81\xd1\x8f\xd1\x86\xd1\x86\xd0\xb5\xd0\xbd\xd1\x82\xd1\x80\xd1\x82\xd1\x80\xd1\x83\xd0\xb4" +
+	"\xd0\xb0\xd1\x81\xd0\xb0\xd0\xbc\xd1\x8b\xd1\x85\xd1\x80\xd1\x8b\xd0\xbd\xd0\xba\xd0\xb0\xd0\x9d\xd0\xbe\xd0\xb2\xd1\x8b\xd0\xb9\xd1\x87\xd0\xb0\xd1\x81\xd0\xbe\xd0\xb2\xd0\xbc\xd0\xb5\xd1\x81" +
+	"\xd1\x82\xd0\xb0\xd1\x84\xd0\xb8\xd0\xbb\xd1\x8c\xd0\xbc\xd0\xbc\xd0\xb0\xd1\x80\xd1\x82\xd0\xb0\xd1\x81\xd1\x82\xd1\x80\xd0\xb0\xd0\xbd\xd0\xbc\xd0\xb5\xd1\x81\xd1\x82\xd0\xb5\xd1\x82\xd0\xb5" +
+	"\xd0\xba\xd1\x81\xd1\x82\xd0\xbd\xd0\xb0\xd1\x88\xd0\xb8\xd1\x85\xd0\xbc\xd0\xb8\xd0\xbd\xd1\x83\xd1\x82\xd0\xb8\xd0\xbc\xd0\xb5\xd0\xbd\xd0\xb8\xd0\xb8\xd0\xbc\xd0\xb5\xd1\x8e\xd1\x82\xd0\xbd" +
+	"\xd0\xbe\xd0\xbc\xd0\xb5\xd1\x80\xd0\xb3\xd0\xbe\xd1\x80\xd0\xbe\xd0\xb4\xd1\x81\xd0\xb0\xd0\xbc\xd0\xbe\xd0\xbc\xd1\x8d\xd1\x82\xd0\xbe\xd0\xbc\xd1\x83\xd0\xba\xd0\xbe\xd0\xbd\xd1\x86\xd0\xb5" +
+	"\xd1\x81\xd0\xb2\xd0\xbe\xd0\xb5\xd0\xbc\xd0\xba\xd0\xb0\xd0\xba\xd0\xbe\xd0\xb9\xd0\x90\xd1\x80\xd1\x85\xd0\xb8\xd0\xb2\xd9\x85\xd9\x86\xd8\xaa\xd8\xaf\xd9\x89\xd8\xa5\xd8\xb1\xd8\xb3\xd8\xa7" +
+	"\xd9\x84\xd8\xb1\xd8\xb3\xd8\xa7\xd9\x84\xd8\xa9\xd8\xa7\xd9\x84\xd8\xb9\xd8\xa7\xd9\x85\xd9\x83\xd8\xaa\xd8\xa8\xd9\x87\xd8\xa7\xd8\xa8\xd8\xb1\xd8\xa7\xd9\x85\xd8\xac\xd8\xa7\xd9\x84\xd9\x8a" +
+	"\xd9\x88\xd9\x85\xd8\xa7\xd9\x84\xd8\xb5\xd9\x88\xd8\xb1\xd8\xac\xd8\xaf\xd9\x8a\xd8\xaf\xd8\xa9\xd8\xa7\xd9\x84\xd8\xb9\xd8\xb6\xd9\x88\xd8\xa5\xd8\xb6\xd8\xa7\xd9\x81\xd8\xa9\xd8\xa7\xd9\x84" +
+	"\xd9\x82\xd8\xb3\xd9\x85\xd8\xa7\xd9\x84\xd8\xb9\xd8\xa7\xd8\xa8\xd8\xaa\xd8\xad\xd9\x85\xd9\x8a\xd9\x84\xd9\x85\xd9\x84\xd9\x81\xd8\xa7\xd8\xaa\xd9\x85\xd9\x84\xd8\xaa\xd9\x82\xd9\x89\xd8\xaa" +
+	"\xd8\xb9\xd8\xaf\xd9\x8a\xd9\x84\xd8\xa7\xd9\x84\xd8\xb4\xd8\xb9\xd8\xb1\xd8\xa3\xd8\xae\xd8\xa8\xd8\xa7\xd8\xb1\xd8\xaa\xd8\xb7\xd9\x88\xd9\x8a\xd8\xb1\xd8\xb9\xd9\x84\xd9\x8a\xd9\x83\xd9\x85" +
+	"\xd8\xa5\xd8\xb1\xd9\x81\xd8\xa7\xd9\x82\xd8\xb7\xd9\x84\xd8\xa8\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd9\x84\xd8\xba\xd8\xa9\xd8\xaa\xd8\xb1\xd8\xaa\xd9\x8a\xd8\xa8\xd8\xa7\xd9\x84\xd9\x86\xd8\xa7" +
+	"\xd8\xb3\xd8\xa7\xd9\x84\xd8\xb4\xd9\x8a\xd8\xae\xd9\x85\xd9\x86\xd8\xaa\xd8\xaf\xd9\x8a\xd8\xa7\xd9\x84\xd8\xb9\xd8\xb1\xd8\xa8\xd8\xa7\xd9\x84\xd9\x82\xd8\xb5\xd8\xb5\xd8\xa7\xd9\x81\xd9\x84" +
+	"\xd8\xa7\xd9\x85\xd8\xb9\xd9\x84\xd9\x8a\xd9\x87\xd8\xa7\xd8\xaa\xd8\xad\xd8\xaf\xd9\x8a\xd8\xab\xd8\xa7\xd9\x84\xd9\x84\xd9\x87\xd9\x85\xd8\xa7\xd9\x84\xd8\xb9\xd9\x85\xd9\x84\xd9\x85\xd9\x83" +
+	"\xd8\xaa\xd8\xa8\xd8\xa9\xd9\x8a\xd9\x85\xd9\x83\xd9\x86\xd9\x83\xd8\xa7\xd9\x84\xd8\xb7\xd9\x81\xd9\x84\xd9\x81\xd9\x8a\xd8\xaf\xd9\x8a\xd9\x88\xd8\xa5\xd8\xaf\xd8\xa7\xd8\xb1\xd8\xa9\xd8\xaa" +
+	"\xd8\xa7\xd8\xb1\xd9\x8a\xd8\xae\xd8\xa7\xd9\x84\xd8\xb5\xd8\xad\xd8\xa9\xd8\xaa\xd8\xb3\xd8\xac\xd9\x8a\xd9\x84\xd8\xa7\xd9\x84\xd9\x88\xd9\x82\xd8\xaa\xd8\xb9\xd9\x86\xd8\xaf\xd9\x85\xd8\xa7" +
+	"\xd9\x85\xd8\xaf\xd9\x8a\xd9\x86\xd8\xa9\xd8\xaa\xd8\xb5\xd9\x85\xd9\x8a\xd9\x85\xd8\xa3\xd8\xb1\xd8\xb4\xd9\x8a\xd9\x81\xd8\xa7\xd9\x84\xd8\xb0\xd9\x8a\xd9\x86\xd8\xb9\xd8\xb1\xd8\xa8\xd9\x8a" +
+	"\xd8\xa9\xd8\xa8\xd9\x88\xd8\xa7\xd8\xa8\xd8\xa9\xd8\xa3\xd9\x84\xd8\xb9\xd8\xa7\xd8\xa8\xd8\xa7\xd9\x84\xd8\xb3\xd9\x81\xd8\xb1\xd9\x85\xd8\xb4\xd8\xa7\xd9\x83\xd9\x84\xd8\xaa\xd8\xb9\xd8\xa7" +
+	"\xd9\x84\xd9\x89\xd8\xa7\xd9\x84\xd8\xa3\xd9\x88\xd9\x84\xd8\xa7\xd9\x84\xd8\xb3\xd9\x86\xd8\xa9\xd8\xac\xd8\xa7\xd9\x85\xd8\xb9\xd8\xa9\xd8\xa7\xd9\x84\xd8\xb5\xd8\xad\xd9\x81\xd8\xa7\xd9\x84" +
+	"\xd8\xaf\xd9\x8a\xd9\x86\xd9\x83\xd9\x84\xd9\x85\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd8\xae\xd8\xa7\xd8\xb5\xd8\xa7\xd9\x84\xd9\x85\xd9\x84\xd9\x81\xd8\xa3\xd8\xb9\xd8\xb6\xd8\xa7\xd8\xa1\xd9\x83" +
+	"\xd8\xaa\xd8\xa7\xd8\xa8\xd8\xa9\xd8\xa7\xd9\x84\xd8\xae\xd9\x8a\xd8\xb1\xd8\xb1\xd8\xb3\xd8\xa7\xd8\xa6\xd9\x84\xd8\xa7\xd9\x84\xd9\x82\xd9\x84\xd8\xa8\xd8\xa7\xd9\x84\xd8\xa3\xd8\xaf\xd8\xa8" +
+	"\xd9\x85\xd9\x82\xd8\xa7\xd8\xb7\xd8\xb9\xd9\x85\xd8\xb1\xd8\xa7\xd8\xb3\xd9\x84\xd9\x85\xd9\x86\xd8\xb7\xd9\x82\xd8\xa9\xd8\xa7\xd9\x84\xd9\x83\xd8\xaa\xd8\xa8\xd8\xa7\xd9\x84\xd8\xb1\xd8\xac" +
+	"\xd9\x84\xd8\xa7\xd8\xb4\xd8\xaa\xd8\xb1\xd9\x83\xd8\xa7\xd9\x84\xd9\x82\xd8\xaf\xd9\x85\xd9\x8a\xd8\xb9\xd8\xb7\xd9\x8a\xd9\x83sByTagName(.jpg\"" +
+	" alt=\"1px solid #.gif\" alt=\"transparentinformati" +
+	"onapplication\" onclick=\"establishedadvertising.p" +
+	"ng\" alt=\"environmentperformanceappropriate&amp;m" +
+	"dash;immediately</strong></rather thantemperatur" +
+	"edevelopmentcompetitionplaceholdervisibility:cop" +
+	"yright\">0\" height=\"even thoughreplacementdestina" +
+	"tionCorporation<ul class=\"Associationindividuals" +
+	"perspectivesetTimeout(url(http://mathematicsmarg" +
+	"in-top:eventually description) no-repeatcollecti" +
+	"ons.JPG|thumb|participate/head><bodyfloat:left;<" +
+	"li class=\"hundreds of\x0a\x0aHowever, compositionclear" +
+	":both;cooperationwithin the label for=\"border-to" +
+	"p:New Zealandrecommendedphotographyinteresting&l" +
+	"t;sup&gt;controversyNetherlandsalternativemaxlen" +
+	"gth=\"switzerlandDevelopmentessentially\x0a\x0aAlthough" +
+	" </textarea>thunderbirdrepresented&amp;ndash;spe" +
+	"culationcommunitieslegislationelectronics\x0a\x09<div " +
+	"id=\"illustratedengineeringterritoriesauthorities" +
+	"distributed6\" height=\"sans-serif;capable of disa" +
+	"ppearedinteractivelooking forit would beAfghanis" +
+	"tanwas createdMath.floor(surroundingcan also beo" +
+	"bservationmaintenanceencountered<h2 class=\"more " +
+	"recentit has beeninvasion of).getTime()fundament" +
+	"alDespite the\"><div id=\"inspirationexaminationpr" +
+	"eparationexplanation<input id=\"</a></span>versio" +
+	"ns ofinstrumentsbefore the  = 'http://Descriptio" +
+	"nrelatively .substring(each of theexperimentsinf" +
+	"luentialintegrationmany peopledue to the combina" +
+	"tiondo not haveMiddle East<noscript><copyright\" " +
+	"perhaps theinstitutionin Decemberarrangementmost" +
+	" famouspersonalitycreation oflimitationsexclusiv" +
+	"elysovereignty-content\">\x0a<td class=\"undergroundp" +
+	"arallel todoctrine ofoccupied byterminologyRenai" +
+	"ssancea number ofsupport forexplorationrecogniti" +
+	"onpredecessor<img src=\"/<h1 class=\"publicationma" +
+	"y also bespecialized</fieldset>progressivemillio" +
+	"ns ofstates thatenforcementaround the one anothe" +
+	"r.parentNodeagricultureAlternativeresearcherstow" +
+	"ards theMost of themany other (especially<td wid" +
+	"th=\";width:100%independent<h3 class=\" onchange=\"" +
+	").addClass(interactionOne of the daughter ofacce" +
+	"ssoriesbranches of\x0d\x0a<div id=\"the largestdeclarat" +
+	"ionregulationsInformationtranslationdocumentaryi" +
+	"n order to\">\x0a<head>\x0a<\" height=\"1across the orien" +
+	"tation);</script>implementedcan be seenthere was" +
+	" ademonstratecontainer\">connectionsthe Britishwa" +
+	"s written!important;px; margin-followed byabilit" +
+	"y to complicatedduring the immigrationalso calle" +
+	"d<h4 class=\"distinctionreplaced bygovernmentsloc" +
+	"ation ofin Novemberwhether the</p>\x0a</div>acquisi" +
+	"tioncalled the persecutiondesignation{font-size:" +
+	"appeared ininvestigateexperiencedmost likelywide" +
+	"ly useddiscussionspresence of (document.extensiv" +
+	"elyIt has beenit does notcontrary toinhabitantsi" +
+	"mprovementscholarshipconsumptioninstructionfor e" +
+	"xampleone or morepx; paddingthe currenta series " +
+	"ofare usuallyrole in thepreviously derivativesev" +
+	"idence ofexperiencescolorschemestated thatcertif" +
+	"icate</a></div>\x0a selected=\"high schoolresponse t" +
+	"ocomfortableadoption ofthree yearsthe countryin " +
+	"Februaryso that thepeople who provided by<param " +
+	"nameaffected byin terms ofappointmentISO-8859-1\"" +
+	"was born inhistorical regarded asmeasurementis b" +
+	"ased on and other : function(significantcelebrat" +
+	"iontransmitted/js/jquery.is known astheoretical " +
+	"tabindex=\"it could be<noscript>\x0ahaving been\x0d\x0a<he" +
+	"ad>\x0d\x0a< &quot;The compilationhe had beenproduced " +
+	"byphilosopherconstructedintended toamong otherco" +
+	"mpared toto say thatEngineeringa differentreferr" +
+	"ed todifferencesbelief thatphotographsidentifyin" +
+	"gHistory of Republic ofnecessarilyprobabilitytec" +
+	"hnicallyleaving thespectacularfraction ofelectri" +
+	"cityhead of therestaurantspartnershipemphasis on" +
+	"most recentshare with saying thatfilled withdesi" +
+	"gned toit is often\"></iframe>as follows:merged w" +
+	"iththrough thecommercial pointed outopportunityv" +
+	"iew of therequirementdivision ofprogramminghe re" +
+	"ceivedsetInterval\"></span></in New Yorkadditiona" +
+	"l compression\x0a\x0a<div id=\"incorporate;</script><at" +
+	"tachEventbecame the \" target=\"_carried outSome o" +
+	"f thescience andthe time ofContainer\">maintainin" +
+	"gChristopherMuch of thewritings of\" height=\"2siz" +
+	"e of theversion of mixture of between theExample" +
+	"s ofeducationalcompetitive onsubmit=\"director of" +
+	"distinctive/DTD XHTML relating totendency toprov" +
+	"ince ofwhich woulddespite thescientific legislat" +
+	"ure.innerHTML allegationsAgriculturewas used ina" +
+	"pproach tointelligentyears later,sans-serifdeter" +
+	"miningPerformanceappearances, which is foundatio" +
+	"nsabbreviatedhigher thans from the individual co" +
+	"mposed ofsupposed toclaims thatattributionfont-s" +
+	"ize:1elements ofHistorical his brotherat the tim" +
+	"eanniversarygoverned byrelated to ultimately inn" +
+	"ovationsit is stillcan only bedefinitionstoGMTSt" +
+	"ringA number ofimg class=\"Eventually,was changed" +
+	"occurred inneighboringdistinguishwhen he wasintr" +
+	"oducingterrestrialMany of theargues thatan Ameri" +
+	"canconquest ofwidespread were killedscreen and I" +
+	"n order toexpected todescendantsare locatedlegis" +
+	"lativegenerations backgroundmost peopleyears aft" +
+	"erthere is nothe highestfrequently they do notar" +
+	"gued thatshowed thatpredominanttheologicalby the" +
+	" timeconsideringshort-lived</span></a>can be use" +
+	"dvery littleone of the had alreadyinterpretedcom" +
+	"municatefeatures ofgovernment,</noscript>entered" +
+	" the\" height=\"3Independentpopulationslarge-scale" +
+	". Although used in thedestructionpossibilitystar" +
+	"ting intwo or moreexpressionssubordinatelarger t" +
+	"hanhistory and</option>\x0d\x0aContinentaleliminatingw" +
+	"ill not bepractice ofin front ofsite of theensur" +
+	"e thatto create amississippipotentiallyoutstandi" +
+	"ngbetter thanwhat is nowsituated inmeta name=\"Tr" +
+	"aditionalsuggestionsTranslationthe form ofatmosp" +
+	"hericideologicalenterprisescalculatingeast of th" +
+	"eremnants ofpluginspage/index.php?remained intra" +
+	"nsformedHe was alsowas alreadystatisticalin favo" +
+	"r ofMinistry ofmovement offormulationis required" +
+	"<link rel=\"This is the <a href=\"/popularizedinvo" +
+	"lved inare used toand severalmade by theseems to" +
+	" belikely thatPalestiniannamed afterit had beenm" +
+	"ost commonto refer tobut this isconsecutivetempo" +
+	"rarilyIn general,conventionstakes placesubdivisi" +
+	"onterritorialoperationalpermanentlywas largelyou" +
+	"tbreak ofin the pastfollowing a xmlns:og=\"><a cl" +
+	"ass=\"class=\"textConversion may be usedmanufactur" +
+	"eafter beingclearfix\">\x0aquestion ofwas electedto " +
+	"become abecause of some peopleinspired bysuccess" +
+	"ful a time whenmore commonamongst thean official" +
+	"width:100%;technology,was adoptedto keep thesett" +
+	"lementslive birthsindex.html\"Connecticutassigned" +
+	" to&amp;times;account foralign=rightthe companya" +
+	"lways beenreturned toinvolvementBecause thethis " +
+	"period\" name=\"q\" confined toa result ofvalue=\"\" " +
+	"/>is actuallyEnvironment\x0d\x0a</head>\x0d\x0aConversely,>\x0a" +
+	"<div id=\"0\" width=\"1is probablyhave becomecontro" +
+	"llingthe problemcitizens ofpoliticiansreached th" +
+	"eas early as:none; over<table cellvalidity ofdir" +
+	"ectly toonmousedownwhere it iswhen it wasmembers" +
+	" of relation toaccommodatealong with In the late" +
+	"the Englishdelicious\">this is notthe presentif t" +
+	"hey areand finallya matter of\x0d\x0a\x09</div>\x0d\x0a\x0d\x0a</scri" +
+	"pt>faster thanmajority ofafter whichcomparativet" +
+	"o maintainimprove theawarded theer\" class=\"frame" +
+	"borderrestorationin the sameanalysis oftheir fir" +
+	"stDuring the continentalsequence offunction(){fo" +
+	"nt-size: work on the</script>\x0a<begins withjavasc" +
+	"ript:constituentwas foundedequilibriumassume tha" +
+	"tis given byneeds to becoordinatesthe variousare" +
+	" part ofonly in thesections ofis a commontheorie" +
+	"s ofdiscoveriesassociationedge of thestrength of" +
+	"position inpresent-dayuniversallyto form thebut " +
+	"insteadcorporationattached tois commonlyreasons " +
+	"for &quot;the can be madewas able towhich meansb" +
+	"ut did notonMouseOveras possibleoperated bycomin" +
+	"g fromthe primaryaddition offor severaltransferr" +
+	"eda period ofare able tohowever, itshould havemu" +
+	"ch larger\x0a\x09</script>adopted theproperty ofdirect" +
+	"ed byeffectivelywas broughtchildren ofProgrammin" +
+	"glonger thanmanuscriptswar againstby means ofand" +
+	" most ofsimilar to proprietaryoriginatingprestig" +
+	"iousgrammaticalexperience.to make theIt was also" +
+	"is found incompetitorsin the U.S.replace thebrou" +
+	"ght thecalculationfall of thethe generalpractica" +
+	"llyin honor ofreleased inresidentialand some ofk" +
+	"ing of thereaction to1st Earl ofculture andprinc" +
+	"ipally</title>\x0a  they can beback to thesome of h" +
+	"isexposure toare similarform of theaddFavoriteci" +
+	"tizenshippart in thepeople within practiceto con" +
+	"tinue&amp;minus;approved by the first allowed th" +
+	"eand for thefunctioningplaying thesolution tohei" +
+	"ght=\"0\" in his bookmore than afollows thecreated" +
+	" thepresence in&nbsp;</td>nationalistthe idea of" +
+	"a characterwere forced class=\"btndays of thefeat" +
+	"ured inshowing theinterest inin place ofturn of " +
+	"thethe head ofLord of thepoliticallyhas its ownE" +
+	"ducationalapproval ofsome of theeach other,behav" +
+	"ior ofand becauseand anotherappeared onrecorded " +
+	"inblack&quot;may includethe world'scan lead tore" +
+	"fers to aborder=\"0\" government winning theresult" +
+	"ed in while the Washington,the subjectcity in th" +
+	"e></div>\x0d\x0a\x09\x09reflect theto completebecame morerad" +
+	"ioactiverejected bywithout anyhis father,which c" +
+	"ouldcopy of theto indicatea politicalaccounts of" +
+	"constitutesworked wither</a></li>of his lifeacco" +
+	"mpaniedclientWidthprevent theLegislativedifferen" +
+	"tlytogether inhas severalfor anothertext of thef" +
+	"ounded thee with the is used forchanged theusual" +
+	"ly theplace wherewhereas the> <a href=\"\"><a href" +
+	"=\"themselves,although hethat can betraditionalro" +
+	"le of theas a resultremoveChilddesigned bywest o" +
+	"f theSome peopleproduction,side of thenewsletter" +
+	"sused by thedown to theaccepted bylive in theatt" +
+	"empts tooutside thefrequenciesHowever, inprogram" +
+	"mersat least inapproximatealthough itwas part of" +
+	"and variousGovernor ofthe articleturned into><a " +
+	"href=\"/the economyis the mostmost widelywould la" +
+	"terand perhapsrise to theoccurs whenunder whichc" +
+	"onditions.the westerntheory thatis producedthe c" +
+	"ity ofin which heseen in thethe centralbuilding " +
+	"ofmany of hisarea of theis the onlymost of thema" +
+	"ny of thethe WesternThere is noextended toStatis" +
+	"ticalcolspan=2 |short storypossible totopologica" +
+	"lcritical ofreported toa Christiandecision tois " +
+	"equal toproblems ofThis can bemerchandisefor mos" +
+	"t ofno evidenceeditions ofelements in&quot;. The" +
+	"com/images/which makesthe processremains thelite" +
+	"rature,is a memberthe popularthe ancientproblems" +
+	" intime of thedefeated bybody of thea few yearsm" +
+	"uch of thethe work ofCalifornia,served as agover" +
+	"nment.concepts ofmovement in\x09\x09<div id=\"it\" value" +
+	"=\"language ofas they areproduced inis that theex" +
+	"plain thediv></div>\x0aHowever thelead to the\x09<a hr" +
+	"ef=\"/was grantedpeople havecontinuallywas seen a" +
+	"sand relatedthe role ofproposed byof the besteac" +
+	"h other.Constantinepeople fromdialects ofto revi" +
+	"sionwas renameda source ofthe initiallaunched in" +
+	"provide theto the westwhere thereand similarbetw" +
+	"een twois also theEnglish andconditions,that it " +
+	"wasentitled tothemselves.quantity ofransparencyt" +
+	"he same asto join thecountry andthis is theThis " +
+	"led toa statementcontrast tolastIndexOfthrough h" +
+	"isis designedthe term isis providedprotect theng" +
+	"</a></li>The currentthe site ofsubstantialexperi" +
+	"ence,in the Westthey shouldsloven\xc4\x8dinacomentario" +
+	"suniversidadcondicionesactividadesexperienciatec" +
+	"nolog\xc3\xadaproducci\xc3\xb3npuntuaci\xc3\xb3naplicaci\xc3\xb3ncontras" +
+	"e\xc3\xb1acategor\xc3\xadasregistrarseprofesionaltratamiento" +
+	"reg\xc3\xadstratesecretar\xc3\xadaprincipalesprotecci\xc3\xb3nimpo" +
+	"rtantesimportanciaposibilidadinteresantecrecimie" +
+	"ntonecesidadessuscribirseasociaci\xc3\xb3ndisponiblese" +
+	"valuaci\xc3\xb3nestudiantesresponsableresoluci\xc3\xb3nguada" +
+	"lajararegistradosoportunidadcomercialesfotograf\xc3" +
+	"\xadaautoridadesingenier\xc3\xadatelevisi\xc3\xb3ncompetenciaop" +
+	"eracionesestablecidosimplementeactualmentenavega" +
+	"ci\xc3\xb3nconformidadline-height:font-family:\" : \"htt" +
+	"p://applicationslink\" href=\"specifically//<![CDA" +
+	"TA[\x0aOrganizationdistribution0px; height:relation" +
+	"shipdevice-width<div class=\"<label for=\"registra" +
+	"tion</noscript>\x0a/index.html\"window.open( !import" +
+	"ant;application/independence//www.googleorganiza" +
+	"tionautocompleterequirementsconservative<form na" +
+	"me=\"intellectualmargin-left:18th centuryan impor" +
+	"tantinstitutionsabbreviation<img class=\"organisa" +
+	"tioncivilization19th centuryarchitectureincorpor" +
+	"ated20th century-container\">most notably/></a></" +
+	"div>notification'undefined')Furthermore,believe " +
+	"thatinnerHTML = prior to thedramaticallyreferrin" +
+	"g tonegotiationsheadquartersSouth Africaunsucces" +
+	"sfulPennsylvaniaAs a result,<html lang=\"&lt;/sup" +
+	"&gt;dealing withphiladelphiahistorically);</scri" +
+	"pt>\x0apadding-top:experimentalgetAttributeinstruct" +
+	"ionstechnologiespart of the =function(){subscrip" +
+	"tionl.dtd\">\x0d\x0a<htgeographicalConstitution', funct" +
+	"ion(supported byagriculturalconstructionpublicat" +
+	"ionsfont-size: 1a variety of<div style=\"Encyclop" +
+	"ediaiframe src=\"demonstratedaccomplisheduniversi" +
+	"tiesDemographics);</script><dedicated toknowledg" +
+	"e ofsatisfactionparticularly</div></div>English " +
+	"(US)appendChild(transmissions. However, intellig" +
+	"ence\" tabindex=\"float:right;Commonwealthranging " +
+	"fromin which theat least onereproductionencyclop" +
+	"edia;font-size:1jurisdictionat that time\"><a cla" +
+	"ss=\"In addition,description+conversationcontact " +
+	"withis generallyr\" content=\"representing&lt;math" +
+	"&gt;presentationoccasionally<img width=\"navigati" +
+	"on\">compensationchampionshipmedia=\"all\" violatio" +
+	"n ofreference toreturn true;Strict//EN\" transact" +
+	"ionsinterventionverificationInformation difficul" +
+	"tiesChampionshipcapabilities<![endif]-->}\x0a</scri" +
+	"pt>\x0aChristianityfor example,Professionalrestrict" +
+	"ionssuggest thatwas released(such as theremoveCl" +
+	"ass(unemploymentthe Americanstructure of/index.h" +
+	"tml published inspan class=\"\"><a href=\"/introduc" +
+	"tionbelonging toclaimed thatconsequences<meta na" +
+	"me=\"Guide to theoverwhelmingagainst the concentr" +
+	"ated,\x0a.nontouch observations</a>\x0a</div>\x0af (docum" +
+	"ent.border: 1px {font-size:1treatment of0\" heigh" +
+	"t=\"1modificationIndependencedivided intogreater " +
+	"thanachievementsestablishingJavaScript\" neverthe" +
+	"lesssignificanceBroadcasting>&nbsp;</td>containe" +
+	"r\">\x0asuch as the influence ofa particularsrc='htt" +
+	"p://navigation\" half of the substantial &nbsp;</" +
+	"div>advantage ofdiscovery offundamental metropol" +
+	"itanthe opposite\" xml:lang=\"deliberatelyalign=ce" +
+	"nterevolution ofpreservationimprovementsbeginnin" +
+	"g inJesus ChristPublicationsdisagreementtext-ali" +
+	"gn:r, function()similaritiesbody></html>is curre" +
+	"ntlyalphabeticalis sometimestype=\"image/many of " +
+	"the flow:hidden;available indescribe theexistenc" +
+	"e ofall over thethe Internet\x09<ul class=\"installa" +
+	"tionneighborhoodarmed forcesreducing thecontinue" +
+	"s toNonetheless,temperatures\x0a\x09\x09<a href=\"close to" +
+	" theexamples of is about the(see below).\" id=\"se" +
+	"archprofessionalis availablethe official\x09\x09</scri" +
+	"pt>\x0a\x0a\x09\x09<div id=\"accelerationthrough the Hall of " +
+	"Famedescriptionstranslationsinterference type='t" +
+	"ext/recent yearsin the worldvery popular{backgro" +
+	"und:traditional some of the connected toexploita" +
+	"tionemergence ofconstitutionA History ofsignific" +
+	"ant manufacturedexpectations><noscript><can be f" +
+	"oundbecause the has not beenneighbouringwithout " +
+	"the added to the\x09<li class=\"instrumentalSoviet U" +
+	"nionacknowledgedwhich can bename for theattentio" +
+	"n toattempts to developmentsIn fact, the<li clas" +
+	"s=\"aimplicationssuitable formuch of the coloniza" +
+	"tionpresidentialcancelBubble Informationmost of " +
+	"the is describedrest of the more or lessin Septe" +
+	"mberIntelligencesrc=\"http://px; height: availabl" +
+	"e tomanufacturerhuman rightslink href=\"/availabi" +
+	"lityproportionaloutside the astronomicalhuman be" +
+	"ingsname of the are found inare based onsmaller " +
+	"thana person whoexpansion ofarguing thatnow know" +
+	"n asIn the earlyintermediatederived fromScandina" +
+	"vian</a></div>\x0d\x0aconsider thean estimatedthe Nati" +
+	"onal<div id=\"pagresulting incommissionedanalogou" +
+	"s toare required/ul>\x0a</div>\x0awas based onand beca" +
+	"me a&nbsp;&nbsp;t\" value=\"\" was capturedno more " +
+	"thanrespectivelycontinue to >\x0d\x0a<head>\x0d\x0a<were cre" +
+	"atedmore generalinformation used for theindepend" +
+	"ent the Imperialcomponent ofto the northinclude " +
+	"the Constructionside of the would not befor inst" +
+	"anceinvention ofmore complexcollectivelybackgrou" +
+	"nd: text-align: its originalinto accountthis pro" +
+	"cessan extensivehowever, thethey are notrejected" +
+	" thecriticism ofduring whichprobably thethis art" +
+	"icle(function(){It should bean agreementaccident" +
+	"allydiffers fromArchitecturebetter knownarrangem" +
+	"entsinfluence onattended theidentical tosouth of" +
+	" thepass throughxml\" title=\"weight:bold;creating" +
+	" thedisplay:nonereplaced the<img src=\"/ihttps://" +
+	"www.World War IItestimonialsfound in therequired" +
+	" to and that thebetween the was designedconsists" +
+	" of considerablypublished bythe languageConserva" +
+	"tionconsisted ofrefer to theback to the css\" med" +
+	"ia=\"People from available onproved to besuggesti" +
+	"ons\"was known asvarieties oflikely to becomprise" +
+	"d ofsupport the hands of thecoupled withconnect " +
+	"and border:none;performancesbefore beinglater be" +
+	"camecalculationsoften calledresidents ofmeaning " +
+	"that><li class=\"evidence forexplanationsenvironm" +
+	"ents\"></a></div>which allowsIntroductiondevelope" +
+	"d bya wide rangeon behalf ofvalign=\"top\"principl" +
+	"e ofat the time,</noscript>\x0dsaid to havein the f" +
+	"irstwhile othershypotheticalphilosopherspower of" +
+	" thecontained inperformed byinability towere wri" +
+	"ttenspan style=\"input name=\"the questionintended" +
+	" forrejection ofimplies thatinvented thethe stan" +
+	"dardwas probablylink betweenprofessor ofinteract" +
+	"ionschanging theIndian Ocean class=\"lastworking " +
+	"with'http://www.years beforeThis was therecreati" +
+	"onalentering themeasurementsan extremelyvalue of" +
+	" thestart of the\x0a</script>\x0a\x0aan effort toincrease" +
+	" theto the southspacing=\"0\">sufficientlythe Euro" +
+	"peanconverted toclearTimeoutdid not haveconseque" +
+	"ntlyfor the nextextension ofeconomic andalthough" +
+	" theare producedand with theinsufficientgiven by" +
+	" thestating thatexpenditures</span></a>\x0athought " +
+	"thaton the basiscellpadding=image of thereturnin" +
+	"g toinformation,separated byassassinateds\" conte" +
+	"nt=\"authority ofnorthwestern</div>\x0a<div \"></div>" +
+	"\x0d\x0a  consultationcommunity ofthe nationalit shoul" +
+	"d beparticipants align=\"leftthe greatestselectio" +
+	"n ofsupernaturaldependent onis mentionedallowing" +
+	" thewas inventedaccompanyinghis personalavailabl" +
+	"e atstudy of theon the otherexecution ofHuman Ri" +
+	"ghtsterms of theassociationsresearch andsucceede" +
+	"d bydefeated theand from thebut they arecommande" +
+	"r ofstate of theyears of agethe study of<ul clas" +
+	"s=\"splace in thewhere he was<li class=\"fthere ar" +
+	"e nowhich becamehe publishedexpressed into which" +
+	" thecommissionerfont-weight:territory ofextensio" +
+	"ns\">Roman Empireequal to theIn contrast,however," +
+	" andis typicallyand his wife(also called><ul cla" +
+	"ss=\"effectively evolved intoseem to havewhich is" +
+	" thethere was noan excellentall of thesedescribe" +
+	"d byIn practice,broadcastingcharged withreflecte" +
+	"d insubjected tomilitary andto the pointeconomic" +
+	"allysetTargetingare actuallyvictory over();</scr" +
+	"ipt>continuouslyrequired forevolutionaryan effec" +
+	"tivenorth of the, which was front of theor other" +
+	"wisesome form ofhad not beengenerated byinformat" +
+	"ion.permitted toincludes thedevelopment,entered " +
+	"intothe previousconsistentlyare known asthe fiel" +
+	"d ofthis type ofgiven to thethe title ofcontains" +
+	" theinstances ofin the northdue to theirare desi" +
+	"gnedcorporationswas that theone of thesemore pop" +
+	"ularsucceeded insupport fromin differentdominate" +
+	"d bydesigned forownership ofand possiblystandard" +
+	"izedresponseTextwas intendedreceived theassumed " +
+	"thatareas of theprimarily inthe basis ofin the s" +
+	"enseaccounts fordestroyed byat least twowas decl" +
+	"aredcould not beSecretary ofappear to bemargin-t" +
+	"op:1/^\\s+|\\s+$/ge){throw e};the start oftwo sepa" +
+	"ratelanguage andwho had beenoperation ofdeath of" +
+	" thereal numbers\x09<link rel=\"provided thethe stor" +
+	"y ofcompetitionsenglish (UK)english (US)\xd0\x9c\xd0\xbe\xd0\xbd\xd0\xb3" +
+	"\xd0\xbe\xd0\xbb\xd0\xa1\xd1\x80\xd0\xbf\xd1\x81\xd0\xba\xd0\xb8\xd1\x81\xd1\x80\xd0\xbf\xd1\x81\xd0\xba\xd0\xb8\xd1\x81\xd1\x80\xd0\xbf\xd1\x81\xd0\xba\xd0\xbe\xd9\x84\xd8\xb9\xd8\xb1\xd8\xa8" +
+	"\xd9\x8a\xd8\xa9\xe6\xad\xa3\xe9\xab\x94\xe4\xb8\xad\xe6\x96\x87\xe7\xae\x80\xe4\xbd\x93\xe4\xb8\xad\xe6\x96\x87\xe7\xb9\x81\xe4\xbd\x93\xe4\xb8\xad\xe6\x96\x87\xe6\x9c\x89\xe9\x99\x90\xe5\x85" +
+	"\xac\xe5\x8f\xb8\xe4\xba\xba\xe6\xb0\x91\xe6\x94\xbf\xe5\xba\x9c\xe9\x98\xbf\xe9\x87\x8c\xe5\xb7\xb4\xe5\xb7\xb4\xe7\xa4\xbe\xe4\xbc\x9a\xe4\xb8\xbb\xe4\xb9\x89\xe6\x93\x8d\xe4\xbd\x9c\xe7\xb3" +
+	"\xbb\xe7\xbb\x9f\xe6\x94\xbf\xe7\xad\x96\xe6\xb3\x95\xe8\xa7\x84informaci\xc3\xb3nherramientaselectr\xc3\xb3" +
+	"nicodescripci\xc3\xb3nclasificadosconocimientopublicac" +
+	"i\xc3\xb3nrelacionadasinform\xc3\xa1ticarelacionadosdepartam" +
+	"entotrabajadoresdirectamenteayuntamientomercadoL" +
+	"ibrecont\xc3\xa1ctenoshabitacionescumplimientorestaura" +
+	"ntesdisposici\xc3\xb3nconsecuenciaelectr\xc3\xb3nicaaplicaci" +
+	"onesdesconectadoinstalaci\xc3\xb3nrealizaci\xc3\xb3nutilizac" +
+	"i\xc3\xb3nenciclopediaenfermedadesinstrumentosexperien" +
+	"ciasinstituci\xc3\xb3nparticularessubcategoria\xd1\x82\xd0\xbe\xd0\xbb\xd1\x8c" +
+	"\xd0\xba\xd0\xbe\xd0\xa0\xd0\xbe\xd1\x81\xd1\x81\xd0\xb8\xd0\xb8\xd1\x80\xd0\xb0\xd0\xb1\xd0\xbe\xd1\x82\xd1\x8b\xd0\xb1\xd0\xbe\xd0\xbb\xd1\x8c\xd1\x88\xd0\xb5\xd0\xbf\xd1\x80\xd0\xbe\xd1\x81" +
+	"\xd1\x82\xd0\xbe\xd0\xbc\xd0\xbe\xd0\xb6\xd0\xb5\xd1\x82\xd0\xb5\xd0\xb4\xd1\x80\xd1\x83\xd0\xb3\xd0\xb8\xd1\x85\xd1\x81\xd0\xbb\xd1\x83\xd1\x87\xd0\xb0\xd0\xb5\xd1\x81\xd0\xb5\xd0\xb9\xd1\x87" +
+	"\xd0\xb0\xd1\x81\xd0\xb2\xd1\x81\xd0\xb5\xd0\xb3\xd0\xb4\xd0\xb0\xd0\xa0\xd0\xbe\xd1\x81\xd1\x81\xd0\xb8\xd1\x8f\xd0\x9c\xd0\xbe\xd1\x81\xd0\xba\xd0\xb2\xd0\xb5\xd0\xb4\xd1\x80\xd1\x83\xd0\xb3" +
+	"\xd0\xb8\xd0\xb5\xd0\xb3\xd0\xbe\xd1\x80\xd0\xbe\xd0\xb4\xd0\xb0\xd0\xb2\xd0\xbe\xd0\xbf\xd1\x80\xd0\xbe\xd1\x81\xd0\xb4\xd0\xb0\xd0\xbd\xd0\xbd\xd1\x8b\xd1\x85\xd0\xb4\xd0\xbe\xd0\xbb\xd0\xb6" +
+	"\xd0\xbd\xd1\x8b\xd0\xb8\xd0\xbc\xd0\xb5\xd0\xbd\xd0\xbd\xd0\xbe\xd0\x9c\xd0\xbe\xd1\x81\xd0\xba\xd0\xb2\xd1\x8b\xd1\x80\xd1\x83\xd0\xb1\xd0\xbb\xd0\xb5\xd0\xb9\xd0\x9c\xd0\xbe\xd1\x81\xd0\xba" +
+	"\xd0\xb2\xd0\xb0\xd1\x81\xd1\x82\xd1\x80\xd0\xb0\xd0\xbd\xd1\x8b\xd0\xbd\xd0\xb8\xd1\x87\xd0\xb5\xd0\xb3\xd0\xbe\xd1\x80\xd0\xb0\xd0\xb1\xd0\xbe\xd1\x82\xd0\xb5\xd0\xb4\xd0\xbe\xd0\xbb\xd0\xb6" +
+	"\xd0\xb5\xd0\xbd\xd1\x83\xd1\x81\xd0\xbb\xd1\x83\xd0\xb3\xd0\xb8\xd1\x82\xd0\xb5\xd0\xbf\xd0\xb5\xd1\x80\xd1\x8c\xd0\x9e\xd0\xb4\xd0\xbd\xd0\xb0\xd0\xba\xd0\xbe\xd0\xbf\xd0\xbe\xd1\x82\xd0\xbe" +
+	"\xd0\xbc\xd1\x83\xd1\x80\xd0\xb0\xd0\xb1\xd0\xbe\xd1\x82\xd1\x83\xd0\xb0\xd0\xbf\xd1\x80\xd0\xb5\xd0\xbb\xd1\x8f\xd0\xb2\xd0\xbe\xd0\xbe\xd0\xb1\xd1\x89\xd0\xb5\xd0\xbe\xd0\xb4\xd0\xbd\xd0\xbe" +
+	"\xd0\xb3\xd0\xbe\xd1\x81\xd0\xb2\xd0\xbe\xd0\xb5\xd0\xb3\xd0\xbe\xd1\x81\xd1\x82\xd0\xb0\xd1\x82\xd1\x8c\xd0\xb8\xd0\xb4\xd1\x80\xd1\x83\xd0\xb3\xd0\xbe\xd0\xb9\xd1\x84\xd0\xbe\xd1\x80\xd1\x83" +
+	"\xd0\xbc\xd0\xb5\xd1\x85\xd0\xbe\xd1\x80\xd0\xbe\xd1\x88\xd0\xbe\xd0\xbf\xd1\x80\xd0\xbe\xd1\x82\xd0\xb8\xd0\xb2\xd1\x81\xd1\x81\xd1\x8b\xd0\xbb\xd0\xba\xd0\xb0\xd0\xba\xd0\xb0\xd0\xb6\xd0\xb4" +
+	"\xd1\x8b\xd0\xb9\xd0\xb2\xd0\xbb\xd0\xb0\xd1\x81\xd1\x82\xd0\xb8\xd0\xb3\xd1\x80\xd1\x83\xd0\xbf\xd0\xbf\xd1\x8b\xd0\xb2\xd0\xbc\xd0\xb5\xd1\x81\xd1\x82\xd0\xb5\xd1\x80\xd0\xb0\xd0\xb1\xd0\xbe" +
+	"\xd1\x82\xd0\xb0\xd1\x81\xd0\xba\xd0\xb0\xd0\xb7\xd0\xb0\xd0\xbb\xd0\xbf\xd0\xb5\xd1\x80\xd0\xb2\xd1\x8b\xd0\xb9\xd0\xb4\xd0\xb5\xd0\xbb\xd0\xb0\xd1\x82\xd1\x8c\xd0\xb4\xd0\xb5\xd0\xbd\xd1\x8c" +
+	"\xd0\xb3\xd0\xb8\xd0\xbf\xd0\xb5\xd1\x80\xd0\xb8\xd0\xbe\xd0\xb4\xd0\xb1\xd0\xb8\xd0\xb7\xd0\xbd\xd0\xb5\xd1\x81\xd0\xbe\xd1\x81\xd0\xbd\xd0\xbe\xd0\xb2\xd0\xb5\xd0\xbc\xd0\xbe\xd0\xbc\xd0\xb5" +
+	"\xd0\xbd\xd1\x82\xd0\xba\xd1\x83\xd0\xbf\xd0\xb8\xd1\x82\xd1\x8c\xd0\xb4\xd0\xbe\xd0\xbb\xd0\xb6\xd0\xbd\xd0\xb0\xd1\x80\xd0\xb0\xd0\xbc\xd0\xba\xd0\xb0\xd1\x85\xd0\xbd\xd0\xb0\xd1\x87\xd0\xb0" +
+	"\xd0\xbb\xd0\xbe\xd0\xa0\xd0\xb0\xd0\xb1\xd0\xbe\xd1\x82\xd0\xb0\xd0\xa2\xd0\xbe\xd0\xbb\xd1\x8c\xd0\xba\xd0\xbe\xd1\x81\xd0\xbe\xd0\xb2\xd1\x81\xd0\xb5\xd0\xbc\xd0\xb2\xd1\x82\xd0\xbe\xd1\x80" +
+	"\xd0\xbe\xd0\xb9\xd0\xbd\xd0\xb0\xd1\x87\xd0\xb0\xd0\xbb\xd0\xb0\xd1\x81\xd0\xbf\xd0\xb8\xd1\x81\xd0\xbe\xd0\xba\xd1\x81\xd0\xbb\xd1\x83\xd0\xb6\xd0\xb1\xd1\x8b\xd1\x81\xd0\xb8\xd1\x81\xd1\x82" +
+	"\xd0\xb5\xd0\xbc\xd0\xbf\xd0\xb5\xd1\x87\xd0\xb0\xd1\x82\xd0\xb8\xd0\xbd\xd0\xbe\xd0\xb2\xd0\xbe\xd0\xb3\xd0\xbe\xd0\xbf\xd0\xbe\xd0\xbc\xd0\xbe\xd1\x89\xd0\xb8\xd1\x81\xd0\xb0\xd0\xb9\xd1\x82" +
+	"\xd0\xbe\xd0\xb2\xd0\xbf\xd0\xbe\xd1\x87\xd0\xb5\xd0\xbc\xd1\x83\xd0\xbf\xd0\xbe\xd0\xbc\xd0\xbe\xd1\x89\xd1\x8c\xd0\xb4\xd0\xbe\xd0\xbb\xd0\xb6\xd0\xbd\xd0\xbe\xd1\x81\xd1\x81\xd1\x8b\xd0\xbb" +
+	"\xd0\xba\xd0\xb8\xd0\xb1\xd1\x8b\xd1\x81\xd1\x82\xd1\x80\xd0\xbe\xd0\xb4\xd0\xb0\xd0\xbd\xd0\xbd\xd1\x8b\xd0\xb5\xd0\xbc\xd0\xbd\xd0\xbe\xd0\xb3\xd0\xb8\xd0\xb5\xd0\xbf\xd1\x80\xd0\xbe\xd0\xb5" +
+	"\xd0\xba\xd1\x82\xd0\xa1\xd0\xb5\xd0\xb9\xd1\x87\xd0\xb0\xd1\x81\xd0\xbc\xd0\xbe\xd0\xb4\xd0\xb5\xd0\xbb\xd0\xb8\xd1\x82\xd0\xb0\xd0\xba\xd0\xbe\xd0\xb3\xd0\xbe\xd0\xbe\xd0\xbd\xd0\xbb\xd0\xb0" +
+	"\xd0\xb9\xd0\xbd\xd0\xb3\xd0\xbe\xd1\x80\xd0\xbe\xd0\xb4\xd0\xb5\xd0\xb2\xd0\xb5\xd1\x80\xd1\x81\xd0\xb8\xd1\x8f\xd1\x81\xd1\x82\xd1\x80\xd0\xb0\xd0\xbd\xd0\xb5\xd1\x84\xd0\xb8\xd0\xbb\xd1\x8c" +
+	"\xd0\xbc\xd1\x8b\xd1\x83\xd1\x80\xd0\xbe\xd0\xb2\xd0\xbd\xd1\x8f\xd1\x80\xd0\xb0\xd0\xb7\xd0\xbd\xd1\x8b\xd1\x85\xd0\xb8\xd1\x81\xd0\xba\xd0\xb0\xd1\x82\xd1\x8c\xd0\xbd\xd0\xb5\xd0\xb4\xd0\xb5" +
+	"\xd0\xbb\xd1\x8e\xd1\x8f\xd0\xbd\xd0\xb2\xd0\xb0\xd1\x80\xd1\x8f\xd0\xbc\xd0\xb5\xd0\xbd\xd1\x8c\xd1\x88\xd0\xb5\xd0\xbc\xd0\xbd\xd0\xbe\xd0\xb3\xd0\xb8\xd1\x85\xd0\xb4\xd0\xb0\xd0\xbd\xd0\xbd" +
+	"\xd0\xbe\xd0\xb9\xd0\xb7\xd0\xbd\xd0\xb0\xd1\x87\xd0\xb8\xd1\x82\xd0\xbd\xd0\xb5\xd0\xbb\xd1\x8c\xd0\xb7\xd1\x8f\xd1\x84\xd0\xbe\xd1\x80\xd1\x83\xd0\xbc\xd0\xb0\xd0\xa2\xd0\xb5\xd0\xbf\xd0\xb5" +
+	"\xd1\x80\xd1\x8c\xd0\xbc\xd0\xb5\xd1\x81\xd1\x8f\xd1\x86\xd0\xb0\xd0\xb7\xd0\xb0\xd1\x89\xd0\xb8\xd1\x82\xd1\x8b\xd0\x9b\xd1\x83\xd1\x87\xd1\x88\xd0\xb8\xd0\xb5\xe0\xa4\xa8\xe0\xa4\xb9\xe0\xa5" +
+	"\x80\xe0\xa4\x82\xe0\xa4\x95\xe0\xa4\xb0\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\x85\xe0\xa4\xaa\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\x95\xe0\xa4\xbf\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\x95\xe0\xa4\xb0\xe0\xa5" +
+	"\x87\xe0\xa4\x82\xe0\xa4\x85\xe0\xa4\xa8\xe0\xa5\x8d\xe0\xa4\xaf\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\x97\xe0\xa4\xbe\xe0\xa4\x87\xe0\xa4\xa1\xe0\xa4\xac\xe0\xa4\xbe\xe0\xa4" +
+	"\xb0\xe0\xa5\x87\xe0\xa4\x95\xe0\xa4\xbf\xe0\xa4\xb8\xe0\xa5\x80\xe0\xa4\xa6\xe0\xa4\xbf\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\xaa\xe0\xa4\xb9\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4\xb8\xe0\xa4\xbf\xe0\xa4" +
+	"\x82\xe0\xa4\xb9\xe0\xa4\xad\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\xa4\xe0\xa4\x85\xe0\xa4\xaa\xe0\xa4\xa8\xe0\xa5\x80\xe0\xa4\xb5\xe0\xa4\xbe\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4\xb8\xe0\xa5\x87\xe0\xa4" +
+	"\xb5\xe0\xa4\xbe\xe0\xa4\x95\xe0\xa4\xb0\xe0\xa4\xa4\xe0\xa5\x87\xe0\xa4\xae\xe0\xa5\x87\xe0\xa4\xb0\xe0\xa5\x87\xe0\xa4\xb9\xe0\xa5\x8b\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\xb8\xe0\xa4\x95\xe0\xa4" +
+	"\xa4\xe0\xa5\x87\xe0\xa4\xac\xe0\xa4\xb9\xe0\xa5\x81\xe0\xa4\xa4\xe0\xa4\xb8\xe0\xa4\xbe\xe0\xa4\x87\xe0\xa4\x9f\xe0\xa4\xb9\xe0\xa5\x8b\xe0\xa4\x97\xe0\xa4\xbe\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4" +
+	"\xa8\xe0\xa5\x87\xe0\xa4\xae\xe0\xa4\xbf\xe0\xa4\xa8\xe0\xa4\x9f\xe0\xa4\x95\xe0\xa4\xb0\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\x95\xe0\xa4\xb0\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\x89\xe0\xa4\xa8\xe0\xa4" +
+	"\x95\xe0\xa5\x87\xe0\xa4\xaf\xe0\xa4\xb9\xe0\xa4\xbe\xe0\xa4\x81\xe0\xa4\xb8\xe0\xa4\xac\xe0\xa4\xb8\xe0\xa5\x87\xe0\xa4\xad\xe0\xa4\xbe\xe0\xa4\xb7\xe0\xa4\xbe\xe0\xa4\x86\xe0\xa4\xaa\xe0\xa4" +
+	"\x95\xe0\xa5\x87\xe0\xa4\xb2\xe0\xa4\xbf\xe0\xa4\xaf\xe0\xa5\x87\xe0\xa4\xb6\xe0\xa5\x81\xe0\xa4\xb0\xe0\xa5\x82\xe0\xa4\x87\xe0\xa4\xb8\xe0\xa4\x95\xe0\xa5\x87\xe0\xa4\x98\xe0\xa4\x82\xe0\xa4" +
+	"\x9f\xe0\xa5\x87\xe0\xa4\xae\xe0\xa5\x87\xe0\xa4\xb0\xe0\xa5\x80\xe0\xa4\xb8\xe0\xa4\x95\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\xae\xe0\xa5\x87\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4" +
+	"\x95\xe0\xa4\xb0\xe0\xa4\x85\xe0\xa4\xa7\xe0\xa4\xbf\xe0\xa4\x95\xe0\xa4\x85\xe0\xa4\xaa\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa4\xae\xe0\xa4\xbe\xe0\xa4\x9c\xe0\xa4\xae\xe0\xa5\x81\xe0\xa4" +
+	"\x9d\xe0\xa5\x87\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\xa3\xe0\xa4\xb9\xe0\xa5\x8b\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\x95\xe0\xa4\xa1\xe0\xa4\xbc\xe0\xa5\x80\xe0\xa4\xaf\xe0\xa4\xb9\xe0\xa4" +
+	"\xbe\xe0\xa4\x82\xe0\xa4\xb9\xe0\xa5\x8b\xe0\xa4\x9f\xe0\xa4\xb2\xe0\xa4\xb6\xe0\xa4\xac\xe0\xa5\x8d\xe0\xa4\xa6\xe0\xa4\xb2\xe0\xa4\xbf\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\x9c\xe0\xa5\x80\xe0\xa4" +
+	"\xb5\xe0\xa4\xa8\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\x95\xe0\xa5\x88\xe0\xa4\xb8\xe0\xa5\x87\xe0\xa4\x86\xe0\xa4\xaa\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xb5\xe0\xa4\xbe\xe0\xa4" +
+	"\xb2\xe0\xa5\x80\xe0\xa4\xa6\xe0\xa5\x87\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\xaa\xe0\xa5\x82\xe0\xa4\xb0\xe0\xa5\x80\xe0\xa4\xaa\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa5\x80\xe0\xa4\x89\xe0\xa4\xb8\xe0\xa4" +
+	"\x95\xe0\xa5\x87\xe0\xa4\xb9\xe0\xa5\x8b\xe0\xa4\x97\xe0\xa5\x80\xe0\xa4\xac\xe0\xa5\x88\xe0\xa4\xa0\xe0\xa4\x95\xe0\xa4\x86\xe0\xa4\xaa\xe0\xa4\x95\xe0\xa5\x80\xe0\xa4\xb5\xe0\xa4\xb0\xe0\xa5" +
+	"\x8d\xe0\xa4\xb7\xe0\xa4\x97\xe0\xa4\xbe\xe0\xa4\x82\xe0\xa4\xb5\xe0\xa4\x86\xe0\xa4\xaa\xe0\xa4\x95\xe0\xa5\x8b\xe0\xa4\x9c\xe0\xa4\xbf\xe0\xa4\xb2\xe0\xa4\xbe\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4" +
+	"\xa8\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa4\xb9\xe0\xa4\xae\xe0\xa4\xa4\xe0\xa4\xb9\xe0\xa4\xae\xe0\xa5\x87\xe0\xa4\x82\xe0\xa4\x89\xe0\xa4\xa8\xe0\xa4\x95\xe0\xa5\x80\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4" +
+	"\xb9\xe0\xa5\x82\xe0\xa4\xa6\xe0\xa4\xb0\xe0\xa5\x8d\xe0\xa4\x9c\xe0\xa4\xb8\xe0\xa5\x82\xe0\xa4\x9a\xe0\xa5\x80\xe0\xa4\xaa\xe0\xa4\xb8\xe0\xa4\x82\xe0\xa4\xa6\xe0\xa4\xb8\xe0\xa4\xb5\xe0\xa4" +
+	"\xbe\xe0\xa4\xb2\xe0\xa4\xb9\xe0\xa5\x8b\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\xb9\xe0\xa5\x8b\xe0\xa4\xa4\xe0\xa5\x80\xe0\xa4\x9c\xe0\xa5\x88\xe0\xa4\xb8\xe0\xa5\x87\xe0\xa4\xb5\xe0\xa4\xbe\xe0\xa4" +
+	"\xaa\xe0\xa4\xb8\xe0\xa4\x9c\xe0\xa4\xa8\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa5\x80\xe0\xa4\x98\xe0\xa4\xbe\xe0\xa4" +
+	"\xaf\xe0\xa4\xb2\xe0\xa4\x9c\xe0\xa4\xbf\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4\xa8\xe0\xa5\x80\xe0\xa4\x9a\xe0\xa5\x87\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4\x82\xe0\xa4\x9a\xe0\xa4\xaa\xe0\xa4\xa4\xe0\xa5" +
+	"\x8d\xe0\xa4\xb0\xe0\xa4\x97\xe0\xa5\x82\xe0\xa4\x97\xe0\xa4\xb2\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4\xa4\xe0\xa5\x87\xe0\xa4\xac\xe0\xa4\xbe\xe0\xa4\xb9\xe0\xa4\xb0\xe0\xa4\x86\xe0\xa4\xaa\xe0\xa4" +
+	"\xa8\xe0\xa5\x87\xe0\xa4\xb5\xe0\xa4\xbe\xe0\xa4\xb9\xe0\xa4\xa8\xe0\xa4\x87\xe0\xa4\xb8\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa5\x81\xe0\xa4\xac\xe0\xa4\xb9\xe0\xa4\xb0\xe0\xa4\xb9\xe0\xa4" +
+	"\xa8\xe0\xa5\x87\xe0\xa4\x87\xe0\xa4\xb8\xe0\xa4\xb8\xe0\xa5\x87\xe0\xa4\xb8\xe0\xa4\xb9\xe0\xa4\xbf\xe0\xa4\xa4\xe0\xa4\xac\xe0\xa4\xa1\xe0\xa4\xbc\xe0\xa5\x87\xe0\xa4\x98\xe0\xa4\x9f\xe0\xa4" +
+	"\xa8\xe0\xa4\xbe\xe0\xa4\xa4\xe0\xa4\xb2\xe0\xa4\xbe\xe0\xa4\xb6\xe0\xa4\xaa\xe0\xa4\xbe\xe0\xa4\x82\xe0\xa4\x9a\xe0\xa4\xb6\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa5\x80\xe0\xa4\xac\xe0\xa4\xa1\xe0\xa4" +
+	"\xbc\xe0\xa5\x80\xe0\xa4\xb9\xe0\xa5\x8b\xe0\xa4\xa4\xe0\xa5\x87\xe0\xa4\xb8\xe0\xa4\xbe\xe0\xa4\x88\xe0\xa4\x9f\xe0\xa4\xb6\xe0\xa4\xbe\xe0\xa4\xaf\xe0\xa4\xa6\xe0\xa4\xb8\xe0\xa4\x95\xe0\xa4" +
+	"\xa4\xe0\xa5\x80\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4\xa4\xe0\xa5\x80\xe0\xa4\xb5\xe0\xa4\xbe\xe0\xa4\xb2\xe0\xa4\xbe\xe0\xa4\xb9\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\xaa\xe0\xa4\x9f\xe0\xa4" +
+	"\xa8\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\x96\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\xb8\xe0\xa4\xa1\xe0\xa4\xbc\xe0\xa4\x95\xe0\xa4\xae\xe0\xa4\xbf\xe0\xa4\xb2\xe0\xa4\xbe\xe0\xa4\x89\xe0\xa4\xb8\xe0\xa4" +
+	"\x95\xe0\xa5\x80\xe0\xa4\x95\xe0\xa5\x87\xe0\xa4\xb5\xe0\xa4\xb2\xe0\xa4\xb2\xe0\xa4\x97\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\x96\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\x85\xe0\xa4\xb0\xe0\xa5" +
+	"\x8d\xe0\xa4\xa5\xe0\xa4\x9c\xe0\xa4\xb9\xe0\xa4\xbe\xe0\xa4\x82\xe0\xa4\xa6\xe0\xa5\x87\xe0\xa4\x96\xe0\xa4\xbe\xe0\xa4\xaa\xe0\xa4\xb9\xe0\xa4\xb2\xe0\xa5\x80\xe0\xa4\xa8\xe0\xa4\xbf\xe0\xa4" +
+	"\xaf\xe0\xa4\xae\xe0\xa4\xac\xe0\xa4\xbf\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\xac\xe0\xa5\x88\xe0\xa4\x82\xe0\xa4\x95\xe0\xa4\x95\xe0\xa4\xb9\xe0\xa5\x80\xe0\xa4\x82\xe0\xa4\x95\xe0\xa4\xb9\xe0\xa4" +
+	"\xa8\xe0\xa4\xbe\xe0\xa4\xa6\xe0\xa5\x87\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\xb9\xe0\xa4\xae\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xab\xe0\xa5\x80\xe0\xa4\x9c\xe0\xa4\xac\xe0\xa4" +
+	"\x95\xe0\xa4\xbf\xe0\xa4\xa4\xe0\xa5\x81\xe0\xa4\xb0\xe0\xa4\xa4\xe0\xa4\xae\xe0\xa4\xbe\xe0\xa4\x82\xe0\xa4\x97\xe0\xa4\xb5\xe0\xa4\xb9\xe0\xa5\x80\xe0\xa4\x82\xe0\xa4\xb0\xe0\xa5\x8b\xe0\xa4" +
+	"\x9c\xe0\xa4\xbc\xe0\xa4\xae\xe0\xa4\xbf\xe0\xa4\xb2\xe0\xa5\x80\xe0\xa4\x86\xe0\xa4\xb0\xe0\xa5\x8b\xe0\xa4\xaa\xe0\xa4\xb8\xe0\xa5\x87\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4" +
+	"\xa6\xe0\xa4\xb5\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\x96\xe0\xa4\xbe\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\x95\xe0\xa4\xb0\xe0\xa5\x80\xe0\xa4\xac\xe0\xa4\x89\xe0\xa4\xa8\xe0\xa4" +
+	"\x95\xe0\xa4\xbe\xe0\xa4\x9c\xe0\xa4\xb5\xe0\xa4\xbe\xe0\xa4\xac\xe0\xa4\xaa\xe0\xa5\x82\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\xac\xe0\xa4\xa1\xe0\xa4\xbc\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa5\x8c\xe0\xa4" +
+	"\xa6\xe0\xa4\xbe\xe0\xa4\xb6\xe0\xa5\x87\xe0\xa4\xaf\xe0\xa4\xb0\xe0\xa4\x95\xe0\xa4\xbf\xe0\xa4\xaf\xe0\xa5\x87\xe0\xa4\x95\xe0\xa4\xb9\xe0\xa4\xbe\xe0\xa4\x82\xe0\xa4\x85\xe0\xa4\x95\xe0\xa4" +
+	"\xb8\xe0\xa4\xb0\xe0\xa4\xac\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\x8f\xe0\xa4\xb5\xe0\xa4\xb9\xe0\xa4\xbe\xe0\xa4\x82\xe0\xa4\xb8\xe0\xa5\x8d\xe0\xa4\xa5\xe0\xa4\xb2\xe0\xa4\xae\xe0\xa4\xbf\xe0\xa4" +
+	"\xb2\xe0\xa5\x87\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4\x96\xe0\xa4\x95\xe0\xa4\xb5\xe0\xa4\xbf\xe0\xa4\xb7\xe0\xa4\xaf\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\x82\xe0\xa4\xb8\xe0\xa4\xae\xe0\xa5" +
+	"\x82\xe0\xa4\xb9\xe0\xa4\xa5\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa4\xbe\xd8\xaa\xd8\xb3\xd8\xaa\xd8\xb7\xd9\x8a\xd8\xb9\xd9\x85\xd8\xb4\xd8\xa7\xd8\xb1\xd9\x83\xd8\xa9\xd8\xa8\xd9\x88\xd8\xa7\xd8\xb3" +
+	"\xd8\xb7\xd8\xa9\xd8\xa7\xd9\x84\xd8\xb5\xd9\x81\xd8\xad\xd8\xa9\xd9\x85\xd9\x88\xd8\xa7\xd8\xb6\xd9\x8a\xd8\xb9\xd8\xa7\xd9\x84\xd8\xae\xd8\xa7\xd8\xb5\xd8\xa9\xd8\xa7\xd9\x84\xd9\x85\xd8\xb2" +
+	"\xd9\x8a\xd8\xaf\xd8\xa7\xd9\x84\xd8\xb9\xd8\xa7\xd9\x85\xd8\xa9\xd8\xa7\xd9\x84\xd9\x83\xd8\xa7\xd8\xaa\xd8\xa8\xd8\xa7\xd9\x84\xd8\xb1\xd8\xaf\xd9\x88\xd8\xaf\xd8\xa8\xd8\xb1\xd9\x86\xd8\xa7" +
+	"\xd9\x85\xd8\xac\xd8\xa7\xd9\x84\xd8\xaf\xd9\x88\xd9\x84\xd8\xa9\xd8\xa7\xd9\x84\xd8\xb9\xd8\xa7\xd9\x84\xd9\x85\xd8\xa7\xd9\x84\xd9\x85\xd9\x88\xd9\x82\xd8\xb9\xd8\xa7\xd9\x84\xd8\xb9\xd8\xb1" +
+	"\xd8\xa8\xd9\x8a\xd8\xa7\xd9\x84\xd8\xb3\xd8\xb1\xd9\x8a\xd8\xb9\xd8\xa7\xd9\x84\xd8\xac\xd9\x88\xd8\xa7\xd9\x84\xd8\xa7\xd9\x84\xd8\xb0\xd9\x87\xd8\xa7\xd8\xa8\xd8\xa7\xd9\x84\xd8\xad\xd9\x8a" +
+	"\xd8\xa7\xd8\xa9\xd8\xa7\xd9\x84\xd8\xad\xd9\x82\xd9\x88\xd9\x82\xd8\xa7\xd9\x84\xd9\x83\xd8\xb1\xd9\x8a\xd9\x85\xd8\xa7\xd9\x84\xd8\xb9\xd8\xb1\xd8\xa7\xd9\x82\xd9\x85\xd8\xad\xd9\x81\xd9\x88" +
+	"\xd8\xb8\xd8\xa9\xd8\xa7\xd9\x84\xd8\xab\xd8\xa7\xd9\x86\xd9\x8a\xd9\x85\xd8\xb4\xd8\xa7\xd9\x87\xd8\xaf\xd8\xa9\xd8\xa7\xd9\x84\xd9\x85\xd8\xb1\xd8\xa3\xd8\xa9\xd8\xa7\xd9\x84\xd9\x82\xd8\xb1" +
+	"\xd8\xa2\xd9\x86\xd8\xa7\xd9\x84\xd8\xb4\xd8\xa8\xd8\xa7\xd8\xa8\xd8\xa7\xd9\x84\xd8\xad\xd9\x88\xd8\xa7\xd8\xb1\xd8\xa7\xd9\x84\xd8\xac\xd8\xaf\xd9\x8a\xd8\xaf\xd8\xa7\xd9\x84\xd8\xa3\xd8\xb3" +
+	"\xd8\xb1\xd8\xa9\xd8\xa7\xd9\x84\xd8\xb9\xd9\x84\xd9\x88\xd9\x85\xd9\x85\xd8\xac\xd9\x85\xd9\x88\xd8\xb9\xd8\xa9\xd8\xa7\xd9\x84\xd8\xb1\xd8\xad\xd9\x85\xd9\x86\xd8\xa7\xd9\x84\xd9\x86\xd9\x82" +
+	"\xd8\xa7\xd8\xb7\xd9\x81\xd9\x84\xd8\xb3\xd8\xb7\xd9\x8a\xd9\x86\xd8\xa7\xd9\x84\xd9\x83\xd9\x88\xd9\x8a\xd8\xaa\xd8\xa7\xd9\x84\xd8\xaf\xd9\x86\xd9\x8a\xd8\xa7\xd8\xa8\xd8\xb1\xd9\x83\xd8\xa7" +
+	"\xd8\xaa\xd9\x87\xd8\xa7\xd9\x84\xd8\xb1\xd9\x8a\xd8\xa7\xd8\xb6\xd8\xaa\xd8\xad\xd9\x8a\xd8\xa7\xd8\xaa\xd9\x8a\xd8\xa8\xd8\xaa\xd9\x88\xd9\x82\xd9\x8a\xd8\xaa\xd8\xa7\xd9\x84\xd8\xa3\xd9\x88" +
+	"\xd9\x84\xd9\x89\xd8\xa7\xd9\x84\xd8\xa8\xd8\xb1\xd9\x8a\xd8\xaf\xd8\xa7\xd9\x84\xd9\x83\xd9\x84\xd8\xa7\xd9\x85\xd8\xa7\xd9\x84\xd8\xb1\xd8\xa7\xd8\xa8\xd8\xb7\xd8\xa7\xd9\x84\xd8\xb4\xd8\xae" +
+	"\xd8\xb5\xd9\x8a\xd8\xb3\xd9\x8a\xd8\xa7\xd8\xb1\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd8\xab\xd8\xa7\xd9\x84\xd8\xab\xd8\xa7\xd9\x84\xd8\xb5\xd9\x84\xd8\xa7\xd8\xa9\xd8\xa7\xd9\x84\xd8\xad\xd8\xaf" +
+	"\xd9\x8a\xd8\xab\xd8\xa7\xd9\x84\xd8\xb2\xd9\x88\xd8\xa7\xd8\xb1\xd8\xa7\xd9\x84\xd8\xae\xd9\x84\xd9\x8a\xd8\xac\xd8\xa7\xd9\x84\xd8\xac\xd9\x85\xd9\x8a\xd8\xb9\xd8\xa7\xd9\x84\xd8\xb9\xd8\xa7" +
+	"\xd9\x85\xd9\x87\xd8\xa7\xd9\x84\xd8\xac\xd9\x85\xd8\xa7\xd9\x84\xd8\xa7\xd9\x84\xd8\xb3\xd8\xa7\xd8\xb9\xd8\xa9\xd9\x85\xd8\xb4\xd8\xa7\xd9\x87\xd8\xaf\xd9\x87\xd8\xa7\xd9\x84\xd8\xb1\xd8\xa6" +
+	"\xd9\x8a\xd8\xb3\xd8\xa7\xd9\x84\xd8\xaf\xd8\xae\xd9\x88\xd9\x84\xd8\xa7\xd9\x84\xd9\x81\xd9\x86\xd9\x8a\xd8\xa9\xd8\xa7\xd9\x84\xd9\x83\xd8\xaa\xd8\xa7\xd8\xa8\xd8\xa7\xd9\x84\xd8\xaf\xd9\x88" +
+	"\xd8\xb1\xd9\x8a\xd8\xa7\xd9\x84\xd8\xaf\xd8\xb1\xd9\x88\xd8\xb3\xd8\xa7\xd8\xb3\xd8\xaa\xd8\xba\xd8\xb1\xd9\x82\xd8\xaa\xd8\xb5\xd8\xa7\xd9\x85\xd9\x8a\xd9\x85\xd8\xa7\xd9\x84\xd8\xa8\xd9\x86" +
+	"\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd8\xb9\xd8\xb8\xd9\x8a\xd9\x85entertainmentunderstanding = fun" +
+	"ction().jpg\" width=\"configuration.png\" width=\"<b" +
+	"ody class=\"Math.random()contemporary United Stat" +
+	"escircumstances.appendChild(organizations<span c" +
+	"lass=\"\"><img src=\"/distinguishedthousands of com" +
+	"municationclear\"></div>investigationfavicon.ico\"" +
+	" margin-right:based on the Massachusettstable bo" +
+	"rder=internationalalso known aspronunciationback" +
+	"ground:#fpadding-left:For example, miscellaneous" +
+	"&lt;/math&gt;psychologicalin particularearch\" ty" +
+	"pe=\"form method=\"as opposed toSupreme Courtoccas" +
+	"ionally Additionally,North Americapx;backgroundo" +
+	"pportunitiesEntertainment.toLowerCase(manufactur" +
+	"ingprofessional combined withFor instance,consis" +
+	"ting of\" maxlength=\"return false;consciousnessMe" +
+	"diterraneanextraordinaryassassinationsubsequentl" +
+	"y button type=\"the number ofthe original compreh" +
+	"ensiverefers to the</ul>\x0a</div>\x0aphilosophicalloc" +
+	"ation.hrefwas publishedSan Francisco(function(){" +
+	"\x0a<div id=\"mainsophisticatedmathematical /head>\x0d\x0a" +
+	"<bodysuggests thatdocumentationconcentrationrela" +
+	"tionshipsmay have been(for example,This article " +
+	"in some casesparts of the definition ofGreat Bri" +
+	"tain cellpadding=equivalent toplaceholder=\"; fon" +
+	"t-size: justificationbelieved thatsuffered froma" +
+	"ttempted to leader of thecript\" src=\"/(function(" +
+	") {are available\x0a\x09<link rel=\" src='http://intere" +
+	"sted inconventional \" alt=\"\" /></are generallyha" +
+	"s also beenmost popular correspondingcredited wi" +
+	"thtyle=\"border:</a></span></.gif\" width=\"<iframe" +
+	" src=\"table class=\"inline-block;according to tog" +
+	"ether withapproximatelyparliamentarymore and mor" +
+	"edisplay:none;traditionallypredominantly&nbsp;|&" +
+	"nbsp;&nbsp;</span> cellspacing=<input name=\"or\" " +
+	"content=\"controversialproperty=\"og:/x-shockwave-" +
+	"demonstrationsurrounded byNevertheless,was the f" +
+	"irstconsiderable Although the collaborationshoul" +
+	"d not beproportion of<span style=\"known as the s" +
+	"hortly afterfor instance,described as /head>\x0a<bo" +
+	"dy starting withincreasingly the fact thatdiscus" +
+	"sion ofmiddle of thean individualdifficult to po" +
+	"int of viewhomosexualityacceptance of</span></di" +
+	"v>manufacturersorigin of thecommonly usedimporta" +
+	"nce ofdenominationsbackground: #length of thedet" +
+	"erminationa significant\" border=\"0\">revolutionar" +
+	"yprinciples ofis consideredwas developedIndo-Eur" +
+	"opeanvulnerable toproponents ofare sometimesclos" +
+	"er to theNew York City name=\"searchattributed to" +
+	"course of themathematicianby the end ofat the en" +
+	"d of\" border=\"0\" technological.removeClass(branc" +
+	"h of theevidence that![endif]-->\x0d\x0aInstitute of i" +
+	"nto a singlerespectively.and thereforeproperties" +
+	" ofis located insome of whichThere is alsocontin" +
+	"ued to appearance of &amp;ndash; describes theco" +
+	"nsiderationauthor of theindependentlyequipped wi" +
+	"thdoes not have</a><a href=\"confused with<link h" +
+	"ref=\"/at the age ofappear in theThese includereg" +
+	"ardless ofcould be used style=&quot;several time" +
+	"srepresent thebody>\x0a</html>thought to bepopulati" +
+	"on ofpossibilitiespercentage ofaccess to thean a" +
+	"ttempt toproduction ofjquery/jquerytwo different" +
+	"belong to theestablishmentreplacing thedescripti" +
+	"on\" determine theavailable forAccording to wide " +
+	"range of\x09<div class=\"more commonlyorganisationsf" +
+	"unctionalitywas completed &amp;mdash; participat" +
+	"ionthe characteran additionalappears to befact t" +
+	"hat thean example ofsignificantlyonmouseover=\"be" +
+	"cause they async = true;problems withseems to ha" +
+	"vethe result of src=\"http://familiar withpossess" +
+	"ion offunction () {took place inand sometimessub" +
+	"stantially<span></span>is often usedin an attemp" +
+	"tgreat deal ofEnvironmentalsuccessfully virtuall" +
+	"y all20th century,professionalsnecessary to dete" +
+	"rmined bycompatibilitybecause it isDictionary of" +
+	"modificationsThe followingmay refer to:Consequen" +
+	"tly,Internationalalthough somethat would beworld" +
+	"'s firstclassified asbottom of the(particularlya" +
+	"lign=\"left\" most commonlybasis for thefoundation" +
+	" ofcontributionspopularity ofcenter of theto red" +
+	"uce thejurisdictionsapproximation onmouseout=\"Ne" +
+	"w Testamentcollection of</span></a></in the Unit" +
+	"edfilm director-strict.dtd\">has been usedreturn " +
+	"to thealthough thischange in theseveral otherbut" +
+	" there areunprecedentedis similar toespecially i" +
+	"nweight: bold;is called thecomputationalindicate" +
+	" thatrestricted to\x09<meta name=\"are typicallyconf" +
+	"lict withHowever, the An example ofcompared with" +
+	"quantities ofrather than aconstellationnecessary" +
+	" forreported thatspecificationpolitical and&nbsp" +
+	";&nbsp;<references tothe same yearGovernment ofg" +
+	"eneration ofhave not beenseveral yearscommitment" +
+	" to\x09\x09<ul class=\"visualization19th century,practi" +
+	"tionersthat he wouldand continuedoccupation ofis" +
+	" defined ascentre of thethe amount of><div style" +
+	"=\"equivalent ofdifferentiatebrought aboutmargin-" +
+	"left: automaticallythought of asSome of these\x0a<d" +
+	"iv class=\"input class=\"replaced withis one of th" +
+	"eeducation andinfluenced byreputation as\x0a<meta n" +
+	"ame=\"accommodation</div>\x0a</div>large part ofInst" +
+	"itute forthe so-called against the In this case," +
+	"was appointedclaimed to beHowever, thisDepartmen" +
+	"t ofthe remainingeffect on theparticularly deal " +
+	"with the\x0a<div style=\"almost alwaysare currentlye" +
+	"xpression ofphilosophy offor more thancivilizati" +
+	"onson the islandselectedIndexcan result in\" valu" +
+	"e=\"\" />the structure /></a></div>Many of theseca" +
+	"used by theof the Unitedspan class=\"mcan be trac" +
+	"edis related tobecame one ofis frequentlyliving " +
+	"in thetheoreticallyFollowing theRevolutionarygov" +
+	"ernment inis determinedthe politicalintroduced i" +
+	"nsufficient todescription\">short storiesseparati" +
+	"on ofas to whetherknown for itswas initiallydisp" +
+	"lay:blockis an examplethe principalconsists of a" +
+	"recognized as/body></html>a substantialreconstru" +
+	"ctedhead of stateresistance toundergraduateThere" +
+	" are twogravitationalare describedintentionallys" +
+	"erved as theclass=\"headeropposition tofundamenta" +
+	"llydominated theand the otheralliance withwas fo" +
+	"rced torespectively,and politicalin support ofpe" +
+	"ople in the20th century.and publishedloadChartbe" +
+	"atto understandmember statesenvironmentalfirst h" +
+	"alf ofcountries andarchitecturalbe consideredcha" +
+	"racterizedclearIntervalauthoritativeFederation o" +
+	"fwas succeededand there area consequencethe Pres" +
+	"identalso includedfree softwaresuccession ofdeve" +
+	"loped thewas destroyedaway from the;\x0a</script>\x0a<" +
+	"although theyfollowed by amore powerfulresulted " +
+	"in aUniversity ofHowever, manythe presidentHowev" +
+	"er, someis thought tountil the endwas announceda" +
+	"re importantalso includes><input type=the center" +
+	" of DO NOT ALTERused to referthemes/?sort=that h" +
+	"ad beenthe basis forhas developedin the summerco" +
+	"mparativelydescribed thesuch as thosethe resulti" +
+	"ngis impossiblevarious otherSouth Africanhave th" +
+	"e sameeffectivenessin which case; text-align:str" +
+	"ucture and; background:regarding thesupported th" +
+	"eis also knownstyle=\"marginincluding thebahasa M" +
+	"elayunorsk bokm\xc3\xa5lnorsk nynorsksloven\xc5\xa1\xc4\x8dinainte" +
+	"rnacionalcalificaci\xc3\xb3ncomunicaci\xc3\xb3nconstrucci\xc3\xb3n" +
+	"\"><div class=\"disambiguationDomainName', 'admini" +
+	"strationsimultaneouslytransportationInternationa" +
+	"l margin-bottom:responsibility<![endif]-->\x0a</><m" +
+	"eta name=\"implementationinfrastructurerepresenta" +
+	"tionborder-bottom:</head>\x0a<body>=http%3A%2F%2F<f" +
+	"orm method=\"method=\"post\" /favicon.ico\" });\x0a</sc" +
+	"ript>\x0a.setAttribute(Administration= new Array();" +
+	"<![endif]-->\x0d\x0adisplay:block;Unfortunately,\">&nbs" +
+	"p;</div>/favicon.ico\">='stylesheet' identificati" +
+	"on, for example,<li><a href=\"/an alternativeas a" +
+	" result ofpt\"></script>\x0atype=\"submit\" \x0a(function" +
+	"() {recommendationform action=\"/transformationre" +
+	"construction.style.display According to hidden\" " +
+	"name=\"along with thedocument.body.approximately " +
+	"Communicationspost\" action=\"meaning &quot;--<![e" +
+	"ndif]-->Prime Ministercharacteristic</a> <a clas" +
+	"s=the history of onmouseover=\"the governmenthref" +
+	"=\"https://was originallywas introducedclassifica" +
+	"tionrepresentativeare considered<![endif]-->\x0a\x0ade" +
+	"pends on theUniversity of in contrast to placeho" +
+	"lder=\"in the case ofinternational constitutional" +
+	"style=\"border-: function() {Because of the-stric" +
+	"t.dtd\">\x0a<table class=\"accompanied byaccount of t" +
+	"he<script src=\"/nature of the the people in in a" +
+	"ddition tos); js.id = id\" width=\"100%\"regarding " +
+	"the Roman Catholican independentfollowing the .g" +
+	"if\" width=\"1the following discriminationarchaeol" +
+	"ogicalprime minister.js\"></script>combination of" +
+	" marginwidth=\"createElement(w.attachEvent(</a></" +
+	"td></tr>src=\"https://aIn particular, align=\"left" +
+	"\" Czech RepublicUnited Kingdomcorrespondenceconc" +
+	"luded that.html\" title=\"(function () {comes from" +
+	" theapplication of<span class=\"sbelieved to beem" +
+	"ent('script'</a>\x0a</li>\x0a<livery different><span c" +
+	"lass=\"option value=\"(also known as\x09<li><a href=\"" +
+	"><input name=\"separated fromreferred to as valig" +
+	"n=\"top\">founder of theattempting to carbon dioxi" +
+	"de\x0a\x0a<div class=\"class=\"search-/body>\x0a</html>oppo" +
+	"rtunity tocommunications</head>\x0d\x0a<body style=\"wi" +
+	"dth:Ti\xe1\xba\xbfng Vi\xe1\xbb\x87tchanges in theborder-color:#0\"" +
+	" border=\"0\" </span></div><was discovered\" type=\"" +
+	"text\" );\x0a</script>\x0a\x0aDepartment of ecclesiastical" +
+	"there has beenresulting from</body></html>has ne" +
+	"ver beenthe first timein response toautomaticall" +
+	"y </div>\x0a\x0a<div iwas consideredpercent of the\" />" +
+	"</a></div>collection of descended fromsection of" +
+	" theaccept-charsetto be confusedmember of the pa" +
+	"dding-right:translation ofinterpretation href='h" +
+	"ttp://whether or notThere are alsothere are many" +
+	"a small numberother parts ofimpossible to  class" +
+	"=\"buttonlocated in the. However, theand eventual" +
+	"lyAt the end of because of itsrepresents the<for" +
+	"m action=\" method=\"post\"it is possiblemore likel" +
+	"y toan increase inhave also beencorresponds toan" +
+	"nounced thatalign=\"right\">many countriesfor many" +
+	" yearsearliest knownbecause it waspt\"></script>\x0d" +
+	" valign=\"top\" inhabitants offollowing year\x0d\x0a<div" +
+	" class=\"million peoplecontroversial concerning t" +
+	"heargue that thegovernment anda reference totran" +
+	"sferred todescribing the style=\"color:although t" +
+	"herebest known forsubmit\" name=\"multiplicationmo" +
+	"re than one recognition ofCouncil of theedition " +
+	"of the  <meta name=\"Entertainment away from the " +
+	";margin-right:at the time ofinvestigationsconnec" +
+	"ted withand many otheralthough it isbeginning wi" +
+	"th <span class=\"descendants of<span class=\"i ali" +
+	"gn=\"right\"</head>\x0a<body aspects of thehas since " +
+	"beenEuropean Unionreminiscent ofmore difficultVi" +
+	"ce Presidentcomposition ofpassed throughmore imp" +
+	"ortantfont-size:11pxexplanation ofthe concept of" +
+	"written in the\x09<span class=\"is one of the resemb" +
+	"lance toon the groundswhich containsincluding th" +
+	"e defined by thepublication ofmeans that theouts" +
+	"ide of thesupport of the<input class=\"<span clas" +
+	"s=\"t(Math.random()most prominentdescription ofCo" +
+	"nstantinoplewere published<div class=\"seappears " +
+	"in the1\" height=\"1\" most importantwhich includes" +
+	"which had beendestruction ofthe population\x0a\x09<div" +
+	" class=\"possibility ofsometimes usedappear to ha" +
+	"vesuccess of theintended to bepresent in thestyl" +
+	"e=\"clear:b\x0d\x0a</script>\x0d\x0a<was founded ininterview " +
+	"with_id\" content=\"capital of the\x0d\x0a<link rel=\"sre" +
+	"lease of thepoint out thatxMLHttpRequestand subs" +
+	"equentsecond largestvery importantspecifications" +
+	"surface of theapplied to theforeign policy_setDo" +
+	"mainNameestablished inis believed toIn addition " +
+	"tomeaning of theis named afterto protect theis r" +
+	"epresentedDeclaration ofmore efficientClassifica" +
+	"tionother forms ofhe returned to<span class=\"cpe" +
+	"rformance of(function() {\x0dif and only ifregions " +
+	"of theleading to therelations withUnited Nations" +
+	"style=\"height:other than theype\" content=\"Associ" +
+	"ation of\x0a</head>\x0a<bodylocated on theis referred " +
+	"to(including theconcentrationsthe individualamon" +
+	"g the mostthan any other/>\x0a<link rel=\" return fa" +
+	"lse;the purpose ofthe ability to;color:#fff}\x0a.\x0a<" +
+	"span class=\"the subject ofdefinitions of>\x0d\x0a<link" +
+	" rel=\"claim that thehave developed<table width=\"" +
+	"celebration ofFollowing the to distinguish<span " +
+	"class=\"btakes place inunder the namenoted that t" +
+	"he><![endif]-->\x0astyle=\"margin-instead of theintr" +
+	"oduced thethe process ofincreasing thedifference" +
+	"s inestimated thatespecially the/div><div id=\"wa" +
+	"s eventuallythroughout histhe differencesomethin" +
+	"g thatspan></span></significantly ></script>\x0d\x0a\x0d\x0a" +
+	"environmental to prevent thehave been usedespeci" +
+	"ally forunderstand theis essentiallywere the fir" +
+	"stis the largesthave been made\" src=\"http://inte" +
+	"rpreted assecond half ofcrolling=\"no\" is compose" +
+	"d ofII, Holy Romanis expected tohave their ownde" +
+	"fined as thetraditionally have differentare ofte" +
+	"n usedto ensure thatagreement withcontaining the" +
+	"are frequentlyinformation onexample is theresult" +
+	"ing in a</a></li></ul> class=\"footerand especial" +
+	"lytype=\"button\" </span></span>which included>\x0a<m" +
+	"eta name=\"considered thecarried out byHowever, i" +
+	"t isbecame part ofin relation topopular in theth" +
+	"e capital ofwas officiallywhich has beenthe Hist" +
+	"ory ofalternative todifferent fromto support the" +
+	"suggested thatin the process  <div class=\"the fo" +
+	"undationbecause of hisconcerned withthe universi" +
+	"tyopposed to thethe context of<span class=\"ptext" +
+	"\" name=\"q\"\x09\x09<div class=\"the scientificrepresente" +
+	"d bymathematicianselected by thethat have been><" +
+	"div class=\"cdiv id=\"headerin particular,converte" +
+	"d into);\x0a</script>\x0a<philosophical srpskohrvatski" +
+	"ti\xe1\xba\xbfng Vi\xe1\xbb\x87t\xd0\xa0\xd1\x83\xd1\x81\xd1\x81\xd0\xba\xd0\xb8\xd0\xb9\xd1\x80\xd1\x83\xd1\x81\xd1\x81\xd0\xba\xd0\xb8\xd0\xb9invest" +
+	"igaci\xc3\xb3nparticipaci\xc3\xb3n\xd0\xba\xd0\xbe\xd1\x82\xd0\xbe\xd1\x80\xd1\x8b\xd0\xb5\xd0\xbe\xd0\xb1\xd0\xbb\xd0\xb0\xd1\x81\xd1\x82" +
+	"\xd0\xb8\xd0\xba\xd0\xbe\xd1\x82\xd0\xbe\xd1\x80\xd1\x8b\xd0\xb9\xd1\x87\xd0\xb5\xd0\xbb\xd0\xbe\xd0\xb2\xd0\xb5\xd0\xba\xd1\x81\xd0\xb8\xd1\x81\xd1\x82\xd0\xb5\xd0\xbc\xd1\x8b\xd0\x9d\xd0\xbe" +
+	"\xd0\xb2\xd0\xbe\xd1\x81\xd1\x82\xd0\xb8\xd0\xba\xd0\xbe\xd1\x82\xd0\xbe\xd1\x80\xd1\x8b\xd1\x85\xd0\xbe\xd0\xb1\xd0\xbb\xd0\xb0\xd1\x81\xd1\x82\xd1\x8c\xd0\xb2\xd1\x80\xd0\xb5\xd0\xbc\xd0\xb5" +
+	"\xd0\xbd\xd0\xb8\xd0\xba\xd0\xbe\xd1\x82\xd0\xbe\xd1\x80\xd0\xb0\xd1\x8f\xd1\x81\xd0\xb5\xd0\xb3\xd0\xbe\xd0\xb4\xd0\xbd\xd1\x8f\xd1\x81\xd0\xba\xd0\xb0\xd1\x87\xd0\xb0\xd1\x82\xd1\x8c\xd0\xbd" +
+	"\xd0\xbe\xd0\xb2\xd0\xbe\xd1\x81\xd1\x82\xd0\xb8\xd0\xa3\xd0\xba\xd1\x80\xd0\xb0\xd0\xb8\xd0\xbd\xd1\x8b\xd0\xb2\xd0\xbe\xd0\xbf\xd1\x80\xd0\xbe\xd1\x81\xd1\x8b\xd0\xba\xd0\xbe\xd1\x82\xd0\xbe" +
+	"\xd1\x80\xd0\xbe\xd0\xb9\xd1\x81\xd0\xb4\xd0\xb5\xd0\xbb\xd0\xb0\xd1\x82\xd1\x8c\xd0\xbf\xd0\xbe\xd0\xbc\xd0\xbe\xd1\x89\xd1\x8c\xd1\x8e\xd1\x81\xd1\x80\xd0\xb5\xd0\xb4\xd1\x81\xd1\x82\xd0\xb2" +
+	"\xd0\xbe\xd0\xb1\xd1\x80\xd0\xb0\xd0\xb7\xd0\xbe\xd0\xbc\xd1\x81\xd1\x82\xd0\xbe\xd1\x80\xd0\xbe\xd0\xbd\xd1\x8b\xd1\x83\xd1\x87\xd0\xb0\xd1\x81\xd1\x82\xd0\xb8\xd0\xb5\xd1\x82\xd0\xb5\xd1\x87" +
+	"\xd0\xb5\xd0\xbd\xd0\xb8\xd0\xb5\xd0\x93\xd0\xbb\xd0\xb0\xd0\xb2\xd0\xbd\xd0\xb0\xd1\x8f\xd0\xb8\xd1\x81\xd1\x82\xd0\xbe\xd1\x80\xd0\xb8\xd0\xb8\xd1\x81\xd0\xb8\xd1\x81\xd1\x82\xd0\xb5\xd0\xbc" +
+	"\xd0\xb0\xd1\x80\xd0\xb5\xd1\x88\xd0\xb5\xd0\xbd\xd0\xb8\xd1\x8f\xd0\xa1\xd0\xba\xd0\xb0\xd1\x87\xd0\xb0\xd1\x82\xd1\x8c\xd0\xbf\xd0\xbe\xd1\x8d\xd1\x82\xd0\xbe\xd0\xbc\xd1\x83\xd1\x81\xd0\xbb" +
+	"\xd0\xb5\xd0\xb4\xd1\x83\xd0\xb5\xd1\x82\xd1\x81\xd0\xba\xd0\xb0\xd0\xb7\xd0\xb0\xd1\x82\xd1\x8c\xd1\x82\xd0\xbe\xd0\xb2\xd0\xb0\xd1\x80\xd0\xbe\xd0\xb2\xd0\xba\xd0\xbe\xd0\xbd\xd0\xb5\xd1\x87" +
+	"\xd0\xbd\xd0\xbe\xd1\x80\xd0\xb5\xd1\x88\xd0\xb5\xd0\xbd\xd0\xb8\xd0\xb5\xd0\xba\xd0\xbe\xd1\x82\xd0\xbe\xd1\x80\xd0\xbe\xd0\xb5\xd0\xbe\xd1\x80\xd0\xb3\xd0\xb0\xd0\xbd\xd0\xbe\xd0\xb2\xd0\xba" +
+	"\xd0\xbe\xd1\x82\xd0\xbe\xd1\x80\xd0\xbe\xd0\xbc\xd0\xa0\xd0\xb5\xd0\xba\xd0\xbb\xd0\xb0\xd0\xbc\xd0\xb0\xd8\xa7\xd9\x84\xd9\x85\xd9\x86\xd8\xaa\xd8\xaf\xd9\x89\xd9\x85\xd9\x86\xd8\xaa\xd8\xaf" +
+	"\xd9\x8a\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd9\x85\xd9\x88\xd8\xb6\xd9\x88\xd8\xb9\xd8\xa7\xd9\x84\xd8\xa8\xd8\xb1\xd8\xa7\xd9\x85\xd8\xac\xd8\xa7\xd9\x84\xd9\x85\xd9\x88\xd8\xa7\xd9\x82\xd8\xb9" +
+	"\xd8\xa7\xd9\x84\xd8\xb1\xd8\xb3\xd8\xa7\xd8\xa6\xd9\x84\xd9\x85\xd8\xb4\xd8\xa7\xd8\xb1\xd9\x83\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd8\xa3\xd8\xb9\xd8\xb6\xd8\xa7\xd8\xa1\xd8\xa7\xd9\x84\xd8\xb1" +
+	"\xd9\x8a\xd8\xa7\xd8\xb6\xd8\xa9\xd8\xa7\xd9\x84\xd8\xaa\xd8\xb5\xd9\x85\xd9\x8a\xd9\x85\xd8\xa7\xd9\x84\xd8\xa7\xd8\xb9\xd8\xb6\xd8\xa7\xd8\xa1\xd8\xa7\xd9\x84\xd9\x86\xd8\xaa\xd8\xa7\xd8\xa6" +
+	"\xd8\xac\xd8\xa7\xd9\x84\xd8\xa3\xd9\x84\xd8\xb9\xd8\xa7\xd8\xa8\xd8\xa7\xd9\x84\xd8\xaa\xd8\xb3\xd8\xac\xd9\x8a\xd9\x84\xd8\xa7\xd9\x84\xd8\xa3\xd9\x82\xd8\xb3\xd8\xa7\xd9\x85\xd8\xa7\xd9\x84" +
+	"\xd8\xb6\xd8\xba\xd8\xb7\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd9\x81\xd9\x8a\xd8\xaf\xd9\x8a\xd9\x88\xd8\xa7\xd9\x84\xd8\xaa\xd8\xb1\xd8\xad\xd9\x8a\xd8\xa8\xd8\xa7\xd9\x84\xd8\xac\xd8\xaf\xd9\x8a" +
+	"\xd8\xaf\xd8\xa9\xd8\xa7\xd9\x84\xd8\xaa\xd8\xb9\xd9\x84\xd9\x8a\xd9\x85\xd8\xa7\xd9\x84\xd8\xa3\xd8\xae\xd8\xa8\xd8\xa7\xd8\xb1\xd8\xa7\xd9\x84\xd8\xa7\xd9\x81\xd9\x84\xd8\xa7\xd9\x85\xd8\xa7" +
+	"\xd9\x84\xd8\xa3\xd9\x81\xd9\x84\xd8\xa7\xd9\x85\xd8\xa7\xd9\x84\xd8\xaa\xd8\xa7\xd8\xb1\xd9\x8a\xd8\xae\xd8\xa7\xd9\x84\xd8\xaa\xd9\x82\xd9\x86\xd9\x8a\xd8\xa9\xd8\xa7\xd9\x84\xd8\xa7\xd9\x84" +
+	"\xd8\xb9\xd8\xa7\xd8\xa8\xd8\xa7\xd9\x84\xd8\xae\xd9\x88\xd8\xa7\xd8\xb7\xd8\xb1\xd8\xa7\xd9\x84\xd9\x85\xd8\xac\xd8\xaa\xd9\x85\xd8\xb9\xd8\xa7\xd9\x84\xd8\xaf\xd9\x8a\xd9\x83\xd9\x88\xd8\xb1" +
+	"\xd8\xa7\xd9\x84\xd8\xb3\xd9\x8a\xd8\xa7\xd8\xad\xd8\xa9\xd8\xb9\xd8\xa8\xd8\xaf\xd8\xa7\xd9\x84\xd9\x84\xd9\x87\xd8\xa7\xd9\x84\xd8\xaa\xd8\xb1\xd8\xa8\xd9\x8a\xd8\xa9\xd8\xa7\xd9\x84\xd8\xb1" +
+	"\xd9\x88\xd8\xa7\xd8\xa8\xd8\xb7\xd8\xa7\xd9\x84\xd8\xa3\xd8\xaf\xd8\xa8\xd9\x8a\xd8\xa9\xd8\xa7\xd9\x84\xd8\xa7\xd8\xae\xd8\xa8\xd8\xa7\xd8\xb1\xd8\xa7\xd9\x84\xd9\x85\xd8\xaa\xd8\xad\xd8\xaf" +
+	"\xd8\xa9\xd8\xa7\xd9\x84\xd8\xa7\xd8\xba\xd8\xa7\xd9\x86\xd9\x8acursor:pointer;</title>\x0a<meta \" " +
+	"href=\"http://\"><span class=\"members of the windo" +
+	"w.locationvertical-align:/a> | <a href=\"<!doctyp" +
+	"e html>media=\"screen\" <option value=\"favicon.ico" +
+	"\" />\x0a\x09\x09<div class=\"characteristics\" method=\"get\"" +
+	" /body>\x0a</html>\x0ashortcut icon\" document.write(pa" +
+	"dding-bottom:representativessubmit\" value=\"align" +
+	"=\"center\" throughout the science fiction\x0a  <div " +
+	"class=\"submit\" class=\"one of the most valign=\"to" +
+	"p\"><was established);\x0d\x0a</script>\x0d\x0areturn false;\"" +
+	">).style.displaybecause of the document.cookie<f" +
+	"orm action=\"/}body{margin:0;Encyclopedia ofversi" +
+	"on of the .createElement(name\" content=\"</div>\x0a<" +
+	"/div>\x0a\x0aadministrative </body>\x0a</html>history of " +
+	"the \"><input type=\"portion of the as part of the" +
+	" &nbsp;<a href=\"other countries\">\x0a<div class=\"</" +
+	"span></span><In other words,display: block;contr" +
+	"ol of the introduction of/>\x0a<meta name=\"as well " +
+	"as the in recent years\x0d\x0a\x09<div class=\"</div>\x0a\x09</d" +
+	"iv>\x0ainspired by thethe end of the compatible wit" +
+	"hbecame known as style=\"margin:.js\"></script>< I" +
+	"nternational there have beenGerman language styl" +
+	"e=\"color:#Communist Partyconsistent withborder=\"" +
+	"0\" cell marginheight=\"the majority of\" align=\"ce" +
+	"nterrelated to the many different Orthodox Churc" +
+	"hsimilar to the />\x0a<link rel=\"swas one of the un" +
+	"til his death})();\x0a</script>other languagescompa" +
+	"red to theportions of thethe Netherlandsthe most" +
+	" commonbackground:url(argued that thescrolling=\"" +
+	"no\" included in theNorth American the name of th" +
+	"einterpretationsthe traditionaldevelopment of fr" +
+	"equently useda collection ofvery similar tosurro" +
+	"unding theexample of thisalign=\"center\">would ha" +
+	"ve beenimage_caption =attached to thesuggesting " +
+	"thatin the form of involved in theis derived fro" +
+	"mnamed after theIntroduction torestrictions on s" +
+	"tyle=\"width: can be used to the creation ofmost " +
+	"important information andresulted in thecollapse" +
+	" of theThis means thatelements of thewas replace" +
+	"d byanalysis of theinspiration forregarded as th" +
+	"emost successfulknown as &quot;a comprehensiveHi" +
+	"story of the were consideredreturned to theare r" +
+	"eferred toUnsourced image>\x0a\x09<div class=\"consists" +
+	" of thestopPropagationinterest in theavailabilit" +
+	"y ofappears to haveelectromagneticenableServices" +
+	"(function of theIt is important</script></div>fu" +
+	"nction(){var relative to theas a result of the p" +
+	"osition ofFor example, in method=\"post\" was foll" +
+	"owed by&amp;mdash; thethe applicationjs\"></scrip" +
+	"t>\x0d\x0aul></div></div>after the deathwith respect t" +
+	"ostyle=\"padding:is particularlydisplay:inline; t" +
+	"ype=\"submit\" is divided into\xe4\xb8\xad\xe6\x96\x87 (\xe7\xae\x80\xe4\xbd\x93)respo" +
+	"nsabilidadadministraci\xc3\xb3ninternacionalescorrespo" +
+	"ndiente\xe0\xa4\x89\xe0\xa4\xaa\xe0\xa4\xaf\xe0\xa5\x8b\xe0\xa4\x97\xe0\xa4\xaa\xe0\xa5\x82\xe0\xa4\xb0\xe0\xa5\x8d\xe0\xa4\xb5\xe0\xa4\xb9\xe0\xa4\xae\xe0\xa4\xbe\xe0\xa4" +
+	"\xb0\xe0\xa5\x87\xe0\xa4\xb2\xe0\xa5\x8b\xe0\xa4\x97\xe0\xa5\x8b\xe0\xa4\x82\xe0\xa4\x9a\xe0\xa5\x81\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\xb5\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4\x95\xe0\xa4\xbf\xe0\xa4" +
+	"\xa8\xe0\xa4\xb8\xe0\xa4\xb0\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\xaa\xe0\xa5\x81\xe0\xa4\xb2\xe0\xa4\xbf\xe0\xa4\xb8\xe0\xa4\x96\xe0\xa5\x8b\xe0\xa4\x9c\xe0\xa5\x87\xe0\xa4\x82\xe0\xa4" +
+	"\x9a\xe0\xa4\xbe\xe0\xa4\xb9\xe0\xa4\xbf\xe0\xa4\x8f\xe0\xa4\xad\xe0\xa5\x87\xe0\xa4\x9c\xe0\xa5\x87\xe0\xa4\x82\xe0\xa4\xb6\xe0\xa4\xbe\xe0\xa4\xae\xe0\xa4\xbf\xe0\xa4\xb2\xe0\xa4\xb9\xe0\xa4" +
+	"\xae\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa5\x80\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4\x97\xe0\xa4\xb0\xe0\xa4\xa3\xe0\xa4\xac\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\x95\xe0\xa5\x81\xe0\xa4" +
+	"\xae\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\xac\xe0\xa5\x8d\xe0\xa4\xb2\xe0\xa5\x89\xe0\xa4\x97\xe0\xa4\xae\xe0\xa4\xbe\xe0\xa4\xb2\xe0\xa4\xbf\xe0\xa4\x95\xe0\xa4\xae\xe0\xa4\xb9\xe0\xa4\xbf\xe0\xa4" +
+	"\xb2\xe0\xa4\xbe\xe0\xa4\xaa\xe0\xa5\x83\xe0\xa4\xb7\xe0\xa5\x8d\xe0\xa4\xa0\xe0\xa4\xac\xe0\xa4\xa2\xe0\xa4\xbc\xe0\xa4\xa4\xe0\xa5\x87\xe0\xa4\xad\xe0\xa4\xbe\xe0\xa4\x9c\xe0\xa4\xaa\xe0\xa4" +
+	"\xbe\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xb2\xe0\xa4\xbf\xe0\xa4\x95\xe0\xa4\x9f\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa5\x87\xe0\xa4\xa8\xe0\xa4\x96\xe0\xa4\xbf\xe0\xa4\xb2\xe0\xa4\xbe\xe0\xa4\xab\xe0\xa4" +
+	"\xa6\xe0\xa5\x8c\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa4\xae\xe0\xa4\xbe\xe0\xa4\xae\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4\xae\xe0\xa4\xa4\xe0\xa4\xa6\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa4\xac\xe0\xa4" +
+	"\xbe\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\xb5\xe0\xa4\xbf\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xaf\xe0\xa5\x8b\xe0\xa4\x82\xe0\xa4\x9a\xe0\xa4\xbe\xe0\xa4" +
+	"\xb9\xe0\xa4\xa4\xe0\xa5\x87\xe0\xa4\xaa\xe0\xa4\xb9\xe0\xa5\x81\xe0\xa4\x81\xe0\xa4\x9a\xe0\xa4\xac\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa4\x82\xe0\xa4\xb5\xe0\xa4" +
+	"\xbe\xe0\xa4\xa6\xe0\xa4\xa6\xe0\xa5\x87\xe0\xa4\x96\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\xaa\xe0\xa4\xbf\xe0\xa4\x9b\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4\xb5\xe0\xa4\xbf\xe0\xa4\xb6\xe0\xa5\x87\xe0\xa4" +
+	"\xb7\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\x9c\xe0\xa5\x8d\xe0\xa4\xaf\xe0\xa4\x89\xe0\xa4\xa4\xe0\xa5\x8d\xe0\xa4\xa4\xe0\xa4\xb0\xe0\xa4\xae\xe0\xa5\x81\xe0\xa4\x82\xe0\xa4\xac\xe0\xa4\x88\xe0\xa4" +
+	"\xa6\xe0\xa5\x8b\xe0\xa4\xa8\xe0\xa5\x8b\xe0\xa4\x82\xe0\xa4\x89\xe0\xa4\xaa\xe0\xa4\x95\xe0\xa4\xb0\xe0\xa4\xa3\xe0\xa4\xaa\xe0\xa4\xa2\xe0\xa4\xbc\xe0\xa5\x87\xe0\xa4\x82\xe0\xa4\xb8\xe0\xa5" +
+	"\x8d\xe0\xa4\xa5\xe0\xa4\xbf\xe0\xa4\xa4\xe0\xa4\xab\xe0\xa4\xbf\xe0\xa4\xb2\xe0\xa5\x8d\xe0\xa4\xae\xe0\xa4\xae\xe0\xa5\x81\xe0\xa4\x96\xe0\xa5\x8d\xe0\xa4\xaf\xe0\xa4\x85\xe0\xa4\x9a\xe0\xa5" +
+	"\x8d\xe0\xa4\x9b\xe0\xa4\xbe\xe0\xa4\x9b\xe0\xa5\x82\xe0\xa4\x9f\xe0\xa4\xa4\xe0\xa5\x80\xe0\xa4\xb8\xe0\xa4\x82\xe0\xa4\x97\xe0\xa5\x80\xe0\xa4\xa4\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4\x8f\xe0\xa4" +
+	"\x97\xe0\xa4\xbe\xe0\xa4\xb5\xe0\xa4\xbf\xe0\xa4\xad\xe0\xa4\xbe\xe0\xa4\x97\xe0\xa4\x98\xe0\xa4\xa3\xe0\xa5\x8d\xe0\xa4\x9f\xe0\xa5\x87\xe0\xa4\xa6\xe0\xa5\x82\xe0\xa4\xb8\xe0\xa4\xb0\xe0\xa5" +
+	"\x87\xe0\xa4\xa6\xe0\xa4\xbf\xe0\xa4\xa8\xe0\xa5\x8b\xe0\xa4\x82\xe0\xa4\xb9\xe0\xa4\xa4\xe0\xa5\x8d\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa5\x87\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xb8\xe0\xa4" +
+	"\x97\xe0\xa4\xbe\xe0\xa4\x82\xe0\xa4\xa7\xe0\xa5\x80\xe0\xa4\xb5\xe0\xa4\xbf\xe0\xa4\xb6\xe0\xa5\x8d\xe0\xa4\xb5\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\xa4\xe0\xa5\x87\xe0\xa4\x82\xe0\xa4\xa6\xe0\xa5" +
+	"\x88\xe0\xa4\x9f\xe0\xa5\x8d\xe0\xa4\xb8\xe0\xa4\xa8\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xb6\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa4\xbe\xe0\xa4\xae\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\x85\xe0\xa4\xa6\xe0\xa4" +
+	"\xbe\xe0\xa4\xb2\xe0\xa4\xa4\xe0\xa4\xac\xe0\xa4\xbf\xe0\xa4\x9c\xe0\xa4\xb2\xe0\xa5\x80\xe0\xa4\xaa\xe0\xa5\x81\xe0\xa4\xb0\xe0\xa5\x82\xe0\xa4\xb7\xe0\xa4\xb9\xe0\xa4\xbf\xe0\xa4\x82\xe0\xa4" +
+	"\xa6\xe0\xa5\x80\xe0\xa4\xae\xe0\xa4\xbf\xe0\xa4\xa4\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\x95\xe0\xa4\xb5\xe0\xa4\xbf\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa5\x81\xe0\xa4\xaa\xe0\xa4\xaf\xe0\xa5" +
+	"\x87\xe0\xa4\xb8\xe0\xa5\x8d\xe0\xa4\xa5\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa4\x95\xe0\xa4\xb0\xe0\xa5\x8b\xe0\xa4\xa1\xe0\xa4\xbc\xe0\xa4\xae\xe0\xa5\x81\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xa4\xe0\xa4" +
+	"\xaf\xe0\xa5\x8b\xe0\xa4\x9c\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\x95\xe0\xa5\x83\xe0\xa4\xaa\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\xaa\xe0\xa5\x8b\xe0\xa4\xb8\xe0\xa5\x8d\xe0\xa4\x9f\xe0\xa4\x98\xe0\xa4" +
+	"\xb0\xe0\xa5\x87\xe0\xa4\xb2\xe0\xa5\x82\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa5\x8d\xe0\xa4\xaf\xe0\xa4\xb5\xe0\xa4\xbf\xe0\xa4\x9a\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\xb8\xe0\xa5\x82\xe0\xa4" +
+	"\x9a\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\xae\xe0\xa5\x82\xe0\xa4\xb2\xe0\xa5\x8d\xe0\xa4\xaf\xe0\xa4\xa6\xe0\xa5\x87\xe0\xa4\x96\xe0\xa5\x87\xe0\xa4\x82\xe0\xa4\xb9\xe0\xa4\xae\xe0\xa5\x87\xe0\xa4" +
+	"\xb6\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa5\x8d\xe0\xa4\x95\xe0\xa5\x82\xe0\xa4\xb2\xe0\xa4\xae\xe0\xa5\x88\xe0\xa4\x82\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\xa4\xe0\xa5\x88\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4" +
+	"\xb0\xe0\xa4\x9c\xe0\xa4\xbf\xe0\xa4\xb8\xe0\xa4\x95\xe0\xa5\x87rss+xml\" title=\"-type\" content=\"" +
+	"title\" content=\"at the same time.js\"></script>\x0a<" +
+	"\" method=\"post\" </span></a></li>vertical-align:t" +
+	"/jquery.min.js\">.click(function( style=\"padding-" +
+	"})();\x0a</script>\x0a</span><a href=\"<a href=\"http://" +
+	"); return false;text-decoration: scrolling=\"no\" " +
+	"border-collapse:associated with Bahasa Indonesia" +
+	"English language<text xml:space=.gif\" border=\"0\"" +
+	"</body>\x0a</html>\x0aoverflow:hidden;img src=\"http://" +
+	"addEventListenerresponsible for s.js\"></script>\x0a" +
+	"/favicon.ico\" />operating system\" style=\"width:1" +
+	"target=\"_blank\">State Universitytext-align:left;" +
+	"\x0adocument.write(, including the around the world" +
+	");\x0d\x0a</script>\x0d\x0a<\" style=\"height:;overflow:hidden" +
+	"more informationan internationala member of the " +
+	"one of the firstcan be found in </div>\x0a\x09\x09</div>\x0a" +
+	"display: none;\">\" />\x0a<link rel=\"\x0a  (function() {" +
+	"the 15th century.preventDefault(large number of " +
+	"Byzantine Empire.jpg|thumb|left|vast majority of" +
+	"majority of the  align=\"center\">University Press" +
+	"dominated by theSecond World Wardistribution of " +
+	"style=\"position:the rest of the characterized by" +
+	" rel=\"nofollow\">derives from therather than the " +
+	"a combination ofstyle=\"width:100English-speaking" +
+	"computer scienceborder=\"0\" alt=\"the existence of" +
+	"Democratic Party\" style=\"margin-For this reason," +
+	".js\"></script>\x0a\x09sByTagName(s)[0]js\"></script>\x0d\x0a<" +
+	".js\"></script>\x0d\x0alink rel=\"icon\" ' alt='' class='" +
+	"formation of theversions of the </a></div></div>" +
+	"/page>\x0a  <page>\x0a<div class=\"contbecame the first" +
+	"bahasa Indonesiaenglish (simple)\xce\x95\xce\xbb\xce\xbb\xce\xb7\xce\xbd\xce\xb9\xce\xba\xce\xac" +
+	"\xd1\x85\xd1\x80\xd0\xb2\xd0\xb0\xd1\x82\xd1\x81\xd0\xba\xd0\xb8\xd0\xba\xd0\xbe\xd0\xbc\xd0\xbf\xd0\xb0\xd0\xbd\xd0\xb8\xd0\xb8\xd1\x8f\xd0\xb2\xd0\xbb\xd1\x8f\xd0\xb5\xd1\x82\xd1\x81\xd1\x8f" +
+	"\xd0\x94\xd0\xbe\xd0\xb1\xd0\xb0\xd0\xb2\xd0\xb8\xd1\x82\xd1\x8c\xd1\x87\xd0\xb5\xd0\xbb\xd0\xbe\xd0\xb2\xd0\xb5\xd0\xba\xd0\xb0\xd1\x80\xd0\xb0\xd0\xb7\xd0\xb2\xd0\xb8\xd1\x82\xd0\xb8\xd1\x8f" +
+	"\xd0\x98\xd0\xbd\xd1\x82\xd0\xb5\xd1\x80\xd0\xbd\xd0\xb5\xd1\x82\xd0\x9e\xd1\x82\xd0\xb2\xd0\xb5\xd1\x82\xd0\xb8\xd1\x82\xd1\x8c\xd0\xbd\xd0\xb0\xd0\xbf\xd1\x80\xd0\xb8\xd0\xbc\xd0\xb5\xd1\x80" +
+	"\xd0\xb8\xd0\xbd\xd1\x82\xd0\xb5\xd1\x80\xd0\xbd\xd0\xb5\xd1\x82\xd0\xba\xd0\xbe\xd1\x82\xd0\xbe\xd1\x80\xd0\xbe\xd0\xb3\xd0\xbe\xd1\x81\xd1\x82\xd1\x80\xd0\xb0\xd0\xbd\xd0\xb8\xd1\x86\xd1\x8b" +
+	"\xd0\xba\xd0\xb0\xd1\x87\xd0\xb5\xd1\x81\xd1\x82\xd0\xb2\xd0\xb5\xd1\x83\xd1\x81\xd0\xbb\xd0\xbe\xd0\xb2\xd0\xb8\xd1\x8f\xd1\x85\xd0\xbf\xd1\x80\xd0\xbe\xd0\xb1\xd0\xbb\xd0\xb5\xd0\xbc\xd1\x8b" +
+	"\xd0\xbf\xd0\xbe\xd0\xbb\xd1\x83\xd1\x87\xd0\xb8\xd1\x82\xd1\x8c\xd1\x8f\xd0\xb2\xd0\xbb\xd1\x8f\xd1\x8e\xd1\x82\xd1\x81\xd1\x8f\xd0\xbd\xd0\xb0\xd0\xb8\xd0\xb1\xd0\xbe\xd0\xbb\xd0\xb5\xd0\xb5" +
+	"\xd0\xba\xd0\xbe\xd0\xbc\xd0\xbf\xd0\xb0\xd0\xbd\xd0\xb8\xd1\x8f\xd0\xb2\xd0\xbd\xd0\xb8\xd0\xbc\xd0\xb0\xd0\xbd\xd0\xb8\xd0\xb5\xd1\x81\xd1\x80\xd0\xb5\xd0\xb4\xd1\x81\xd1\x82\xd0\xb2\xd0\xb0" +
+	"\xd8\xa7\xd9\x84\xd9\x85\xd9\x88\xd8\xa7\xd8\xb6\xd9\x8a\xd8\xb9\xd8\xa7\xd9\x84\xd8\xb1\xd8\xa6\xd9\x8a\xd8\xb3\xd9\x8a\xd8\xa9\xd8\xa7\xd9\x84\xd8\xa7\xd9\x86\xd8\xaa\xd9\x82\xd8\xa7\xd9\x84" +
+	"\xd9\x85\xd8\xb4\xd8\xa7\xd8\xb1\xd9\x83\xd8\xa7\xd8\xaa\xd9\x83\xd8\xa7\xd9\x84\xd8\xb3\xd9\x8a\xd8\xa7\xd8\xb1\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd9\x85\xd9\x83\xd8\xaa\xd9\x88\xd8\xa8\xd8\xa9" +
+	"\xd8\xa7\xd9\x84\xd8\xb3\xd8\xb9\xd9\x88\xd8\xaf\xd9\x8a\xd8\xa9\xd8\xa7\xd8\xad\xd8\xb5\xd8\xa7\xd8\xa6\xd9\x8a\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd8\xb9\xd8\xa7\xd9\x84\xd9\x85\xd9\x8a\xd8\xa9" +
+	"\xd8\xa7\xd9\x84\xd8\xb5\xd9\x88\xd8\xaa\xd9\x8a\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd8\xa7\xd9\x86\xd8\xaa\xd8\xb1\xd9\x86\xd8\xaa\xd8\xa7\xd9\x84\xd8\xaa\xd8\xb5\xd8\xa7\xd9\x85\xd9\x8a\xd9\x85" +
+	"\xd8\xa7\xd9\x84\xd8\xa5\xd8\xb3\xd9\x84\xd8\xa7\xd9\x85\xd9\x8a\xd8\xa7\xd9\x84\xd9\x85\xd8\xb4\xd8\xa7\xd8\xb1\xd9\x83\xd8\xa9\xd8\xa7\xd9\x84\xd9\x85\xd8\xb1\xd8\xa6\xd9\x8a\xd8\xa7\xd8\xaa" +
+	"robots\" content=\"<div id=\"footer\">the United Sta" +
+	"tes<img src=\"http://.jpg|right|thumb|.js\"></scri" +
+	"pt>\x0d\x0a<location.protocolframeborder=\"0\" s\" />\x0a<me" +
+	"ta name=\"</a></div></div><font-weight:bold;&quot" +
+	"; and &quot;depending on the margin:0;padding:\" " +
+	"rel=\"nofollow\" President of the twentieth centur" +
+	"yevision>\x0a  </pageInternet Explorera.async = tru" +
+	"e;\x0d\x0ainformation about<div id=\"header\">\" action=\"" +
+	"http://<a href=\"https://<div id=\"content\"</div>\x0d" +
+	"\x0a</div>\x0d\x0a<derived from the <img src='http://acco" +
+	"rding to the \x0a</body>\x0a</html>\x0astyle=\"font-size:s" +
+	"cript language=\"Arial, Helvetica,</a><span class" +
+	"=\"</script><script political partiestd></tr></ta" +
+	"ble><href=\"http://www.interpretation ofrel=\"styl" +
+	"esheet\" document.write('<charset=\"utf-8\">\x0abeginn" +
+	"ing of the revealed that thetelevision series\" r" +
+	"el=\"nofollow\"> target=\"_blank\">claiming that the" +
+	"http%3A%2F%2Fwww.manifestations ofPrime Minister" +
+	" ofinfluenced by theclass=\"clearfix\">/div>\x0d\x0a</di" +
+	"v>\x0d\x0a\x0d\x0athree-dimensionalChurch of Englandof North" +
+	" Carolinasquare kilometres.addEventListenerdisti" +
+	"nct from thecommonly known asPhonetic Alphabetde" +
+	"clared that thecontrolled by theBenjamin Frankli" +
+	"nrole-playing gamethe University ofin Western Eu" +
+	"ropepersonal computerProject Gutenbergregardless" +
+	" of thehas been proposedtogether with the></li><" +
+	"li class=\"in some countriesmin.js\"></script>of t" +
+	"he populationofficial language<img src=\"images/i" +
+	"dentified by thenatural resourcesclassification " +
+	"ofcan be consideredquantum mechanicsNevertheless" +
+	", themillion years ago</body>\x0d\x0a</html>\x0d\xce\x95\xce\xbb\xce\xbb\xce\xb7\xce" +
+	"\xbd\xce\xb9\xce\xba\xce\xac\x0atake advantage ofand, according toattrib" +
+	"uted to theMicrosoft Windowsthe first centuryund" +
+	"er the controldiv class=\"headershortly after the" +
+	"notable exceptiontens of thousandsseveral differ" +
+	"entaround the world.reaching militaryisolated fr" +
+	"om theopposition to thethe Old TestamentAfrican " +
+	"Americansinserted into theseparate from themetro" +
+	"politan areamakes it possibleacknowledged thatar" +
+	"guably the mosttype=\"text/css\">\x0athe Internationa" +
+	"lAccording to the pe=\"text/css\" />\x0acoincide with" +
+	" thetwo-thirds of theDuring this time,during the" +
+	" periodannounced that hethe internationaland mor" +
+	"e recentlybelieved that theconsciousness andform" +
+	"erly known assurrounded by thefirst appeared ino" +
+	"ccasionally usedposition:absolute;\" target=\"_bla" +
+	"nk\" position:relative;text-align:center;jax/libs" +
+	"/jquery/1.background-color:#type=\"application/an" +
+	"guage\" content=\"<meta http-equiv=\"Privacy Policy" +
+	"</a>e(\"%3Cscript src='\" target=\"_blank\">On the o" +
+	"ther hand,.jpg|thumb|right|2</div><div class=\"<d" +
+	"iv style=\"float:nineteenth century</body>\x0d\x0a</htm" +
+	"l>\x0d\x0a<img src=\"http://s;text-align:centerfont-wei" +
+	"ght: bold; According to the difference between\" " +
+	"frameborder=\"0\" \" style=\"position:link href=\"htt" +
+	"p://html4/loose.dtd\">\x0aduring this period</td></t" +
+	"r></table>closely related tofor the first time;f" +
+	"ont-weight:bold;input type=\"text\" <span style=\"f" +
+	"ont-onreadystatechange\x09<div class=\"cleardocument" +
+	".location. For example, the a wide variety of <!" +
+	"DOCTYPE html>\x0d\x0a<&nbsp;&nbsp;&nbsp;\"><a href=\"htt" +
+	"p://style=\"float:left;concerned with the=http%3A" +
+	"%2F%2Fwww.in popular culturetype=\"text/css\" />it" +
+	" is possible to Harvard Universitytylesheet\" hre" +
+	"f=\"/the main characterOxford University  name=\"k" +
+	"eywords\" cstyle=\"text-align:the United Kingdomfe" +
+	"deral government<div style=\"margin depending on " +
+	"the description of the<div class=\"header.min.js\"" +
+	"></script>destruction of theslightly differentin" +
+	" accordance withtelecommunicationsindicates that" +
+	" theshortly thereafterespecially in the European" +
+	" countriesHowever, there aresrc=\"http://staticsu" +
+	"ggested that the\" src=\"http://www.a large number" +
+	" of Telecommunications\" rel=\"nofollow\" tHoly Rom" +
+	"an Emperoralmost exclusively\" border=\"0\" alt=\"Se" +
+	"cretary of Stateculminating in theCIA World Fact" +
+	"bookthe most importantanniversary of thestyle=\"b" +
+	"ackground-<li><em><a href=\"/the Atlantic Oceanst" +
+	"rictly speaking,shortly before thedifferent type" +
+	"s ofthe Ottoman Empire><img src=\"http://An Intro" +
+	"duction toconsequence of thedeparture from theCo" +
+	"nfederate Statesindigenous peoplesProceedings of" +
+	" theinformation on thetheories have beeninvolvem" +
+	"ent in thedivided into threeadjacent countriesis" +
+	" responsible fordissolution of thecollaboration " +
+	"withwidely regarded ashis contemporariesfounding" +
+	" member ofDominican Republicgenerally acceptedth" +
+	"e possibility ofare also availableunder construc" +
+	"tionrestoration of thethe general publicis almos" +
+	"t entirelypasses through thehas been suggestedco" +
+	"mputer and videoGermanic languages according to " +
+	"the different from theshortly afterwardshref=\"ht" +
+	"tps://www.recent developmentBoard of Directors<d" +
+	"iv class=\"search| <a href=\"http://In particular," +
+	" theMultiple footnotesor other substancethousand" +
+	"s of yearstranslation of the</div>\x0d\x0a</div>\x0d\x0a\x0d\x0a<a" +
+	" href=\"index.phpwas established inmin.js\"></scri" +
+	"pt>\x0aparticipate in thea strong influencestyle=\"m" +
+	"argin-top:represented by thegraduated from theTr" +
+	"aditionally, theElement(\"script\");However, since" +
+	" the/div>\x0a</div>\x0a<div left; margin-left:protecti" +
+	"on against0; vertical-align:Unfortunately, thety" +
+	"pe=\"image/x-icon/div>\x0a<div class=\" class=\"clearf" +
+	"ix\"><div class=\"footer\x09\x09</div>\x0a\x09\x09</div>\x0athe moti" +
+	"on picture\xd0\x91\xd1\x8a\xd0\xbb\xd0\xb3\xd0\xb0\xd1\x80\xd1\x81\xd0\xba\xd0\xb8\xd0\xb1\xd1\x8a\xd0\xbb\xd0\xb3\xd0\xb0\xd1\x80\xd1\x81\xd0\xba\xd0\xb8\xd0\xa4" +
+	"\xd0\xb5\xd0\xb4\xd0\xb5\xd1\x80\xd0\xb0\xd1\x86\xd0\xb8\xd0\xb8\xd0\xbd\xd0\xb5\xd1\x81\xd0\xba\xd0\xbe\xd0\xbb\xd1\x8c\xd0\xba\xd0\xbe\xd1\x81\xd0\xbe\xd0\xbe\xd0\xb1\xd1\x89\xd0\xb5\xd0\xbd" +
+	"\xd0\xb8\xd0\xb5\xd1\x81\xd0\xbe\xd0\xbe\xd0\xb1\xd1\x89\xd0\xb5\xd0\xbd\xd0\xb8\xd1\x8f\xd0\xbf\xd1\x80\xd0\xbe\xd0\xb3\xd1\x80\xd0\xb0\xd0\xbc\xd0\xbc\xd1\x8b\xd0\x9e\xd1\x82\xd0\xbf\xd1\x80" +
+	"\xd0\xb0\xd0\xb2\xd0\xb8\xd1\x82\xd1\x8c\xd0\xb1\xd0\xb5\xd1\x81\xd0\xbf\xd0\xbb\xd0\xb0\xd1\x82\xd0\xbd\xd0\xbe\xd0\xbc\xd0\xb0\xd1\x82\xd0\xb5\xd1\x80\xd0\xb8\xd0\xb0\xd0\xbb\xd1\x8b\xd0\xbf" +
+	"\xd0\xbe\xd0\xb7\xd0\xb2\xd0\xbe\xd0\xbb\xd1\x8f\xd0\xb5\xd1\x82\xd0\xbf\xd0\xbe\xd1\x81\xd0\xbb\xd0\xb5\xd0\xb4\xd0\xbd\xd0\xb8\xd0\xb5\xd1\x80\xd0\xb0\xd0\xb7\xd0\xbb\xd0\xb8\xd1\x87\xd0\xbd" +
+	"\xd1\x8b\xd1\x85\xd0\xbf\xd1\x80\xd0\xbe\xd0\xb4\xd1\x83\xd0\xba\xd1\x86\xd0\xb8\xd0\xb8\xd0\xbf\xd1\x80\xd0\xbe\xd0\xb3\xd1\x80\xd0\xb0\xd0\xbc\xd0\xbc\xd0\xb0\xd0\xbf\xd0\xbe\xd0\xbb\xd0\xbd" +
+	"\xd0\xbe\xd1\x81\xd1\x82\xd1\x8c\xd1\x8e\xd0\xbd\xd0\xb0\xd1\x85\xd0\xbe\xd0\xb4\xd0\xb8\xd1\x82\xd1\x81\xd1\x8f\xd0\xb8\xd0\xb7\xd0\xb1\xd1\x80\xd0\xb0\xd0\xbd\xd0\xbd\xd0\xbe\xd0\xb5\xd0\xbd" +
+	"\xd0\xb0\xd1\x81\xd0\xb5\xd0\xbb\xd0\xb5\xd0\xbd\xd0\xb8\xd1\x8f\xd0\xb8\xd0\xb7\xd0\xbc\xd0\xb5\xd0\xbd\xd0\xb5\xd0\xbd\xd0\xb8\xd1\x8f\xd0\xba\xd0\xb0\xd1\x82\xd0\xb5\xd0\xb3\xd0\xbe\xd1\x80" +
+	"\xd0\xb8\xd0\xb8\xd0\x90\xd0\xbb\xd0\xb5\xd0\xba\xd1\x81\xd0\xb0\xd0\xbd\xd0\xb4\xd1\x80\xe0\xa4\xa6\xe0\xa5\x8d\xe0\xa4\xb5\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\xae\xe0\xa5\x88\xe0\xa4" +
+	"\xa8\xe0\xa5\x81\xe0\xa4\x85\xe0\xa4\xb2\xe0\xa4\xaa\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xa6\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa4\xad\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\xa4\xe0\xa5\x80\xe0\xa4\xaf\xe0\xa4" +
+	"\x85\xe0\xa4\xa8\xe0\xa5\x81\xe0\xa4\xa6\xe0\xa5\x87\xe0\xa4\xb6\xe0\xa4\xb9\xe0\xa4\xbf\xe0\xa4\xa8\xe0\xa5\x8d\xe0\xa4\xa6\xe0\xa5\x80\xe0\xa4\x87\xe0\xa4\x82\xe0\xa4\xa1\xe0\xa4\xbf\xe0\xa4" +
+	"\xaf\xe0\xa4\xbe\xe0\xa4\xa6\xe0\xa4\xbf\xe0\xa4\xb2\xe0\xa5\x8d\xe0\xa4\xb2\xe0\xa5\x80\xe0\xa4\x85\xe0\xa4\xa7\xe0\xa4\xbf\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\xb5\xe0\xa5\x80\xe0\xa4" +
+	"\xa1\xe0\xa4\xbf\xe0\xa4\xaf\xe0\xa5\x8b\xe0\xa4\x9a\xe0\xa4\xbf\xe0\xa4\x9f\xe0\xa5\x8d\xe0\xa4\xa0\xe0\xa5\x87\xe0\xa4\xb8\xe0\xa4\xae\xe0\xa4\xbe\xe0\xa4\x9a\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4" +
+	"\x9c\xe0\xa4\x82\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xb6\xe0\xa4\xa8\xe0\xa4\xa6\xe0\xa5\x81\xe0\xa4\xa8\xe0\xa4\xbf\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\xaa\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xaf\xe0\xa5" +
+	"\x8b\xe0\xa4\x97\xe0\xa4\x85\xe0\xa4\xa8\xe0\xa5\x81\xe0\xa4\xb8\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\x91\xe0\xa4\xa8\xe0\xa4\xb2\xe0\xa4\xbe\xe0\xa4\x87\xe0\xa4\xa8\xe0\xa4\xaa\xe0\xa4\xbe\xe0\xa4" +
+	"\xb0\xe0\xa5\x8d\xe0\xa4\x9f\xe0\xa5\x80\xe0\xa4\xb6\xe0\xa4\xb0\xe0\xa5\x8d\xe0\xa4\xa4\xe0\xa5\x8b\xe0\xa4\x82\xe0\xa4\xb2\xe0\xa5\x8b\xe0\xa4\x95\xe0\xa4\xb8\xe0\xa4\xad\xe0\xa4\xbe\xe0\xa4" +
+	"\xab\xe0\xa4\xbc\xe0\xa5\x8d\xe0\xa4\xb2\xe0\xa5\x88\xe0\xa4\xb6\xe0\xa4\xb6\xe0\xa4\xb0\xe0\xa5\x8d\xe0\xa4\xa4\xe0\xa5\x87\xe0\xa4\x82\xe0\xa4\xaa\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xa6\xe0\xa5" +
+	"\x87\xe0\xa4\xb6\xe0\xa4\xaa\xe0\xa5\x8d\xe0\xa4\xb2\xe0\xa5\x87\xe0\xa4\xaf\xe0\xa4\xb0\xe0\xa4\x95\xe0\xa5\x87\xe0\xa4\x82\xe0\xa4\xa6\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xb8\xe0\xa5\x8d\xe0\xa4" +
+	"\xa5\xe0\xa4\xbf\xe0\xa4\xa4\xe0\xa4\xbf\xe0\xa4\x89\xe0\xa4\xa4\xe0\xa5\x8d\xe0\xa4\xaa\xe0\xa4\xbe\xe0\xa4\xa6\xe0\xa4\x89\xe0\xa4\xa8\xe0\xa5\x8d\xe0\xa4\xb9\xe0\xa5\x87\xe0\xa4\x82\xe0\xa4" +
+	"\x9a\xe0\xa4\xbf\xe0\xa4\x9f\xe0\xa5\x8d\xe0\xa4\xa0\xe0\xa4\xbe\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\xa4\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\x9c\xe0\xa5\x8d\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4" +
+	"\xa6\xe0\xa4\xbe\xe0\xa4\xaa\xe0\xa5\x81\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\x9c\xe0\xa5\x8b\xe0\xa4\xa1\xe0\xa4\xbc\xe0\xa5\x87\xe0\xa4\x82\xe0\xa4\x85\xe0\xa4\xa8\xe0\xa5" +
+	"\x81\xe0\xa4\xb5\xe0\xa4\xbe\xe0\xa4\xa6\xe0\xa4\xb6\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa5\x87\xe0\xa4\xa3\xe0\xa5\x80\xe0\xa4\xb6\xe0\xa4\xbf\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xb7\xe0\xa4\xbe\xe0\xa4" +
+	"\xb8\xe0\xa4\xb0\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa5\x80\xe0\xa4\xb8\xe0\xa4\x82\xe0\xa4\x97\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xb9\xe0\xa4\xaa\xe0\xa4\xb0\xe0\xa4\xbf\xe0\xa4\xa3\xe0\xa4" +
+	"\xbe\xe0\xa4\xae\xe0\xa4\xac\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\x82\xe0\xa4\xa1\xe0\xa4\xac\xe0\xa4\x9a\xe0\xa5\x8d\xe0\xa4\x9a\xe0\xa5\x8b\xe0\xa4\x82\xe0\xa4\x89\xe0\xa4\xaa\xe0\xa4" +
+	"\xb2\xe0\xa4\xac\xe0\xa5\x8d\xe0\xa4\xa7\xe0\xa4\xae\xe0\xa4\x82\xe0\xa4\xa4\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa5\x80\xe0\xa4\xb8\xe0\xa4\x82\xe0\xa4\xaa\xe0\xa4\xb0\xe0\xa5\x8d\xe0\xa4\x95\xe0\xa4" +
+	"\x89\xe0\xa4\xae\xe0\xa5\x8d\xe0\xa4\xae\xe0\xa5\x80\xe0\xa4\xa6\xe0\xa4\xae\xe0\xa4\xbe\xe0\xa4\xa7\xe0\xa5\x8d\xe0\xa4\xaf\xe0\xa4\xae\xe0\xa4\xb8\xe0\xa4\xb9\xe0\xa4\xbe\xe0\xa4\xaf\xe0\xa4" +
+	"\xa4\xe0\xa4\xbe\xe0\xa4\xb6\xe0\xa4\xac\xe0\xa5\x8d\xe0\xa4\xa6\xe0\xa5\x8b\xe0\xa4\x82\xe0\xa4\xae\xe0\xa5\x80\xe0\xa4\xa1\xe0\xa4\xbf\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4\x86\xe0\xa4\x88\xe0\xa4" +
+	"\xaa\xe0\xa5\x80\xe0\xa4\x8f\xe0\xa4\xb2\xe0\xa4\xae\xe0\xa5\x8b\xe0\xa4\xac\xe0\xa4\xbe\xe0\xa4\x87\xe0\xa4\xb2\xe0\xa4\xb8\xe0\xa4\x82\xe0\xa4\x96\xe0\xa5\x8d\xe0\xa4\xaf\xe0\xa4\xbe\xe0\xa4" +
+	"\x86\xe0\xa4\xaa\xe0\xa4\xb0\xe0\xa5\x87\xe0\xa4\xb6\xe0\xa4\xa8\xe0\xa4\x85\xe0\xa4\xa8\xe0\xa5\x81\xe0\xa4\xac\xe0\xa4\x82\xe0\xa4\xa7\xe0\xa4\xac\xe0\xa4\xbe\xe0\xa4\x9c\xe0\xa4\xbc\xe0\xa4" +
+	"\xbe\xe0\xa4\xb0\xe0\xa4\xa8\xe0\xa4\xb5\xe0\xa5\x80\xe0\xa4\xa8\xe0\xa4\xa4\xe0\xa4\xae\xe0\xa4\xaa\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xae\xe0\xa5\x81\xe0\xa4\x96\xe0\xa4\xaa\xe0\xa5\x8d\xe0\xa4" +
+	"\xb0\xe0\xa4\xb6\xe0\xa5\x8d\xe0\xa4\xa8\xe0\xa4\xaa\xe0\xa4\xb0\xe0\xa4\xbf\xe0\xa4\xb5\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\xa8\xe0\xa5\x81\xe0\xa4\x95\xe0\xa4\xb8\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa4" +
+	"\xb8\xe0\xa4\xae\xe0\xa4\xb0\xe0\xa5\x8d\xe0\xa4\xa5\xe0\xa4\xa8\xe0\xa4\x86\xe0\xa4\xaf\xe0\xa5\x8b\xe0\xa4\x9c\xe0\xa4\xbf\xe0\xa4\xa4\xe0\xa4\xb8\xe0\xa5\x8b\xe0\xa4\xae\xe0\xa4\xb5\xe0\xa4" +
+	"\xbe\xe0\xa4\xb0\xd8\xa7\xd9\x84\xd9\x85\xd8\xb4\xd8\xa7\xd8\xb1\xd9\x83\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd9\x85\xd9\x86\xd8\xaa\xd8\xaf\xd9\x8a\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd9\x83\xd9\x85" +
+	"\xd8\xa8\xd9\x8a\xd9\x88\xd8\xaa\xd8\xb1\xd8\xa7\xd9\x84\xd9\x85\xd8\xb4\xd8\xa7\xd9\x87\xd8\xaf\xd8\xa7\xd8\xaa\xd8\xb9\xd8\xaf\xd8\xaf\xd8\xa7\xd9\x84\xd8\xb2\xd9\x88\xd8\xa7\xd8\xb1\xd8\xb9" +
+	"\xd8\xaf\xd8\xaf\xd8\xa7\xd9\x84\xd8\xb1\xd8\xaf\xd9\x88\xd8\xaf\xd8\xa7\xd9\x84\xd8\xa5\xd8\xb3\xd9\x84\xd8\xa7\xd9\x85\xd9\x8a\xd8\xa9\xd8\xa7\xd9\x84\xd9\x81\xd9\x88\xd8\xaa\xd9\x88\xd8\xb4" +
+	"\xd9\x88\xd8\xa8\xd8\xa7\xd9\x84\xd9\x85\xd8\xb3\xd8\xa7\xd8\xa8\xd9\x82\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd9\x85\xd8\xb9\xd9\x84\xd9\x88\xd9\x85\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd9\x85\xd8\xb3" +
+	"\xd9\x84\xd8\xb3\xd9\x84\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84\xd8\xac\xd8\xb1\xd8\xa7\xd9\x81\xd9\x8a\xd9\x83\xd8\xb3\xd8\xa7\xd9\x84\xd8\xa7\xd8\xb3\xd9\x84\xd8\xa7\xd9\x85\xd9\x8a\xd8\xa9\xd8\xa7" +
+	"\xd9\x84\xd8\xa7\xd8\xaa\xd8\xb5\xd8\xa7\xd9\x84\xd8\xa7\xd8\xaakeywords\" content=\"w3.org/1999/x" +
+	"html\"><a target=\"_blank\" text/html; charset=\" ta" +
+	"rget=\"_blank\"><table cellpadding=\"autocomplete=\"" +
+	"off\" text-align: center;to last version by backg" +
+	"round-color: #\" href=\"http://www./div></div><div" +
+	" id=<a href=\"#\" class=\"\"><img src=\"http://cript\"" +
+	" src=\"http://\x0a<script language=\"//EN\" \"http://ww" +
+	"w.wencodeURIComponent(\" href=\"javascript:<div cl" +
+	"ass=\"contentdocument.write('<scposition: absolut" +
+	"e;script src=\"http:// style=\"margin-top:.min.js\"" +
+	"></script>\x0a</div>\x0a<div class=\"w3.org/1999/xhtml\"" +
+	" \x0a\x0d\x0a</body>\x0d\x0a</html>distinction between/\" target" +
+	"=\"_blank\"><link href=\"http://encoding=\"utf-8\"?>\x0a" +
+	"w.addEventListener?action=\"http://www.icon\" href" +
+	"=\"http:// style=\"background:type=\"text/css\" />\x0am" +
+	"eta property=\"og:t<input type=\"text\"  style=\"tex" +
+	"t-align:the development of tylesheet\" type=\"teht" +
+	"ml; charset=utf-8is considered to betable width=" +
+	"\"100%\" In addition to the contributed to the dif" +
+	"ferences betweendevelopment of the It is importa" +
+	"nt to </script>\x0a\x0a<script  style=\"font-size:1></s" +
+	"pan><span id=gbLibrary of Congress<img src=\"http" +
+	"://imEnglish translationAcademy of Sciencesdiv s" +
+	"tyle=\"display:construction of the.getElementById" +
+	"(id)in conjunction withElement('script'); <meta " +
+	"property=\"og:\xd0\x91\xd1\x8a\xd0\xbb\xd0\xb3\xd0\xb0\xd1\x80\xd1\x81\xd0\xba\xd0\xb8\x0a type=\"text\" nam" +
+	"e=\">Privacy Policy</a>administered by theenableS" +
+	"ingleRequeststyle=&quot;margin:</div></div></div" +
+	"><><img src=\"http://i style=&quot;float:referred" +
+	" to as the total population ofin Washington, D.C" +
+	". style=\"background-among other things,organizat" +
+	"ion of theparticipated in thethe introduction of" +
+	"identified with thefictional character Oxford Un" +
+	"iversity misunderstanding ofThere are, however,s" +
+	"tylesheet\" href=\"/Columbia Universityexpanded to" +
+	" includeusually referred toindicating that theha" +
+	"ve suggested thataffiliated with thecorrelation " +
+	"betweennumber of different></td></tr></table>Rep" +
+	"ublic of Ireland\x0a</script>\x0a<script under the inf" +
+	"luencecontribution to theOfficial website ofhead" +
+	"quarters of thecentered around theimplications o" +
+	"f thehave been developedFederal Republic ofbecam" +
+	"e increasinglycontinuation of theNote, however, " +
+	"thatsimilar to that of capabilities of theaccord" +
+	"ance with theparticipants in thefurther developm" +
+	"entunder the directionis often consideredhis you" +
+	"nger brother</td></tr></table><a http-equiv=\"X-U" +
+	"A-physical propertiesof British Columbiahas been" +
+	" criticized(with the exceptionquestions about th" +
+	"epassing through the0\" cellpadding=\"0\" thousands" +
+	" of peopleredirects here. Forhave children under" +
+	"%3E%3C/script%3E\"));<a href=\"http://www.<li><a h" +
+	"ref=\"http://site_name\" content=\"text-decoration:" +
+	"nonestyle=\"display: none<meta http-equiv=\"X-new " +
+	"Date().getTime() type=\"image/x-icon\"</span><span" +
+	" class=\"language=\"javascriptwindow.location.href" +
+	"<a href=\"javascript:-->\x0d\x0a<script type=\"t<a href=" +
+	"'http://www.hortcut icon\" href=\"</div>\x0d\x0a<div cla" +
+	"ss=\"<script src=\"http://\" rel=\"stylesheet\" t</di" +
+	"v>\x0a<script type=/a> <a href=\"http:// allowTransp" +
+	"arency=\"X-UA-Compatible\" conrelationship between" +
+	"\x0a</script>\x0d\x0a<script </a></li></ul></div>associat" +
+	"ed with the programming language</a><a href=\"htt" +
+	"p://</a></li><li class=\"form action=\"http://<div" +
+	" style=\"display:type=\"text\" name=\"q\"<table width" +
+	"=\"100%\" background-position:\" border=\"0\" width=\"" +
+	"rel=\"shortcut icon\" h6><ul><li><a href=\"  <meta " +
+	"http-equiv=\"css\" media=\"screen\" responsible for " +
+	"the \" type=\"application/\" style=\"background-html" +
+	"; charset=utf-8\" allowtransparency=\"stylesheet\" " +
+	"type=\"te\x0d\x0a<meta http-equiv=\"></span><span class=" +
+	"\"0\" cellspacing=\"0\">;\x0a</script>\x0a<script sometime" +
+	"s called thedoes not necessarilyFor more informa" +
+	"tionat the beginning of <!DOCTYPE html><htmlpart" +
+	"icularly in the type=\"hidden\" name=\"javascript:v" +
+	"oid(0);\"effectiveness of the autocomplete=\"off\" " +
+	"generally considered><input type=\"text\" \"></scri" +
+	"pt>\x0d\x0a<scriptthroughout the worldcommon misconcep" +
+	"tionassociation with the</div>\x0a</div>\x0a<div cduri" +
+	"ng his lifetime,corresponding to thetype=\"image/" +
+	"x-icon\" an increasing numberdiplomatic relations" +
+	"are often consideredmeta charset=\"utf-8\" <input " +
+	"type=\"text\" examples include the\"><img src=\"http" +
+	"://iparticipation in thethe establishment of\x0a</d" +
+	"iv>\x0a<div class=\"&amp;nbsp;&amp;nbsp;to determine" +
+	" whetherquite different frommarked the beginning" +
+	"distance between thecontributions to theconflict" +
+	" between thewidely considered towas one of the f" +
+	"irstwith varying degreeshave speculated that(doc" +
+	"ument.getElementparticipating in theoriginally d" +
+	"evelopedeta charset=\"utf-8\"> type=\"text/css\" />\x0a" +
+	"interchangeably withmore closely relatedsocial a" +
+	"nd politicalthat would otherwiseperpendicular to" +
+	" thestyle type=\"text/csstype=\"submit\" name=\"fami" +
+	"lies residing indeveloping countriescomputer pro" +
+	"grammingeconomic developmentdetermination of the" +
+	"for more informationon several occasionsportugu\xc3" +
+	"\xaas (Europeu)\xd0\xa3\xd0\xba\xd1\x80\xd0\xb0\xd1\x97\xd0\xbd\xd1\x81\xd1\x8c\xd0\xba\xd0\xb0\xd1\x83\xd0\xba\xd1\x80\xd0\xb0\xd1\x97\xd0\xbd\xd1\x81\xd1\x8c" +
+	"\xd0\xba\xd0\xb0\xd0\xa0\xd0\xbe\xd1\x81\xd1\x81\xd0\xb8\xd0\xb9\xd1\x81\xd0\xba\xd0\xbe\xd0\xb9\xd0\xbc\xd0\xb0\xd1\x82\xd0\xb5\xd1\x80\xd0\xb8\xd0\xb0\xd0\xbb\xd0\xbe\xd0\xb2\xd0\xb8\xd0\xbd" +
+	"\xd1\x84\xd0\xbe\xd1\x80\xd0\xbc\xd0\xb0\xd1\x86\xd0\xb8\xd0\xb8\xd1\x83\xd0\xbf\xd1\x80\xd0\xb0\xd0\xb2\xd0\xbb\xd0\xb5\xd0\xbd\xd0\xb8\xd1\x8f\xd0\xbd\xd0\xb5\xd0\xbe\xd0\xb1\xd1\x85\xd0\xbe" +
+	"\xd0\xb4\xd0\xb8\xd0\xbc\xd0\xbe\xd0\xb8\xd0\xbd\xd1\x84\xd0\xbe\xd1\x80\xd0\xbc\xd0\xb0\xd1\x86\xd0\xb8\xd1\x8f\xd0\x98\xd0\xbd\xd1\x84\xd0\xbe\xd1\x80\xd0\xbc\xd0\xb0\xd1\x86\xd0\xb8\xd1\x8f" +
+	"\xd0\xa0\xd0\xb5\xd1\x81\xd0\xbf\xd1\x83\xd0\xb1\xd0\xbb\xd0\xb8\xd0\xba\xd0\xb8\xd0\xba\xd0\xbe\xd0\xbb\xd0\xb8\xd1\x87\xd0\xb5\xd1\x81\xd1\x82\xd0\xb2\xd0\xbe\xd0\xb8\xd0\xbd\xd1\x84\xd0\xbe" +
+	"\xd1\x80\xd0\xbc\xd0\xb0\xd1\x86\xd0\xb8\xd1\x8e\xd1\x82\xd0\xb5\xd1\x80\xd1\x80\xd0\xb8\xd1\x82\xd0\xbe\xd1\x80\xd0\xb8\xd0\xb8\xd0\xb4\xd0\xbe\xd1\x81\xd1\x82\xd0\xb0\xd1\x82\xd0\xbe\xd1\x87" +
+	"\xd0\xbd\xd0\xbe\xd8\xa7\xd9\x84\xd9\x85\xd8\xaa\xd9\x88\xd8\xa7\xd8\xac\xd8\xaf\xd9\x88\xd9\x86\xd8\xa7\xd9\x84\xd8\xa7\xd8\xb4\xd8\xaa\xd8\xb1\xd8\xa7\xd9\x83\xd8\xa7\xd8\xaa\xd8\xa7\xd9\x84" +
+	"\xd8\xa7\xd9\x82\xd8\xaa\xd8\xb1\xd8\xa7\xd8\xad\xd8\xa7\xd8\xaahtml; charset=UTF-8\" setTimeout(" +
+	"function()display:inline-block;<input type=\"subm" +
+	"it\" type = 'text/javascri<img src=\"http://www.\" " +
+	"\"http://www.w3.org/shortcut icon\" href=\"\" autoco" +
+	"mplete=\"off\" </a></div><div class=</a></li>\x0a<li " +
+	"class=\"css\" type=\"text/css\" <form action=\"http:/" +
+	"/xt/css\" href=\"http://link rel=\"alternate\" \x0d\x0a<sc" +
+	"ript type=\"text/ onclick=\"javascript:(new Date)." +
+	"getTime()}height=\"1\" width=\"1\" People's Republic" +
+	" of  <a href=\"http://www.text-decoration:underth" +
+	"e beginning of the </div>\x0a</div>\x0a</div>\x0aestablis" +
+	"hment of the </div></div></div></d#viewport{min-" +
+	"height:\x0a<script src=\"http://option><option value" +
+	"=often referred to as /option>\x0a<option valu<!DOC" +
+	"TYPE html>\x0a<!--[International Airport>\x0a<a href=\"" +
+	"http://www</a><a href=\"http://w\xe0\xb8\xa0\xe0\xb8\xb2\xe0\xb8\xa9\xe0\xb8\xb2\xe0\xb9\x84\xe0\xb8" +
+	"\x97\xe0\xb8\xa2\xe1\x83\xa5\xe1\x83\x90\xe1\x83\xa0\xe1\x83\x97\xe1\x83\xa3\xe1\x83\x9a\xe1\x83\x98\xe6\xad\xa3\xe9\xab\x94\xe4\xb8\xad\xe6\x96\x87 (\xe7\xb9\x81\xe9\xab\x94)\xe0\xa4" +
+	"\xa8\xe0\xa4\xbf\xe0\xa4\xb0\xe0\xa5\x8d\xe0\xa4\xa6\xe0\xa5\x87\xe0\xa4\xb6\xe0\xa4\xa1\xe0\xa4\xbe\xe0\xa4\x89\xe0\xa4\xa8\xe0\xa4\xb2\xe0\xa5\x8b\xe0\xa4\xa1\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4" +
+	"\xb7\xe0\xa5\x87\xe0\xa4\xa4\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\x9c\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa5\x80\xe0\xa4\xb8\xe0\xa4\x82\xe0\xa4\xac\xe0\xa4\x82\xe0\xa4" +
+	"\xa7\xe0\xa4\xbf\xe0\xa4\xa4\xe0\xa4\xb8\xe0\xa5\x8d\xe0\xa4\xa5\xe0\xa4\xbe\xe0\xa4\xaa\xe0\xa4\xa8\xe0\xa4\xbe\xe0\xa4\xb8\xe0\xa5\x8d\xe0\xa4\xb5\xe0\xa5\x80\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4" +
+	"\xb0\xe0\xa4\xb8\xe0\xa4\x82\xe0\xa4\xb8\xe0\xa5\x8d\xe0\xa4\x95\xe0\xa4\xb0\xe0\xa4\xa3\xe0\xa4\xb8\xe0\xa4\xbe\xe0\xa4\xae\xe0\xa4\x97\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa5\x80\xe0\xa4\x9a\xe0\xa4" +
+	"\xbf\xe0\xa4\x9f\xe0\xa5\x8d\xe0\xa4\xa0\xe0\xa5\x8b\xe0\xa4\x82\xe0\xa4\xb5\xe0\xa4\xbf\xe0\xa4\x9c\xe0\xa5\x8d\xe0\xa4\x9e\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa4\x85\xe0\xa4\xae\xe0\xa5\x87\xe0\xa4" +
+	"\xb0\xe0\xa4\xbf\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xb5\xe0\xa4\xbf\xe0\xa4\xad\xe0\xa4\xbf\xe0\xa4\xa8\xe0\xa5\x8d\xe0\xa4\xa8\xe0\xa4\x97\xe0\xa4\xbe\xe0\xa4\xa1\xe0\xa4\xbf\xe0\xa4\xaf\xe0\xa4" +
+	"\xbe\xe0\xa4\x81\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xaf\xe0\xa5\x8b\xe0\xa4\x82\xe0\xa4\x95\xe0\xa4\xbf\xe0\xa4\xb8\xe0\xa5\x81\xe0\xa4\xb0\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xb7\xe0\xa4\xbe\xe0\xa4" +
+	"\xaa\xe0\xa4\xb9\xe0\xa5\x81\xe0\xa4\x81\xe0\xa4\x9a\xe0\xa4\xa4\xe0\xa5\x80\xe0\xa4\xaa\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xac\xe0\xa4\x82\xe0\xa4\xa7\xe0\xa4\xa8\xe0\xa4\x9f\xe0\xa4\xbf\xe0\xa4" +
+	"\xaa\xe0\xa5\x8d\xe0\xa4\xaa\xe0\xa4\xa3\xe0\xa5\x80\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xbf\xe0\xa4\x95\xe0\xa5\x87\xe0\xa4\x9f\xe0\xa4\xaa\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4" +
+	"\xb0\xe0\xa4\x82\xe0\xa4\xad\xe0\xa4\xaa\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\xaa\xe0\xa5\x8d\xe0\xa4\xa4\xe0\xa4\xae\xe0\xa4\xbe\xe0\xa4\xb2\xe0\xa4\xbf\xe0\xa4\x95\xe0\xa5\x8b\xe0\xa4" +
+	"\x82\xe0\xa4\xb0\xe0\xa4\xab\xe0\xa4\xbc\xe0\xa5\x8d\xe0\xa4\xa4\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa4\xa8\xe0\xa4\xbf\xe0\xa4\xb0\xe0\xa5\x8d\xe0\xa4\xae\xe0\xa4\xbe\xe0\xa4\xa3\xe0\xa4\xb2\xe0\xa4" +
+	"\xbf\xe0\xa4\xae\xe0\xa4\xbf\xe0\xa4\x9f\xe0\xa5\x87\xe0\xa4\xa1description\" content=\"document.l" +
+	"ocation.prot.getElementsByTagName(<!DOCTYPE html" +
+	">\x0a<html <meta charset=\"utf-8\">:url\" content=\"htt" +
+	"p://.css\" rel=\"stylesheet\"style type=\"text/css\">" +
+	"type=\"text/css\" href=\"w3.org/1999/xhtml\" xmltype" +
+	"=\"text/javascript\" method=\"get\" action=\"link rel" +
+	"=\"stylesheet\"  = document.getElementtype=\"image/" +
+	"x-icon\" />cellpadding=\"0\" cellsp.css\" type=\"text" +
+	"/css\" </a></li><li><a href=\"\" width=\"1\" height=\"" +
+	"1\"\"><a href=\"http://www.style=\"display:none;\">al" +
+	"ternate\" type=\"appli-//W3C//DTD XHTML 1.0 ellspa" +
+	"cing=\"0\" cellpad type=\"hidden\" value=\"/a>&nbsp;<" +
+	"span role=\"s\x0a<input type=\"hidden\" language=\"Java" +
+	"Script\"  document.getElementsBg=\"0\" cellspacing=" +
+	"\"0\" ype=\"text/css\" media=\"type='text/javascript'" +
+	"with the exception of ype=\"text/css\" rel=\"st hei" +
+	"ght=\"1\" width=\"1\" ='+encodeURIComponent(<link re" +
+	"l=\"alternate\" \x0abody, tr, input, textmeta name=\"r" +
+	"obots\" conmethod=\"post\" action=\">\x0a<a href=\"http:" +
+	"//www.css\" rel=\"stylesheet\" </div></div><div cla" +
+	"sslanguage=\"javascript\">aria-hidden=\"true\">\xc2\xb7<ri" +
+	"pt\" type=\"text/javasl=0;})();\x0a(function(){backgr" +
+	"ound-image: url(/a></li><li><a href=\"h\x09\x09<li><a h" +
+	"ref=\"http://ator\" aria-hidden=\"tru> <a href=\"htt" +
+	"p://www.language=\"javascript\" /option>\x0a<option v" +
+	"alue/div></div><div class=rator\" aria-hidden=\"tr" +
+	"e=(new Date).getTime()portugu\xc3\xaas (do Brasil)\xd0\xbe\xd1\x80" +
+	"\xd0\xb3\xd0\xb0\xd0\xbd\xd0\xb8\xd0\xb7\xd0\xb0\xd1\x86\xd0\xb8\xd0\xb8\xd0\xb2\xd0\xbe\xd0\xb7\xd0\xbc\xd0\xbe\xd0\xb6\xd0\xbd\xd0\xbe\xd1\x81\xd1\x82\xd1\x8c\xd0\xbe\xd0\xb1\xd1\x80\xd0\xb0" +
+	"\xd0\xb7\xd0\xbe\xd0\xb2\xd0\xb0\xd0\xbd\xd0\xb8\xd1\x8f\xd1\x80\xd0\xb5\xd0\xb3\xd0\xb8\xd1\x81\xd1\x82\xd1\x80\xd0\xb0\xd1\x86\xd0\xb8\xd0\xb8\xd0\xb2\xd0\xbe\xd0\xb7\xd0\xbc\xd0\xbe\xd0\xb6" +
+	"\xd0\xbd\xd0\xbe\xd1\x81\xd1\x82\xd0\xb8\xd0\xbe\xd0\xb1\xd1\x8f\xd0\xb7\xd0\xb0\xd1\x82\xd0\xb5\xd0\xbb\xd1\x8c\xd0\xbd\xd0\xb0<!DOCTYPE html P" +
+	"UBLIC \"nt-Type\" content=\"text/<meta http-equiv=\"" +
+	"Conteransitional//EN\" \"http:<html xmlns=\"http://" +
+	"www-//W3C//DTD XHTML 1.0 TDTD/xhtml1-transitiona" +
+	"l//www.w3.org/TR/xhtml1/pe = 'text/javascript';<" +
+	"meta name=\"descriptionparentNode.insertBefore<in" +
+	"put type=\"hidden\" najs\" type=\"text/javascri(docu" +
+	"ment).ready(functiscript type=\"text/javasimage\" " +
+	"content=\"http://UA-Compatible\" content=tml; char" +
+	"set=utf-8\" />\x0alink rel=\"shortcut icon<link rel=\"" +
+	"stylesheet\" </script>\x0a<script type== document.cr" +
+	"eateElemen<a target=\"_blank\" href= document.getE" +
+	"lementsBinput type=\"text\" name=a.type = 'text/ja" +
+	"vascrinput type=\"hidden\" namehtml; charset=utf-8" +
+	"\" />dtd\">\x0a<html xmlns=\"http-//W3C//DTD HTML 4.01" +
+	" TentsByTagName('script')input type=\"hidden\" nam" +
+	"<script type=\"text/javas\" style=\"display:none;\">" +
+	"document.getElementById(=document.createElement(" +
+	"' type='text/javascript'input type=\"text\" name=\"" +
+	"d.getElementsByTagName(snical\" href=\"http://www." +
+	"C//DTD HTML 4.01 Transit<style type=\"text/css\">\x0a" +
+	"\x0a<style type=\"text/css\">ional.dtd\">\x0a<html xmlns=" +
+	"http-equiv=\"Content-Typeding=\"0\" cellspacing=\"0\"" +
+	"html; charset=utf-8\" />\x0a style=\"display:none;\"><" +
+	"<li><a href=\"http://www. type='text/javascript'>" +
+	"\xd0\xb4\xd0\xb5\xd1\x8f\xd1\x82\xd0\xb5\xd0\xbb\xd1\x8c\xd0\xbd\xd0\xbe\xd1\x81\xd1\x82\xd0\xb8\xd1\x81\xd0\xbe\xd0\xbe\xd1\x82\xd0\xb2\xd0\xb5\xd1\x82\xd1\x81\xd1\x82\xd0\xb2\xd0\xb8\xd0\xb8" +
+	"\xd0\xbf\xd1\x80\xd0\xbe\xd0\xb8\xd0\xb7\xd0\xb2\xd0\xbe\xd0\xb4\xd1\x81\xd1\x82\xd0\xb2\xd0\xb0\xd0\xb1\xd0\xb5\xd0\xb7\xd0\xbe\xd0\xbf\xd0\xb0\xd1\x81\xd0\xbd\xd0\xbe\xd1\x81\xd1\x82\xd0\xb8" +
+	"\xe0\xa4\xaa\xe0\xa5\x81\xe0\xa4\xb8\xe0\xa5\x8d\xe0\xa4\xa4\xe0\xa4\xbf\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\x82\xe0\xa4\x97\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa5\x87\xe0\xa4\xb8" +
+	"\xe0\xa4\x89\xe0\xa4\xa8\xe0\xa5\x8d\xe0\xa4\xb9\xe0\xa5\x8b\xe0\xa4\x82\xe0\xa4\xa8\xe0\xa5\x87\xe0\xa4\xb5\xe0\xa4\xbf\xe0\xa4\xa7\xe0\xa4\xbe\xe0\xa4\xa8\xe0\xa4\xb8\xe0\xa4\xad\xe0\xa4\xbe" +
+	"\xe0\xa4\xab\xe0\xa4\xbf\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xb8\xe0\xa4\xbf\xe0\xa4\x82\xe0\xa4\x97\xe0\xa4\xb8\xe0\xa5\x81\xe0\xa4\xb0\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xb7\xe0\xa4\xbf\xe0\xa4\xa4" +
+	"\xe0\xa4\x95\xe0\xa5\x89\xe0\xa4\xaa\xe0\xa5\x80\xe0\xa4\xb0\xe0\xa4\xbe\xe0\xa4\x87\xe0\xa4\x9f\xe0\xa4\xb5\xe0\xa4\xbf\xe0\xa4\x9c\xe0\xa5\x8d\xe0\xa4\x9e\xe0\xa4\xbe\xe0\xa4\xaa\xe0\xa4\xa8" +
+	"\xe0\xa4\x95\xe0\xa4\xbe\xe0\xa4\xb0\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xb5\xe0\xa4\xbe\xe0\xa4\x88\xe0\xa4\xb8\xe0\xa4\x95\xe0\xa5\x8d\xe0\xa4\xb0\xe0\xa4\xbf\xe0\xa4\xaf\xe0\xa4\xa4\xe0\xa4\xbe"
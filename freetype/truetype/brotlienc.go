@@ -0,0 +1,459 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import "sort"
+
+// This file implements a Brotli compressor, for writing WOFF2 data. It finds
+// repeated strings with hash chains, and codes each meta-block with one prefix
+// code for each of its literals, commands and distances. It does not use the
+// static dictionary, block splitting or context modeling, so its output is
+// larger than that of the reference compressor, but any Brotli decompressor
+// can read it.
+
+const (
+	// brotliWindowBits is the log2 of the compressor's window size.
+	brotliWindowBits = 22
+	// brotliMaxDistance is the furthest back that a copy can be from.
+	brotliMaxDistance = 1<<brotliWindowBits - 16
+	// brotliMaxMetaBlock is the most bytes that a meta-block can hold.
+	brotliMaxMetaBlock = 1 << 24
+	// brotliMinMatch is the shortest repeated string that is copied, and
+	// brotliNiceMatch is long enough to stop looking for a longer one.
+	brotliMinMatch  = 4
+	brotliNiceMatch = 256
+	// brotliMaxChain is the most earlier positions that are tried for each
+	// position's match.
+	brotliMaxChain = 32
+	brotliHashBits = 16
+)
+
+// brotliWriter writes the bits of Brotli data, least significant bit first.
+type brotliWriter struct {
+	b []byte
+	v uint64
+	n uint
+}
+
+// write writes the low n bits of x, for n <= 32.
+func (w *brotliWriter) write(x int, n uint) {
+	w.v |= uint64(x) & (1<<n - 1) << w.n
+	w.n += n
+	for w.n >= 8 {
+		w.b = append(w.b, byte(w.v))
+		w.v >>= 8
+		w.n -= 8
+	}
+}
+
+// bytes returns the written data, padded with zero bits to a byte boundary.
+func (w *brotliWriter) bytes() []byte {
+	if w.n > 0 {
+		w.b = append(w.b, byte(w.v))
+		w.v, w.n = 0, 0
+	}
+	return w.b
+}
+
+// brotliCommand is an insert-and-copy command: insert literals, and then
+// copy bytes from dist bytes back. A copy of zero bytes ends a meta-block.
+type brotliCommand struct {
+	insert, copy, dist int
+}
+
+// encodeBrotli returns the Brotli compressed data b.
+func encodeBrotli(b []byte) []byte {
+	w := &brotliWriter{}
+	// The window size is coded as a 1 bit and then its log2 minus 17.
+	w.write(1|(brotliWindowBits-17)<<1, 4)
+	if len(b) == 0 {
+		// ISLAST and ISLASTEMPTY.
+		w.write(3, 2)
+		return w.bytes()
+	}
+	m := brotliMatcher{b: b, prev: make([]int32, brotliPrevSize(len(b)))}
+	for i := range m.head {
+		m.head[i] = -1
+	}
+	last := 4
+	for start := 0; start < len(b); start += brotliMaxMetaBlock {
+		end := start + brotliMaxMetaBlock
+		if end > len(b) {
+			end = len(b)
+		}
+		commands := m.commands(start, end)
+		last = writeBrotliMetaBlock(w, b[start:end], commands, last, end == len(b))
+	}
+	return w.bytes()
+}
+
+// brotliPrevSize returns the size of the hash chain of data of length n.
+func brotliPrevSize(n int) int {
+	size := 1 << brotliWindowBits
+	for size/2 >= n && size > 1 {
+		size /= 2
+	}
+	return size
+}
+
+// brotliMatcher finds repeated strings in b. head holds the last position with
+// each hash, and prev, indexed modulo its length, the position before it with
+// the same hash.
+type brotliMatcher struct {
+	b    []byte
+	head [1 << brotliHashBits]int32
+	prev []int32
+}
+
+func (m *brotliMatcher) hash(i int) int {
+	x := uint32(m.b[i]) | uint32(m.b[i+1])<<8 | uint32(m.b[i+2])<<16 | uint32(m.b[i+3])<<24
+	return int(x * 0x1e35a7bd >> (32 - brotliHashBits))
+}
+
+// insert adds position i to the hash chains.
+func (m *brotliMatcher) insert(i int) {
+	if i+4 > len(m.b) {
+		return
+	}
+	h := m.hash(i)
+	m.prev[i&(len(m.prev)-1)] = m.head[h]
+	m.head[h] = int32(i)
+}
+
+// commands returns the commands that produce b[start:end], which may copy
+// from b[:start].
+func (m *brotliMatcher) commands(start, end int) []brotliCommand {
+	var commands []brotliCommand
+	lit := start
+	for i := start; i+brotliMinMatch <= end; {
+		best, dist := 0, 0
+		j := int(m.head[m.hash(i)])
+		for n := 0; j >= 0 && i-j <= brotliMaxDistance && n < brotliMaxChain; n++ {
+			l := 0
+			for i+l < end && m.b[j+l] == m.b[i+l] {
+				l++
+			}
+			if l > best {
+				best, dist = l, i-j
+				if l >= brotliNiceMatch {
+					break
+				}
+			}
+			j = int(m.prev[j&(len(m.prev)-1)])
+		}
+		if best < brotliMinMatch {
+			m.insert(i)
+			i++
+			continue
+		}
+		commands = append(commands, brotliCommand{i - lit, best, dist})
+		for k := i; k < i+best; k++ {
+			m.insert(k)
+		}
+		i += best
+		lit = i
+	}
+	if lit < end {
+		for k := lit; k < end; k++ {
+			m.insert(k)
+		}
+		commands = append(commands, brotliCommand{end - lit, 0, 0})
+	}
+	return commands
+}
+
+// brotliLengthCode returns the code of length n in the given table of insert
+// or copy lengths, and its extra bits.
+func brotliLengthCode(table *[24]struct {
+	base  int
+	extra uint8
+}, n int) (code, extra int, bits uint) {
+	code = sort.Search(len(table), func(i int) bool { return table[i].base > n }) - 1
+	return code, n - table[code].base, uint(table[code].extra)
+}
+
+// brotliCommandCode returns the insert-and-copy length code of the given
+// insert and copy length codes, with an explicit distance.
+func brotliCommandCode(insert, copy int) int {
+	for cell := 2; cell < len(brotliInsertCells); cell++ {
+		if int(brotliInsertCells[cell]) == insert&^7 && int(brotliCopyCells[cell]) == copy&^7 {
+			return cell<<6 | (insert&7)<<3 | copy&7
+		}
+	}
+	panic("unreachable")
+}
+
+// brotliDistanceCode returns the code of the distance, with no postfix bits
+// or direct distance codes, and its extra bits.
+func brotliDistanceCode(dist int) (code, extra int, bits uint) {
+	v := dist + 3
+	for v>>(bits+2) != 0 {
+		bits++
+	}
+	high := v>>bits - 2
+	return 16 + 2*int(bits-1) + high, v - (2+high)<<bits, bits
+}
+
+// writeBrotliMetaBlock writes a compressed meta-block of the data b, produced
+// by the given commands, and returns the last distance, given the one before
+// the meta-block.
+func writeBrotliMetaBlock(w *brotliWriter, b []byte, commands []brotliCommand, last int, isLast bool) int {
+	// Code the commands, and count the symbols of each category.
+	type coded struct {
+		cmd, insertExtra, copyExtra, dist, distExtra int
+		insertBits, copyBits, distBits               uint
+	}
+	codes := make([]coded, len(commands))
+	var literalCounts [256]int
+	var commandCounts [704]int
+	var distanceCounts [64]int
+	x := 0
+	for i, c := range commands {
+		var ic, cc int
+		k := &codes[i]
+		ic, k.insertExtra, k.insertBits = brotliLengthCode(&brotliInsertLengths, c.insert)
+		if c.copy > 0 {
+			cc, k.copyExtra, k.copyBits = brotliLengthCode(&brotliCopyLengths, c.copy)
+			if c.dist != last {
+				k.dist, k.distExtra, k.distBits = brotliDistanceCode(c.dist)
+				last = c.dist
+			}
+			distanceCounts[k.dist]++
+		}
+		k.cmd = brotliCommandCode(ic, cc)
+		commandCounts[k.cmd]++
+		for _, l := range b[x : x+c.insert] {
+			literalCounts[l]++
+		}
+		x += c.insert + c.copy
+	}
+
+	// ISLAST, and ISLASTEMPTY or ISUNCOMPRESSED, surround MNIBBLES and MLEN.
+	w.write(b2i(isLast), 1)
+	if isLast {
+		w.write(0, 1)
+	}
+	nibbles := uint(4)
+	for (len(b)-1)>>(4*nibbles) != 0 {
+		nibbles++
+	}
+	w.write(int(nibbles-4), 2)
+	w.write(len(b)-1, 4*nibbles)
+	if !isLast {
+		w.write(0, 1)
+	}
+	// One block type for each category, no postfix bits or direct distance
+	// codes, the LSB6 context mode, and one literal and one distance code,
+	// so that there are no context maps.
+	w.write(0, 3)
+	w.write(0, 6)
+	w.write(0, 2)
+	w.write(0, 2)
+	literalLengths, literalCodes := writeBrotliCode(w, literalCounts[:])
+	commandLengths, commandCodes := writeBrotliCode(w, commandCounts[:])
+	distanceLengths, distanceCodes := writeBrotliCode(w, distanceCounts[:])
+
+	x = 0
+	for i, c := range commands {
+		k := &codes[i]
+		w.write(int(commandCodes[k.cmd]), uint(commandLengths[k.cmd]))
+		w.write(k.insertExtra, k.insertBits)
+		w.write(k.copyExtra, k.copyBits)
+		for _, l := range b[x : x+c.insert] {
+			w.write(int(literalCodes[l]), uint(literalLengths[l]))
+		}
+		if c.copy > 0 {
+			w.write(int(distanceCodes[k.dist]), uint(distanceLengths[k.dist]))
+			w.write(k.distExtra, k.distBits)
+		}
+		x += c.insert + c.copy
+	}
+	return last
+}
+
+func b2i(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// brotliCodeLengthCodes are the fixed prefix codes, as bits and their number,
+// of the code length code's lengths from 0 to 5.
+var brotliCodeLengthCodes = [6]struct {
+	bits int
+	n    uint
+}{{0, 2}, {7, 4}, {3, 3}, {2, 2}, {1, 2}, {15, 4}}
+
+// writeBrotliCode writes a prefix code for symbols with the given counts, as
+// in RFC 7932 section 3, and returns its code lengths and reversed codes by
+// symbol.
+func writeBrotliCode(w *brotliWriter, counts []int) (lengths []uint8, codes []uint16) {
+	var used []int
+	for s, c := range counts {
+		if c > 0 {
+			used = append(used, s)
+		}
+	}
+	if len(used) <= 4 {
+		// A simple prefix code, whose symbols are listed in order of
+		// increasing code length.
+		if len(used) == 0 {
+			used = []int{0}
+		}
+		bits := uint(0)
+		for (len(counts)-1)>>bits != 0 {
+			bits++
+		}
+		lengths = make([]uint8, len(counts))
+		sort.SliceStable(used, func(i, j int) bool { return counts[used[i]] > counts[used[j]] })
+		shape := [5][]uint8{nil, {0}, {1, 1}, {1, 2, 2}, {2, 2, 2, 2}}[len(used)]
+		if len(used) == 4 {
+			if l := brotliCodeLengths(counts, 15); l[used[0]] == 1 {
+				shape = []uint8{1, 2, 3, 3}
+			}
+		}
+		w.write(1, 2)
+		w.write(len(used)-1, 2)
+		for i, s := range used {
+			w.write(s, bits)
+			lengths[s] = shape[i]
+		}
+		if len(used) == 4 {
+			w.write(b2i(shape[0] == 1), 1)
+		}
+		return lengths, brotliCodes(lengths)
+	}
+
+	// A complex prefix code, whose code lengths, up to the last non-zero
+	// one, are themselves prefix coded.
+	lengths = brotliCodeLengths(counts, 15)
+	n := used[len(used)-1] + 1
+	var clCounts [18]int
+	for _, l := range lengths[:n] {
+		clCounts[l]++
+	}
+	clUsed := 0
+	for _, c := range clCounts {
+		if c > 0 {
+			clUsed++
+		}
+	}
+	w.write(0, 2)
+	if clUsed == 1 {
+		// Every code length is the same, so the code length code has one
+		// symbol, which takes no bits. All of its lengths are written.
+		for _, s := range brotliCodeLengthOrder {
+			l := 0
+			if s == lengths[0] {
+				l = 1
+			}
+			w.write(brotliCodeLengthCodes[l].bits, brotliCodeLengthCodes[l].n)
+		}
+		return lengths, brotliCodes(lengths)
+	}
+	clLengths := brotliCodeLengths(clCounts[:], 5)
+	space := 32
+	for i := 0; i < len(brotliCodeLengthOrder) && space > 0; i++ {
+		l := clLengths[brotliCodeLengthOrder[i]]
+		w.write(brotliCodeLengthCodes[l].bits, brotliCodeLengthCodes[l].n)
+		if l != 0 {
+			space -= 32 >> l
+		}
+	}
+	clCodes := brotliCodes(clLengths)
+	for _, l := range lengths[:n] {
+		w.write(int(clCodes[l]), uint(clLengths[l]))
+	}
+	return lengths, brotliCodes(lengths)
+}
+
+// brotliCodeLengths returns the code lengths of a prefix code, of at most
+// maxBits bits, for at least two symbols with the given non-zero counts. It
+// builds a Huffman code, raising the smallest counts until the code is short
+// enough.
+func brotliCodeLengths(counts []int, maxBits int) []uint8 {
+	var leaves []int
+	for s, c := range counts {
+		if c > 0 {
+			leaves = append(leaves, s)
+		}
+	}
+	n := len(leaves)
+	lengths := make([]uint8, len(counts))
+	for floor := 1; ; floor *= 2 {
+		count := func(s int) int {
+			if counts[s] < floor {
+				return floor
+			}
+			return counts[s]
+		}
+		sort.SliceStable(leaves, func(i, j int) bool { return count(leaves[i]) < count(leaves[j]) })
+		// The leaves are nodes 0 to n-1, and the internal nodes, which are
+		// made in order of increasing weight, follow them.
+		weights := make([]int, n, 2*n-1)
+		for i, s := range leaves {
+			weights[i] = count(s)
+		}
+		parent := make([]int, 2*n-1)
+		li, ii := 0, n
+		for k := n; k < 2*n-1; k++ {
+			var pair [2]int
+			for j := range pair {
+				if li < n && (ii >= k || weights[li] <= weights[ii]) {
+					pair[j], li = li, li+1
+				} else {
+					pair[j], ii = ii, ii+1
+				}
+			}
+			weights = append(weights, weights[pair[0]]+weights[pair[1]])
+			parent[pair[0]], parent[pair[1]] = k, k
+		}
+		depth := make([]int, 2*n-1)
+		longest := 0
+		for k := 2*n - 3; k >= 0; k-- {
+			depth[k] = depth[parent[k]] + 1
+			if k < n && depth[k] > longest {
+				longest = depth[k]
+			}
+		}
+		if longest <= maxBits {
+			for i, s := range leaves {
+				lengths[s] = uint8(depth[i])
+			}
+			return lengths
+		}
+	}
+}
+
+// brotliCodes returns the codes, bit reversed, of the canonical prefix code
+// with the given code lengths, as newBrotliCode assigns them.
+func brotliCodes(lengths []uint8) []uint16 {
+	var count [16]int
+	for _, l := range lengths {
+		if l != 0 {
+			count[l]++
+		}
+	}
+	var next [16]int
+	for l, code := 1, 0; l < 16; l++ {
+		code = (code + count[l-1]) << 1
+		next[l] = code
+	}
+	codes := make([]uint16, len(lengths))
+	for s, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		code, rev := next[l], 0
+		next[l]++
+		for i := uint8(0); i < l; i++ {
+			rev = rev<<1 | code>>i&1
+		}
+		codes[s] = uint16(rev)
+	}
+	return codes
+}
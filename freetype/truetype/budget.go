@@ -0,0 +1,98 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// A Budget is charged for the memory of the caches that a Font, and the
+// GlyphBufs that load its glyphs, build as they are used: the reverse
+// character map built by Runes, the glyph names, the parsed GSUB lookups and,
+// for each size, the results of running the font's prep program. The
+// freetype package's Budget is one, so that a single cap covers these caches
+// and the freetype package's glyph caches.
+type Budget interface {
+	// Charge charges size bytes for a cache entry, and returns a function
+	// that releases the charge, or nil if the entry is too large to cache.
+	// To make room for other entries, the Budget may release the charge
+	// itself by calling evict, from any goroutine, after which the entry is
+	// dropped and rebuilt when it is next needed. evict must not block or
+	// call the Budget.
+	Charge(size int64, evict func()) (release func())
+}
+
+// lazyCache holds a value that is derived from a font's data when it is first
+// needed, such as the reverse character map. The value's size is charged to
+// the font's Budget, if it has one, which may evict it.
+type lazyCache struct {
+	// mu serializes building the value.
+	mu sync.Mutex
+	// v holds a cachedValue, which is zero if there is no value.
+	v atomic.Value
+	// release, if non-nil, releases the value's charge.
+	release func()
+}
+
+// cachedValue is the value held by a lazyCache.
+type cachedValue struct {
+	x  interface{}
+	ok bool
+}
+
+// load returns the cached value, if there is one.
+func (c *lazyCache) load() (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	v, _ := c.v.Load().(cachedValue)
+	return v.x, v.ok
+}
+
+// store caches a value of the given size, charging it to b, if b is non-nil.
+func (c *lazyCache) store(b Budget, x interface{}, size int64) {
+	// The value is stored before it is charged, as the Budget may evict it
+	// as soon as it is charged.
+	c.v.Store(cachedValue{x, true})
+	if b == nil {
+		return
+	}
+	if c.release = b.Charge(size, func() { c.v.Store(cachedValue{}) }); c.release == nil {
+		c.v.Store(cachedValue{})
+	}
+}
+
+// drop drops the cached value and releases its charge, such as when the
+// value is replaced. It must not be called concurrently with other methods.
+func (c *lazyCache) drop() {
+	c.v.Store(cachedValue{})
+	if c.release != nil {
+		c.release()
+		c.release = nil
+	}
+}
+
+// get returns the cached value, calling build to build it, and its size, if
+// there is none. A nil *lazyCache caches nothing, for Fonts not made by
+// parse.
+func (c *lazyCache) get(b Budget, build func() (interface{}, int64)) interface{} {
+	if c == nil {
+		x, _ := build()
+		return x
+	}
+	if x, ok := c.load(); ok {
+		return x
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if x, ok := c.load(); ok {
+		return x
+	}
+	x, size := build()
+	c.store(b, x, size)
+	return x
+}
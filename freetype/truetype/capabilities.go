@@ -0,0 +1,54 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// Capabilities describes what a font supports, as per the tables that it
+// has. It lets applications choose how to use a font, such as by falling back
+// to another font, instead of discovering the font's limitations from errors
+// when its glyphs are loaded.
+type Capabilities struct {
+	// HasHinting is whether the font has TrueType hinting programs. Fonts
+	// without them can be hinted by the AutoHinting policy.
+	HasHinting bool
+	// HasColor is whether the font has color glyphs, in COLR, CBDT, sbix or
+	// SVG tables. This package only draws such glyphs' outlines, if any, but
+	// a font's SbixGlyph method returns its sbix images.
+	HasColor bool
+	// HasVariations is whether the font is a variable font.
+	HasVariations bool
+	// IsCFF is whether the font has CFF outlines, rather than TrueType ones.
+	// Such fonts' glyphs have cubic curves, and cannot be hinted by their
+	// own programs. This package cannot load CFF2 glyphs.
+	IsCFF bool
+	// HasVertical is whether the font has vertical metrics, in vhea and vmtx
+	// tables.
+	HasVertical bool
+}
+
+// addTable updates c for a table, with the given tag, in the font.
+func (c *Capabilities) addTable(tag string) {
+	switch tag {
+	case "fpgm", "prep":
+		c.HasHinting = true
+	case "COLR", "CBDT", "sbix", "SVG ":
+		c.HasColor = true
+	case "fvar", "gvar":
+		c.HasVariations = true
+	case "CFF ":
+		c.IsCFF = true
+	case "CFF2":
+		c.IsCFF, c.HasVariations = true, true
+	case "vhea":
+		c.HasVertical = true
+	}
+}
+
+// Capabilities returns what the font supports.
+func (f *Font) Capabilities() Capabilities {
+	c := f.caps
+	c.HasVertical = c.HasVertical && f.vmtx != nil
+	return c
+}
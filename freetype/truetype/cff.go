@@ -0,0 +1,763 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// The CFF table and its Type 2 charstrings are documented at
+// http://partners.adobe.com/public/developer/en/font/5176.CFF.pdf and
+// http://partners.adobe.com/public/developer/en/font/5177.Type2.pdf
+//
+// The charstrings' co-ordinates are taken to be in FUnits, as OpenType
+// requires a CFF font's FontMatrix to match its head table's unitsPerEm.
+
+const (
+	// cffMaxStack is the maximum depth of the Type 2 argument stack.
+	cffMaxStack = 48
+	// cffMaxCalls is the maximum nesting of Type 2 subroutine calls.
+	cffMaxCalls = 10
+)
+
+// cffIndex is a CFF INDEX, an array of variable length objects.
+type cffIndex struct {
+	count, offSize int
+	offsets, data  []byte
+	// dataOffset is the offset of data in the CFF table.
+	dataOffset int
+}
+
+// parseCFFIndex parses the INDEX at b[offset:]. It returns the offset just
+// past the INDEX.
+func parseCFFIndex(b []byte, offset int) (x cffIndex, next int, err error) {
+	if offset < 0 || offset+2 > len(b) {
+		return cffIndex{}, 0, FormatError{Table: "CFF ", Offset: offset, Reason: "CFF INDEX too short"}
+	}
+	x.count = int(u16(b, offset))
+	if x.count == 0 {
+		return cffIndex{}, offset + 2, nil
+	}
+	if offset+3 > len(b) {
+		return cffIndex{}, 0, FormatError{Table: "CFF ", Offset: offset, Reason: "CFF INDEX too short"}
+	}
+	x.offSize = int(b[offset+2])
+	if x.offSize < 1 || 4 < x.offSize {
+		return cffIndex{}, 0, FormatError{Table: "CFF ", Offset: offset, Reason: "bad CFF INDEX offset size"}
+	}
+	start := offset + 3
+	end := start + (x.count+1)*x.offSize
+	if end > len(b) {
+		return cffIndex{}, 0, FormatError{Table: "CFF ", Offset: offset, Reason: "CFF INDEX too short"}
+	}
+	x.offsets = b[start:end]
+	// The offsets are 1-based, relative to the byte before the data.
+	n := x.offset(x.count) - 1
+	if n < 0 || end+n > len(b) {
+		return cffIndex{}, 0, FormatError{Table: "CFF ", Offset: offset, Reason: "CFF INDEX too short"}
+	}
+	x.data, x.dataOffset = b[end:end+n], end
+	return x, end + n, nil
+}
+
+func (x cffIndex) offset(i int) int {
+	v := 0
+	for _, c := range x.offsets[i*x.offSize : (i+1)*x.offSize] {
+		v = v<<8 | int(c)
+	}
+	return v
+}
+
+// objectOffset returns the offset of the i'th object in the CFF table, or
+// zero if there is no such object.
+func (x cffIndex) objectOffset(i int) int {
+	if i < 0 || x.count <= i {
+		return 0
+	}
+	return x.dataOffset + x.offset(i) - 1
+}
+
+// get returns the i'th object, or nil if there is no such valid object.
+func (x cffIndex) get(i int) []byte {
+	if i < 0 || x.count <= i {
+		return nil
+	}
+	lo, hi := x.offset(i)-1, x.offset(i+1)-1
+	if lo < 0 || hi < lo || len(x.data) < hi {
+		return nil
+	}
+	return x.data[lo:hi]
+}
+
+// cffBias returns the bias added to the operand of a callsubr or callgsubr
+// for the given number of subroutines.
+func cffBias(count int) int {
+	if count < 1240 {
+		return 107
+	} else if count < 33900 {
+		return 1131
+	}
+	return 32768
+}
+
+// These are the CFF DICT operators that are used. Two byte operators are
+// 1200 plus their second byte.
+const (
+	cffOpCharStrings    = 17
+	cffOpPrivate        = 18
+	cffOpSubrs          = 19
+	cffOpCharstringType = 1206
+	cffOpFDArray        = 1236
+	cffOpFDSelect       = 1237
+)
+
+// parseCFFDict calls fn for each operator in the DICT b, with its operands.
+// Real number operands, which none of the used operators have, are zero. The
+// DICT is at the given offset in the CFF table.
+func parseCFFDict(b []byte, offset int, fn func(op int, args []int) error) error {
+	var args []int
+	for i := 0; i < len(b); {
+		c := int(b[i])
+		i++
+		switch {
+		case c <= 21:
+			if c == 12 {
+				if i >= len(b) {
+					return FormatError{Table: "CFF ", Offset: offset, Reason: "CFF DICT too short"}
+				}
+				c = 1200 + int(b[i])
+				i++
+			}
+			if err := fn(c, args); err != nil {
+				return err
+			}
+			args = args[:0]
+			continue
+		case c == 28:
+			if i+2 > len(b) {
+				return FormatError{Table: "CFF ", Offset: offset, Reason: "CFF DICT too short"}
+			}
+			args = append(args, int(int16(u16(b, i))))
+			i += 2
+		case c == 29:
+			if i+4 > len(b) {
+				return FormatError{Table: "CFF ", Offset: offset, Reason: "CFF DICT too short"}
+			}
+			args = append(args, int(int32(u32(b, i))))
+			i += 4
+		case c == 30:
+			// Skip the real number's nibbles, up to its 0xf terminator.
+			for ; i < len(b); i++ {
+				if b[i]&0x0f == 0x0f || b[i]&0xf0 == 0xf0 {
+					break
+				}
+			}
+			i++
+			args = append(args, 0)
+		case 32 <= c && c <= 246:
+			args = append(args, c-139)
+		case 247 <= c && c <= 254:
+			if i >= len(b) {
+				return FormatError{Table: "CFF ", Offset: offset, Reason: "CFF DICT too short"}
+			}
+			if c <= 250 {
+				args = append(args, (c-247)*256+int(b[i])+108)
+			} else {
+				args = append(args, -(c-251)*256-int(b[i])-108)
+			}
+			i++
+		default:
+			return FormatError{Table: "CFF ", Offset: offset, Reason: "bad CFF DICT operand"}
+		}
+		if len(args) > cffMaxStack {
+			return FormatError{Table: "CFF ", Offset: offset, Reason: "CFF DICT has too many operands"}
+		}
+	}
+	return nil
+}
+
+// cffFont is a CFF table's glyph outlines.
+type cffFont struct {
+	charStrings, gsubrs cffIndex
+	// subrs are the local subroutines of each Font DICT. A CID-keyed font has
+	// an array of Font DICTs, selected per glyph by fdSelect. Other fonts
+	// have a single one.
+	subrs    []cffIndex
+	fdSelect []byte
+	// fdSelectOffset is the offset of fdSelect in the CFF table.
+	fdSelectOffset int
+}
+
+// parseCFF parses the CFF table's glyph outlines.
+func parseCFF(b []byte) (*cffFont, error) {
+	if len(b) < 4 {
+		return nil, FormatError{Table: "CFF ", Reason: "CFF table too short"}
+	}
+	if b[0] != 1 {
+		return nil, UnsupportedError{Feature: "CFF version"}
+	}
+	// Skip the header and the Name INDEX.
+	_, offset, err := parseCFFIndex(b, int(b[2]))
+	if err != nil {
+		return nil, err
+	}
+	topDicts, offset, err := parseCFFIndex(b, offset)
+	if err != nil {
+		return nil, err
+	}
+	// Skip the String INDEX.
+	_, offset, err = parseCFFIndex(b, offset)
+	if err != nil {
+		return nil, err
+	}
+	c := &cffFont{}
+	if c.gsubrs, _, err = parseCFFIndex(b, offset); err != nil {
+		return nil, err
+	}
+
+	charStrings, fdArray, fdSelect, private := -1, -1, -1, []int(nil)
+	topDict := topDicts.objectOffset(0)
+	err = parseCFFDict(topDicts.get(0), topDict, func(op int, args []int) error {
+		switch op {
+		case cffOpCharStrings:
+			charStrings = argOr(args, -1)
+		case cffOpPrivate:
+			if len(args) != 2 {
+				return FormatError{Table: "CFF ", Offset: topDict, Reason: "bad CFF Private DICT operands"}
+			}
+			private = append(private[:0], args...)
+		case cffOpCharstringType:
+			if argOr(args, 2) != 2 {
+				return UnsupportedError{Feature: "CFF charstring type"}
+			}
+		case cffOpFDArray:
+			fdArray = argOr(args, -1)
+		case cffOpFDSelect:
+			fdSelect = argOr(args, -1)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if c.charStrings, _, err = parseCFFIndex(b, charStrings); err != nil {
+		return nil, err
+	}
+	if c.charStrings.count == 0 {
+		return nil, FormatError{Table: "CFF ", Offset: charStrings, Reason: "CFF has no CharStrings"}
+	}
+
+	if fdArray < 0 {
+		subrs, err := parseCFFPrivate(b, private)
+		if err != nil {
+			return nil, err
+		}
+		c.subrs = []cffIndex{subrs}
+		return c, nil
+	}
+	fonts, _, err := parseCFFIndex(b, fdArray)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < fonts.count; i++ {
+		private = nil
+		fontDict := fonts.objectOffset(i)
+		err := parseCFFDict(fonts.get(i), fontDict, func(op int, args []int) error {
+			if op == cffOpPrivate {
+				if len(args) != 2 {
+					return FormatError{Table: "CFF ", Offset: fontDict, Reason: "bad CFF Private DICT operands"}
+				}
+				private = append(private[:0], args...)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		subrs, err := parseCFFPrivate(b, private)
+		if err != nil {
+			return nil, err
+		}
+		c.subrs = append(c.subrs, subrs)
+	}
+	if fdSelect < 0 || fdSelect >= len(b) {
+		return nil, FormatError{Table: "CFF ", Offset: topDict, Reason: "bad CFF FDSelect offset"}
+	}
+	c.fdSelect, c.fdSelectOffset = b[fdSelect:], fdSelect
+	return c, nil
+}
+
+// argOr returns the only operand in args, or the default value if there are
+// none.
+func argOr(args []int, dflt int) int {
+	if len(args) == 0 {
+		return dflt
+	}
+	return args[0]
+}
+
+// parseCFFPrivate parses the local subroutines of the Private DICT whose
+// size and offset are given by private.
+func parseCFFPrivate(b []byte, private []int) (cffIndex, error) {
+	if len(private) != 2 {
+		// A font with no Private DICT has no local subroutines.
+		return cffIndex{}, nil
+	}
+	size, offset := private[0], private[1]
+	if size < 0 || offset < 0 || offset+size > len(b) {
+		return cffIndex{}, FormatError{Table: "CFF ", Reason: "bad CFF Private DICT offset"}
+	}
+	subrs := -1
+	err := parseCFFDict(b[offset:offset+size], offset, func(op int, args []int) error {
+		if op == cffOpSubrs {
+			subrs = argOr(args, -1)
+		}
+		return nil
+	})
+	if err != nil || subrs < 0 {
+		return cffIndex{}, err
+	}
+	x, _, err := parseCFFIndex(b, offset+subrs)
+	return x, err
+}
+
+// fd returns the index of the Font DICT for the glyph with the given index.
+func (c *cffFont) fd(i Index) int {
+	if c.fdSelect == nil {
+		return 0
+	}
+	b := c.fdSelect
+	switch b[0] {
+	case 0:
+		if 1+int(i) < len(b) {
+			return int(b[1+int(i)])
+		}
+	case 3:
+		if len(b) < 3 {
+			return -1
+		}
+		n := int(u16(b, 1))
+		if len(b) < 5+3*n {
+			return -1
+		}
+		for j := 0; j < n; j++ {
+			first, next := Index(u16(b, 3+3*j)), Index(u16(b, 6+3*j))
+			if first <= i && i < next {
+				return int(b[5+3*j])
+			}
+		}
+	}
+	return -1
+}
+
+// cffDecoder decodes Type 2 charstrings into a glyph's contours, in FUnits.
+// Its co-ordinates and arguments are 16.16 fixed point numbers.
+type cffDecoder struct {
+	font          *cffFont
+	subrs         cffIndex
+	points        []Point
+	ends          []int
+	stack         [cffMaxStack]int32
+	n             int
+	x, y          int32
+	nStems        int
+	seenWidth     bool
+	done          bool
+	contourStart  int
+	contourClosed bool
+}
+
+// decode decodes the charstring of the glyph with the given index.
+func (d *cffDecoder) decode(c *cffFont, i Index) error {
+	cs := c.charStrings.get(int(i))
+	if cs == nil {
+		return FormatError{Table: "CFF ", Reason: "bad CFF glyph index"}
+	}
+	fd := c.fd(i)
+	if fd < 0 || len(c.subrs) <= fd {
+		return FormatError{Table: "CFF ", Offset: c.fdSelectOffset, Reason: "bad CFF FDSelect"}
+	}
+	*d = cffDecoder{
+		font:          c,
+		subrs:         c.subrs[fd],
+		points:        d.points[:0],
+		ends:          d.ends[:0],
+		contourClosed: true,
+	}
+	if err := d.run(cs, 0); err != nil {
+		// A FormatError's Offset is that of the glyph's charstring, even if
+		// the error is in a subroutine that it calls.
+		if e, ok := err.(FormatError); ok {
+			e.Offset = c.charStrings.objectOffset(int(i))
+			err = e
+		}
+		return err
+	}
+	d.closeContour()
+	return nil
+}
+
+func (d *cffDecoder) push(v int32) error {
+	if d.n == cffMaxStack {
+		return FormatError{Table: "CFF ", Reason: "CFF stack overflow"}
+	}
+	d.stack[d.n] = v
+	d.n++
+	return nil
+}
+
+// width drops the optional width argument, the first of the arguments to
+// the first stack-clearing operator, if there is one more than the want
+// arguments that the operator takes, modulo mod.
+func (d *cffDecoder) width(want, mod int) {
+	if d.seenWidth {
+		return
+	}
+	d.seenWidth = true
+	n := d.n - want
+	if mod != 0 && n > 0 {
+		n %= mod
+	}
+	if n == 1 {
+		copy(d.stack[:], d.stack[1:d.n])
+		d.n--
+	}
+}
+
+func (d *cffDecoder) closeContour() {
+	if d.contourClosed {
+		return
+	}
+	d.contourClosed = true
+	// The contour is implicitly closed, as for TrueType, so a final point
+	// that coincides with the start point is dropped.
+	start, n := d.contourStart, len(d.points)
+	if n-start > 1 {
+		p, q := d.points[start], d.points[n-1]
+		if p.X == q.X && p.Y == q.Y && q.Flags&flagOnCurve != 0 {
+			d.points = d.points[:n-1]
+		}
+	}
+	if len(d.points)-start <= 1 {
+		d.points = d.points[:start]
+		return
+	}
+	d.ends = append(d.ends, len(d.points))
+}
+
+// cffRound rounds a 16.16 fixed point number to the nearest integer.
+func cffRound(x int32) int32 {
+	return (x + 0x8000) >> 16
+}
+
+func (d *cffDecoder) moveTo(dx, dy int32) {
+	d.closeContour()
+	d.contourStart, d.contourClosed = len(d.points), false
+	d.x += dx
+	d.y += dy
+	d.points = append(d.points, Point{X: cffRound(d.x), Y: cffRound(d.y), Flags: flagOnCurve})
+}
+
+func (d *cffDecoder) lineTo(dx, dy int32) {
+	d.x += dx
+	d.y += dy
+	d.points = append(d.points, Point{X: cffRound(d.x), Y: cffRound(d.y), Flags: flagOnCurve})
+}
+
+func (d *cffDecoder) curveTo(dxa, dya, dxb, dyb, dxc, dyc int32) {
+	xa, ya := d.x+dxa, d.y+dya
+	xb, yb := xa+dxb, ya+dyb
+	d.x, d.y = xb+dxc, yb+dyc
+	d.points = append(d.points,
+		Point{X: cffRound(xa), Y: cffRound(ya), Flags: FlagCubic},
+		Point{X: cffRound(xb), Y: cffRound(yb), Flags: FlagCubic},
+		Point{X: cffRound(d.x), Y: cffRound(d.y), Flags: flagOnCurve},
+	)
+}
+
+// run interprets the charstring cs, at the given subroutine call depth.
+func (d *cffDecoder) run(cs []byte, depth int) error {
+	if depth > cffMaxCalls {
+		return FormatError{Table: "CFF ", Reason: "CFF subroutine calls too deep"}
+	}
+	for i := 0; i < len(cs) && !d.done; {
+		op := int(cs[i])
+		i++
+		// Decode an operand.
+		if op >= 32 || op == 28 {
+			v := int32(0)
+			switch {
+			case op == 28:
+				if i+2 > len(cs) {
+					return FormatError{Table: "CFF ", Reason: "CFF charstring too short"}
+				}
+				v = int32(int16(u16(cs, i))) << 16
+				i += 2
+			case op <= 246:
+				v = int32(op-139) << 16
+			case op <= 250:
+				if i >= len(cs) {
+					return FormatError{Table: "CFF ", Reason: "CFF charstring too short"}
+				}
+				v = int32((op-247)*256+int(cs[i])+108) << 16
+				i++
+			case op <= 254:
+				if i >= len(cs) {
+					return FormatError{Table: "CFF ", Reason: "CFF charstring too short"}
+				}
+				v = int32(-(op-251)*256-int(cs[i])-108) << 16
+				i++
+			default:
+				if i+4 > len(cs) {
+					return FormatError{Table: "CFF ", Reason: "CFF charstring too short"}
+				}
+				v = int32(u32(cs, i))
+				i += 4
+			}
+			if err := d.push(v); err != nil {
+				return err
+			}
+			continue
+		}
+		if op == 12 {
+			if i >= len(cs) {
+				return FormatError{Table: "CFF ", Reason: "CFF charstring too short"}
+			}
+			op = 1200 + int(cs[i])
+			i++
+		}
+		args := d.stack[:d.n]
+		switch op {
+		case 1, 3, 18, 23: // hstem, vstem, hstemhm, vstemhm.
+			d.width(0, 2)
+			d.nStems += d.n / 2
+
+		case 19, 20: // hintmask, cntrmask.
+			// Any arguments are an implicit vstem.
+			d.width(0, 2)
+			d.nStems += d.n / 2
+			i += (d.nStems + 7) / 8
+			if i > len(cs) {
+				return FormatError{Table: "CFF ", Reason: "CFF charstring too short"}
+			}
+
+		case 21: // rmoveto.
+			d.width(2, 0)
+			if d.n < 2 {
+				return FormatError{Table: "CFF ", Reason: "CFF rmoveto"}
+			}
+			d.moveTo(d.stack[d.n-2], d.stack[d.n-1])
+
+		case 22: // hmoveto.
+			d.width(1, 0)
+			if d.n < 1 {
+				return FormatError{Table: "CFF ", Reason: "CFF hmoveto"}
+			}
+			d.moveTo(d.stack[d.n-1], 0)
+
+		case 4: // vmoveto.
+			d.width(1, 0)
+			if d.n < 1 {
+				return FormatError{Table: "CFF ", Reason: "CFF vmoveto"}
+			}
+			d.moveTo(0, d.stack[d.n-1])
+
+		case 5: // rlineto.
+			for j := 0; j+2 <= len(args); j += 2 {
+				d.lineTo(args[j], args[j+1])
+			}
+
+		case 6, 7: // hlineto, vlineto.
+			horizontal := op == 6
+			for _, a := range args {
+				if horizontal {
+					d.lineTo(a, 0)
+				} else {
+					d.lineTo(0, a)
+				}
+				horizontal = !horizontal
+			}
+
+		case 8: // rrcurveto.
+			for j := 0; j+6 <= len(args); j += 6 {
+				d.curveTo(args[j], args[j+1], args[j+2], args[j+3], args[j+4], args[j+5])
+			}
+
+		case 24: // rcurveline.
+			j := 0
+			for ; j+6 <= len(args)-2; j += 6 {
+				d.curveTo(args[j], args[j+1], args[j+2], args[j+3], args[j+4], args[j+5])
+			}
+			if j+2 <= len(args) {
+				d.lineTo(args[j], args[j+1])
+			}
+
+		case 25: // rlinecurve.
+			j := 0
+			for ; j+2 <= len(args)-6; j += 2 {
+				d.lineTo(args[j], args[j+1])
+			}
+			if j+6 <= len(args) {
+				d.curveTo(args[j], args[j+1], args[j+2], args[j+3], args[j+4], args[j+5])
+			}
+
+		case 26, 27: // vvcurveto, hhcurveto.
+			// An odd number of arguments starts with the first curve's
+			// other co-ordinate.
+			j, other := 0, int32(0)
+			if len(args)%2 == 1 {
+				j, other = 1, args[0]
+			}
+			for ; j+4 <= len(args); j += 4 {
+				if op == 26 {
+					d.curveTo(other, args[j], args[j+1], args[j+2], 0, args[j+3])
+				} else {
+					d.curveTo(args[j], other, args[j+1], args[j+2], args[j+3], 0)
+				}
+				other = 0
+			}
+
+		case 30, 31: // vhcurveto, hvcurveto.
+			// The curves alternate between starting vertically and
+			// horizontally. The last curve may have a fifth argument.
+			horizontal := op == 31
+			for j := 0; j+4 <= len(args); j += 4 {
+				last := int32(0)
+				if len(args)-j == 5 {
+					last = args[j+4]
+				}
+				if horizontal {
+					d.curveTo(args[j], 0, args[j+1], args[j+2], last, args[j+3])
+				} else {
+					d.curveTo(0, args[j], args[j+1], args[j+2], args[j+3], last)
+				}
+				horizontal = !horizontal
+			}
+
+		case 10, 29: // callsubr, callgsubr.
+			if d.n < 1 {
+				return FormatError{Table: "CFF ", Reason: "CFF subroutine call"}
+			}
+			d.n--
+			subrs := d.subrs
+			if op == 29 {
+				subrs = d.font.gsubrs
+			}
+			subr := subrs.get(int(cffRound(d.stack[d.n])) + cffBias(subrs.count))
+			if subr == nil {
+				return FormatError{Table: "CFF ", Reason: "bad CFF subroutine index"}
+			}
+			if err := d.run(subr, depth+1); err != nil {
+				return err
+			}
+			continue
+
+		case 11: // return.
+			return nil
+
+		case 14: // endchar.
+			d.width(0, 4)
+			if d.n == 4 {
+				return UnsupportedError{Feature: "CFF accented character (seac)"}
+			}
+			d.done = true
+
+		case 1234: // hflex.
+			if d.n < 7 {
+				return FormatError{Table: "CFF ", Reason: "CFF hflex"}
+			}
+			y := d.y
+			d.curveTo(args[0], 0, args[1], args[2], args[3], 0)
+			d.curveTo(args[4], 0, args[5], y-d.y, args[6], 0)
+
+		case 1235: // flex.
+			if d.n < 13 {
+				return FormatError{Table: "CFF ", Reason: "CFF flex"}
+			}
+			d.curveTo(args[0], args[1], args[2], args[3], args[4], args[5])
+			d.curveTo(args[6], args[7], args[8], args[9], args[10], args[11])
+
+		case 1236: // hflex1.
+			if d.n < 9 {
+				return FormatError{Table: "CFF ", Reason: "CFF hflex1"}
+			}
+			y := d.y
+			d.curveTo(args[0], args[1], args[2], args[3], args[4], 0)
+			d.curveTo(args[5], 0, args[6], args[7], args[8], y-d.y-args[7])
+
+		case 1237: // flex1.
+			if d.n < 11 {
+				return FormatError{Table: "CFF ", Reason: "CFF flex1"}
+			}
+			x, y := d.x, d.y
+			dx, dy := int32(0), int32(0)
+			for j := 0; j < 10; j += 2 {
+				dx += args[j]
+				dy += args[j+1]
+			}
+			d.curveTo(args[0], args[1], args[2], args[3], args[4], args[5])
+			// The last point is either horizontally or vertically level
+			// with the first, depending on the flex's overall direction.
+			if abs32(dx) > abs32(dy) {
+				d.curveTo(args[6], args[7], args[8], args[9], args[10], y-d.y-args[7]-args[9])
+			} else {
+				d.curveTo(args[6], args[7], args[8], args[9], x-d.x-args[6]-args[8], args[10])
+			}
+
+		default:
+			if err := d.arithmetic(op); err != nil {
+				return err
+			}
+			continue
+		}
+		d.n = 0
+	}
+	return nil
+}
+
+// arithmetic runs one of the Type 2 arithmetic and stack operators, which do
+// not clear the stack.
+func (d *cffDecoder) arithmetic(op int) error {
+	args := d.stack[:d.n]
+	need := 2
+	switch op {
+	case 1209, 1214, 1218, 1227: // abs, neg, drop, dup.
+		need = 1
+	case 1210, 1211, 1212, 1224, 1228: // add, sub, div, mul, exch.
+	default:
+		return UnsupportedError{Feature: "CFF charstring operator"}
+	}
+	if d.n < need {
+		return FormatError{Table: "CFF ", Reason: "CFF stack underflow"}
+	}
+	a := args[d.n-need]
+	b := args[d.n-1]
+	switch op {
+	case 1209:
+		args[d.n-1] = abs32(a)
+	case 1214:
+		args[d.n-1] = -a
+	case 1218:
+		d.n--
+	case 1227:
+		return d.push(a)
+	case 1228:
+		args[d.n-2], args[d.n-1] = b, a
+	default:
+		switch op {
+		case 1210:
+			a += b
+		case 1211:
+			a -= b
+		case 1212:
+			if b == 0 {
+				return FormatError{Table: "CFF ", Reason: "CFF division by zero"}
+			}
+			a = int32((int64(a) << 16) / int64(b))
+		case 1224:
+			a = int32((int64(a) * int64(b)) >> 16)
+		}
+		d.n--
+		args[d.n-1] = a
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// A TableChecksum is the checksum of one of a font's tables.
+type TableChecksum struct {
+	// Tag is the table's tag, such as "glyf".
+	Tag string
+	// Recorded is the checksum in the font's table directory, and Computed
+	// is the checksum of the table's data.
+	Recorded, Computed uint32
+}
+
+// A ChecksumReport is the result of verifying a font's checksums.
+type ChecksumReport struct {
+	// Tables are the checksums of the font's tables, in the order of its
+	// table directory.
+	Tables []TableChecksum
+	// HasHead is whether the font has a head table. If so, Recorded and
+	// Computed are its checkSumAdjustment, and the adjustment computed from
+	// the whole font.
+	HasHead            bool
+	Recorded, Computed uint32
+}
+
+// OK returns whether all of the report's checksums match.
+func (r *ChecksumReport) OK() bool {
+	for _, t := range r.Tables {
+		if t.Recorded != t.Computed {
+			return false
+		}
+	}
+	return r.Recorded == r.Computed
+}
+
+// Mismatched returns the tags of the tables whose checksums do not match.
+func (r *ChecksumReport) Mismatched() []string {
+	var tags []string
+	for _, t := range r.Tables {
+		if t.Recorded != t.Computed {
+			tags = append(tags, t.Tag)
+		}
+	}
+	return tags
+}
+
+// VerifyChecksums verifies the checksums of the given TTF data's tables, and
+// its head table's checkSumAdjustment. Parse does not verify them, as many
+// fonts in use have wrong checksums, but services that accept untrusted fonts
+// may want to reject such corrupted files. The error is non-nil if the data
+// is not a valid table directory; mismatched checksums are reported, not
+// returned as an error. TrueType collections and WOFF data are not supported.
+//
+// The checksums are documented at https://www.microsoft.com/typography/otspec/otff.htm
+func VerifyChecksums(ttf []byte) (*ChecksumReport, error) {
+	if len(ttf) < 12 {
+		return nil, FormatError{Reason: "TTF data is too short"}
+	}
+	switch u32(ttf, 0) {
+	case 0x00010000, 0x4f54544f, 0x74727565: // 1.0, "OTTO" and "true".
+	case 0x74746366, 0x774f4646, 0x774f4632: // "ttcf", "wOFF" and "wOF2".
+		return nil, UnsupportedError{Feature: "checksums of a TTC or WOFF font"}
+	default:
+		return nil, FormatError{Reason: "bad TTF version"}
+	}
+	n := int(u16(ttf, 4))
+	if len(ttf) < 12+16*n {
+		return nil, FormatError{Offset: 12, Reason: "TTF data is too short"}
+	}
+	r := &ChecksumReport{Tables: make([]TableChecksum, n)}
+	for i := range r.Tables {
+		x := 12 + 16*i
+		t, err := readTable(ttf, ttf[x+8:x+16])
+		if err != nil {
+			return nil, err
+		}
+		c := &r.Tables[i]
+		c.Tag, c.Recorded, c.Computed = string(ttf[x:x+4]), u32(ttf, x+4), checksum(t)
+		if c.Tag == "head" && len(t) >= 12 {
+			// The head table's checksum is of the table with a zero
+			// checkSumAdjustment.
+			r.HasHead, r.Recorded = true, u32(t, 8)
+			c.Computed -= r.Recorded
+		}
+	}
+	if r.HasHead {
+		r.Computed = 0xb1b0afba - (checksum(ttf) - r.Recorded)
+	}
+	return r, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"fmt"
+	"sort"
+)
+
+// parseCmapFormat0 parses the format 0 cmap subtable at the given offset.
+// Format 0 is an array of one byte glyph indexes for the 256 single byte
+// character codes, typically of the Macintosh Roman encoding. Runs of codes
+// whose glyph indexes are consecutive become a single cm entry.
+func (f *Font) parseCmapFormat0(offset int) error {
+	if len(f.cmap)-offset < 6+256 {
+		return FormatError{Table: "cmap", Offset: offset, Reason: "cmap too short"}
+	}
+	if language := u16(f.cmap, offset+4); language != 0 {
+		return UnsupportedError{Feature: fmt.Sprintf("language: %d", language)}
+	}
+	var cms []cm
+	for c := uint32(0); c < 256; c++ {
+		g := uint32(f.cmap[offset+6+int(c)])
+		if g == 0 {
+			continue
+		}
+		// Extend the previous entry, if it is for the previous code.
+		if n := len(cms); n > 0 {
+			if p := &cms[n-1]; p.end+1 == c && p.delta == g-c {
+				p.end = c
+				continue
+			}
+		}
+		cms = append(cms, cm{start: c, end: c, delta: g - c})
+	}
+	f.cm, f.cmapIndexes = cms, nil
+	return nil
+}
+
+// macRomanCode returns the Macintosh Roman code of r. ok is false if the
+// encoding has no such rune.
+func macRomanCode(r rune) (c byte, ok bool) {
+	if 0 <= r && r < 0x80 {
+		return byte(r), true
+	}
+	for i, m := range macRoman {
+		if m == r {
+			return byte(0x80 + i), true
+		}
+	}
+	return 0, false
+}
+
+// rangeMacRoman is RangeCharmap for a Macintosh Roman cmap subtable, whose
+// codes are not in the same order as their runes.
+func (f *Font) rangeMacRoman(fn func(r rune, i Index) bool) {
+	var runes []rune
+	for c := uint32(0); c < 256; c++ {
+		if f.index(c) == 0 {
+			continue
+		}
+		if c < 0x80 {
+			runes = append(runes, rune(c))
+		} else {
+			runes = append(runes, macRoman[c-0x80])
+		}
+	}
+	sort.Sort(runeSlice(runes))
+	for _, r := range runes {
+		if !fn(r, f.Index(r)) {
+			return
+		}
+	}
+}
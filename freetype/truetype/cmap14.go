@@ -0,0 +1,112 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// u24 returns the big-endian 24-bit unsigned integer at b[i:].
+func u24(b []byte, i int) uint32 {
+	return uint32(b[i])<<16 | uint32(b[i+1])<<8 | uint32(b[i+2])
+}
+
+// parseCmapVariants returns the format 14 subtable at the given offset in the
+// cmap table, or nil if it is invalid. The subtable is documented at
+// https://www.microsoft.com/typography/otspec/cmap.htm. An invalid subtable is
+// ignored, rather than being an error, as the font's other subtable still
+// maps runes without variation selectors.
+func parseCmapVariants(cmap []byte, offset int) []byte {
+	if offset <= 0 || len(cmap)-offset < 10 || u16(cmap, offset) != 14 {
+		return nil
+	}
+	length := int64(u32(cmap, offset+2))
+	if length > int64(len(cmap)-offset) {
+		return nil
+	}
+	b := cmap[offset : offset+int(length)]
+	n := int64(u32(b, 6))
+	if 10+11*n > length {
+		return nil
+	}
+	for i := 0; i < int(n); i++ {
+		x := 10 + 11*i
+		// IndexVariant does a binary search, so the records must be sorted.
+		if i > 0 && u24(b, x) <= u24(b, x-11) {
+			return nil
+		}
+		// The default and non-default UVS tables have 4 and 5 byte entries.
+		for j, size := range [2]int64{4, 5} {
+			o := int64(u32(b, x+3+4*j))
+			if o == 0 {
+				continue
+			}
+			if o+4 > length || o+4+size*int64(u32(b, int(o))) > length {
+				return nil
+			}
+		}
+	}
+	return b
+}
+
+// IndexVariant returns a Font's index for the Unicode variation sequence of
+// the rune r followed by the variation selector, such as U+FE0F to request
+// an emoji presentation or one of U+E0100 to U+E01EF to request a CJK
+// ideograph variant. ok is whether the font supports the sequence, as given
+// by its cmap's format 14 subtable. If not, the selector should be ignored,
+// and IndexVariant returns the index for r alone.
+func (f *Font) IndexVariant(r, selector rune) (index Index, ok bool) {
+	b := f.cmapVariants
+	if b == nil {
+		return f.Index(r), false
+	}
+	// Find the selector's record.
+	x := -1
+	for i, j := 0, int(u32(b, 6)); i < j; {
+		h := i + (j-i)/2
+		s := rune(u24(b, 10+11*h))
+		if selector < s {
+			j = h
+		} else if s < selector {
+			i = h + 1
+		} else {
+			x = 10 + 11*h
+			break
+		}
+	}
+	if x < 0 {
+		return f.Index(r), false
+	}
+	// The non-default UVS table maps sequences to glyphs other than r's
+	// usual glyph.
+	if o := int(u32(b, x+7)); o != 0 {
+		for i, j := 0, int(u32(b, o)); i < j; {
+			h := i + (j-i)/2
+			y := o + 4 + 5*h
+			c := rune(u24(b, y))
+			if r < c {
+				j = h
+			} else if c < r {
+				i = h + 1
+			} else {
+				return Index(u16(b, y+3)), true
+			}
+		}
+	}
+	// The default UVS table lists ranges of sequences that use r's usual
+	// glyph.
+	if o := int(u32(b, x+3)); o != 0 {
+		for i, j := 0, int(u32(b, o)); i < j; {
+			h := i + (j-i)/2
+			y := o + 4 + 4*h
+			start := rune(u24(b, y))
+			if r < start {
+				j = h
+			} else if start+rune(b[y+3]) < r {
+				i = h + 1
+			} else {
+				return f.Index(r), true
+			}
+		}
+	}
+	return f.Index(r), false
+}
@@ -0,0 +1,90 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"fmt"
+	"sort"
+)
+
+// parseCmapFormat2 parses the format 2 cmap subtable at the given offset.
+// Format 2 is for legacy East Asian encodings, which mix one and two byte
+// character codes. The subHeaderKeys array maps each high byte to a subHeader,
+// which maps a range of low bytes. High bytes that map to subHeader 0 are
+// single byte codes, which are looked up in subHeader 0 themselves.
+func (f *Font) parseCmapFormat2(offset int) error {
+	const headerSize = 6 + 2*256
+	if len(f.cmap)-offset < headerSize+8 {
+		return FormatError{Table: "cmap", Offset: offset, Reason: "cmap too short"}
+	}
+	if language := u16(f.cmap, offset+4); language != 0 {
+		return UnsupportedError{Feature: fmt.Sprintf("language: %d", language)}
+	}
+	// subHeader returns the i'th subHeader's range of low bytes, its idDelta
+	// and the offset, relative to the subtable, of its first glyph index.
+	subHeader := func(i int) (first, count, delta, indexes uint32, err error) {
+		x := offset + headerSize + 8*i
+		if len(f.cmap)-x < 8 {
+			return 0, 0, 0, 0, FormatError{Table: "cmap", Offset: x, Reason: "cmap too short"}
+		}
+		first, count = uint32(u16(f.cmap, x)), uint32(u16(f.cmap, x+2))
+		if first+count > 256 {
+			return 0, 0, 0, 0, FormatError{Table: "cmap", Offset: x, Reason: "bad cmap subHeader"}
+		}
+		// The idRangeOffset is relative to its own position.
+		indexes = uint32(x+6-offset) + uint32(u16(f.cmap, x+6))
+		return first, count, uint32(u16(f.cmap, x+4)), indexes, nil
+	}
+
+	var cms []cm
+	first0, count0, delta0, indexes0, err := subHeader(0)
+	if err != nil {
+		return err
+	}
+	for hi := 0; hi < 256; hi++ {
+		k := int(u16(f.cmap, offset+6+2*hi) / 8)
+		if k == 0 {
+			// A single byte code, hi itself.
+			c := uint32(hi)
+			if c < first0 || first0+count0 <= c {
+				continue
+			}
+			e := cm{start: c, end: c, delta: delta0, offset: indexes0 + 2*(c-first0)}
+			// Extend the previous entry, if it is for the previous code.
+			if n := len(cms); n > 0 {
+				if p := &cms[n-1]; p.end+1 == c && p.delta == e.delta && p.offset+2*(c-p.start) == e.offset {
+					p.end = c
+					continue
+				}
+			}
+			cms = append(cms, e)
+			continue
+		}
+		first, count, delta, indexes, err := subHeader(k)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			continue
+		}
+		start := uint32(hi)<<8 + first
+		cms = append(cms, cm{start: start, end: start + count - 1, delta: delta, offset: indexes})
+	}
+	sort.Sort(cmSlice(cms))
+	for i := 1; i < len(cms); i++ {
+		if cms[i].start <= cms[i-1].end {
+			return FormatError{Table: "cmap", Offset: offset, Reason: "bad cmap subHeaderKeys"}
+		}
+	}
+	f.cm, f.cmapIndexes = cms, f.cmap[offset:]
+	return nil
+}
+
+type cmSlice []cm
+
+func (s cmSlice) Len() int           { return len(s) }
+func (s cmSlice) Less(i, j int) bool { return s[i].start < s[j].start }
+func (s cmSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
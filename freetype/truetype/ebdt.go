@@ -0,0 +1,212 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// A BitmapGlyph is a glyph's pre-rendered bitmap from the font's EBDT and
+// EBLC tables, which hold strikes of bitmaps for particular sizes. Such
+// bitmaps are designed to be drawn at exactly their strike's size, instead of
+// the scaled outline.
+type BitmapGlyph struct {
+	// PPEM is the pixels per em of the glyph's strike.
+	PPEM int32
+	// BitDepth is the number of bits per pixel: 1 for monochrome bitmaps, or
+	// 2, 4 or 8 for grayscale ones, where the maximum value is black.
+	BitDepth int
+	// Width and Height are the bitmap's size in pixels.
+	Width, Height int
+	// BearingX and BearingY are the offset, in pixels, of the bitmap's top
+	// left corner from the glyph's origin, with Y increasing upwards.
+	// Advance is the glyph's advance width in pixels.
+	BearingX, BearingY, Advance int32
+	// Stride is the number of bytes in each row of Data, which holds the
+	// bitmap's rows from top to bottom, with each pixel's bits most
+	// significant first.
+	Stride int
+	Data   []byte
+}
+
+// BitmapGlyph returns the embedded bitmap of the glyph with the given index,
+// from the font's EBDT strike for the given scale, which is the number of 26.6
+// fixed point units in 1 em. As bitmaps are not scaled, the scale must be a
+// whole number of pixels per em, and the strike must be for that size. ok is
+// false if the font has no such bitmap, or it is a composite bitmap, which
+// this package does not support.
+//
+// The tables are documented at https://www.microsoft.com/typography/otspec/eblc.htm
+// and https://www.microsoft.com/typography/otspec/ebdt.htm
+func (f *Font) BitmapGlyph(scale int32, i Index) (g BitmapGlyph, ok bool) {
+	b := f.eblc
+	if scale <= 0 || scale&63 != 0 || len(b) < 8 || f.ebdt == nil {
+		return BitmapGlyph{}, false
+	}
+	ppem, n := scale>>6, int(u32(b, 4))
+	if n < 0 || len(b) < 8+48*n {
+		return BitmapGlyph{}, false
+	}
+	for j := 0; j < n; j++ {
+		size := b[8+48*j : 56+48*j]
+		if int32(size[44]) != ppem || int32(size[45]) != ppem {
+			continue
+		}
+		if i < Index(u16(size, 40)) || Index(u16(size, 42)) < i {
+			continue
+		}
+		if g, ok = f.bitmapGlyph(size, i); ok {
+			g.PPEM = ppem
+			return g, true
+		}
+	}
+	return BitmapGlyph{}, false
+}
+
+// bitmapGlyph returns the bitmap of glyph i in the strike with the given
+// EBLC BitmapSize record.
+func (f *Font) bitmapGlyph(size []byte, i Index) (g BitmapGlyph, ok bool) {
+	b := f.eblc
+	array, n := u32(size, 0), u32(size, 8)
+	if uint64(len(b)) < uint64(array)+8*uint64(n) {
+		return BitmapGlyph{}, false
+	}
+	for k := uint32(0); k < n; k++ {
+		x := array + 8*k
+		first, last := Index(u16(b, int(x))), Index(u16(b, int(x+2)))
+		if i < first || last < i {
+			continue
+		}
+		sub := uint64(array) + uint64(u32(b, int(x+4)))
+		if uint64(len(b)) < sub+8 {
+			return BitmapGlyph{}, false
+		}
+		s := b[sub:]
+		indexFormat, imageFormat, imageOffset := u16(s, 0), u16(s, 2), u32(s, 4)
+		j := uint32(i - first)
+		// metrics are the big glyph metrics for index formats 2 and 5, whose
+		// images have the same metrics.
+		var g0, g1 uint32
+		var metrics []byte
+		switch indexFormat {
+		case 1:
+			if len(s) < 16+4*int(j) {
+				return BitmapGlyph{}, false
+			}
+			g0, g1 = u32(s, 8+4*int(j)), u32(s, 12+4*int(j))
+		case 2:
+			if len(s) < 20 {
+				return BitmapGlyph{}, false
+			}
+			g0, metrics = u32(s, 8)*j, s[12:20]
+			g1 = g0 + u32(s, 8)
+		case 3:
+			if len(s) < 12+2*int(j) {
+				return BitmapGlyph{}, false
+			}
+			g0, g1 = uint32(u16(s, 8+2*int(j))), uint32(u16(s, 10+2*int(j)))
+		case 4:
+			if len(s) < 12 {
+				return BitmapGlyph{}, false
+			}
+			m := int(u32(s, 8))
+			if m < 0 || len(s) < 12+4*(m+1) {
+				return BitmapGlyph{}, false
+			}
+			found := false
+			for p := 0; p < m; p++ {
+				if Index(u16(s, 12+4*p)) == i {
+					g0, g1, found = uint32(u16(s, 14+4*p)), uint32(u16(s, 18+4*p)), true
+					break
+				}
+			}
+			if !found {
+				return BitmapGlyph{}, false
+			}
+		case 5:
+			if len(s) < 24 {
+				return BitmapGlyph{}, false
+			}
+			m := int(u32(s, 20))
+			if m < 0 || len(s) < 24+2*m {
+				return BitmapGlyph{}, false
+			}
+			found := false
+			for p := 0; p < m; p++ {
+				if Index(u16(s, 24+2*p)) == i {
+					g0, found = u32(s, 8)*uint32(p), true
+					break
+				}
+			}
+			if !found {
+				return BitmapGlyph{}, false
+			}
+			g1, metrics = g0+u32(s, 8), s[12:20]
+		default:
+			return BitmapGlyph{}, false
+		}
+		if g1 <= g0 || uint64(len(f.ebdt)) < uint64(imageOffset)+uint64(g1) {
+			return BitmapGlyph{}, false
+		}
+		return decodeBitmap(f.ebdt[imageOffset+g0:imageOffset+g1], imageFormat, metrics, int(size[46]))
+	}
+	return BitmapGlyph{}, false
+}
+
+// decodeBitmap decodes an EBDT glyph bitmap with the given image format.
+// metrics are the big glyph metrics from the EBLC table, for image format 5.
+func decodeBitmap(data []byte, imageFormat uint16, metrics []byte, bitDepth int) (g BitmapGlyph, ok bool) {
+	switch bitDepth {
+	case 1, 2, 4, 8:
+	default:
+		return BitmapGlyph{}, false
+	}
+	switch imageFormat {
+	case 1, 2:
+		// The images start with small glyph metrics.
+		if len(data) < 5 {
+			return BitmapGlyph{}, false
+		}
+		metrics, data = data[:5], data[5:]
+	case 5:
+		if metrics == nil {
+			return BitmapGlyph{}, false
+		}
+	case 6, 7:
+		// The images start with big glyph metrics.
+		if len(data) < 8 {
+			return BitmapGlyph{}, false
+		}
+		metrics, data = data[:8], data[8:]
+	default:
+		return BitmapGlyph{}, false
+	}
+	g = BitmapGlyph{
+		BitDepth: bitDepth,
+		Height:   int(metrics[0]),
+		Width:    int(metrics[1]),
+		BearingX: int32(int8(metrics[2])),
+		BearingY: int32(int8(metrics[3])),
+		Advance:  int32(metrics[4]),
+	}
+	rowBits := g.Width * bitDepth
+	g.Stride = (rowBits + 7) / 8
+	// Image formats 1 and 6 have byte aligned rows, and the others are bit
+	// aligned, with no padding between rows.
+	srcRowBits := rowBits
+	if imageFormat == 1 || imageFormat == 6 {
+		srcRowBits = 8 * g.Stride
+	}
+	if len(data)*8 < srcRowBits*g.Height {
+		return BitmapGlyph{}, false
+	}
+	g.Data = make([]byte, g.Stride*g.Height)
+	for y := 0; y < g.Height; y++ {
+		for x := 0; x < rowBits; x++ {
+			k := y*srcRowBits + x
+			if data[k/8]&(0x80>>uint(k%8)) != 0 {
+				g.Data[y*g.Stride+x/8] |= 0x80 >> uint(x%8)
+			}
+		}
+	}
+	return g, true
+}
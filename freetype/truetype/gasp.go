@@ -0,0 +1,54 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// GaspBehavior is a bit set of a font's recommended rendering for a range of
+// sizes, from its Grid-fitting And Scan-conversion Procedure (gasp) table.
+type GaspBehavior uint16
+
+const (
+	// GaspGridFit recommends grid-fitting, i.e. hinting.
+	GaspGridFit GaspBehavior = 1 << iota
+	// GaspDoGray recommends anti-aliasing, rather than bilevel rendering.
+	GaspDoGray
+	// GaspSymmetricGridFit recommends grid-fitting for ClearType's
+	// subpixel rendering.
+	GaspSymmetricGridFit
+	// GaspSymmetricSmoothing recommends anti-aliasing in the y direction,
+	// as well as the x direction, for ClearType's subpixel rendering.
+	GaspSymmetricSmoothing
+)
+
+// Gasp returns the font's recommended rendering for the given scale, which is
+// the number of 26.6 fixed point units in 1 em. ok is false if the font has no
+// valid gasp table, in which case renderers typically grid-fit and anti-alias
+// at all sizes.
+//
+// The table is documented at https://www.microsoft.com/typography/otspec/gasp.htm
+func (f *Font) Gasp(scale int32) (b GaspBehavior, ok bool) {
+	g := f.gasp
+	if len(g) < 4 {
+		return 0, false
+	}
+	version, n := u16(g, 0), int(u16(g, 2))
+	if version > 1 || len(g) < 4+4*n {
+		return 0, false
+	}
+	// The ranges are sorted by their maximum ppem, and the last range's
+	// maximum is 0xffff.
+	ppem := (scale + 32) >> 6
+	for j := 0; j < n; j++ {
+		if ppem <= int32(u16(g, 4+4*j)) {
+			b = GaspBehavior(u16(g, 6+4*j))
+			// The symmetric bits are new in version 1 of the table.
+			if version == 0 {
+				b &= GaspGridFit | GaspDoGray
+			}
+			return b, true
+		}
+	}
+	return 0, false
+}
@@ -5,6 +5,11 @@
 
 package truetype
 
+import (
+	"context"
+	"sync"
+)
+
 // Hinting is the policy for snapping a glyph's contours to pixel boundaries.
 type Hinting int32
 
@@ -13,8 +18,27 @@ const (
 	NoHinting Hinting = iota
 	// FullHinting means to use the font's hinting instructions.
 	FullHinting
-
-	// TODO: implement VerticalHinting.
+	// SubpixelHinting means to use the font's hinting instructions, as
+	// interpreted by ClearType's subpixel rasterizer. Unless the font says
+	// that it is written for ClearType, its hints in the x direction are
+	// ignored, as they are for the version 40 interpreter of C Freetype.
+	// This suits glyphs rendered with subpixel precision in the x direction,
+	// which many modern fonts' hints assume.
+	SubpixelHinting
+	// VerticalHinting means to use the font's hinting instructions, but only
+	// in the y direction, similar to C Freetype's light hinting. Glyphs'
+	// horizontal co-ordinates and advance widths are not snapped to the
+	// pixel grid, so baselines and x-heights are crisp but glyph shapes and
+	// spacing are undistorted.
+	VerticalHinting
+	// AutoHinting means to ignore the font's hinting instructions, and to
+	// instead detect the glyphs' horizontal stems and edges and snap them to
+	// the pixel grid and to the font's blue zones, such as its baseline and
+	// x-height, similar to C Freetype's light autohinting. Like
+	// VerticalHinting, it only hints in the y direction. It suits fonts that
+	// have no hinting instructions, which would otherwise render blurry at
+	// small sizes.
+	AutoHinting
 )
 
 // A Point is a co-ordinate pair plus whether it is ``on'' a contour or an
@@ -22,12 +46,27 @@ const (
 type Point struct {
 	X, Y int32
 	// The Flags' LSB means whether or not this Point is ``on'' the contour.
-	// Other bits are reserved for internal use.
+	// An ``off'' Point with the FlagCubic bit is a cubic, rather than
+	// quadratic, Bézier control point. Other bits are reserved for internal
+	// use.
 	Flags uint32
 }
 
+// FlagCubic is set in the Flags of a Point that is a cubic Bézier control
+// point, as in glyphs with CFF outlines. Such Points come in pairs, between
+// ``on'' Points, and a contour's first Point is ``on''.
+const FlagCubic = 1 << 8
+
 // A GlyphBuf holds a glyph's contours. A GlyphBuf can be re-used to load a
-// series of glyphs from a Font.
+// series of glyphs from a Font. Load re-uses the GlyphBuf's slices, only
+// growing them when a glyph does not fit, so that once a GlyphBuf has loaded
+// a font's largest glyphs, or been sized by Reserve, loading glyphs does not
+// allocate, other than when hinting at a size for the first time.
+//
+// A GlyphBuf holds all of the mutable state of loading and hinting a glyph,
+// so it must not be used by multiple goroutines at once, but any number of
+// GlyphBufs may load glyphs from the same Font concurrently. GetGlyphBuf and
+// PutGlyphBuf manage a pool of GlyphBufs for such use.
 type GlyphBuf struct {
 	// AdvanceWidth is the glyph's advance width.
 	AdvanceWidth int32
@@ -47,7 +86,14 @@ type GlyphBuf struct {
 	font    *Font
 	scale   int32
 	hinting Hinting
-	hinter  hinter
+	// widthScale is the 16.16 fixed point horizontal scaling factor, or zero
+	// for no horizontal scaling. xScale is the horizontal scale of the glyph
+	// being loaded.
+	widthScale int32
+	xScale     int32
+	hinter     hinter
+	// auto is the autohinter, for AutoHinting.
+	auto autohinter
 	// phantomPoints are the co-ordinates of the synthetic phantom points
 	// used for hinting and bounding box calculations.
 	phantomPoints [4]Point
@@ -58,8 +104,25 @@ type GlyphBuf struct {
 	// metricsSet is whether the glyph's metrics have been set yet. For a
 	// compound glyph, a sub-glyph may override the outer glyph's metrics.
 	metricsSet bool
+	// scanControl and scanType are the dropout control state after hinting.
+	scanControl bool
+	scanType    int32
 	// tmp is a scratch buffer.
 	tmp []Point
+	// cff decodes the charstrings of fonts with CFF outlines.
+	cff cffDecoder
+	// coords are the normalized co-ordinates of the variable font instance
+	// to load, or empty for the default instance. deltas, sharedPoints,
+	// privatePoints and tupleDeltas are scratch buffers for applying the
+	// font's gvar table.
+	coords        []int16
+	deltas        []Point
+	sharedPoints  []int
+	privatePoints []int
+	tupleDeltas   []int16
+	// hinterOptionsSet is whether SetHinterOptions was last called with
+	// non-nil options.
+	hinterOptionsSet bool
 }
 
 // Flags for decoding a glyph's contours. These flags are documented at
@@ -84,6 +147,93 @@ const (
 	flagThisYIsSame = flagPositiveYShortVector
 )
 
+// ScanControl returns whether the hinting programs for the most recently
+// loaded glyph turned on dropout control, via the SCANCTRL instruction. A
+// rasterizer that supports dropout control should honor it when rendering
+// the glyph as a bilevel image. It is always false for unhinted glyphs.
+func (g *GlyphBuf) ScanControl() bool {
+	return g.scanControl
+}
+
+// ScanType returns the dropout control mode, as set by the SCANTYPE
+// instruction, for the most recently loaded glyph. The modes are described
+// at https://www.microsoft.com/typography/otspec/ttinst.htm
+func (g *GlyphBuf) ScanType() int32 {
+	return g.scanType
+}
+
+// SetPointSize sets the point size, as a 26.6 fixed point number (i.e. 64
+// times the number of points, as in ``a 12 point font''), reported to a
+// font's hinting programs by the MPS instruction. The point size is distinct
+// from the scale passed to Load, which is in pixels and so also depends on
+// the output resolution. A zero point size means that the point size is
+// unknown, in which case MPS reports the pixels per em.
+func (g *GlyphBuf) SetPointSize(pointSize int32) {
+	g.hinter.pointSize = f26dot6(pointSize)
+}
+
+// SetWidthScale sets the horizontal scaling factor, as a 16.16 fixed point
+// number, for glyphs loaded by Load. A factor less than 1<<16 synthesizes a
+// condensed style and a factor greater than 1<<16 an expanded one. Unlike
+// stretching a rendered glyph, the factor is applied before hinting, so that
+// stems and advance widths are still fitted to the pixel grid. A zero factor
+// means no horizontal scaling.
+func (g *GlyphBuf) SetWidthScale(widthScale int32) {
+	if widthScale == 1<<16 {
+		widthScale = 0
+	}
+	g.widthScale = widthScale
+}
+
+// horizontalScale returns the horizontal scale for the given scale, and
+// passes it on to the hinter.
+func (g *GlyphBuf) horizontalScale(scale int32) int32 {
+	if g.widthScale == 0 {
+		g.hinter.xScale = 0
+		return scale
+	}
+	x := int32(int64(scale) * int64(g.widthScale) >> 16)
+	g.hinter.xScale = x
+	return x
+}
+
+// SetHinterOptions sets the options for the bytecode hinter used by Load. A
+// nil o means to use the defaults.
+func (g *GlyphBuf) SetHinterOptions(o *HinterOptions) {
+	if o == nil {
+		g.hinter.opts = HinterOptions{}
+	} else {
+		g.hinter.opts = *o
+	}
+	g.hinterOptionsSet = o != nil
+	// The font's fpgm and prep bytecode may depend on the options, such as
+	// the engine version, so they need to be re-run.
+	g.hinter.font = nil
+}
+
+// Prepare readies g to hint glyphs from f at the given scale. It runs the
+// font's fpgm bytecode, if f differs from the Font last used with g, and
+// the font's prep bytecode, if it has not already been run at that scale
+// and the current point size. Load does this implicitly, but calling Prepare
+// first lets any errors in those programs be reported before any glyph is
+// loaded. The results of running the prep bytecode are cached for a number of
+// scales, so that switching between a few sizes doesn't re-run it each time.
+func (g *GlyphBuf) Prepare(f *Font, scale int32) error {
+	g.horizontalScale(scale)
+	return g.hinter.init(f, scale)
+}
+
+// setHintingMode configures g's hinter for the given hinting policy.
+func (g *GlyphBuf) setHintingMode(h Hinting) {
+	// The font's fpgm and prep bytecode may depend on the hinting mode, via
+	// the GETINFO instruction, so they need to be re-run if it changes.
+	if subpixel := h == SubpixelHinting; g.hinter.subpixel != subpixel {
+		g.hinter.subpixel = subpixel
+		g.hinter.font = nil
+	}
+	g.hinter.vertical = h == VerticalHinting
+}
+
 // Load loads a glyph's contours from a Font, overwriting any previously
 // loaded contours for this GlyphBuf. scale is the number of 26.6 fixed point
 // units in 1 em, i is the glyph index, and h is the hinting policy.
@@ -95,18 +245,42 @@ func (g *GlyphBuf) Load(f *Font, scale int32, i Index, h Hinting) error {
 	g.font = f
 	g.hinting = h
 	g.scale = scale
+	g.xScale = g.horizontalScale(scale)
 	g.pp1x = 0
 	g.phantomPoints = [4]Point{}
 	g.metricsSet = false
 
+	g.scanControl = false
+	g.scanType = 0
+
+	// The autohinter works on the unhinted, scaled glyph.
+	auto := h == AutoHinting
+	if auto {
+		h = NoHinting
+		g.hinting = h
+	}
 	if h != NoHinting {
+		g.setHintingMode(h)
 		if err := g.hinter.init(f, scale); err != nil {
 			return err
 		}
+		// As per C Freetype, the prep program can turn hinting off, such as
+		// at sizes for which the font's hinting is known to be poor.
+		if g.hinter.defaultGS.instructControl&instructControlInhibitGridFit != 0 {
+			h = NoHinting
+			g.hinting = h
+		}
+	}
+	if h != NoHinting {
+		g.hinter.gs = g.hinter.defaultGS
 	}
 	if err := g.load(0, i, true); err != nil {
 		return err
 	}
+	if h != NoHinting {
+		g.scanControl = g.hinter.gs.scanControl
+		g.scanType = g.hinter.gs.scanType
+	}
 	// TODO: this selection of either g.pp1x or g.phantomPoints[0].X isn't ideal,
 	// and should be cleaned up once we have all the testScaling tests passing,
 	// plus additional tests for Freetype-Go's bounding boxes matching C Freetype's.
@@ -121,21 +295,23 @@ func (g *GlyphBuf) Load(f *Font, scale int32, i Index, h Hinting) error {
 	}
 
 	advanceWidth := g.phantomPoints[1].X - g.phantomPoints[0].X
-	if h != NoHinting {
-		if len(f.hdmx) >= 8 {
-			if n := u32(f.hdmx, 4); n > 3+uint32(i) {
-				for hdmx := f.hdmx[8:]; uint32(len(hdmx)) >= n; hdmx = hdmx[n:] {
-					if int32(hdmx[0]) == scale>>6 {
-						advanceWidth = int32(hdmx[2+i]) << 6
-						break
-					}
-				}
+	if h != NoHinting && h != VerticalHinting {
+		// The hdmx table's advance widths do not account for horizontal
+		// scaling, or for variations, as they are of the default instance.
+		if g.xScale == scale && len(g.coords) == 0 {
+			if a, ok := f.hdmxAdvance(scale, i); ok {
+				advanceWidth = a << 6
 			}
 		}
 		advanceWidth = (advanceWidth + 32) &^ 63
 	}
 	g.AdvanceWidth = advanceWidth
 
+	if auto {
+		g.auto.init(f, scale, g.coords)
+		g.auto.hint(g.Point, g.End)
+	}
+
 	// Set g.B to the 'control box', which is the bounding box of the Bézier
 	// curves' control points. This is easier to calculate, no smaller than
 	// and often equal to the tightest possible bounding box of the curves
@@ -163,11 +339,13 @@ func (g *GlyphBuf) Load(f *Font, scale int32, i Index, h Hinting) error {
 			}
 		}
 		// Snap the box to the grid, if hinting is on.
-		if h != NoHinting {
-			g.B.XMin &^= 63
+		if h != NoHinting || auto {
+			if h != VerticalHinting && !auto {
+				g.B.XMin &^= 63
+				g.B.XMax += 63
+				g.B.XMax &^= 63
+			}
 			g.B.YMin &^= 63
-			g.B.XMax += 63
-			g.B.XMax &^= 63
 			g.B.YMax += 63
 			g.B.YMax &^= 63
 		}
@@ -175,30 +353,62 @@ func (g *GlyphBuf) Load(f *Font, scale int32, i Index, h Hinting) error {
 	return nil
 }
 
+// LoadContext is like Load, but stops hinting with ctx's error, such as
+// context.DeadlineExceeded, once ctx is done. It lets servers bound the time
+// spent on fonts whose hinting programs are slow, in addition to the step
+// limits of the HinterOptions.
+func (g *GlyphBuf) LoadContext(ctx context.Context, f *Font, scale int32, i Index, h Hinting) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	g.hinter.ctx = ctx
+	err := g.Load(f, scale, i, h)
+	g.hinter.ctx = nil
+	if err != nil && ctx.Err() != nil {
+		// The font's fpgm or prep bytecode may have been interrupted, so
+		// they need to be re-run.
+		g.hinter.font = nil
+	}
+	return err
+}
+
 func (g *GlyphBuf) load(recursion int32, i Index, useMyMetrics bool) (err error) {
 	// The recursion limit here is arbitrary, but defends against malformed glyphs.
 	if recursion >= 32 {
-		return UnsupportedError("excessive compound glyph recursion")
+		return UnsupportedError{Feature: "excessive compound glyph recursion"}
 	}
-	// Find the relevant slice of g.font.glyf.
-	var g0, g1 uint32
-	if g.font.locaOffsetFormat == locaOffsetFormatShort {
-		g0 = 2 * uint32(u16(g.font.loca, 2*int(i)))
-		g1 = 2 * uint32(u16(g.font.loca, 2*int(i)+2))
+	glyf, ne, boundsXMin, boundsYMax := []byte(nil), 0, int32(0), int32(0)
+	cff := g.font.cffOutlines != nil && g.font.src == nil
+	if cff {
+		// Decode the charstring, and take its bounding box from its points.
+		if err := g.cff.decode(g.font.cffOutlines, i); err != nil {
+			return err
+		}
+		ne = len(g.cff.ends)
+		for j, p := range g.cff.points {
+			if j == 0 || boundsXMin > p.X {
+				boundsXMin = p.X
+			}
+			if j == 0 || boundsYMax < p.Y {
+				boundsYMax = p.Y
+			}
+		}
 	} else {
-		g0 = u32(g.font.loca, 4*int(i))
-		g1 = u32(g.font.loca, 4*int(i)+4)
-	}
+		// Find the glyph's data.
+		data, err := g.font.glyphData(i)
+		if err != nil {
+			return err
+		}
 
-	// Decode the contour count and nominal bounding box, from the first
-	// 10 bytes of the glyf data. boundsYMin and boundsXMax, at offsets 4
-	// and 6, are unused.
-	glyf, ne, boundsXMin, boundsYMax := []byte(nil), 0, int32(0), int32(0)
-	if g0+10 <= g1 {
-		glyf = g.font.glyf[g0:g1]
-		ne = int(int16(u16(glyf, 0)))
-		boundsXMin = int32(int16(u16(glyf, 2)))
-		boundsYMax = int32(int16(u16(glyf, 8)))
+		// Decode the contour count and nominal bounding box, from the first
+		// 10 bytes of the glyf data. boundsYMin and boundsXMax, at offsets 4
+		// and 6, are unused.
+		if len(data) >= 10 {
+			glyf = data
+			ne = int(int16(u16(glyf, 0)))
+			boundsXMin = int32(int16(u16(glyf, 2)))
+			boundsYMax = int32(int16(u16(glyf, 8)))
+		}
 	}
 
 	// Create the phantom points.
@@ -210,7 +420,13 @@ func (g *GlyphBuf) load(recursion int32, i Index, useMyMetrics bool) (err error)
 		{X: uhm.AdvanceWidth / 2, Y: boundsYMax + uvm.TopSideBearing},
 		{X: uhm.AdvanceWidth / 2, Y: boundsYMax + uvm.TopSideBearing - uvm.AdvanceHeight},
 	}
-	if len(glyf) == 0 {
+	vary := len(g.coords) != 0 && g.font.gvar != nil && !cff
+	if len(glyf) == 0 && (!cff || ne == 0) {
+		if vary {
+			if err := g.varySimple(i, len(g.Point), nil); err != nil {
+				return err
+			}
+		}
 		g.addPhantomsAndScale(len(g.Point), len(g.Point), true, true)
 		copy(g.phantomPoints[:], g.Point[len(g.Point)-4:])
 		g.Point = g.Point[:len(g.Point)-4]
@@ -222,15 +438,34 @@ func (g *GlyphBuf) load(recursion int32, i Index, useMyMetrics bool) (err error)
 		if ne != -1 {
 			// http://developer.apple.com/fonts/TTRefMan/RM06/Chap6glyf.html says that
 			// "the values -2, -3, and so forth, are reserved for future use."
-			return UnsupportedError("negative number of contours")
+			return UnsupportedError{Feature: "negative number of contours"}
 		}
-		pp1x = g.font.scale(g.scale * (boundsXMin - uhm.LeftSideBearing))
-		if err := g.loadCompound(recursion, uhm, i, glyf, useMyMetrics); err != nil {
+		var deltas []Point
+		if vary {
+			if deltas, err = g.varyCompound(i, glyf); err != nil {
+				return err
+			}
+		}
+		pp1x = g.font.scale(g.xScale * g.phantomPoints[0].X)
+		if err := g.loadCompound(recursion, uhm, i, glyf, deltas, useMyMetrics); err != nil {
 			return err
 		}
 	} else {
 		np0, ne0 := len(g.Point), len(g.End)
-		program := g.loadSimple(glyf, ne)
+		program := []byte(nil)
+		if cff {
+			g.Point = append(g.Point, g.cff.points...)
+			g.End = append(g.End, g.cff.ends...)
+		} else {
+			if program, err = g.loadSimple(glyf, ne); err != nil {
+				return g.font.glyfError(i, "bad simple glyph")
+			}
+			if vary {
+				if err := g.varySimple(i, np0, g.End[ne0:]); err != nil {
+					return err
+				}
+			}
+		}
 		g.addPhantomsAndScale(np0, np0, true, true)
 		pp1x = g.Point[len(g.Point)-4].X
 		if g.hinting != NoHinting {
@@ -270,33 +505,66 @@ func (g *GlyphBuf) load(recursion int32, i Index, useMyMetrics bool) (err error)
 	return nil
 }
 
+// IsCompound returns whether the glyph with the given index is a compound
+// glyph, made of transformed copies of other glyphs. The outline of a compound
+// glyph depends on the scale in ways other than linear scaling, as component
+// offsets may be rounded to the pixel grid.
+func (f *Font) IsCompound(i Index) bool {
+	glyf, err := f.glyphData(i)
+	if err != nil || len(glyf) < 10 {
+		return false
+	}
+	return int16(u16(glyf, 0)) < 0
+}
+
 // loadOffset is the initial offset for loadSimple and loadCompound. The first
 // 10 bytes are the number of contours and the bounding box.
 const loadOffset = 10
 
-func (g *GlyphBuf) loadSimple(glyf []byte, ne int) (program []byte) {
+// loadSimple appends the points and contour ends of the simple glyph with the
+// given glyf data and ne contours, and returns its hinting program. Every
+// offset into glyf is checked, as the data may be malformed.
+func (g *GlyphBuf) loadSimple(glyf []byte, ne int) (program []byte, err error) {
+	errBad := FormatError{Table: "glyf", Reason: "bad simple glyph"}
 	offset := loadOffset
+	if len(glyf) < offset+2*ne+2 {
+		return nil, errBad
+	}
+	np := 0
 	for i := 0; i < ne; i++ {
-		g.End = append(g.End, 1+int(u16(glyf, offset)))
+		end := 1 + int(u16(glyf, offset))
+		if end < np {
+			return nil, errBad
+		}
+		g.End, np = append(g.End, end), end
 		offset += 2
 	}
 
 	// Note the TrueType hinting instructions.
 	instrLen := int(u16(glyf, offset))
 	offset += 2
+	if len(glyf) < offset+instrLen {
+		return nil, errBad
+	}
 	program = glyf[offset : offset+instrLen]
 	offset += instrLen
 
 	np0 := len(g.Point)
-	np1 := np0 + int(g.End[len(g.End)-1])
+	np1 := np0 + np
 
-	// Decode the flags.
+	// Decode the flags. A repeat count may not go past the last point.
 	for i := np0; i < np1; {
+		if len(glyf) <= offset {
+			return nil, errBad
+		}
 		c := uint32(glyf[offset])
 		offset++
 		g.Point = append(g.Point, Point{Flags: c})
 		i++
 		if c&flagRepeat != 0 {
+			if len(glyf) <= offset || np1-i < int(glyf[offset]) {
+				return nil, errBad
+			}
 			count := glyf[offset]
 			offset++
 			for ; count > 0; count-- {
@@ -306,6 +574,26 @@ func (g *GlyphBuf) loadSimple(glyf []byte, ne int) (program []byte) {
 		}
 	}
 
+	// Check the length of the co-ordinates, before decoding them.
+	n := 0
+	for i := np0; i < np1; i++ {
+		switch f := g.Point[i].Flags; {
+		case f&flagXShortVector != 0:
+			n++
+		case f&flagThisXIsSame == 0:
+			n += 2
+		}
+		switch f := g.Point[i].Flags; {
+		case f&flagYShortVector != 0:
+			n++
+		case f&flagThisYIsSame == 0:
+			n += 2
+		}
+	}
+	if len(glyf) < offset+n {
+		return nil, errBad
+	}
+
 	// Decode the co-ordinates.
 	var x int16
 	for i := np0; i < np1; i++ {
@@ -342,11 +630,11 @@ func (g *GlyphBuf) loadSimple(glyf []byte, ne int) (program []byte) {
 		g.Point[i].Y = int32(y)
 	}
 
-	return program
+	return program, nil
 }
 
 func (g *GlyphBuf) loadCompound(recursion int32, uhm HMetric, i Index,
-	glyf []byte, useMyMetrics bool) error {
+	glyf []byte, deltas []Point, useMyMetrics bool) error {
 
 	// Flags for decoding a compound glyph. These flags are documented at
 	// http://developer.apple.com/fonts/TTRefMan/RM06/Chap6glyf.html.
@@ -363,24 +651,33 @@ func (g *GlyphBuf) loadCompound(recursion int32, uhm HMetric, i Index,
 		flagUseMyMetrics
 		flagOverlapCompound
 	)
+	// Check the components' lengths, before decoding them.
+	if _, err := walkComponents(glyf, func(int) {}); err != nil {
+		return g.font.glyfError(i, "bad compound glyph")
+	}
 	np0, ne0 := len(g.Point), len(g.End)
 	offset := loadOffset
-	for {
+	for k := 0; ; k++ {
 		flags := u16(glyf, offset)
 		component := Index(u16(glyf, offset+2))
-		dx, dy, transform, hasTransform := int32(0), int32(0), [4]int32{}, false
+		// The two arguments are either a signed offset, or the unsigned
+		// indexes of a point in the compound glyph so far and of a point in
+		// the component, which are to be matched.
+		xy := flags&flagArgsAreXYValues != 0
+		arg1, arg2, transform, hasTransform := int32(0), int32(0), [4]int32{}, false
 		if flags&flagArg1And2AreWords != 0 {
-			dx = int32(int16(u16(glyf, offset+4)))
-			dy = int32(int16(u16(glyf, offset+6)))
+			arg1, arg2 = int32(u16(glyf, offset+4)), int32(u16(glyf, offset+6))
+			if xy {
+				arg1, arg2 = int32(int16(arg1)), int32(int16(arg2))
+			}
 			offset += 8
 		} else {
-			dx = int32(int16(int8(glyf[offset+4])))
-			dy = int32(int16(int8(glyf[offset+5])))
+			arg1, arg2 = int32(glyf[offset+4]), int32(glyf[offset+5])
+			if xy {
+				arg1, arg2 = int32(int8(arg1)), int32(int8(arg2))
+			}
 			offset += 6
 		}
-		if flags&flagArgsAreXYValues == 0 {
-			return UnsupportedError("compound glyph transform vector")
-		}
 		if flags&(flagWeHaveAScale|flagWeHaveAnXAndYScale|flagWeHaveATwoByTwo) != 0 {
 			hasTransform = true
 			switch {
@@ -401,7 +698,7 @@ func (g *GlyphBuf) loadCompound(recursion int32, uhm HMetric, i Index,
 			}
 		}
 		savedPP := g.phantomPoints
-		np0 := len(g.Point)
+		np1 := len(g.Point)
 		componentUMM := useMyMetrics && (flags&flagUseMyMetrics != 0)
 		if err := g.load(recursion+1, component, componentUMM); err != nil {
 			return err
@@ -410,7 +707,7 @@ func (g *GlyphBuf) loadCompound(recursion int32, uhm HMetric, i Index,
 			g.phantomPoints = savedPP
 		}
 		if hasTransform {
-			for j := np0; j < len(g.Point); j++ {
+			for j := np1; j < len(g.Point); j++ {
 				p := &g.Point[j]
 				newX := int32((int64(p.X)*int64(transform[0])+1<<13)>>14) +
 					int32((int64(p.Y)*int64(transform[2])+1<<13)>>14)
@@ -419,13 +716,31 @@ func (g *GlyphBuf) loadCompound(recursion int32, uhm HMetric, i Index,
 				p.X, p.Y = newX, newY
 			}
 		}
-		dx = g.font.scale(g.scale * dx)
-		dy = g.font.scale(g.scale * dy)
-		if flags&flagRoundXYToGrid != 0 {
-			dx = (dx + 32) &^ 63
-			dy = (dy + 32) &^ 63
+		dx, dy := int32(0), int32(0)
+		if xy {
+			// The variation deltas, if any, move the component's offset.
+			if k < len(deltas) {
+				arg1 += deltas[k].X
+				arg2 += deltas[k].Y
+			}
+			dx = g.font.scale(g.xScale * arg1)
+			dy = g.font.scale(g.scale * arg2)
+			if flags&flagRoundXYToGrid != 0 {
+				dx = (dx + 32) &^ 63
+				dy = (dy + 32) &^ 63
+			}
+		} else {
+			// Move the component so that its point arg2 is on the compound
+			// glyph's point arg1. Both points are already scaled, hinted and
+			// transformed, so the offset is not rounded.
+			p1, p2 := np0+int(arg1), np1+int(arg2)
+			if p1 >= np1 || p2 >= len(g.Point) {
+				return g.font.glyfError(i, "compound glyph point index")
+			}
+			dx = g.Point[p1].X - g.Point[p2].X
+			dy = g.Point[p1].Y - g.Point[p2].Y
 		}
-		for j := np0; j < len(g.Point); j++ {
+		for j := np1; j < len(g.Point); j++ {
 			p := &g.Point[j]
 			p.X += dx
 			p.Y += dy
@@ -457,6 +772,9 @@ func (g *GlyphBuf) loadCompound(recursion int32, uhm HMetric, i Index,
 	}
 
 	// Hint the compound glyph.
+	if len(glyf) < offset+instrLen {
+		return g.font.glyfError(i, "bad compound glyph")
+	}
 	program := glyf[offset : offset+instrLen]
 	// Temporarily adjust the ends to be relative to this compound glyph.
 	if np0 != 0 {
@@ -490,7 +808,7 @@ func (g *GlyphBuf) addPhantomsAndScale(np0, np1 int, simple, adjust bool) {
 	}
 	for i := np1; i < len(g.Point); i++ {
 		p := &g.Point[i]
-		p.X = g.font.scale(g.scale * p.X)
+		p.X = g.font.scale(g.xScale * p.X)
 		p.Y = g.font.scale(g.scale * p.Y)
 	}
 	if g.hinting == NoHinting {
@@ -501,7 +819,7 @@ func (g *GlyphBuf) addPhantomsAndScale(np0, np1 int, simple, adjust bool) {
 	// TODO: delete this adjustment and the np0/np1 distinction, when
 	// we update the compatibility tests to C Freetype 2.5.3.
 	// See http://git.savannah.gnu.org/cgit/freetype/freetype2.git/commit/?id=05c786d990390a7ca18e62962641dac740bacb06
-	if adjust {
+	if adjust && g.hinting != VerticalHinting {
 		pp1x := g.Point[len(g.Point)-4].X
 		if dx := ((pp1x + 32) &^ 63) - pp1x; dx != 0 {
 			for i := np0; i < len(g.Point); i++ {
@@ -514,7 +832,9 @@ func (g *GlyphBuf) addPhantomsAndScale(np0, np1 int, simple, adjust bool) {
 	}
 	// Round the 2nd and 4th phantom point to the grid.
 	p := &g.Point[len(g.Point)-3]
-	p.X = (p.X + 32) &^ 63
+	if g.hinting != VerticalHinting {
+		p.X = (p.X + 32) &^ 63
+	}
 	p = &g.Point[len(g.Point)-1]
 	p.Y = (p.Y + 32) &^ 63
 }
@@ -528,3 +848,71 @@ func NewGlyphBuf() *GlyphBuf {
 		End:   make([]int, 0, 32),
 	}
 }
+
+// Reserve grows g's slices, if necessary, to hold any of f's glyphs, as per
+// the sizes declared in f's maxp table, so that Load does not need to grow
+// them. It is only a hint, as a font may declare sizes that are too small. The
+// currently loaded glyph is kept.
+func (g *GlyphBuf) Reserve(f *Font) {
+	points, contours := f.MaxGlyphSize()
+	// A glyph's points are followed by its four phantom points while it is
+	// loaded.
+	points += 4
+	if cap(g.Point) < points {
+		g.Point = append(make([]Point, 0, points), g.Point...)
+	}
+	if cap(g.Unhinted) < points {
+		g.Unhinted = append(make([]Point, 0, points), g.Unhinted...)
+	}
+	if cap(g.InFontUnits) < points {
+		g.InFontUnits = append(make([]Point, 0, points), g.InFontUnits...)
+	}
+	if cap(g.End) < contours {
+		g.End = append(make([]int, 0, contours), g.End...)
+	}
+}
+
+// BufferSize returns the approximate number of bytes used by g's buffers: its
+// slices and the hinter's stacks and twilight zone. The hinter's results of
+// running a font's prep program, for each size, are not included, as they are
+// charged to the font's Budget, if it has one.
+func (g *GlyphBuf) BufferSize() int {
+	n := 12*(cap(g.Point)+cap(g.Unhinted)+cap(g.InFontUnits)+cap(g.tmp)+cap(g.deltas)+cap(g.cff.points)) +
+		8*(cap(g.End)+cap(g.sharedPoints)+cap(g.privatePoints)+cap(g.cff.ends)) +
+		2*(cap(g.coords)+cap(g.tupleDeltas))
+	h := &g.hinter
+	n += 4*cap(h.stack) + 64*cap(h.callStack)
+	for _, p := range h.points[twilightZone] {
+		n += 12 * cap(p)
+	}
+	return n
+}
+
+// glyphBufPool holds the GlyphBufs of GetGlyphBuf and PutGlyphBuf.
+var glyphBufPool = sync.Pool{
+	New: func() interface{} { return NewGlyphBuf() },
+}
+
+// GetGlyphBuf returns a GlyphBuf from a pool that is shared by all
+// goroutines, with the default point size, width scale, hinter options and
+// variation. Goroutines that render from a shared Font can use it instead of
+// allocating a GlyphBuf per glyph, and the pooled GlyphBuf keeps the results
+// of running the font's fpgm and prep programs from its previous use, if that
+// was for the same Font. It should be returned by PutGlyphBuf when it is no
+// longer needed.
+func GetGlyphBuf() *GlyphBuf {
+	return glyphBufPool.Get().(*GlyphBuf)
+}
+
+// PutGlyphBuf resets the settings of g, obtained from GetGlyphBuf, and
+// returns it to the pool. Neither g nor the slices of its exported fields may
+// be used afterwards.
+func PutGlyphBuf(g *GlyphBuf) {
+	g.SetPointSize(0)
+	g.SetWidthScale(0)
+	g.SetVariation(nil)
+	if g.hinterOptionsSet {
+		g.SetHinterOptions(nil)
+	}
+	glyphBufPool.Put(g)
+}
@@ -0,0 +1,280 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// This file implements kerning from the GPOS table's pair adjustment lookups,
+// and mark positioning from its mark attachment lookups, which are documented
+// at https://www.microsoft.com/typography/otspec/gpos.htm
+
+const (
+	// gposPair, gposMarkToBase, gposMarkToLigature, gposMarkToMark and
+	// gposExtension are GPOS lookup types.
+	gposPair           = 2
+	gposMarkToBase     = 4
+	gposMarkToLigature = 5
+	gposMarkToMark     = 6
+	gposExtension      = 9
+)
+
+// parseGPOS finds the GPOS table's pair adjustment lookups for the "kern"
+// feature, and its mark attachment lookups for the "mark" and "mkmk"
+// features. An invalid GPOS table is ignored.
+func (f *Font) parseGPOS() {
+	f.gposKern = nil
+	lookups, ok := featureLookups(f.gpos, gposExtension, "kern")
+	if !ok {
+		f.warn("truetype: ignoring invalid GPOS table")
+	}
+	for _, l := range lookups {
+		if l.typ != gposPair {
+			f.warn("truetype: skipping unsupported GPOS lookup", "feature", "kern", "type", l.typ)
+			continue
+		}
+		f.gposKern = append(f.gposKern, l)
+	}
+	f.gposMark = nil
+	lookups, _ = featureLookups(f.gpos, gposExtension, "mark", "mkmk")
+	for _, l := range lookups {
+		if l.typ != gposMarkToBase && l.typ != gposMarkToLigature && l.typ != gposMarkToMark {
+			f.warn("truetype: skipping unsupported GPOS lookup", "feature", "mark", "type", l.typ)
+			continue
+		}
+		f.gposMark = append(f.gposMark, l)
+	}
+}
+
+// valueRecordSize returns the size in bytes of a GPOS value record with the
+// given value format, which has one 16-bit field for each of its low 8 bits.
+func valueRecordSize(format uint16) int {
+	n := 0
+	for f := format & 0xff; f != 0; f &= f - 1 {
+		n++
+	}
+	return 2 * n
+}
+
+// xAdvance returns the XAdvance field of the value record at b[x:], with the
+// given value format.
+func xAdvance(b []byte, x int, format uint16) int32 {
+	if format&0x0004 == 0 {
+		return 0
+	}
+	// The XAdvance follows the XPlacement and YPlacement fields, if present.
+	x += valueRecordSize(format & 0x0003)
+	return int32(int16(u16(b, x)))
+}
+
+// pairAdjustment returns the adjustment, in FUnits, to the advance width of
+// the glyph i0 followed by the glyph i1, from the pair adjustment subtable b.
+// ok is whether the subtable applies to the pair.
+//
+// Only the first glyph's XAdvance is used, as that is how fonts encode
+// horizontal kerning. Placement adjustments, the second glyph's value record
+// and device tables are ignored.
+func pairAdjustment(b []byte, i0, i1 Index) (adjustment int32, ok bool) {
+	if len(b) < 10 {
+		return 0, false
+	}
+	c := coverageIndex(offsetTable(b, 2), i0)
+	if c < 0 {
+		return 0, false
+	}
+	format1, format2 := u16(b, 4), u16(b, 6)
+	size1, size2 := valueRecordSize(format1), valueRecordSize(format2)
+	switch u16(b, 0) {
+	case 1:
+		// Format 1 has, for each covered first glyph, a set of second glyphs
+		// sorted by glyph index, and their value records.
+		if c >= int(u16(b, 8)) {
+			return 0, false
+		}
+		set := offsetTable(b, 10+2*c)
+		if len(set) < 2 {
+			return 0, false
+		}
+		n, size := int(u16(set, 0)), 2+size1+size2
+		if len(set) < 2+size*n {
+			return 0, false
+		}
+		for lo, hi := 0, n; lo < hi; {
+			i := (lo + hi) / 2
+			x := 2 + size*i
+			if g := Index(u16(set, x)); g < i1 {
+				lo = i + 1
+			} else if g > i1 {
+				hi = i
+			} else {
+				return xAdvance(set, x+2, format1), true
+			}
+		}
+	case 2:
+		// Format 2 has value records for each pair of a first glyph class and
+		// a second glyph class.
+		if len(b) < 16 {
+			return 0, false
+		}
+		c1 := glyphClass(offsetTable(b, 8), i0)
+		c2 := glyphClass(offsetTable(b, 10), i1)
+		n1, n2 := int(u16(b, 12)), int(u16(b, 14))
+		if c1 >= n1 || c2 >= n2 {
+			return 0, false
+		}
+		x := 16 + (c1*n2+c2)*(size1+size2)
+		if len(b) < x+size1 {
+			return 0, false
+		}
+		return xAdvance(b, x, format1), true
+	}
+	return 0, false
+}
+
+// gposKerning returns the kerning, in FUnits, for the given glyph pair from
+// the GPOS table. Each lookup's first subtable that applies to the pair
+// contributes to the total.
+func (f *Font) gposKerning(i0, i1 Index) int32 {
+	k := int32(0)
+	for _, l := range f.gposKern {
+		for _, s := range l.subtables {
+			if v, ok := pairAdjustment(s, i0, i1); ok {
+				k += v
+				break
+			}
+		}
+	}
+	return k
+}
+
+// A MarkPosition is the position of a mark glyph, such as an accent, that is
+// attached to a preceding glyph of a run.
+type MarkPosition struct {
+	// Base is the index in the run of the glyph that the mark is attached to,
+	// which is a base glyph, a ligature or another mark, or -1 if the mark is
+	// not attached.
+	Base int
+	// X and Y are the offset of the mark's origin from the origin of the
+	// glyph that it is attached to, in the same units as the scale passed to
+	// PositionMarks. Y increases upwards.
+	X, Y int32
+}
+
+// PositionMarks returns the positions of the mark glyphs of a run, from the
+// mark-to-base, mark-to-ligature and mark-to-mark attachment lookups of the
+// GPOS table's "mark" and "mkmk" features, so that combining marks are drawn
+// on their bases' anchors. The result has one element per glyph, whose Base
+// is -1 for glyphs that are not attached marks. scale is the number of units
+// in 1 em.
+//
+// A glyph is a mark if the GDEF table classes it as one or, if there is no
+// GDEF table, if a mark attachment lookup covers it as a mark. Marks on a
+// ligature are attached to its last component, as the components of a
+// ligature are not tracked.
+func (f *Font) PositionMarks(scale int32, glyphs []Index) []MarkPosition {
+	positions := make([]MarkPosition, len(glyphs))
+	for i := range positions {
+		positions[i].Base = -1
+	}
+	if len(f.gposMark) == 0 {
+		return positions
+	}
+	for _, l := range f.gposMark {
+		for i := 1; i < len(glyphs); i++ {
+			// A mark attaches to the previous mark, for mark-to-mark lookups,
+			// or otherwise to the nearest preceding glyph that is not a mark.
+			j := i - 1
+			if l.typ != gposMarkToMark {
+				for j >= 0 && f.isMark(glyphs[j]) {
+					j--
+				}
+				if j < 0 {
+					continue
+				}
+			}
+			for _, s := range l.subtables {
+				if x, y, ok := markAttachment(s, l.typ, glyphs[j], glyphs[i]); ok {
+					positions[i] = MarkPosition{j, f.scale(scale * x), f.scale(scale * y)}
+					break
+				}
+			}
+		}
+	}
+	return positions
+}
+
+// isMark returns whether the glyph is a mark.
+func (f *Font) isMark(glyph Index) bool {
+	const markGlyph = 3
+	if classes := offsetTable(f.gdef, 4); classes != nil {
+		return glyphClass(classes, glyph) == markGlyph
+	}
+	for _, l := range f.gposMark {
+		for _, s := range l.subtables {
+			if coverageIndex(offsetTable(s, 2), glyph) >= 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// markAttachment returns the offset, in FUnits, of the mark's origin from the
+// base's origin, as per the mark attachment subtable b of the given lookup
+// type. ok is whether the subtable applies to the pair.
+func markAttachment(b []byte, typ uint16, base, mark Index) (x, y int32, ok bool) {
+	if len(b) < 12 || u16(b, 0) != 1 {
+		return 0, 0, false
+	}
+	// The subtables of the three lookup types have the same layout: the
+	// mark coverage, the base coverage, the number of mark classes, the mark
+	// array, and the base array. The base array holds an anchor for each
+	// base and mark class, except that the ligature array of a mark-to-
+	// ligature subtable holds them for each component of each ligature.
+	m := coverageIndex(offsetTable(b, 2), mark)
+	c := coverageIndex(offsetTable(b, 4), base)
+	if m < 0 || c < 0 {
+		return 0, 0, false
+	}
+	nClasses := int(u16(b, 6))
+	markArray, baseArray := offsetTable(b, 8), offsetTable(b, 10)
+	if len(markArray) < 2 || m >= int(u16(markArray, 0)) || len(markArray) < 6+4*m {
+		return 0, 0, false
+	}
+	class := int(u16(markArray, 2+4*m))
+	mx, my, ok := anchor(offsetTable(markArray, 4+4*m))
+	if !ok || class >= nClasses {
+		return 0, 0, false
+	}
+	if typ == gposMarkToLigature {
+		if len(baseArray) < 2 || c >= int(u16(baseArray, 0)) {
+			return 0, 0, false
+		}
+		baseArray = offsetTable(baseArray, 2+2*c)
+		if len(baseArray) < 2 {
+			return 0, 0, false
+		}
+		c = int(u16(baseArray, 0)) - 1
+	}
+	if len(baseArray) < 2 || c < 0 || c >= int(u16(baseArray, 0)) {
+		return 0, 0, false
+	}
+	i := 2 + 2*(c*nClasses+class)
+	if len(baseArray) < i+2 {
+		return 0, 0, false
+	}
+	bx, by, ok := anchor(offsetTable(baseArray, i))
+	if !ok {
+		return 0, 0, false
+	}
+	return bx - mx, by - my, true
+}
+
+// anchor returns the coordinates, in FUnits, of the anchor table b. The
+// contour points and device tables of formats 2 and 3 are ignored.
+func anchor(b []byte) (x, y int32, ok bool) {
+	if len(b) < 6 {
+		return 0, 0, false
+	}
+	return int32(int16(u16(b, 2))), int32(int16(u16(b, 4))), true
+}
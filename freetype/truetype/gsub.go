@@ -0,0 +1,300 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import "sort"
+
+// This file implements glyph substitution from the GSUB table's single and
+// ligature substitution lookups, which are documented at
+// https://www.microsoft.com/typography/otspec/gsub.htm
+
+const (
+	// gsubSingle, gsubLigature and gsubExtension are GSUB lookup types.
+	gsubSingle    = 1
+	gsubLigature  = 4
+	gsubExtension = 7
+)
+
+// defaultFeatures are the GSUB features that Substitute applies if it is not
+// given any. They are the ligature and glyph composition features that web
+// browsers apply by default.
+var defaultFeatures = []string{"ccmp", "rlig", "liga", "clig"}
+
+// gsubLayout is the GSUB table's lookups and features, as returned by
+// layoutFeatures, with unsupported lookups zeroed, and its lookups for the
+// default features.
+type gsubLayout struct {
+	lookups  []lookup
+	features map[string][]int
+	def      []lookup
+}
+
+// selectLookups returns the lookups for the given features, or for the
+// default features if none are given.
+func (l *gsubLayout) selectLookups(features []string) []lookup {
+	if len(features) == 0 {
+		return l.def
+	}
+	return selectLookups(l.lookups, l.features, features)
+}
+
+// size returns the approximate number of bytes used by l.
+func (l *gsubLayout) size() int64 {
+	n := 32 * (len(l.lookups) + len(l.def))
+	for _, x := range l.lookups {
+		n += 24 * len(x.subtables)
+	}
+	for _, x := range l.features {
+		n += 48 + 8*len(x)
+	}
+	return int64(n)
+}
+
+// parseGSUB parses the GSUB table's supported lookups, and finds those for
+// the default features. An invalid GSUB table is ignored.
+func (f *Font) parseGSUB() {
+	f.gsubCache = &lazyCache{}
+	l := f.layoutGSUB(true)
+	f.gsubCache.store(f.budget, l, l.size())
+}
+
+// gsubLayout returns the parsed GSUB table, parsing it again if the font's
+// Budget evicted it.
+func (f *Font) gsubLayout() *gsubLayout {
+	return f.gsubCache.get(f.budget, func() (interface{}, int64) {
+		l := f.layoutGSUB(false)
+		return l, l.size()
+	}).(*gsubLayout)
+}
+
+// layoutGSUB parses the GSUB table, telling the font's Logger of anomalies if
+// warn is true.
+func (f *Font) layoutGSUB(warn bool) *gsubLayout {
+	lookups, features, ok := layoutFeatures(f.gsub, gsubExtension)
+	if !ok {
+		if warn {
+			f.warn("truetype: ignoring invalid GSUB table")
+		}
+		return &gsubLayout{}
+	}
+	for i, l := range lookups {
+		if l.typ == 0 || l.typ == gsubSingle || l.typ == gsubLigature {
+			continue
+		}
+		lookups[i] = lookup{}
+		if !warn {
+			continue
+		}
+		var tags []string
+		for tag, indexes := range features {
+			for _, j := range indexes {
+				if j == i {
+					tags = append(tags, tag)
+					break
+				}
+			}
+		}
+		sort.Strings(tags)
+		f.warn("truetype: skipping unsupported GSUB lookup", "features", tags, "type", l.typ)
+	}
+	return &gsubLayout{lookups, features, selectLookups(lookups, features, defaultFeatures)}
+}
+
+// Substitute applies the GSUB table's single and ligature substitutions for
+// the given features, such as "smcp" for small capitals, to a run of glyphs,
+// such as replacing the glyphs for 'f' and 'i' with an "fi" ligature glyph.
+// If no features are given, it applies the "ccmp", "rlig", "liga" and "clig"
+// features, as web browsers do. Other lookup types, such as contextual
+// substitutions, and the lookup flags that skip marks are not supported.
+//
+// A font without a GSUB table, such as an Apple system font, may instead have
+// an AAT morx table, whose rearrangement, contextual, ligature and
+// noncontextual subtables are applied for the font's default features and
+// the AAT equivalents of the given features, such as common ligatures for
+// "liga". Its insertion subtables are not supported.
+//
+// clusters, if non-nil, has one element per glyph, such as the byte offset
+// in the text of each glyph's rune. A ligature's cluster is that of its first
+// component. Substitute modifies glyphs and clusters in place and returns
+// them, shortened by any ligatures.
+func (f *Font) Substitute(glyphs []Index, clusters []int, features ...string) ([]Index, []int) {
+	if f.gsub == nil && f.morxChains != nil {
+		return f.morxSubstitute(glyphs, clusters, features)
+	}
+	for _, l := range f.gsubLayout().selectLookups(features) {
+		glyphs, clusters = applySubstitution(l, glyphs, clusters)
+	}
+	return glyphs, clusters
+}
+
+// SubstitutionClosure returns the given glyphs and the glyphs that the GSUB
+// table's single and ligature substitutions for the given features, as made
+// by Substitute, can replace them with, such as the "fi" ligature glyph if the
+// glyphs include those for 'f' and 'i'. If no features are given, it is the
+// closure of the default features. The glyphs are returned in increasing
+// order, without duplicates. A font's AAT morx table is not used.
+func (f *Font) SubstitutionClosure(glyphs []Index, features ...string) []Index {
+	lookups := f.gsubLayout().selectLookups(features)
+	included := make(map[Index]bool, len(glyphs))
+	for _, g := range glyphs {
+		included[g] = true
+	}
+	// A substitute may itself be substituted, so repeat until there are no
+	// more substitutes.
+	for changed := true; changed; {
+		changed = false
+		add := func(g Index) {
+			if !included[g] {
+				included[g], changed = true, true
+			}
+		}
+		for _, l := range lookups {
+			for _, s := range l.subtables {
+				if l.typ == gsubSingle {
+					rangeCoverage(offsetTable(s, 2), func(g Index, _ int) {
+						if sub, ok := singleSubstitute(s, g); ok && included[g] {
+							add(sub)
+						}
+					})
+					continue
+				}
+				rangeLigatures(s, func(lig Index, components []Index) {
+					for _, c := range components {
+						if !included[c] {
+							return
+						}
+					}
+					add(lig)
+				})
+			}
+		}
+	}
+	closure := make([]Index, 0, len(included))
+	for g := range included {
+		closure = append(closure, g)
+	}
+	sort.Sort(indexSlice(closure))
+	return closure
+}
+
+// applySubstitution applies the lookup at each position of glyphs.
+func applySubstitution(l lookup, glyphs []Index, clusters []int) ([]Index, []int) {
+	for i := 0; i < len(glyphs); i++ {
+		for _, s := range l.subtables {
+			if l.typ == gsubSingle {
+				if g, ok := singleSubstitute(s, glyphs[i]); ok {
+					glyphs[i] = g
+					break
+				}
+				continue
+			}
+			if g, n, ok := ligatureSubstitute(s, glyphs[i:]); ok {
+				glyphs[i] = g
+				glyphs = append(glyphs[:i+1], glyphs[i+n:]...)
+				if clusters != nil {
+					clusters = append(clusters[:i+1], clusters[i+n:]...)
+				}
+				break
+			}
+		}
+	}
+	return glyphs, clusters
+}
+
+// singleSubstitute returns the substitute for the glyph from the single
+// substitution subtable b. ok is whether the subtable covers the glyph.
+func singleSubstitute(b []byte, glyph Index) (substitute Index, ok bool) {
+	if len(b) < 6 {
+		return 0, false
+	}
+	c := coverageIndex(offsetTable(b, 2), glyph)
+	if c < 0 {
+		return 0, false
+	}
+	switch u16(b, 0) {
+	case 1:
+		// Format 1 adds a delta, modulo 65536, to the glyph index.
+		return glyph + Index(u16(b, 4)), true
+	case 2:
+		// Format 2 lists the substitutes in coverage index order.
+		if c >= int(u16(b, 4)) || len(b) < 6+2*c+2 {
+			return 0, false
+		}
+		return Index(u16(b, 6+2*c)), true
+	}
+	return 0, false
+}
+
+// rangeLigatures calls fn with each ligature glyph of the ligature
+// substitution subtable b, and the glyphs that it replaces.
+func rangeLigatures(b []byte, fn func(ligature Index, components []Index)) {
+	if len(b) < 6 || u16(b, 0) != 1 {
+		return
+	}
+	var components []Index
+	rangeCoverage(offsetTable(b, 2), func(first Index, c int) {
+		if c >= int(u16(b, 4)) {
+			return
+		}
+		set := offsetTable(b, 6+2*c)
+		if len(set) < 2 {
+			return
+		}
+		for i, m := 0, int(u16(set, 0)); i < m; i++ {
+			lig := offsetTable(set, 2+2*i)
+			if len(lig) < 4 {
+				continue
+			}
+			n := int(u16(lig, 2))
+			if n == 0 || len(lig) < 2+2*n {
+				continue
+			}
+			components = append(components[:0], first)
+			for j := 1; j < n; j++ {
+				components = append(components, Index(u16(lig, 2+2*j)))
+			}
+			fn(Index(u16(lig, 0)), components)
+		}
+	})
+}
+
+// ligatureSubstitute returns the ligature that replaces the first n glyphs
+// from the ligature substitution subtable b. ok is whether the subtable has
+// a ligature for a prefix of glyphs.
+func ligatureSubstitute(b []byte, glyphs []Index) (ligature Index, n int, ok bool) {
+	if len(b) < 6 || u16(b, 0) != 1 {
+		return 0, 0, false
+	}
+	c := coverageIndex(offsetTable(b, 2), glyphs[0])
+	if c < 0 || c >= int(u16(b, 4)) {
+		return 0, 0, false
+	}
+	// The ligature set for the first glyph lists its ligatures in order of
+	// preference, such as "ffi" before "ff".
+	set := offsetTable(b, 6+2*c)
+	if len(set) < 2 {
+		return 0, 0, false
+	}
+loop:
+	for i, m := 0, int(u16(set, 0)); i < m; i++ {
+		lig := offsetTable(set, 2+2*i)
+		if len(lig) < 4 {
+			continue
+		}
+		n := int(u16(lig, 2))
+		if n == 0 || n > len(glyphs) || len(lig) < 2+2*n {
+			continue
+		}
+		// The components after the first are listed explicitly.
+		for j := 1; j < n; j++ {
+			if glyphs[j] != Index(u16(lig, 2+2*j)) {
+				continue loop
+			}
+		}
+		return Index(u16(lig, 0)), n, true
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,478 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// The gvar table holds the variations of a variable font's glyph outlines. It
+// is documented at https://www.microsoft.com/typography/otspec/gvar.htm
+
+// parseGvar checks the gvar table's header. An invalid table is ignored, and
+// glyphs are loaded without variations.
+func (f *Font) parseGvar() {
+	b := f.gvar
+	if b == nil {
+		return
+	}
+	if len(b) < 20 || u16(b, 0) != 1 || int(u16(b, 4)) != len(f.axes) || int(u16(b, 12)) != f.nGlyph {
+		f.warn("truetype: ignoring invalid gvar table")
+		f.gvar = nil
+		return
+	}
+	nAxis, nShared := int(u16(b, 4)), int(u16(b, 6))
+	offsetSize := 2
+	if u16(b, 14)&1 != 0 {
+		offsetSize = 4
+	}
+	if uint64(len(b)) < uint64(u32(b, 8))+uint64(2*nAxis*nShared) ||
+		len(b) < 20+offsetSize*(f.nGlyph+1) ||
+		uint64(len(b)) < uint64(u32(b, 16)) {
+		f.warn("truetype: ignoring invalid gvar table")
+		f.gvar = nil
+	}
+}
+
+// glyphVariationData returns the gvar table's data for the glyph with the
+// given index, which is empty if the glyph has no variations, and its offset
+// in the gvar table.
+func (f *Font) glyphVariationData(i Index) ([]byte, int, error) {
+	b := f.gvar
+	if int(i) >= f.nGlyph {
+		return nil, 0, nil
+	}
+	var g0, g1 uint32
+	x := 20 + 2*int(i)
+	if u16(b, 14)&1 != 0 {
+		x = 20 + 4*int(i)
+		g0, g1 = u32(b, x), u32(b, x+4)
+	} else {
+		g0, g1 = 2*uint32(u16(b, x)), 2*uint32(u16(b, x+2))
+	}
+	offset := u32(b, 16)
+	if g1 < g0 || uint64(len(b)) < uint64(offset)+uint64(g1) {
+		return nil, 0, FormatError{Table: "gvar", Offset: x, Reason: "gvar glyph variation data"}
+	}
+	return b[offset+g0 : offset+g1], int(offset + g0), nil
+}
+
+// SetVariation sets the normalized co-ordinates, as returned by a Font's
+// NormalizeVariation method, of the variable font instance whose glyphs are
+// loaded by Load. The glyphs' outlines and advance widths are varied by the
+// font's gvar table. A nil coords, or one that is all zeroes, means the
+// font's default instance.
+func (g *GlyphBuf) SetVariation(coords []int16) {
+	g.coords = g.coords[:0]
+	for _, c := range coords {
+		if c != 0 {
+			g.coords = append(g.coords, coords...)
+			break
+		}
+	}
+}
+
+// variationDeltas returns the deltas, in 16.16 fixed point font units, of
+// glyph i's n points, including its four phantom points, at g's variation
+// co-ordinates. For a simple glyph, orig are its unvaried points and ends
+// are its contour ends, which are used to infer the deltas of points that a
+// variation does not move explicitly. For a compound glyph, whose points are
+// its components' offsets, they are nil. The deltas are appended to dst[:0].
+func (g *GlyphBuf) variationDeltas(dst []Point, i Index, n int, orig []Point, ends []int) ([]Point, error) {
+	dst = dst[:0]
+	for j := 0; j < n; j++ {
+		dst = append(dst, Point{})
+	}
+	data, base, err := g.font.glyphVariationData(i)
+	if err != nil || len(data) == 0 {
+		return dst, err
+	}
+	if len(data) < 4 {
+		return nil, FormatError{Table: "gvar", Offset: base, Reason: "gvar glyph variation data"}
+	}
+	const (
+		sharedPointNumbers  = 0x8000
+		embeddedPeakTuple   = 0x8000
+		intermediateRegion  = 0x4000
+		privatePointNumbers = 0x2000
+		tupleIndexMask      = 0x0fff
+	)
+	nAxis := len(g.font.axes)
+	nTuple, offset := int(u16(data, 0)), 4
+	serialized := int(u16(data, 2))
+	if serialized > len(data) {
+		return nil, FormatError{Table: "gvar", Offset: base, Reason: "gvar glyph variation data"}
+	}
+	var sharedPoints []int
+	if nTuple&sharedPointNumbers != 0 {
+		sharedPoints, serialized, err = unpackPointNumbers(g.sharedPoints[:0], data, serialized)
+		if err != nil {
+			return nil, addOffset(err, base)
+		}
+		g.sharedPoints = sharedPoints
+	}
+	for t := 0; t < nTuple&0x0fff; t++ {
+		if len(data) < offset+4 {
+			return nil, FormatError{Table: "gvar", Offset: base + offset, Reason: "gvar tuple variation header"}
+		}
+		header := base + offset
+		size, index := int(u16(data, offset)), int(u16(data, offset+2))
+		offset += 4
+		need := offset
+		if index&embeddedPeakTuple != 0 {
+			need += 2 * nAxis
+		}
+		if index&intermediateRegion != 0 {
+			need += 4 * nAxis
+		}
+		if len(data) < need || len(data) < serialized+size {
+			return nil, FormatError{Table: "gvar", Offset: header, Reason: "gvar tuple variation header"}
+		}
+		peak := g.font.gvar[u32(g.font.gvar, 8):]
+		if index&embeddedPeakTuple != 0 {
+			peak, offset = data[offset:], offset+2*nAxis
+		} else if index&tupleIndexMask >= int(u16(g.font.gvar, 6)) {
+			return nil, FormatError{Table: "gvar", Offset: header, Reason: "gvar shared tuple index"}
+		} else {
+			peak = peak[2*nAxis*(index&tupleIndexMask):]
+		}
+		start, end := []byte(nil), []byte(nil)
+		if index&intermediateRegion != 0 {
+			start, end, offset = data[offset:], data[offset+2*nAxis:], offset+4*nAxis
+		}
+		tuple, tupleOffset := data[serialized:serialized+size], base+serialized
+		serialized += size
+		scalar := g.tupleScalar(peak, start, end)
+		if scalar == 0 {
+			continue
+		}
+
+		// Decode the tuple's point numbers and deltas.
+		points, p := sharedPoints, 0
+		if index&privatePointNumbers != 0 {
+			points, p, err = unpackPointNumbers(g.privatePoints[:0], tuple, 0)
+			if err != nil {
+				return nil, addOffset(err, tupleOffset)
+			}
+			g.privatePoints = points
+		}
+		nPoint := len(points)
+		if points == nil {
+			nPoint = n
+		}
+		g.tupleDeltas, p, err = unpackDeltas(g.tupleDeltas[:0], tuple, p, 2*nPoint)
+		if err != nil {
+			return nil, addOffset(err, tupleOffset)
+		}
+		dx, dy := g.tupleDeltas[:nPoint], g.tupleDeltas[nPoint:]
+
+		if points == nil {
+			for j := range dst {
+				dst[j].X += int32(dx[j]) * scalar
+				dst[j].Y += int32(dy[j]) * scalar
+			}
+			continue
+		}
+		if orig == nil {
+			for j, k := range points {
+				if k < n {
+					dst[k].X += int32(dx[j]) * scalar
+					dst[k].Y += int32(dy[j]) * scalar
+				}
+			}
+			continue
+		}
+		// Infer the deltas of the untouched points, in g.tmp. The deltas are
+		// scaled first, so that the inferred deltas are more precise.
+		g.tmp = g.tmp[:0]
+		for j := 0; j < n; j++ {
+			g.tmp = append(g.tmp, Point{})
+		}
+		for j, k := range points {
+			if k < n {
+				g.tmp[k] = Point{X: int32(dx[j]) * scalar, Y: int32(dy[j]) * scalar, Flags: flagTouchedX}
+			}
+		}
+		inferDeltas(g.tmp, orig, ends)
+		for j, d := range g.tmp {
+			dst[j].X += d.X
+			dst[j].Y += d.Y
+		}
+	}
+	return dst, nil
+}
+
+// tupleScalar returns the 16.16 fixed point scalar, at g's variation
+// co-ordinates, for the deltas of a tuple variation with the given peak and
+// optional intermediate region, which are 2.14 fixed point tuples.
+func (g *GlyphBuf) tupleScalar(peak, start, end []byte) int32 {
+	scalar := int64(1 << 16)
+	for a := range g.font.axes {
+		p, c := int64(int16(u16(peak, 2*a))), int64(0)
+		if a < len(g.coords) {
+			c = int64(g.coords[a])
+		}
+		switch {
+		case p == 0 || c == p:
+			continue
+		case c == 0:
+			return 0
+		case start != nil:
+			s, e := int64(int16(u16(start, 2*a))), int64(int16(u16(end, 2*a)))
+			if s > p || p > e || (s < 0 && e > 0) {
+				continue
+			}
+			if c < s || c > e {
+				return 0
+			}
+			if c < p {
+				scalar = scalar * (c - s) / (p - s)
+			} else {
+				scalar = scalar * (e - c) / (e - p)
+			}
+		default:
+			// c must be between zero and p.
+			if c < 0 && p > 0 || c > 0 && p < 0 || c > p && p > 0 || c < p && p < 0 {
+				return 0
+			}
+			scalar = scalar * c / p
+		}
+	}
+	return int32(scalar)
+}
+
+// addOffset returns err with delta added to its Offset, if it is a
+// FormatError.
+func addOffset(err error, delta int) error {
+	if e, ok := err.(FormatError); ok {
+		e.Offset += delta
+		return e
+	}
+	return err
+}
+
+// unpackPointNumbers decodes the packed point numbers at b[offset:], and
+// appends them to dst. The returned slice is nil if the point numbers are
+// all of a glyph's points. A FormatError's Offset is relative to b.
+func unpackPointNumbers(dst []int, b []byte, offset int) ([]int, int, error) {
+	if len(b) < offset+1 {
+		return nil, 0, FormatError{Table: "gvar", Offset: offset, Reason: "gvar point numbers"}
+	}
+	count := int(b[offset])
+	offset++
+	if count&0x80 != 0 {
+		if len(b) < offset+1 {
+			return nil, 0, FormatError{Table: "gvar", Offset: offset, Reason: "gvar point numbers"}
+		}
+		count = count&0x7f<<8 | int(b[offset])
+		offset++
+	}
+	if count == 0 {
+		return nil, offset, nil
+	}
+	point := 0
+	for len(dst) < count {
+		if len(b) < offset+1 {
+			return nil, 0, FormatError{Table: "gvar", Offset: offset, Reason: "gvar point numbers"}
+		}
+		run, words := int(b[offset]&0x7f)+1, b[offset]&0x80 != 0
+		offset++
+		for ; run > 0 && len(dst) < count; run-- {
+			if words {
+				if len(b) < offset+2 {
+					return nil, 0, FormatError{Table: "gvar", Offset: offset, Reason: "gvar point numbers"}
+				}
+				point += int(u16(b, offset))
+				offset += 2
+			} else {
+				if len(b) < offset+1 {
+					return nil, 0, FormatError{Table: "gvar", Offset: offset, Reason: "gvar point numbers"}
+				}
+				point += int(b[offset])
+				offset++
+			}
+			dst = append(dst, point)
+		}
+	}
+	return dst, offset, nil
+}
+
+// unpackDeltas decodes count packed deltas at b[offset:], and appends them to
+// dst. A FormatError's Offset is relative to b.
+func unpackDeltas(dst []int16, b []byte, offset, count int) ([]int16, int, error) {
+	const (
+		deltasAreZero  = 0x80
+		deltasAreWords = 0x40
+	)
+	for len(dst) < count {
+		if len(b) < offset+1 {
+			return nil, 0, FormatError{Table: "gvar", Offset: offset, Reason: "gvar deltas"}
+		}
+		control := b[offset]
+		run := int(control&0x3f) + 1
+		offset++
+		for ; run > 0 && len(dst) < count; run-- {
+			switch {
+			case control&deltasAreZero != 0:
+				dst = append(dst, 0)
+			case control&deltasAreWords != 0:
+				if len(b) < offset+2 {
+					return nil, 0, FormatError{Table: "gvar", Offset: offset, Reason: "gvar deltas"}
+				}
+				dst = append(dst, int16(u16(b, offset)))
+				offset += 2
+			default:
+				if len(b) < offset+1 {
+					return nil, 0, FormatError{Table: "gvar", Offset: offset, Reason: "gvar deltas"}
+				}
+				dst = append(dst, int16(int8(b[offset])))
+				offset++
+			}
+		}
+	}
+	return dst, offset, nil
+}
+
+// inferDeltas sets the deltas of the untouched points of each contour, which
+// are those without the flagTouchedX bit, by interpolating between the
+// deltas of the touched points before and after them, based on the points'
+// unvaried positions orig. The phantom points, after the last contour, are
+// not inferred.
+func inferDeltas(deltas, orig []Point, ends []int) {
+	start := 0
+	for _, end := range ends {
+		if end > len(deltas) || end > len(orig) {
+			return
+		}
+		inferContourDeltas(deltas[start:end], orig[start:end])
+		start = end
+	}
+}
+
+func inferContourDeltas(deltas, orig []Point) {
+	first := -1
+	for j := range deltas {
+		if deltas[j].Flags&flagTouchedX != 0 {
+			first = j
+			break
+		}
+	}
+	if first < 0 {
+		return
+	}
+	n := len(deltas)
+	for prev := first; ; {
+		// Find the next touched point after prev, cyclically.
+		next := (prev + 1) % n
+		for deltas[next].Flags&flagTouchedX == 0 {
+			next = (next + 1) % n
+		}
+		for j := (prev + 1) % n; j != next; j = (j + 1) % n {
+			deltas[j].X = inferDelta(orig[j].X, orig[prev].X, orig[next].X, deltas[prev].X, deltas[next].X)
+			deltas[j].Y = inferDelta(orig[j].Y, orig[prev].Y, orig[next].Y, deltas[prev].Y, deltas[next].Y)
+		}
+		if next <= prev {
+			break
+		}
+		prev = next
+	}
+}
+
+// inferDelta returns the delta of a point at c, between touched points at c1
+// and c2 with deltas d1 and d2.
+func inferDelta(c, c1, c2, d1, d2 int32) int32 {
+	if c1 > c2 {
+		c1, c2, d1, d2 = c2, c1, d2, d1
+	}
+	switch {
+	case c1 == c2:
+		if d1 == d2 {
+			return d1
+		}
+		return 0
+	case c <= c1:
+		return d1
+	case c >= c2:
+		return d2
+	}
+	return d1 + int32(int64(c-c1)*int64(d2-d1)/int64(c2-c1))
+}
+
+// roundDelta rounds a 16.16 fixed point delta to the nearest font unit.
+func roundDelta(d int32) int32 {
+	return (d + 1<<15) >> 16
+}
+
+// varySimple applies the variations of simple glyph i to its points,
+// g.Point[np0:], and to g.phantomPoints. ends are the glyph's contour ends,
+// relative to np0.
+func (g *GlyphBuf) varySimple(i Index, np0 int, ends []int) (err error) {
+	n := len(g.Point) - np0 + 4
+	g.deltas, err = g.variationDeltas(g.deltas, i, n, g.Point[np0:], ends)
+	if err != nil {
+		return err
+	}
+	for j, d := range g.deltas[:n-4] {
+		p := &g.Point[np0+j]
+		p.X += roundDelta(d.X)
+		p.Y += roundDelta(d.Y)
+	}
+	for j, d := range g.deltas[n-4:] {
+		p := &g.phantomPoints[j]
+		p.X += roundDelta(d.X)
+		p.Y += roundDelta(d.Y)
+	}
+	return nil
+}
+
+// varyCompound applies the variations of compound glyph i to g.phantomPoints,
+// and returns the deltas, in font units, of its components' offsets. Unlike
+// g.deltas, the returned slice is not re-used by the loading of the
+// components.
+func (g *GlyphBuf) varyCompound(i Index, glyf []byte) ([]Point, error) {
+	n := compoundComponents(glyf) + 4
+	deltas, err := g.variationDeltas(nil, i, n, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for j := range deltas {
+		deltas[j].X = roundDelta(deltas[j].X)
+		deltas[j].Y = roundDelta(deltas[j].Y)
+	}
+	for j, d := range deltas[n-4:] {
+		g.phantomPoints[j].X += d.X
+		g.phantomPoints[j].Y += d.Y
+	}
+	return deltas[:n-4], nil
+}
+
+// compoundComponents returns the number of components of a compound glyph.
+func compoundComponents(glyf []byte) int {
+	const (
+		flagArg1And2AreWords   = 0x0001
+		flagWeHaveAScale       = 0x0008
+		flagMoreComponents     = 0x0020
+		flagWeHaveAnXAndYScale = 0x0040
+		flagWeHaveATwoByTwo    = 0x0080
+	)
+	n := 0
+	for offset := loadOffset; offset+2 <= len(glyf); {
+		flags := u16(glyf, offset)
+		n++
+		offset += 6
+		if flags&flagArg1And2AreWords != 0 {
+			offset += 2
+		}
+		switch {
+		case flags&flagWeHaveAScale != 0:
+			offset += 2
+		case flags&flagWeHaveAnXAndYScale != 0:
+			offset += 4
+		case flags&flagWeHaveATwoByTwo != 0:
+			offset += 8
+		}
+		if flags&flagMoreComponents == 0 {
+			break
+		}
+	}
+	return n
+}
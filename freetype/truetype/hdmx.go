@@ -0,0 +1,83 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// This file implements the device metrics of the hdmx and LTSH tables, which
+// are documented at http://www.microsoft.com/typography/otspec/hdmx.htm and
+// http://www.microsoft.com/typography/otspec/ltsh.htm
+
+// parseHdmx checks the hdmx and LTSH tables against the number of glyphs. An
+// invalid table is ignored.
+func (f *Font) parseHdmx() {
+	if len(f.hdmx) != 0 {
+		if len(f.hdmx) < 8 || u16(f.hdmx, 0) != 0 {
+			f.warn("truetype: ignoring invalid hdmx table")
+			f.hdmx = nil
+		} else if n, size := int(u16(f.hdmx, 2)), int(u32(f.hdmx, 4)); size < 2+f.nGlyph ||
+			(len(f.hdmx)-8)/size < n {
+			f.warn("truetype: ignoring invalid hdmx table", "records", n, "size", size)
+			f.hdmx = nil
+		}
+	}
+	if len(f.ltsh) != 0 {
+		if len(f.ltsh) < 4+f.nGlyph || u16(f.ltsh, 0) != 0 || int(u16(f.ltsh, 2)) != f.nGlyph {
+			f.warn("truetype: ignoring invalid LTSH table")
+			f.ltsh = nil
+		}
+	}
+}
+
+// hdmxAdvance returns the glyph's advance width, in whole pixels, from the
+// hdmx table's record for the given scale. ok is false if the scale is not a
+// whole number of pixels per em or the font has no such record.
+func (f *Font) hdmxAdvance(scale int32, i Index) (advance int32, ok bool) {
+	if len(f.hdmx) < 8 || scale&63 != 0 || int(i) >= f.nGlyph {
+		return 0, false
+	}
+	n, size := int(u16(f.hdmx, 2)), int(u32(f.hdmx, 4))
+	for j := 0; j < n; j++ {
+		record := f.hdmx[8+size*j:]
+		if int32(record[0]) == scale>>6 {
+			return int32(record[2+int(i)]), true
+		}
+	}
+	return 0, false
+}
+
+// DeviceAdvance returns the glyph's advance width as the hinter would give
+// it, in the same units as scale, which is the number of 26.6 fixed point
+// units in 1 em, such as 640 for 10 pixels per em. It is a whole number of
+// pixels, and is the advance that Windows uses for hinted text, without the
+// cost of loading and hinting the glyph.
+//
+// The advance is the one recorded in the font's hdmx table, if it has a
+// record for the scale. Otherwise, it is the rounded value of the linearly
+// scaled advance of the hmtx table if the font's head table says that its
+// instructions do not change advance widths, or its LTSH table says that the
+// glyph scales linearly at this size. ok is false if neither is so, in which
+// case the advance can only be found by loading the glyph with hinting.
+func (f *Font) DeviceAdvance(scale int32, i Index) (advance int32, ok bool) {
+	if int(i) >= f.nGlyph {
+		return 0, false
+	}
+	if a, ok := f.hdmxAdvance(scale, i); ok {
+		return a << 6, true
+	}
+	// Bit 4 of the head table's flags is set if the instructions may alter
+	// advance widths.
+	linear := len(f.head) < 18 || u16(f.head, 16)&0x10 == 0
+	if !linear && len(f.ltsh) != 0 && scale&63 == 0 {
+		// A yPels value is the smallest size at which the glyph scales
+		// linearly.
+		if yPels := int32(f.ltsh[4+int(i)]); yPels != 0 && scale>>6 >= yPels {
+			linear = true
+		}
+	}
+	if !linear {
+		return 0, false
+	}
+	return (f.scale(scale*f.unscaledHMetric(i).AdvanceWidth) + 32) &^ 63, true
+}
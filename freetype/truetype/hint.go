@@ -9,10 +9,15 @@ package truetype
 // The opcodes are described at https://developer.apple.com/fonts/TTRefMan/RM05/Chap5.html
 
 import (
-	"errors"
+	"context"
 	"math"
+	"time"
 )
 
+// ctxCheckSteps is how many instructions the hinter runs between checks of
+// its context.
+const ctxCheckSteps = 1024
+
 const (
 	twilightZone = 0
 	glyphZone    = 1
@@ -28,11 +33,15 @@ const (
 	numPointType           = 3
 )
 
-// callStackEntry is a bytecode call stack entry.
+// callStackEntry is a bytecode call stack entry. When profiling, function is
+// the number of the called function, or -1 for a user-defined instruction,
+// and start is when the call started.
 type callStackEntry struct {
 	program   []byte
 	pc        int
 	loopCount int32
+	function  int32
+	start     time.Time
 }
 
 // hinter implements bytecode hinting. A hinter can be re-used to hint a series
@@ -40,15 +49,51 @@ type callStackEntry struct {
 type hinter struct {
 	stack, store []int32
 
+	// callStack is the bytecode call stack, whose length is the maximum call
+	// depth.
+	callStack []callStackEntry
+
+	// opts are the hinter options.
+	opts HinterOptions
+
 	// functions is a map from function number to bytecode.
 	functions map[int32][]byte
 
+	// instructions is a map from opcode to the bytecode of a user-defined
+	// instruction, as defined by IDEF.
+	instructions map[uint8][]byte
+
 	// font and scale are the font and scale last used for this hinter.
 	// Changing the font will require running the new font's fpgm bytecode.
-	// Changing either will require running the font's prep bytecode.
+	// Changing either will require running the font's prep bytecode, unless
+	// the results of running it at that scale are in sizes.
 	font  *Font
 	scale int32
 
+	// xScale is the horizontal scale when glyphs are scaled horizontally, or
+	// zero if it is the same as scale, and prepXScale is the horizontal scale last
+	// used to run the font's prep bytecode.
+	xScale, prepXScale int32
+
+	// fontFunctions, fontInstructions and fontStore are the function and
+	// instruction definitions and storage area after running the font's fpgm
+	// bytecode. They are copied before running the prep bytecode for each
+	// size.
+	fontFunctions    map[int32][]byte
+	fontInstructions map[uint8][]byte
+	fontStore        []int32
+
+	// sizes caches the results of running the font's prep bytecode, as
+	// *sizeStates charged to the font's Budget, and size is the entry for the
+	// current scale and point size.
+	sizes map[sizeKey]*lazyCache
+	size  *sizeState
+
+	// pointSize is the point size, as a 26.6 fixed point number, reported by
+	// the MPS instruction, or zero if unknown. prepPointSize is the point
+	// size last used to run the font's prep bytecode.
+	pointSize, prepPointSize f26dot6
+
 	// gs and defaultGS are the current and default graphics state. The
 	// default graphics state is the global default graphics state after
 	// the font's fpgm and prep programs have been run.
@@ -59,11 +104,70 @@ type hinter struct {
 	points [numZone][numPointType][]Point
 	ends   []int
 
+	// subpixel is whether the hinter emulates the ClearType (version 40)
+	// interpreter, as for SubpixelHinting. iupXCalled and iupYCalled are
+	// whether the running glyph program has interpolated untouched points in
+	// the x and y directions.
+	subpixel               bool
+	iupXCalled, iupYCalled bool
+
+	// vertical is whether glyph programs' moves in the x direction are
+	// ignored, as for VerticalHinting.
+	vertical bool
+
+	// twilightOutOfRange is whether the last point index found to be out of
+	// range was past the end of the twilight zone.
+	twilightOutOfRange bool
+
+	// inPrep is whether the font's prep bytecode is running. Some
+	// instructions, such as INSTCTRL, may only be used from the prep.
+	inPrep bool
+
 	// scaledCVT is the lazily initialized scaled Control Value Table.
 	scaledCVTInitialized bool
 	scaledCVT            []f26dot6
+
+	// ctx, if non-nil, is checked periodically while programs run, as set
+	// by GlyphBuf.LoadContext.
+	ctx context.Context
+}
+
+// sizeKey identifies the inputs to running a font's prep bytecode.
+type sizeKey struct {
+	scale     int32
+	xScale    int32
+	pointSize f26dot6
+}
+
+// sizeState is the result of running a font's prep bytecode at a given size.
+type sizeState struct {
+	functions            map[int32][]byte
+	instructions         map[uint8][]byte
+	store                []int32
+	defaultGS            graphicsState
+	scaledCVTInitialized bool
+	scaledCVT            []f26dot6
+}
+
+// bytes returns the approximate number of bytes used by s.
+func (s *sizeState) bytes(f *Font) int64 {
+	n := 48*(len(s.functions)+len(s.instructions)) + 4*len(s.store)
+	// The scaled CVT is computed lazily, but charged up front.
+	return int64(n + 4*len(f.cvt)/2)
+}
+
+// dropSizes drops the cached results of running the font's prep bytecode.
+func (h *hinter) dropSizes() {
+	for _, c := range h.sizes {
+		c.drop()
+	}
+	h.sizes = nil
 }
 
+// maxCachedSizes is the maximum number of sizes for which a hinter caches the
+// results of running a font's prep bytecode.
+const maxCachedSizes = 16
+
 // graphicsState is described at https://developer.apple.com/fonts/TTRefMan/RM04/Chap4.html
 type graphicsState struct {
 	// Projection vector, freedom vector and dual projection vector.
@@ -83,8 +187,27 @@ type graphicsState struct {
 	roundSuper45                            bool
 	// Auto-flip.
 	autoFlip bool
+	// Dropout control, as set by SCANCTRL and SCANTYPE.
+	scanControl bool
+	scanType    int32
+	// Instruction control, as set by INSTCTRL.
+	instructControl int32
 }
 
+// These are the instruction control flags set by INSTCTRL. Each flag's value
+// is that of its INSTCTRL selector.
+const (
+	// instructControlInhibitGridFit means to not run glyph programs.
+	instructControlInhibitGridFit = 1
+	// instructControlIgnorePrepGS means to ignore the prep program's changes
+	// to the default graphics state.
+	instructControlIgnorePrepGS = 2
+	// instructControlNativeClearType means that the font's glyph programs
+	// are written for ClearType, and so do not need the subpixel hinting
+	// mode's backward compatibility heuristics.
+	instructControlNativeClearType = 4
+)
+
 var globalDefaultGS = graphicsState{
 	pv:                [2]f2dot14{0x4000, 0}, // Unit vector along the X axis.
 	fv:                [2]f2dot14{0x4000, 0},
@@ -113,49 +236,110 @@ func resetTwilightPoints(f *Font, p []Point) []Point {
 	return p
 }
 
+// stackSlack is the number of stack elements allocated beyond a font's
+// maxp.maxStackElements. Some fonts understate their stack usage slightly.
+const stackSlack = 32
+
+// stackSize returns the size of the stack needed to hint f's glyphs. As
+// maxp.maxStackElements is a uint16, the size is capped at 65535+stackSlack.
+func stackSize(f *Font) int {
+	return int(f.maxStackElements) + stackSlack
+}
+
+// callDepth returns the maximum call depth for hinting glyphs, the
+// MaxCallDepth option. Like C Freetype, it does not depend on the number of
+// definitions declared in the font's maxp table, as functions may recurse.
+func (h *hinter) callDepth() int {
+	if h.opts.MaxCallDepth > 0 {
+		return h.opts.MaxCallDepth
+	}
+	return DefaultMaxCallDepth
+}
+
 func (h *hinter) init(f *Font, scale int32) error {
 	h.points[twilightZone][0] = resetTwilightPoints(f, h.points[twilightZone][0])
 	h.points[twilightZone][1] = resetTwilightPoints(f, h.points[twilightZone][1])
 	h.points[twilightZone][2] = resetTwilightPoints(f, h.points[twilightZone][2])
 
-	rescale := h.scale != scale
+	rescale := h.scale != scale || h.prepXScale != h.xScale || h.prepPointSize != h.pointSize
 	if h.font != f {
-		h.font, rescale = f, true
-		if h.functions == nil {
-			h.functions = make(map[int32][]byte)
-		} else {
-			for k := range h.functions {
-				delete(h.functions, k)
-			}
-		}
+		h.dropSizes()
+		h.font, h.size, rescale = f, nil, true
+		h.functions = make(map[int32][]byte)
+		h.instructions = make(map[uint8][]byte)
 
-		if x := int(f.maxStackElements); x > len(h.stack) {
-			x += 255
-			x &^= 255
-			h.stack = make([]int32, x)
+		if n := stackSize(f); n != len(h.stack) {
+			h.stack = make([]int32, n)
 		}
-		if x := int(f.maxStorage); x > len(h.store) {
-			x += 15
-			x &^= 15
-			h.store = make([]int32, x)
+		if n := h.callDepth(); n != len(h.callStack) {
+			h.callStack = make([]callStackEntry, n)
 		}
+		// Some fonts slightly understate maxStorage, so the storage area has
+		// some slack.
+		x := int(f.maxStorage) + 15
+		x &^= 15
+		h.store = make([]int32, x)
 		if len(f.fpgm) != 0 {
 			if err := h.run(f.fpgm, nil, nil, nil, nil); err != nil {
 				return err
 			}
 		}
+		h.fontFunctions = h.functions
+		h.fontInstructions = h.instructions
+		h.fontStore = h.store
 	}
 
 	if rescale {
+		// Save the current size's lazily initialized state.
+		if h.size != nil {
+			h.size.scaledCVTInitialized = h.scaledCVTInitialized
+			h.size.scaledCVT = h.scaledCVT
+		}
 		h.scale = scale
+		h.prepXScale = h.xScale
+		h.prepPointSize = h.pointSize
+
+		key := sizeKey{scale, h.xScale, h.pointSize}
+		if x, ok := h.sizes[key].load(); ok {
+			s := x.(*sizeState)
+			h.size = s
+			h.functions = s.functions
+			h.instructions = s.instructions
+			h.store = s.store
+			h.defaultGS = s.defaultGS
+			h.scaledCVTInitialized = s.scaledCVTInitialized
+			h.scaledCVT = s.scaledCVT
+			return nil
+		}
+
+		// The prep bytecode may define functions and instructions and write
+		// to the storage area, so each size gets its own copy of them.
+		h.functions = make(map[int32][]byte, len(h.fontFunctions))
+		for k, v := range h.fontFunctions {
+			h.functions[k] = v
+		}
+		h.instructions = make(map[uint8][]byte, len(h.fontInstructions))
+		for k, v := range h.fontInstructions {
+			h.instructions[k] = v
+		}
+		h.store = append([]int32(nil), h.fontStore...)
 		h.scaledCVTInitialized = false
+		h.scaledCVT = nil
+		h.size = nil
 
 		h.defaultGS = globalDefaultGS
 
 		if len(f.prep) != 0 {
-			if err := h.run(f.prep, nil, nil, nil, nil); err != nil {
+			h.inPrep = true
+			err := h.run(f.prep, nil, nil, nil, nil)
+			h.inPrep = false
+			if err != nil {
 				return err
 			}
+			if ic := h.gs.instructControl; ic&instructControlIgnorePrepGS != 0 {
+				h.gs = globalDefaultGS
+				h.gs.instructControl = ic
+			}
 			h.defaultGS = h.gs
 			// The MS rasterizer doesn't allow the following graphics state
 			// variables to be modified by the CVT program.
@@ -166,6 +350,25 @@ func (h *hinter) init(f *Font, scale int32) error {
 			h.defaultGS.zp = globalDefaultGS.zp
 			h.defaultGS.loop = globalDefaultGS.loop
 		}
+
+		if h.sizes == nil || len(h.sizes) >= maxCachedSizes {
+			h.dropSizes()
+			h.sizes = make(map[sizeKey]*lazyCache)
+		}
+		h.size = &sizeState{
+			functions:            h.functions,
+			instructions:         h.instructions,
+			store:                h.store,
+			defaultGS:            h.defaultGS,
+			scaledCVTInitialized: h.scaledCVTInitialized,
+			scaledCVT:            h.scaledCVT,
+		}
+		c := h.sizes[key]
+		if c == nil {
+			c = &lazyCache{}
+			h.sizes[key] = c
+		}
+		c.store(f.budget, h.size, h.size.bytes(f))
 	}
 	return nil
 }
@@ -176,26 +379,53 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 	h.points[glyphZone][unhinted] = pUnhinted
 	h.points[glyphZone][inFontUnits] = pInFontUnits
 	h.ends = ends
+	h.iupXCalled, h.iupYCalled = false, false
+	h.twilightOutOfRange = false
 
-	if len(program) > 50000 {
-		return errors.New("truetype: hinting: too many instructions")
+	maxSteps, maxProgramSize := h.opts.MaxSteps, h.opts.MaxProgramSize
+	if maxSteps <= 0 {
+		maxSteps = DefaultMaxSteps
+	}
+	if maxProgramSize <= 0 {
+		maxProgramSize = DefaultMaxProgramSize
+	}
+	if len(program) > maxProgramSize {
+		// The program is rejected before its first instruction is run.
+		return HintingError{Opcode: program[0], Reason: "too many instructions"}
 	}
 	var (
 		steps, pc, top int
 		opcode         uint8
 
-		callStack    [32]callStackEntry
+		callStack    = h.callStack
 		callStackTop int
 	)
 
 	for 0 <= pc && pc < len(program) {
 		steps++
-		if steps == 100000 {
-			return errors.New("truetype: hinting: too many steps")
+		if steps > maxSteps {
+			return HintingError{PC: pc, Opcode: opcode, Reason: "too many steps"}
+		}
+		if h.ctx != nil && steps%ctxCheckSteps == 0 {
+			if err := h.ctx.Err(); err != nil {
+				return err
+			}
 		}
 		opcode = program[pc]
+		if h.opts.Profile != nil {
+			h.opts.Profile.Opcodes[opcode]++
+		}
+		if h.opts.Trace != nil {
+			h.opts.Trace(&TraceEvent{
+				Program: program,
+				PC:      pc,
+				Opcode:  opcode,
+				Stack:   h.stack[:top:top],
+				GS:      h.gs.export(),
+			})
+		}
 		if top < int(popCount[opcode]) {
-			return errors.New("truetype: hinting: stack underflow")
+			return HintingError{PC: pc, Opcode: opcode, Reason: "stack underflow"}
 		}
 		switch opcode {
 
@@ -228,7 +458,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			p1 := h.point(0, current, h.stack[top+0])
 			p2 := h.point(0, current, h.stack[top+1])
 			if p1 == nil || p2 == nil {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 			dx := f2dot14(p1.X - p2.X)
 			dy := f2dot14(p1.Y - p2.Y)
@@ -257,7 +487,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 
 		case opGPV:
 			if top+1 >= len(h.stack) {
-				return errors.New("truetype: hinting: stack overflow")
+				return LimitError("maxStackElements")
 			}
 			h.stack[top+0] = int32(h.gs.pv[0])
 			h.stack[top+1] = int32(h.gs.pv[1])
@@ -265,7 +495,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 
 		case opGFV:
 			if top+1 >= len(h.stack) {
-				return errors.New("truetype: hinting: stack overflow")
+				return LimitError("maxStackElements")
 			}
 			h.stack[top+0] = int32(h.gs.fv[0])
 			h.stack[top+1] = int32(h.gs.fv[1])
@@ -282,7 +512,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			b0 := h.point(0, current, h.stack[top+3])
 			b1 := h.point(0, current, h.stack[top+4])
 			if p == nil || a0 == nil || a1 == nil || b0 == nil || b1 == nil {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 
 			dbx := b1.X - b0.X
@@ -329,10 +559,16 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 
 		case opSZP0, opSZP1, opSZP2:
 			top--
+			if z := h.stack[top]; z != twilightZone && z != glyphZone {
+				return HintingError{PC: pc, Opcode: opcode, Reason: "invalid data"}
+			}
 			h.gs.zp[opcode-opSZP0] = h.stack[top]
 
 		case opSZPS:
 			top--
+			if z := h.stack[top]; z != twilightZone && z != glyphZone {
+				return HintingError{PC: pc, Opcode: opcode, Reason: "invalid data"}
+			}
 			h.gs.zp[0] = h.stack[top]
 			h.gs.zp[1] = h.stack[top]
 			h.gs.zp[2] = h.stack[top]
@@ -340,7 +576,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 		case opSLOOP:
 			top--
 			if h.stack[top] <= 0 {
-				return errors.New("truetype: hinting: invalid data")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "invalid data"}
 			}
 			h.gs.loop = h.stack[top]
 
@@ -383,7 +619,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 
 		case opDUP:
 			if top >= len(h.stack) {
-				return errors.New("truetype: hinting: stack overflow")
+				return LimitError("maxStackElements")
 			}
 			h.stack[top] = h.stack[top-1]
 			top++
@@ -399,7 +635,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 
 		case opDEPTH:
 			if top >= len(h.stack) {
-				return errors.New("truetype: hinting: stack overflow")
+				return LimitError("maxStackElements")
 			}
 			h.stack[top] = int32(top)
 			top++
@@ -407,7 +643,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 		case opCINDEX, opMINDEX:
 			x := int(h.stack[top-1])
 			if x <= 0 || x >= top {
-				return errors.New("truetype: hinting: invalid data")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "invalid data"}
 			}
 			h.stack[top-1] = h.stack[top-1-x]
 			if opcode == opMINDEX {
@@ -420,7 +656,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			p := h.point(1, current, h.stack[top])
 			q := h.point(0, current, h.stack[top+1])
 			if p == nil || q == nil {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 			d := dotProduct(f26dot6(q.X-p.X), f26dot6(q.Y-p.Y), h.gs.pv) / 2
 			h.move(p, +d, true)
@@ -430,20 +666,23 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			top--
 			p := h.point(0, current, h.stack[top])
 			if p == nil {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 			p.Flags &^= flagTouchedX | flagTouchedY
 
 		case opLOOPCALL, opCALL:
 			if callStackTop >= len(callStack) {
-				return errors.New("truetype: hinting: call stack overflow")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "call stack overflow"}
 			}
 			top--
 			f, ok := h.functions[h.stack[top]]
 			if !ok {
-				return errors.New("truetype: hinting: undefined function")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "undefined function"}
+			}
+			callStack[callStackTop] = callStackEntry{program, pc, 1, h.stack[top], time.Time{}}
+			if h.opts.Profile != nil {
+				callStack[callStackTop].start = time.Now()
 			}
-			callStack[callStackTop] = callStackEntry{program, pc, 1}
 			if opcode == opLOOPCALL {
 				top--
 				if h.stack[top] == 0 {
@@ -455,36 +694,55 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			program, pc = f, 0
 			continue
 
-		case opFDEF:
+		case opFDEF, opIDEF:
 			// Save all bytecode up until the next ENDF.
 			startPC := pc + 1
 		fdefloop:
 			for {
 				pc++
 				if pc >= len(program) {
-					return errors.New("truetype: hinting: unbalanced FDEF")
+					return HintingError{PC: pc, Opcode: opcode, Reason: "unbalanced FDEF"}
 				}
 				switch program[pc] {
-				case opFDEF:
-					return errors.New("truetype: hinting: nested FDEF")
+				case opFDEF, opIDEF:
+					return HintingError{PC: pc, Opcode: opcode, Reason: "nested FDEF"}
 				case opENDF:
 					top--
-					h.functions[h.stack[top]] = program[startPC : pc+1]
+					if opcode == opFDEF {
+						x := h.stack[top]
+						if x < 0 {
+							return HintingError{PC: pc, Opcode: opcode, Reason: "invalid data"}
+						}
+						if err := h.checkFunctionDef(x); err != nil {
+							return err
+						}
+						h.functions[x] = program[startPC : pc+1]
+						break fdefloop
+					}
+					x := h.stack[top]
+					if x < 0 || 0xff < x {
+						return HintingError{PC: pc, Opcode: opcode, Reason: "invalid data"}
+					}
+					h.instructions[uint8(x)] = program[startPC : pc+1]
 					break fdefloop
 				default:
 					var ok bool
 					pc, ok = skipInstructionPayload(program, pc)
 					if !ok {
-						return errors.New("truetype: hinting: unbalanced FDEF")
+						return HintingError{PC: pc, Opcode: opcode, Reason: "unbalanced FDEF"}
 					}
 				}
 			}
 
 		case opENDF:
 			if callStackTop == 0 {
-				return errors.New("truetype: hinting: call stack underflow")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "call stack underflow"}
 			}
 			callStackTop--
+			if p := h.opts.Profile; p != nil && callStack[callStackTop].function >= 0 {
+				p.addCall(callStack[callStackTop].function, callStack[callStackTop].start)
+				callStack[callStackTop].start = time.Now()
+			}
 			callStack[callStackTop].loopCount--
 			if callStack[callStackTop].loopCount != 0 {
 				callStackTop++
@@ -498,7 +756,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			i := h.stack[top]
 			p := h.point(0, current, i)
 			if p == nil {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 			distance := f26dot6(0)
 			if opcode == opMDAP1 {
@@ -515,6 +773,20 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			if iupY {
 				mask = flagTouchedY
 			}
+			// As per C Freetype, in backward compatibility mode, once both
+			// directions have been interpolated, the glyph is considered
+			// finished.
+			if h.backwardCompatibility() && h.iupXCalled && h.iupYCalled {
+				break
+			}
+			if !iupY && h.verticalOnly() {
+				break
+			}
+			if iupY {
+				h.iupYCalled = true
+			} else {
+				h.iupXCalled = true
+			}
 			prevEnd := 0
 			for _, end := range h.ends {
 				for i := prevEnd; i < end; i++ {
@@ -546,17 +818,17 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 
 		case opSHP0, opSHP1:
 			if top < int(h.gs.loop) {
-				return errors.New("truetype: hinting: stack underflow")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "stack underflow"}
 			}
 			_, _, d, ok := h.displacement(opcode&1 == 0)
 			if !ok {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 			for ; h.gs.loop != 0; h.gs.loop-- {
 				top--
 				p := h.point(2, current, h.stack[top])
 				if p == nil {
-					return errors.New("truetype: hinting: point out of range")
+					return h.pointError(pc, opcode)
 				}
 				h.move(p, d, true)
 			}
@@ -566,15 +838,12 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			top--
 			zonePointer, i, d, ok := h.displacement(opcode&1 == 0)
 			if !ok {
-				return errors.New("truetype: hinting: point out of range")
-			}
-			if h.gs.zp[2] == 0 {
-				// TODO: implement this when we have a glyph that does this.
-				return errors.New("hinting: unimplemented SHC instruction")
+				return h.pointError(pc, opcode)
 			}
+			// The twilight zone has no contours.
 			contour := h.stack[top]
-			if contour < 0 || len(ends) <= int(contour) {
-				return errors.New("truetype: hinting: contour out of range")
+			if h.gs.zp[2] == twilightZone || contour < 0 || len(ends) <= int(contour) {
+				return HintingError{PC: pc, Opcode: opcode, Reason: "contour out of range"}
 			}
 			j0, j1 := int32(0), int32(h.ends[contour])
 			if contour > 0 {
@@ -583,15 +852,24 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			move := h.gs.zp[zonePointer] != h.gs.zp[2]
 			for j := j0; j < j1; j++ {
 				if move || j != i {
-					h.move(h.point(2, current, j), d, true)
+					p := h.point(2, current, j)
+					if p == nil {
+						return h.pointError(pc, opcode)
+					}
+					h.move(p, d, true)
 				}
 			}
 
 		case opSHZ0, opSHZ1:
 			top--
+			// As per C Freetype, the zone argument is checked but otherwise
+			// ignored: it is the points in zp2 that are moved.
+			if z := h.stack[top]; z != twilightZone && z != glyphZone {
+				return HintingError{PC: pc, Opcode: opcode, Reason: "invalid data"}
+			}
 			zonePointer, i, d, ok := h.displacement(opcode&1 == 0)
 			if !ok {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 
 			// As per C Freetype, SHZ doesn't move the phantom points, or mark
@@ -610,21 +888,38 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			top--
 			d := f26dot6(h.stack[top])
 			if top < int(h.gs.loop) {
-				return errors.New("truetype: hinting: stack underflow")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "stack underflow"}
 			}
 			for ; h.gs.loop != 0; h.gs.loop-- {
 				top--
 				p := h.point(2, current, h.stack[top])
 				if p == nil {
-					return errors.New("truetype: hinting: point out of range")
+					return h.pointError(pc, opcode)
+				}
+				if h.backwardCompatibility() {
+					// As per C Freetype, only points that have already been
+					// moved vertically can be shifted, and only vertically.
+					if !(h.iupXCalled && h.iupYCalled) && p.Flags&flagTouchedY != 0 {
+						p.Y += int32((int64(d) * int64(h.gs.fv[1])) >> 14)
+					}
+					continue
+				}
+				// Unlike the other shifts, the distance is measured along the
+				// freedom vector, not the projection vector.
+				if h.gs.fv[0] != 0 && !h.verticalOnly() {
+					p.X += int32((int64(d) * int64(h.gs.fv[0])) >> 14)
+					p.Flags |= flagTouchedX
+				}
+				if h.gs.fv[1] != 0 {
+					p.Y += int32((int64(d) * int64(h.gs.fv[1])) >> 14)
+					p.Flags |= flagTouchedY
 				}
-				h.move(p, d, true)
 			}
 			h.gs.loop = 1
 
 		case opIP:
 			if top < int(h.gs.loop) {
-				return errors.New("truetype: hinting: stack underflow")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "stack underflow"}
 			}
 			pointType := inFontUnits
 			twilight := h.gs.zp[0] == 0 || h.gs.zp[1] == 0 || h.gs.zp[2] == 0
@@ -633,17 +928,29 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			}
 			p := h.point(1, pointType, h.gs.rp[2])
 			oldP := h.point(0, pointType, h.gs.rp[1])
+			if p == nil || oldP == nil {
+				return h.pointError(pc, opcode)
+			}
 			oldRange := dotProduct(f26dot6(p.X-oldP.X), f26dot6(p.Y-oldP.Y), h.gs.dv)
 
 			p = h.point(1, current, h.gs.rp[2])
 			curP := h.point(0, current, h.gs.rp[1])
+			if p == nil || curP == nil {
+				return h.pointError(pc, opcode)
+			}
 			curRange := dotProduct(f26dot6(p.X-curP.X), f26dot6(p.Y-curP.Y), h.gs.pv)
 			for ; h.gs.loop != 0; h.gs.loop-- {
 				top--
 				i := h.stack[top]
 				p = h.point(2, pointType, i)
+				if p == nil {
+					return h.pointError(pc, opcode)
+				}
 				oldDist := dotProduct(f26dot6(p.X-oldP.X), f26dot6(p.Y-oldP.Y), h.gs.dv)
 				p = h.point(2, current, i)
+				if p == nil {
+					return h.pointError(pc, opcode)
+				}
 				curDist := dotProduct(f26dot6(p.X-curP.X), f26dot6(p.Y-curP.Y), h.gs.pv)
 				newDist := f26dot6(0)
 				if oldDist != 0 {
@@ -662,11 +969,22 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			i := h.stack[top]
 			distance := f26dot6(h.stack[top+1])
 
-			// TODO: special case h.gs.zp[1] == 0 in C Freetype.
+			if h.gs.zp[1] == twilightZone {
+				// As per C Freetype, a twilight point is first placed at the
+				// given distance from the reference point's original position.
+				ref := h.point(0, unhinted, h.gs.rp[0])
+				q := h.point(1, unhinted, i)
+				if ref == nil || q == nil {
+					return h.pointError(pc, opcode)
+				}
+				q.X, q.Y = ref.X, ref.Y
+				h.move(q, distance, false)
+				h.points[twilightZone][current][i] = *q
+			}
 			ref := h.point(0, current, h.gs.rp[0])
 			p := h.point(1, current, i)
 			if ref == nil || p == nil {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 			curDist := dotProduct(f26dot6(p.X-ref.X), f26dot6(p.Y-ref.Y), h.gs.pv)
 
@@ -682,17 +1000,17 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 
 		case opALIGNRP:
 			if top < int(h.gs.loop) {
-				return errors.New("truetype: hinting: stack underflow")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "stack underflow"}
 			}
 			ref := h.point(0, current, h.gs.rp[0])
 			if ref == nil {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 			for ; h.gs.loop != 0; h.gs.loop-- {
 				top--
 				p := h.point(1, current, h.stack[top])
 				if p == nil {
-					return errors.New("truetype: hinting: point out of range")
+					return h.pointError(pc, opcode)
 				}
 				h.move(p, -dotProduct(f26dot6(p.X-ref.X), f26dot6(p.Y-ref.Y), h.gs.pv), true)
 			}
@@ -711,11 +1029,17 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			if h.gs.zp[0] == 0 {
 				p := h.point(0, unhinted, i)
 				q := h.point(0, current, i)
+				if p == nil || q == nil {
+					return h.pointError(pc, opcode)
+				}
 				p.X = int32((int64(distance) * int64(h.gs.fv[0])) >> 14)
 				p.Y = int32((int64(distance) * int64(h.gs.fv[1])) >> 14)
 				*q = *p
 			}
 			p := h.point(0, current, i)
+			if p == nil {
+				return h.pointError(pc, opcode)
+			}
 			oldDist := dotProduct(f26dot6(p.X), f26dot6(p.Y), h.gs.pv)
 			if opcode == opMIAP1 {
 				if (distance - oldDist).abs() > h.gs.controlValueCutIn {
@@ -739,21 +1063,32 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 		case opWS:
 			top -= 2
 			i := int(h.stack[top])
-			if i < 0 || len(h.store) <= i {
-				return errors.New("truetype: hinting: invalid data")
+			if i < 0 {
+				return HintingError{PC: pc, Opcode: opcode, Reason: "invalid data"}
+			}
+			if err := h.checkStorage(i); err != nil {
+				return err
+			}
+			if i < len(h.store) {
+				h.store[i] = h.stack[top+1]
 			}
-			h.store[i] = h.stack[top+1]
 
 		case opRS:
 			i := int(h.stack[top-1])
-			if i < 0 || len(h.store) <= i {
-				return errors.New("truetype: hinting: invalid data")
+			if i < 0 {
+				return HintingError{PC: pc, Opcode: opcode, Reason: "invalid data"}
+			}
+			if err := h.checkStorage(i); err != nil {
+				return err
+			}
+			h.stack[top-1] = 0
+			if i < len(h.store) {
+				h.stack[top-1] = h.store[i]
 			}
-			h.stack[top-1] = h.store[i]
 
 		case opWCVTP:
 			top -= 2
-			h.setScaledCVT(h.stack[top], f26dot6(h.stack[top+1]))
+			h.setScaledCVT(h.stack[top], h.unstretchCVT(f26dot6(h.stack[top+1])))
 
 		case opRCVT:
 			h.stack[top-1] = int32(h.getScaledCVT(h.stack[top-1]))
@@ -762,9 +1097,15 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			i := h.stack[top-1]
 			if opcode == opGC0 {
 				p := h.point(2, current, i)
+				if p == nil {
+					return h.pointError(pc, opcode)
+				}
 				h.stack[top-1] = int32(dotProduct(f26dot6(p.X), f26dot6(p.Y), h.gs.pv))
 			} else {
 				p := h.point(2, unhinted, i)
+				if p == nil {
+					return h.pointError(pc, opcode)
+				}
 				// Using dv as per C Freetype.
 				h.stack[top-1] = int32(dotProduct(f26dot6(p.X), f26dot6(p.Y), h.gs.dv))
 			}
@@ -774,7 +1115,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			i := h.stack[top]
 			p := h.point(2, current, i)
 			if p == nil {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 			c := dotProduct(f26dot6(p.X), f26dot6(p.Y), h.gs.pv)
 			h.move(p, f26dot6(h.stack[top+1])-c, true)
@@ -783,7 +1124,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			}
 			q := h.point(2, unhinted, i)
 			if q == nil {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 			q.X = p.X
 			q.Y = p.Y
@@ -805,7 +1146,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			p := h.point(0, pt, h.stack[top-1])
 			q := h.point(1, pt, h.stack[top])
 			if p == nil || q == nil {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 			d := int32(dotProduct(f26dot6(p.X-q.X), f26dot6(p.Y-q.Y), v))
 			if scale {
@@ -815,10 +1156,15 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 
 		case opMPPEM, opMPS:
 			if top >= len(h.stack) {
-				return errors.New("truetype: hinting: stack overflow")
+				return LimitError("maxStackElements")
+			}
+			if opcode == opMPS && h.pointSize != 0 {
+				h.stack[top] = int32(h.pointSize)
+			} else {
+				// The pixels per em is rounded to the nearest integer. If the
+				// point size is unknown, MPS returns the PPEM, like C Freetype.
+				h.stack[top] = (h.projectedScale() + 32) >> 6
 			}
-			// For MPS, point size should be irrelevant; we return the PPEM.
-			h.stack[top] = h.scale >> 6
 			top++
 
 		case opFLIPON, opFLIPOFF:
@@ -885,6 +1231,11 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 
 		case opSDS:
 			top--
+			// As per C Freetype, the shift is at most 6, so that the smallest
+			// delta step is 1/64 of a pixel.
+			if x := h.stack[top]; x < 0 || 6 < x {
+				return HintingError{PC: pc, Opcode: opcode, Reason: "invalid data"}
+			}
 			h.gs.deltaShift = h.stack[top]
 
 		case opADD:
@@ -898,7 +1249,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 		case opDIV:
 			top--
 			if h.stack[top] == 0 {
-				return errors.New("truetype: hinting: division by zero")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "division by zero"}
 			}
 			h.stack[top-1] = int32(f26dot6(h.stack[top-1]).div(f26dot6(h.stack[top])))
 
@@ -922,16 +1273,14 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			h.stack[top-1] &^= 63
 
 		case opROUND00, opROUND01, opROUND10, opROUND11:
-			// The four flavors of opROUND are equivalent. See the comment below on
-			// opNROUND for the rationale.
-			h.stack[top-1] = int32(h.round(f26dot6(h.stack[top-1])))
+			// The low two bits of the opcode select the distance type, and hence
+			// the engine compensation. See the comment on engineCompensation.
+			c := engineCompensation[opcode-opROUND00]
+			h.stack[top-1] = int32(h.roundCompensated(f26dot6(h.stack[top-1]), c))
 
 		case opNROUND00, opNROUND01, opNROUND10, opNROUND11:
-			// No-op. The spec says to add one of four "compensations for the engine
-			// characteristics", to cater for things like "different dot-size printers".
-			// https://developer.apple.com/fonts/TTRefMan/RM02/Chap2.html#engine_compensation
-			// This code does not implement engine compensation, as we don't expect to
-			// be used to output on dot-matrix printers.
+			c := engineCompensation[opcode-opNROUND00]
+			h.stack[top-1] = int32(compensate(f26dot6(h.stack[top-1]), c))
 
 		case opWCVTF:
 			top -= 2
@@ -1002,14 +1351,14 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 
 		case opFLIPPT:
 			if top < int(h.gs.loop) {
-				return errors.New("truetype: hinting: stack underflow")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "stack underflow"}
 			}
 			points := h.points[glyphZone][current]
 			for ; h.gs.loop != 0; h.gs.loop-- {
 				top--
 				i := h.stack[top]
 				if i < 0 || len(points) <= int(i) {
-					return errors.New("truetype: hinting: point out of range")
+					return h.pointError(pc, opcode)
 				}
 				points[i].Flags ^= flagOnCurve
 			}
@@ -1019,7 +1368,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			top -= 2
 			i, j, points := h.stack[top], h.stack[top+1], h.points[glyphZone][current]
 			if i < 0 || len(points) <= int(i) || j < 0 || len(points) <= int(j) {
-				return errors.New("truetype: hinting: point out of range")
+				return h.pointError(pc, opcode)
 			}
 			for ; i <= j; i++ {
 				if opcode == opFLIPRGON {
@@ -1030,8 +1379,26 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			}
 
 		case opSCANCTRL:
-			// We do not support dropout control, as we always rasterize grayscale glyphs.
+			// As per C Freetype. The low byte is a PPEM threshold, and the
+			// other bits are conditions under which to turn dropout control
+			// on or off. Rotated and stretched glyphs are not supported, so
+			// we ignore the conditions that depend on them.
 			top--
+			x := h.stack[top]
+			switch n := x & 0xff; n {
+			case 0xff:
+				h.gs.scanControl = true
+			case 0:
+				h.gs.scanControl = false
+			default:
+				ppem := (h.projectedScale() + 32) >> 6
+				if x&0x100 != 0 && ppem <= n {
+					h.gs.scanControl = true
+				}
+				if x&0x800 != 0 && ppem > n {
+					h.gs.scanControl = false
+				}
+			}
 
 		case opSDPVTL0, opSDPVTL1:
 			top -= 2
@@ -1043,7 +1410,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 				p := h.point(1, pt, h.stack[top])
 				q := h.point(2, pt, h.stack[top+1])
 				if p == nil || q == nil {
-					return errors.New("truetype: hinting: point out of range")
+					return h.pointError(pc, opcode)
 				}
 				dx := f2dot14(p.X - q.X)
 				dy := f2dot14(p.Y - q.Y)
@@ -1061,24 +1428,35 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			}
 
 		case opGETINFO:
-			res := int32(0)
-			if h.stack[top-1]&(1<<0) != 0 {
-				// Set the engine version. We hard-code this to 35, the same as
-				// the C freetype code, which says that "Version~35 corresponds
-				// to MS rasterizer v.1.7 as used e.g. in Windows~98".
-				res |= 35
-			}
-			if h.stack[top-1]&(1<<5) != 0 {
-				// Set that we support grayscale.
-				res |= 1 << 12
-			}
-			// We set no other bits, as we do not support rotated or stretched glyphs.
+			// The selector bits are documented at
+			// https://www.microsoft.com/typography/otspec/ttinst.htm#getinfo
+			selector, res := h.stack[top-1], int32(0)
+			version, flags := h.opts.EngineVersion, h.opts.EngineFlags
+			if version == 0 && h.subpixel {
+				// As per C Freetype's version 40 interpreter, we report
+				// ClearType with subpixel positioning and symmetric smoothing.
+				version = 40
+				flags = EngineSubpixel | EngineSymmetricSmoothing | EngineSubpixelPositioned
+			} else if version == 0 {
+				// We default to 35, the same as the C freetype code, which says
+				// that "Version~35 corresponds to MS rasterizer v.1.7 as used
+				// e.g. in Windows~98".
+				version, flags = 35, EngineGrayscale
+			}
+			if selector&(1<<0) != 0 {
+				res |= version & 0xff
+			}
+			// Selector bits 5 and above query the EngineFlags, and the
+			// results are 7 bits higher. We set no bits for rotated or
+			// stretched glyphs (selector bits 1 and 2), as we do not support
+			// them.
+			for i := uint(0); i < numEngineFlags; i++ {
+				if selector&(1<<(5+i)) != 0 && flags&(1<<i) != 0 {
+					res |= 1 << (12 + i)
+				}
+			}
 			h.stack[top-1] = res
 
-		case opIDEF:
-			// IDEF is for ancient versions of the bytecode interpreter, and is no longer used.
-			return errors.New("truetype: hinting: unsupported IDEF instruction")
-
 		case opROLL:
 			h.stack[top-1], h.stack[top-3], h.stack[top-2] =
 				h.stack[top-3], h.stack[top-2], h.stack[top-1]
@@ -1096,21 +1474,46 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			}
 
 		case opSCANTYPE:
-			// We do not support dropout control, as we always rasterize grayscale glyphs.
 			top--
+			if x := h.stack[top]; x >= 0 {
+				h.gs.scanType = x & 0xffff
+			}
 
 		case opINSTCTRL:
-			// TODO: support instruction execution control? It seems rare, and even when
-			// nominally used (e.g. Source Sans Pro), it seems conditional on extreme or
-			// unusual rasterization conditions. For example, the code snippet at
-			// https://developer.apple.com/fonts/TTRefMan/RM05/Chap5.html#INSTCTRL
-			// uses INSTCTRL when grid-fitting a rotated or stretched glyph, but
-			// freetype-go does not support rotated or stretched glyphs.
 			top -= 2
+			selector, value := h.stack[top+1], h.stack[top]
+			// As per C Freetype, INSTCTRL is ignored with an invalid selector,
+			// and outside of the prep.
+			if selector < 1 || 3 < selector {
+				h.font.warn("truetype: hinting: ignoring INSTCTRL with an invalid selector", "selector", selector)
+				break
+			}
+			if !h.inPrep {
+				h.font.warn("truetype: hinting: ignoring INSTCTRL outside of the prep program")
+				break
+			}
+			// Selector n controls flag bit n-1.
+			flag := int32(1) << uint(selector-1)
+			if value != 0 {
+				value = flag
+			}
+			h.gs.instructControl = h.gs.instructControl&^flag | value
 
 		default:
 			if opcode < opPUSHB000 {
-				return errors.New("truetype: hinting: unrecognized instruction")
+				// An unrecognized opcode may have been defined by IDEF, in
+				// which case it is called like a function.
+				f, ok := h.instructions[opcode]
+				if !ok {
+					return HintingError{PC: pc, Opcode: opcode, Reason: "unrecognized instruction"}
+				}
+				if callStackTop >= len(callStack) {
+					return HintingError{PC: pc, Opcode: opcode, Reason: "call stack overflow"}
+				}
+				callStack[callStackTop] = callStackEntry{program, pc, 1, -1, time.Time{}}
+				callStackTop++
+				program, pc = f, 0
+				continue
 			}
 
 			if opcode < opMDRP00000 {
@@ -1132,17 +1535,23 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 				ref := h.point(0, current, h.gs.rp[0])
 				p := h.point(1, current, i)
 				if ref == nil || p == nil {
-					return errors.New("truetype: hinting: point out of range")
+					return h.pointError(pc, opcode)
 				}
 
 				oldDist := f26dot6(0)
 				if h.gs.zp[0] == 0 || h.gs.zp[1] == 0 {
 					p0 := h.point(1, unhinted, i)
 					p1 := h.point(0, unhinted, h.gs.rp[0])
+					if p0 == nil || p1 == nil {
+						return h.pointError(pc, opcode)
+					}
 					oldDist = dotProduct(f26dot6(p0.X-p1.X), f26dot6(p0.Y-p1.Y), h.gs.dv)
 				} else {
 					p0 := h.point(1, inFontUnits, i)
 					p1 := h.point(0, inFontUnits, h.gs.rp[0])
+					if p0 == nil || p1 == nil {
+						return h.pointError(pc, opcode)
+					}
 					oldDist = dotProduct(f26dot6(p0.X-p1.X), f26dot6(p0.Y-p1.Y), h.gs.dv)
 					oldDist = f26dot6(h.font.scale(h.scale * int32(oldDist)))
 				}
@@ -1201,23 +1610,31 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 					}
 				}
 
-				if h.gs.zp[1] == 0 {
-					// TODO: implement once we have a .ttf file that triggers
-					// this, so that we can step through C's freetype.
-					return errors.New("truetype: hinting: unimplemented twilight point adjustment")
+				if h.gs.zp[1] == twilightZone {
+					// As per C Freetype, a twilight point's original position
+					// is set to be the CVT distance from the reference point,
+					// along the freedom vector.
+					ref := h.point(0, unhinted, h.gs.rp[0])
+					p := h.point(1, unhinted, i)
+					if ref == nil || p == nil {
+						return h.pointError(pc, opcode)
+					}
+					p.X = ref.X + int32((int64(cvtDist)*int64(h.gs.fv[0]))>>14)
+					p.Y = ref.Y + int32((int64(cvtDist)*int64(h.gs.fv[1]))>>14)
+					h.points[twilightZone][current][i] = *p
 				}
 
 				ref := h.point(0, unhinted, h.gs.rp[0])
 				p := h.point(1, unhinted, i)
 				if ref == nil || p == nil {
-					return errors.New("truetype: hinting: point out of range")
+					return h.pointError(pc, opcode)
 				}
 				oldDist := dotProduct(f26dot6(p.X-ref.X), f26dot6(p.Y-ref.Y), h.gs.dv)
 
 				ref = h.point(0, current, h.gs.rp[0])
 				p = h.point(1, current, i)
 				if ref == nil || p == nil {
-					return errors.New("truetype: hinting: point out of range")
+					return h.pointError(pc, opcode)
 				}
 				curDist := dotProduct(f26dot6(p.X-ref.X), f26dot6(p.Y-ref.Y), h.gs.pv)
 
@@ -1274,7 +1691,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			for depth := 0; ; {
 				pc++
 				if pc >= len(program) {
-					return errors.New("truetype: hinting: unbalanced IF or ELSE")
+					return HintingError{PC: pc, Opcode: opcode, Reason: "unbalanced IF or ELSE"}
 				}
 				switch program[pc] {
 				case opIF:
@@ -1292,7 +1709,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 					var ok bool
 					pc, ok = skipInstructionPayload(program, pc)
 					if !ok {
-						return errors.New("truetype: hinting: unbalanced IF or ELSE")
+						return HintingError{PC: pc, Opcode: opcode, Reason: "unbalanced IF or ELSE"}
 					}
 				}
 			}
@@ -1314,16 +1731,16 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			if opcode == 0 {
 				pc++
 				if pc >= len(program) {
-					return errors.New("truetype: hinting: insufficient data")
+					return HintingError{PC: pc, Opcode: opcode, Reason: "insufficient data"}
 				}
 				opcode = program[pc]
 			}
 			pc++
 			if top+int(opcode) > len(h.stack) {
-				return errors.New("truetype: hinting: stack overflow")
+				return LimitError("maxStackElements")
 			}
 			if pc+width*int(opcode) > len(program) {
-				return errors.New("truetype: hinting: insufficient data")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "insufficient data"}
 			}
 			for ; opcode > 0; opcode-- {
 				if width == 1 {
@@ -1345,7 +1762,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 			top--
 			n := h.stack[top]
 			if int32(top) < 2*n {
-				return errors.New("truetype: hinting: stack underflow")
+				return HintingError{PC: pc, Opcode: opcode, Reason: "stack underflow"}
 			}
 			for ; n > 0; n-- {
 				top -= 2
@@ -1358,7 +1775,7 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 					c += 32
 				}
 				c += h.gs.deltaBase
-				if ppem := (h.scale + 1<<5) >> 6; ppem != c {
+				if ppem := (h.projectedScale() + 1<<5) >> 6; ppem != c {
 					continue
 				}
 				b = (b & 0x0f) - 8
@@ -1369,13 +1786,19 @@ func (h *hinter) run(program []byte, pCurrent, pUnhinted, pInFontUnits []Point,
 				if opcode >= opDELTAC1 {
 					a := h.stack[top+1]
 					if a < 0 || len(h.scaledCVT) <= int(a) {
-						return errors.New("truetype: hinting: index out of range")
+						return HintingError{PC: pc, Opcode: opcode, Reason: "index out of range"}
 					}
-					h.scaledCVT[a] += f26dot6(b)
+					h.scaledCVT[a] += h.unstretchCVT(f26dot6(b))
 				} else {
 					p := h.point(0, current, h.stack[top+1])
 					if p == nil {
-						return errors.New("truetype: hinting: point out of range")
+						return h.pointError(pc, opcode)
+					}
+					// As per C Freetype, in backward compatibility mode, only
+					// points that have already been moved vertically can be
+					// delta'd.
+					if h.backwardCompatibility() && p.Flags&flagTouchedY == 0 {
+						continue
 					}
 					h.move(p, f26dot6(b), true)
 				}
@@ -1411,7 +1834,37 @@ func (h *hinter) getScaledCVT(i int32) f26dot6 {
 	if i < 0 || len(h.scaledCVT) <= int(i) {
 		return 0
 	}
-	return h.scaledCVT[i]
+	return h.stretchCVT(h.scaledCVT[i])
+}
+
+// projectedScale returns the scale along the projection vector. It differs
+// from scale when glyphs are scaled horizontally, in which case, as per C
+// Freetype, the pixels per em and the CVT values, which are scaled by the
+// vertical scale, are stretched along the projection vector.
+func (h *hinter) projectedScale() int32 {
+	if h.xScale == h.scale || h.xScale == 0 {
+		return h.scale
+	}
+	x := float64(h.gs.pv[0]) * float64(h.xScale)
+	y := float64(h.gs.pv[1]) * float64(h.scale)
+	return int32(math.Hypot(x, y)/0x4000 + 0.5)
+}
+
+// stretchCVT converts a CVT value from the vertical scale to the scale along
+// the projection vector.
+func (h *hinter) stretchCVT(v f26dot6) f26dot6 {
+	if s := h.projectedScale(); s != h.scale && h.scale != 0 {
+		return f26dot6(int64(v) * int64(s) / int64(h.scale))
+	}
+	return v
+}
+
+// unstretchCVT is the inverse of stretchCVT.
+func (h *hinter) unstretchCVT(v f26dot6) f26dot6 {
+	if s := h.projectedScale(); s != h.scale && s != 0 {
+		return f26dot6(int64(v) * int64(h.scale) / int64(s))
+	}
+	return v
 }
 
 // setScaledCVT overrides the scaled value from the font's Control Value Table.
@@ -1428,16 +1881,85 @@ func (h *hinter) setScaledCVT(i int32, v f26dot6) {
 func (h *hinter) point(zonePointer uint32, pt pointType, i int32) *Point {
 	points := h.points[h.gs.zp[zonePointer]][pt]
 	if i < 0 || len(points) <= int(i) {
+		h.twilightOutOfRange = h.gs.zp[zonePointer] == twilightZone && i >= 0
 		return nil
 	}
 	return &points[i]
 }
 
+// checkFunctionDef returns an error if defining the function with the given
+// number exceeds the font's maxFunctionDefs. For a Strict font, the number
+// must be less than maxFunctionDefs. Otherwise, as for C Freetype, it is the
+// number of functions defined that must not exceed maxFunctionDefs, and the
+// number must fit in 16 bits.
+func (h *hinter) checkFunctionDef(x int32) error {
+	if h.font.strictness == Strict {
+		if x >= int32(h.font.maxFunctionDefs) {
+			return LimitError("maxFunctionDefs")
+		}
+		return nil
+	}
+	if _, ok := h.functions[x]; !ok && (len(h.functions) >= int(h.font.maxFunctionDefs) || x > 0xffff) {
+		return LimitError("maxFunctionDefs")
+	}
+	return nil
+}
+
+// checkStorage returns an error if the storage area index i, which is
+// non-negative, exceeds a Strict font's maxStorage. For other fonts, as for
+// C Freetype, writing past the end of the storage area is ignored, and
+// reading past it reads zero.
+func (h *hinter) checkStorage(i int) error {
+	if h.font.strictness == Strict && i >= int(h.font.maxStorage) {
+		return LimitError("maxStorage")
+	}
+	return nil
+}
+
+// pointError returns the error for a point index that is out of range, used
+// by the instruction at pc. An index past the end of the twilight zone
+// exceeds the font's maxTwilightPoints.
+func (h *hinter) pointError(pc int, opcode uint8) error {
+	if h.twilightOutOfRange {
+		h.twilightOutOfRange = false
+		return LimitError("maxTwilightPoints")
+	}
+	return HintingError{PC: pc, Opcode: opcode, Reason: "point out of range"}
+}
+
+// backwardCompatibility returns whether the hinter is in the subpixel hinting
+// mode's backward compatibility mode, for fonts that are not written for
+// ClearType. As per C Freetype's version 40 interpreter, that mode ignores
+// moves in the x direction, and any moves after the glyph program has
+// interpolated untouched points in both directions, as such fonts' x
+// direction hints distort glyphs that are rendered with subpixel precision.
+func (h *hinter) backwardCompatibility() bool {
+	return h.subpixel && !h.inPrep && h.gs.instructControl&instructControlNativeClearType == 0
+}
+
+// verticalOnly returns whether moves in the x direction are ignored, as for
+// VerticalHinting. The font's prep bytecode, which can only move twilight
+// points, is unaffected.
+func (h *hinter) verticalOnly() bool {
+	return h.vertical && !h.inPrep
+}
+
 func (h *hinter) move(p *Point, distance f26dot6, touch bool) {
+	// In backward compatibility mode, points are still marked as touched,
+	// so that IUP does not interpolate them, but they do not move in x.
+	moveX, moveY := true, true
+	if h.backwardCompatibility() {
+		moveX, moveY = false, !(h.iupXCalled && h.iupYCalled)
+	} else if h.verticalOnly() {
+		moveX = false
+	}
+
 	fvx := int64(h.gs.fv[0])
 	pvx := int64(h.gs.pv[0])
 	if fvx == 0x4000 && pvx == 0x4000 {
-		p.X += int32(distance)
+		if moveX {
+			p.X += int32(distance)
+		}
 		if touch {
 			p.Flags |= flagTouchedX
 		}
@@ -1447,7 +1969,9 @@ func (h *hinter) move(p *Point, distance f26dot6, touch bool) {
 	fvy := int64(h.gs.fv[1])
 	pvy := int64(h.gs.pv[1])
 	if fvy == 0x4000 && pvy == 0x4000 {
-		p.Y += int32(distance)
+		if moveY {
+			p.Y += int32(distance)
+		}
 		if touch {
 			p.Flags |= flagTouchedY
 		}
@@ -1455,16 +1979,25 @@ func (h *hinter) move(p *Point, distance f26dot6, touch bool) {
 	}
 
 	fvDotPv := (fvx*pvx + fvy*pvy) >> 14
+	if -0x400 < fvDotPv && fvDotPv < 0x400 {
+		// As per C Freetype, nearly orthogonal freedom and projection vectors
+		// are treated as parallel, instead of dividing by a tiny number.
+		fvDotPv = 0x4000
+	}
 
 	if fvx != 0 {
-		p.X += int32(mulDiv(fvx, int64(distance), fvDotPv))
+		if moveX {
+			p.X += int32(mulDiv(fvx, int64(distance), fvDotPv))
+		}
 		if touch {
 			p.Flags |= flagTouchedX
 		}
 	}
 
 	if fvy != 0 {
-		p.Y += int32(mulDiv(fvy, int64(distance), fvDotPv))
+		if moveY {
+			p.Y += int32(mulDiv(fvy, int64(distance), fvDotPv))
+		}
 		if touch {
 			p.Flags |= flagTouchedY
 		}
@@ -1723,15 +2256,46 @@ func mulDiv(x, y, z int64) int64 {
 	return xy / z
 }
 
+// engineCompensation is the compensation for the engine characteristics,
+// indexed by distance type (gray, black, white and a reserved fourth type).
+// The spec says to add one of these to a distance when rounding, to cater for
+// things like "different dot-size printers".
+// https://developer.apple.com/fonts/TTRefMan/RM02/Chap2.html#engine_compensation
+// Like C Freetype, we assume an ideal output device, where all of the
+// compensations are zero, as we don't expect to be used to output on
+// dot-matrix printers.
+var engineCompensation = [4]f26dot6{}
+
+// compensate adds the engine compensation c to the magnitude of x, without
+// changing x's sign. It implements the NROUND instruction.
+func compensate(x, c f26dot6) f26dot6 {
+	if x >= 0 {
+		if x += c; x < 0 {
+			x = 0
+		}
+		return x
+	}
+	if x -= c; x > 0 {
+		x = 0
+	}
+	return x
+}
+
 // round rounds the given number. The rounding algorithm is described at
 // https://developer.apple.com/fonts/TTRefMan/RM02/Chap2.html#rounding
 func (h *hinter) round(x f26dot6) f26dot6 {
+	return h.roundCompensated(x, 0)
+}
+
+// roundCompensated is like round, except that the engine compensation c is
+// added to the magnitude of x before rounding.
+func (h *hinter) roundCompensated(x, c f26dot6) f26dot6 {
 	if h.gs.roundPeriod == 0 {
 		// Rounding is off.
-		return x
+		return compensate(x, c)
 	}
 	if x >= 0 {
-		ret := x - h.gs.roundPhase + h.gs.roundThreshold
+		ret := x + c - h.gs.roundPhase + h.gs.roundThreshold
 		if h.gs.roundSuper45 {
 			ret /= h.gs.roundPeriod
 			ret *= h.gs.roundPeriod
@@ -1743,7 +2307,7 @@ func (h *hinter) round(x f26dot6) f26dot6 {
 		}
 		return ret + h.gs.roundPhase
 	}
-	ret := -x - h.gs.roundPhase + h.gs.roundThreshold
+	ret := -x + c - h.gs.roundPhase + h.gs.roundThreshold
 	if h.gs.roundSuper45 {
 		ret /= h.gs.roundPeriod
 		ret *= h.gs.roundPeriod
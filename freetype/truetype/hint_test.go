@@ -6,6 +6,7 @@
 package truetype
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
@@ -505,6 +506,245 @@ func TestBytecode(t *testing.T) {
 			[]int32{-2, -5},
 			"",
 		},
+		{
+			"rounding",
+			[]byte{
+				opPUSHB000, // [96]
+				96,
+				opRTG,
+				opROUND00,  // [128]
+				opPUSHB000, // [128, 10]
+				10,
+				opRTHG,
+				opROUND01,  // [128, 32]
+				opPUSHB000, // [128, 32, 50]
+				50,
+				opRTDG,
+				opROUND10,  // [128, 32, 64]
+				opPUSHB000, // [128, 32, 64, 65]
+				65,
+				opRUTG,
+				opROUND11,  // [128, 32, 64, 128]
+				opPUSHB000, // [128, 32, 64, 128, 127]
+				127,
+				opRDTG,
+				opROUND00,  // [128, 32, 64, 128, 64]
+				opPUSHB000, // [128, 32, 64, 128, 64, 127]
+				127,
+				opROFF,
+				opROUND00,  // [128, 32, 64, 128, 64, 127]
+				opPUSHB001, // [128, 32, 64, 128, 64, 127, 100, 0x58]
+				100,
+				0x58,
+				opSROUND,   // [128, 32, 64, 128, 64, 127, 100]
+				opROUND00,  // [128, 32, 64, 128, 64, 127, 80]
+				opPUSHB001, // [128, 32, 64, 128, 64, 127, 80, 100, 0x48]
+				100,
+				0x48,
+				opS45ROUND, // [128, 32, 64, 128, 64, 127, 80, 100]
+				opROUND00,  // [128, 32, 64, 128, 64, 127, 80, 90]
+				opPUSHW000, // [128, 32, 64, 128, 64, 127, 80, 90, -100]
+				0xff,
+				0x9c,
+				opNROUND00, // [128, 32, 64, 128, 64, 127, 80, 90, -100]
+			},
+			[]int32{128, 32, 64, 128, 64, 127, 80, 90, -100},
+			"",
+		},
+		{
+			"twilight zone",
+			[]byte{
+				opPUSHB000, // [0]
+				0,
+				opSZPS,     // []
+				opSVTCA1,   // []
+				opPUSHB001, // [1, 100]
+				1,
+				100,
+				opMSIRP0,   // []
+				opPUSHB001, // [1, 1]
+				1,
+				1,
+				opGC0, // [1, 100]
+				opSWAP,
+				opGC1, // [100, 100]
+			},
+			[]int32{100, 100},
+			"",
+		},
+		{
+			"invalid zone pointer",
+			[]byte{
+				opPUSHB000, // [2]
+				2,
+				opSZP0,
+			},
+			nil,
+			"invalid data",
+		},
+		{
+			"shpix along a diagonal freedom vector",
+			[]byte{
+				opPUSHB000, // [0]
+				0,
+				opSZPS,     // []
+				opPUSHB001, // [1, 1]
+				1,
+				1,
+				opSFVFS,    // []
+				opPUSHB001, // [1, 64]
+				1,
+				64,
+				opSHPIX,    // []
+				opPUSHB000, // [1]
+				1,
+				opGC0, // [45]
+				opSVTCA0,
+				opPUSHB000, // [45, 1]
+				1,
+				opGC0, // [45, 45]
+			},
+			[]int32{45, 45},
+			"",
+		},
+		{
+			"shz invalid zone",
+			[]byte{
+				opPUSHB000, // [2]
+				2,
+				opSHZ0,
+			},
+			nil,
+			"invalid data",
+		},
+		{
+			"isect",
+			[]byte{
+				opPUSHB000, // [0]
+				0,
+				opSZPS,     // []
+				opSVTCA1,   // []
+				opPUSHB011, // [2, 128, 4, 128]
+				2,
+				128,
+				4,
+				128,
+				opSCFS,     // [2, 128]
+				opSCFS,     // []
+				opSVTCA0,   // []
+				opPUSHB011, // [2, 128, 3, 128]
+				2,
+				128,
+				3,
+				128,
+				opSCFS,     // [2, 128]
+				opSCFS,     // []
+				opSVTCA1,   // []
+				opPUSHB100, // [5, 1, 2, 3, 4]
+				5,
+				1,
+				2,
+				3,
+				4,
+				opISECT,    // []
+				opPUSHB000, // [5]
+				5,
+				opGC0,      // [64]
+				opSVTCA0,   // [64]
+				opPUSHB000, // [64, 5]
+				5,
+				opGC0, // [64, 64]
+			},
+			[]int32{64, 64},
+			"",
+		},
+		{
+			"alignpts",
+			[]byte{
+				opPUSHB000, // [0]
+				0,
+				opSZPS,     // []
+				opSVTCA1,   // []
+				opPUSHB011, // [2, 128, 4, 128]
+				2,
+				128,
+				4,
+				128,
+				opSCFS,     // [2, 128]
+				opSCFS,     // []
+				opSVTCA0,   // []
+				opPUSHB011, // [2, 128, 3, 128]
+				2,
+				128,
+				3,
+				128,
+				opSCFS,     // [2, 128]
+				opSCFS,     // []
+				opSVTCA1,   // []
+				opPUSHB001, // [1, 4]
+				1,
+				4,
+				opALIGNPTS, // []
+				opPUSHB001, // [1, 4]
+				1,
+				4,
+				opGC0, // [1, 64]
+				opSWAP,
+				opGC0, // [64, 64]
+			},
+			[]int32{64, 64},
+			"",
+		},
+		{
+			"alignrp",
+			[]byte{
+				opPUSHB000, // [0]
+				0,
+				opSZPS,     // []
+				opSVTCA1,   // []
+				opPUSHB011, // [2, 128, 4, 128]
+				2,
+				128,
+				4,
+				128,
+				opSCFS,     // [2, 128]
+				opSCFS,     // []
+				opSVTCA0,   // []
+				opPUSHB011, // [2, 128, 3, 128]
+				2,
+				128,
+				3,
+				128,
+				opSCFS,     // [2, 128]
+				opSCFS,     // []
+				opSVTCA1,   // []
+				opPUSHB011, // [3, 5, 2, 2]
+				3,
+				5,
+				2,
+				2,
+				opSRP0,     // [3, 5, 2]
+				opSLOOP,    // [3, 5]
+				opALIGNRP,  // []
+				opPUSHB001, // [3, 5]
+				3,
+				5,
+				opGC0, // [3, 128]
+				opSWAP,
+				opGC0, // [128, 128]
+			},
+			[]int32{128, 128},
+			"",
+		},
+		{
+			"mppem and mps",
+			[]byte{
+				opMPPEM, // [12]
+				opMPS,   // [12, 12]
+			},
+			[]int32{12, 12},
+			"",
+		},
 		{
 			"functions",
 			[]byte{
@@ -551,13 +791,56 @@ func TestBytecode(t *testing.T) {
 			[]int32{99, 99, 99, 99, 20, 20},
 			"",
 		},
+		{
+			"idef",
+			[]byte{
+				opPUSHB000, // [0x91]
+				0x91,
+				opIDEF, // Instruction 0x91 doubles the top of the stack.
+				opDUP,
+				opADD,
+				opENDF,
+				opPUSHB000, // [3]
+				3,
+				0x91, // [6]
+				0x91, // [12]
+			},
+			[]int32{12},
+			"",
+		},
+		{
+			"idef invalid opcode",
+			[]byte{
+				opPUSHW000, // [256]
+				1,
+				0,
+				opIDEF,
+				opENDF,
+			},
+			[]int32{},
+			"invalid data",
+		},
+		{
+			"undefined instruction",
+			[]byte{
+				opPUSHB000, // [0x91]
+				0x91,
+				opIDEF,
+				opENDF,
+				0x92,
+			},
+			[]int32{},
+			"unrecognized instruction",
+		},
 	}
 
 	for _, tc := range testCases {
 		h := &hinter{}
 		h.init(&Font{
-			maxStorage:       32,
-			maxStackElements: 100,
+			maxStorage:        32,
+			maxFunctionDefs:   8,
+			maxStackElements:  100,
+			maxTwilightPoints: 8,
 		}, 768)
 		err, errStr := h.run(tc.prog, nil, nil, nil, nil), ""
 		if err != nil {
@@ -583,6 +866,469 @@ func TestBytecode(t *testing.T) {
 	}
 }
 
+// TestMPPEMAndMPS tests that MPPEM pushes the rounded number of pixels per
+// em, and MPS the point size.
+func TestMPPEMAndMPS(t *testing.T) {
+	h := &hinter{}
+	// A 9 point font at 96 DPI is 12 pixels per em. A scale of 760 is 11.875
+	// pixels per em, which rounds to 12.
+	h.pointSize = 9 << 6
+	if err := h.init(&Font{maxStackElements: 100}, 760); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.run([]byte{opMPPEM, opMPS}, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := h.stack[:2], []int32{12, 9 << 6}; got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestInstructionAndScanControl(t *testing.T) {
+	h := &hinter{}
+	f := &Font{
+		maxStackElements: 100,
+		prep: []byte{
+			opPUSHB001, // [1, 1]
+			1,
+			1,
+			opINSTCTRL, // []
+			opPUSHW000, // [0x110]
+			0x01,
+			0x10,
+			opSCANCTRL, // []
+			opPUSHB000, // [2]
+			2,
+			opSCANTYPE, // []
+		},
+	}
+	if err := h.init(f, 768); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := h.defaultGS.instructControl, int32(instructControlInhibitGridFit); got != want {
+		t.Errorf("instructControl: got %d, want %d", got, want)
+	}
+	if !h.defaultGS.scanControl {
+		t.Errorf("scanControl: got false, want true")
+	}
+	if got, want := h.defaultGS.scanType, int32(2); got != want {
+		t.Errorf("scanType: got %d, want %d", got, want)
+	}
+
+	// INSTCTRL is ignored outside of the prep.
+	if err := h.run([]byte{opPUSHB001, 0, 1, opINSTCTRL}, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := h.gs.instructControl, int32(instructControlInhibitGridFit); got != want {
+		t.Errorf("instructControl after glyph program: got %d, want %d", got, want)
+	}
+
+	// Selector 3, as in a ClearType font's prep, sets flag 4 and not the
+	// flags of selectors 1 and 2. Selector 4 is invalid, and ignored.
+	f.prep = []byte{
+		opPUSHB011, // [4, 3, 1, 4]
+		4,
+		3,
+		1,
+		4,
+		opINSTCTRL, // [4, 3]
+		opINSTCTRL, // []
+	}
+	h = &hinter{}
+	if err := h.init(f, 768); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := h.defaultGS.instructControl, int32(instructControlNativeClearType); got != want {
+		t.Errorf("ClearType instructControl: got %d, want %d", got, want)
+	}
+}
+
+func TestSizeCache(t *testing.T) {
+	// The prep program counts the number of times that it has been run at
+	// each size, in storage location 0.
+	h := &hinter{}
+	f := &Font{
+		maxStorage:       1,
+		maxStackElements: 100,
+		prep: []byte{
+			opPUSHB001, // [0, 0]
+			0,
+			0,
+			opRS,       // [0, count]
+			opPUSHB000, // [0, count, 1]
+			1,
+			opADD, // [0, count+1]
+			opWS,  // []
+		},
+	}
+	for _, scale := range []int32{768, 1024, 768, 1024, 768} {
+		if err := h.init(f, scale); err != nil {
+			t.Fatal(err)
+		}
+		if got := h.store[0]; got != 1 {
+			t.Errorf("scale %d: prep run %d times, want 1", scale, got)
+		}
+	}
+}
+
+func TestTrace(t *testing.T) {
+	var got []string
+	h := &hinter{}
+	h.opts.Trace = func(e *TraceEvent) {
+		got = append(got, fmt.Sprintf("%d:%#02x:%v", e.PC, e.Opcode, e.Stack))
+	}
+	if err := h.init(&Font{maxStackElements: 100}, 768); err != nil {
+		t.Fatal(err)
+	}
+	prog := []byte{
+		opPUSHB001, // [7, 3]
+		7,
+		3,
+		opADD, // [10]
+		opDUP, // [10, 10]
+	}
+	if err := h.run(prog, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"0:0xb1:[]",
+		"3:0x60:[7 3]",
+		"4:0x20:[10]",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestProfile(t *testing.T) {
+	p := &Profile{}
+	h := &hinter{}
+	h.opts.Profile = p
+	if err := h.init(&Font{maxFunctionDefs: 2, maxStackElements: 100}, 768); err != nil {
+		t.Fatal(err)
+	}
+	prog := []byte{
+		opPUSHB000, // [1]
+		1,
+		opFDEF, // Function #1 calls function #0.
+		opPUSHB000,
+		0,
+		opCALL,
+		opENDF,
+		opPUSHB000, // [0]
+		0,
+		opFDEF, // Function #0 does nothing.
+		opENDF,
+		opPUSHB001, // [3, 1]
+		3,
+		1,
+		opLOOPCALL,
+	}
+	if err := h.run(prog, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	// The PUSHB000 is run 2 times at the top level and 3 times in function #1.
+	if got, want := p.Opcodes[opPUSHB000], uint64(5); got != want {
+		t.Errorf("PUSHB[0] count: got %d, want %d", got, want)
+	}
+	if got, want := p.Opcodes[opENDF], uint64(6); got != want {
+		t.Errorf("ENDF count: got %d, want %d", got, want)
+	}
+	if got, want := p.Steps(), uint64(5+2+1+1+3+6); got != want {
+		t.Errorf("steps: got %d, want %d", got, want)
+	}
+	for f, want := range map[int32]uint64{0: 3, 1: 3} {
+		if fp := p.Functions[f]; fp == nil || fp.Calls != want {
+			t.Errorf("function #%d: got %+v, want %d calls", f, fp, want)
+		}
+	}
+	p.Reset()
+	if p.Steps() != 0 || p.Functions != nil {
+		t.Errorf("Reset: got %+v", p)
+	}
+}
+
+func TestGETINFO(t *testing.T) {
+	testCases := []struct {
+		opts     HinterOptions
+		selector int32
+		want     int32
+	}{
+		{HinterOptions{}, 1, 35},
+		{HinterOptions{}, 1<<5 | 1, 1<<12 | 35},
+		{HinterOptions{}, 1 << 6, 0},
+		{HinterOptions{EngineVersion: 40, EngineFlags: EngineGrayscale | EngineSubpixel}, 1, 40},
+		{HinterOptions{EngineVersion: 40, EngineFlags: EngineSubpixel}, 1<<6 | 1<<5, 1 << 13},
+		{HinterOptions{EngineVersion: 38, EngineFlags: EngineSubpixel | EngineBGR}, 1<<9 | 1, 1<<16 | 38},
+	}
+	for i, tc := range testCases {
+		h := &hinter{opts: tc.opts}
+		if err := h.init(&Font{maxStackElements: 100}, 768); err != nil {
+			t.Fatal(err)
+		}
+		prog := []byte{opPUSHW000, byte(tc.selector >> 8), byte(tc.selector), opGETINFO}
+		if err := h.run(prog, nil, nil, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+		if got := h.stack[0]; got != tc.want {
+			t.Errorf("#%d: got %#x, want %#x", i, got, tc.want)
+		}
+	}
+}
+
+func TestSubpixelHinting(t *testing.T) {
+	// Each program rounds point 0 to the grid with MDAP[1], in the x
+	// direction (SVTCA[1]) or y direction (SVTCA[0]), possibly after
+	// interpolating untouched points in both directions with IUP.
+	iup := []byte{opIUP0, opIUP1}
+	testCases := []struct {
+		desc         string
+		subpixel     bool
+		nativeCT     bool
+		prog         []byte
+		wantX, wantY int32
+	}{
+		{"full x", false, false, []byte{opSVTCA1, opPUSHB000, 0, opMDAP1}, 0, 10},
+		{"full y", false, false, []byte{opSVTCA0, opPUSHB000, 0, opMDAP1}, 10, 0},
+		{"subpixel x", true, false, []byte{opSVTCA1, opPUSHB000, 0, opMDAP1}, 10, 10},
+		{"subpixel y", true, false, []byte{opSVTCA0, opPUSHB000, 0, opMDAP1}, 10, 0},
+		{"subpixel y after iup", true, false, append(iup, opSVTCA0, opPUSHB000, 0, opMDAP1), 10, 10},
+		{"full y after iup", false, false, append(iup, opSVTCA0, opPUSHB000, 0, opMDAP1), 10, 0},
+		{"native ClearType x", true, true, []byte{opSVTCA1, opPUSHB000, 0, opMDAP1}, 0, 10},
+	}
+	for _, tc := range testCases {
+		h := &hinter{subpixel: tc.subpixel}
+		if err := h.init(&Font{maxStackElements: 100}, 768); err != nil {
+			t.Fatal(err)
+		}
+		if tc.nativeCT {
+			h.defaultGS.instructControl = instructControlNativeClearType
+		}
+		points := []Point{{X: 10, Y: 10}, {X: 200, Y: 200}}
+		unhinted := append([]Point(nil), points...)
+		inFontUnits := append([]Point(nil), points...)
+		if err := h.run(tc.prog, points, unhinted, inFontUnits, []int{2}); err != nil {
+			t.Errorf("%s: %v", tc.desc, err)
+			continue
+		}
+		if p := points[0]; p.X != tc.wantX || p.Y != tc.wantY {
+			t.Errorf("%s: got (%d, %d), want (%d, %d)", tc.desc, p.X, p.Y, tc.wantX, tc.wantY)
+		}
+	}
+
+	// GETINFO reports the version 40 interpreter and ClearType.
+	h := &hinter{subpixel: true}
+	if err := h.init(&Font{maxStackElements: 100}, 768); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.run([]byte{opPUSHB000, 1<<6 | 1, opGETINFO}, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := h.stack[0], int32(1<<13|40); got != want {
+		t.Errorf("GETINFO: got %#x, want %#x", got, want)
+	}
+}
+
+func TestHinterLimits(t *testing.T) {
+	// callDepth3 calls function #2, which calls #1, which calls #0.
+	callDepth3 := []byte{
+		opPUSHB000, 0, opFDEF, opENDF,
+		opPUSHB000, 1, opFDEF, opPUSHB000, 0, opCALL, opENDF,
+		opPUSHB000, 2, opFDEF, opPUSHB000, 1, opCALL, opENDF,
+		opPUSHB000, 2, opCALL,
+	}
+	// recursion10 calls function #0, which calls itself until the count on
+	// the stack, initially 10, is 0.
+	recursion10 := []byte{
+		opPUSHB000, 0, opFDEF, opDUP, opIF, opPUSHB000, 1, opSUB, opPUSHB000, 0, opCALL, opEIF, opENDF,
+		opPUSHB001, 10, 0, opCALL,
+	}
+	testCases := []struct {
+		desc   string
+		opts   HinterOptions
+		prog   []byte
+		errStr string
+	}{
+		{"steps ok", HinterOptions{MaxSteps: 4}, []byte{opPUSHB000, 1, opPOP, opPUSHB000, 1, opPOP}, ""},
+		{"too many steps", HinterOptions{MaxSteps: 3}, []byte{opPUSHB000, 1, opPOP, opPUSHB000, 1, opPOP}, "too many steps"},
+		{"call depth ok", HinterOptions{MaxCallDepth: 3}, callDepth3, ""},
+		{"call depth default", HinterOptions{}, callDepth3, ""},
+		{"call stack overflow", HinterOptions{MaxCallDepth: 2}, callDepth3, "call stack overflow"},
+		{"recursion deeper than maxp's definitions", HinterOptions{}, recursion10, ""},
+		{"recursion too deep", HinterOptions{MaxCallDepth: 10}, recursion10, "call stack overflow"},
+		{"program size ok", HinterOptions{MaxProgramSize: 3}, []byte{opPUSHB000, 1, opPOP}, ""},
+		{"program too long", HinterOptions{MaxProgramSize: 2}, []byte{opPUSHB000, 1, opPOP}, "too many instructions"},
+	}
+	for _, tc := range testCases {
+		h := &hinter{opts: tc.opts}
+		if err := h.init(&Font{maxFunctionDefs: 3, maxStackElements: 100}, 768); err != nil {
+			t.Fatal(err)
+		}
+		err, errStr := h.run(tc.prog, nil, nil, nil, nil), ""
+		if err != nil {
+			errStr = err.Error()
+		}
+		if tc.errStr == "" {
+			if errStr != "" {
+				t.Errorf("%s: got error %q, want none", tc.desc, errStr)
+			}
+		} else if !strings.Contains(errStr, tc.errStr) {
+			t.Errorf("%s: got error %q, want one containing %q", tc.desc, errStr, tc.errStr)
+		}
+	}
+}
+
+func TestHintingError(t *testing.T) {
+	h := &hinter{}
+	if err := h.init(&Font{maxStackElements: 100}, 768); err != nil {
+		t.Fatal(err)
+	}
+	err := h.run([]byte{opPUSHB000, 1, opPOP, opPOP}, nil, nil, nil, nil)
+	want := HintingError{PC: 3, Opcode: opPOP, Reason: "stack underflow"}
+	if err != want {
+		t.Errorf("got %#v, want %#v", err, want)
+	}
+}
+
+func TestHinterStackSizes(t *testing.T) {
+	testCases := []struct {
+		desc                     string
+		opts                     HinterOptions
+		font                     Font
+		wantStack, wantCallDepth int
+	}{
+		{"empty maxp", HinterOptions{}, Font{}, 32, DefaultMaxCallDepth},
+		{"small font", HinterOptions{}, Font{maxStackElements: 100, maxFunctionDefs: 3}, 132, DefaultMaxCallDepth},
+		{"idefs", HinterOptions{}, Font{maxFunctionDefs: 3, maxInstructionDefs: 2}, 32, DefaultMaxCallDepth},
+		{"deep stack", HinterOptions{}, Font{maxStackElements: 4000, maxFunctionDefs: 200}, 4032, DefaultMaxCallDepth},
+		{"capped call depth", HinterOptions{MaxCallDepth: 8}, Font{maxFunctionDefs: 200}, 32, 8},
+	}
+	for _, tc := range testCases {
+		h := &hinter{opts: tc.opts}
+		if err := h.init(&tc.font, 768); err != nil {
+			t.Errorf("%s: init: %v", tc.desc, err)
+			continue
+		}
+		if got := len(h.stack); got != tc.wantStack {
+			t.Errorf("%s: stack size: got %d, want %d", tc.desc, got, tc.wantStack)
+		}
+		if got := len(h.callStack); got != tc.wantCallDepth {
+			t.Errorf("%s: call depth: got %d, want %d", tc.desc, got, tc.wantCallDepth)
+		}
+	}
+
+	// Re-initializing a hinter for a smaller font shrinks its stack.
+	h := &hinter{}
+	if err := h.init(&Font{maxStackElements: 4000}, 768); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.init(&Font{maxStackElements: 10}, 768); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(h.stack), 42; got != want {
+		t.Errorf("stack size after font change: got %d, want %d", got, want)
+	}
+
+	// A font that declares two function definitions cannot nest three calls,
+	// such as by recursion.
+	recursion := []byte{
+		opPUSHB000, 0, opFDEF, opPUSHB000, 1, opCALL, opENDF,
+		opPUSHB000, 1, opFDEF, opPUSHB000, 0, opCALL, opENDF,
+		opPUSHB000, 0, opCALL,
+	}
+	if err := h.init(&Font{maxStackElements: 10, maxFunctionDefs: 2}, 768); err != nil {
+		t.Fatal(err)
+	}
+	err := h.run(recursion, nil, nil, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "call stack overflow") {
+		t.Errorf("maxFunctionDefs=2: got error %v, want call stack overflow", err)
+	}
+}
+
+func TestMaxpLimits(t *testing.T) {
+	f := Font{
+		maxTwilightPoints: 2,
+		maxStorage:        2,
+		maxFunctionDefs:   2,
+		maxStackElements:  4,
+	}
+	strict := f
+	strict.strictness = Strict
+	// pushN pushes n zeroes, one at a time.
+	pushN := func(n int) []byte {
+		var b []byte
+		for i := 0; i < n; i++ {
+			b = append(b, opPUSHB000, 0)
+		}
+		return b
+	}
+	testCases := []struct {
+		desc string
+		font *Font
+		prog []byte
+		want error
+		// read is the value read by a program that ends with RS.
+		read int32
+	}{
+		{"functions ok", &f, []byte{opPUSHB000, 1, opFDEF, opENDF}, nil, 0},
+		{"function number past maxFunctionDefs", &f, []byte{opPUSHB000, 9, opFDEF, opENDF}, nil, 0},
+		{"redefined function", &f, []byte{opPUSHB000, 9, opFDEF, opENDF, opPUSHB000, 0, opFDEF, opENDF, opPUSHB000, 9, opFDEF, opENDF}, nil, 0},
+		{"too many functions", &f, []byte{opPUSHB010, 0, 1, 2, opFDEF, opENDF, opFDEF, opENDF, opFDEF, opENDF}, LimitError("maxFunctionDefs"), 0},
+		{"strict functions ok", &strict, []byte{opPUSHB000, 1, opFDEF, opENDF}, nil, 0},
+		{"strict function number past maxFunctionDefs", &strict, []byte{opPUSHB000, 2, opFDEF, opENDF}, LimitError("maxFunctionDefs"), 0},
+		{"storage ok", &f, []byte{opPUSHB001, 1, 7, opWS, opPUSHB000, 1, opRS}, nil, 7},
+		// The storage area has slack for fonts that understate maxStorage.
+		{"storage slack", &f, []byte{opPUSHB001, 15, 7, opWS, opPUSHB000, 15, opRS}, nil, 7},
+		{"write past storage", &f, []byte{opPUSHB001, 200, 7, opWS}, nil, 0},
+		{"read past storage", &f, []byte{opPUSHB000, 200, opRS}, nil, 0},
+		{"strict storage ok", &strict, []byte{opPUSHB001, 1, 7, opWS}, nil, 0},
+		{"strict write past storage", &strict, []byte{opPUSHB001, 2, 7, opWS}, LimitError("maxStorage"), 0},
+		{"strict read past storage", &strict, []byte{opPUSHB000, 2, opRS}, LimitError("maxStorage"), 0},
+		{"stack ok", &f, pushN(4 + stackSlack), nil, 0},
+		{"stack overflow", &f, pushN(5 + stackSlack), LimitError("maxStackElements"), 0},
+		// The twilight zone has 4 points more than maxTwilightPoints.
+		{"twilight ok", &f, []byte{opPUSHB000, 0, opSZPS, opPUSHB000, 5, opMDAP0}, nil, 0},
+		{"past twilight", &f, []byte{opPUSHB000, 0, opSZPS, opPUSHB000, 6, opMDAP0}, LimitError("maxTwilightPoints"), 0},
+	}
+	for _, tc := range testCases {
+		h := &hinter{}
+		if err := h.init(tc.font, 768); err != nil {
+			t.Fatal(err)
+		}
+		if got := h.run(tc.prog, nil, nil, nil, nil); got != tc.want {
+			t.Errorf("%s: got error %v, want %v", tc.desc, got, tc.want)
+		}
+		if tc.want == nil && tc.prog[len(tc.prog)-1] == opRS && h.stack[0] != tc.read {
+			t.Errorf("%s: read %d, want %d", tc.desc, h.stack[0], tc.read)
+		}
+	}
+}
+
+func TestOpcode(t *testing.T) {
+	testCases := []struct {
+		opcode uint8
+		want   OpcodeInfo
+	}{
+		{opSVTCA1, OpcodeInfo{"SVTCA[1]", 0, 0, false, CategoryGraphicsState}},
+		{opGPV, OpcodeInfo{"GPV", 0, 2, false, CategoryGraphicsState}},
+		{opDUP, OpcodeInfo{"DUP", 1, 2, false, CategoryStack}},
+		{opNPUSHB, OpcodeInfo{"NPUSHB", 0, 0, true, CategoryPush}},
+		{opPUSHW011, OpcodeInfo{"PUSHW[011]", 0, 4, false, CategoryPush}},
+		{opSHP0, OpcodeInfo{"SHP[0]", 0, 0, true, CategoryOutline}},
+		{opMD1, OpcodeInfo{"MD[1]", 2, 1, false, CategoryMeasurement}},
+		{opDELTAC2, OpcodeInfo{"DELTAC2", 1, 0, true, CategoryException}},
+		{opROUND10, OpcodeInfo{"ROUND[10]", 1, 1, false, CategoryArithmetic}},
+		{opROLL, OpcodeInfo{"ROLL", 3, 3, false, CategoryStack}},
+		{opMIRP00101, OpcodeInfo{"MIRP[00101]", 2, 0, false, CategoryOutline}},
+		{op_0x91, OpcodeInfo{"", 0, 0, false, CategoryUndefined}},
+	}
+	for _, tc := range testCases {
+		if got := Opcode(tc.opcode); got != tc.want {
+			t.Errorf("opcode %#02x: got %+v, want %+v", tc.opcode, got, tc.want)
+		}
+	}
+}
+
 // TestMove tests that the hinter.move method matches the output of the C
 // Freetype implementation.
 func TestMove(t *testing.T) {
@@ -671,3 +1417,70 @@ func TestNormalize(t *testing.T) {
 		}
 	}
 }
+
+// glyphProgram returns the hinting program of the i'th glyph of f, or nil if
+// that glyph is empty or compound.
+func glyphProgram(f *Font, i Index) []byte {
+	glyf, err := f.glyphData(i)
+	if err != nil || len(glyf) < loadOffset || int16(u16(glyf, 0)) <= 0 {
+		return nil
+	}
+	offset := loadOffset + 2*int(u16(glyf, 0))
+	if offset+2 > len(glyf) {
+		return nil
+	}
+	n := int(u16(glyf, offset))
+	offset += 2
+	if offset+n > len(glyf) {
+		return nil
+	}
+	return glyf[offset : offset+n]
+}
+
+// FuzzHinter runs arbitrary programs as glyph programs, after running the
+// luxisr font's fpgm and prep programs, on glyph zones of arbitrary sizes. The
+// programs may fail, but must not panic or hang. The seed corpus is the font's
+// own programs. Run it with:
+//
+//	go test -run=NONE -fuzz=FuzzHinter
+func FuzzHinter(f *testing.F) {
+	font, testdataIsOptional, err := parseTestdataFont("luxisr")
+	if err != nil {
+		if testdataIsOptional {
+			f.Skip(err)
+		}
+		f.Fatal(err)
+	}
+	f.Add(font.fpgm, uint8(0), uint8(0))
+	f.Add(font.prep, uint8(0), uint8(0))
+	for i := Index(0); int(i) < font.nGlyph; i++ {
+		if program := glyphProgram(font, i); len(program) != 0 {
+			f.Add(program, uint8(64), uint8(4))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, program []byte, nPoints, nContours uint8) {
+		h := &hinter{}
+		if err := h.init(font, 768); err != nil {
+			t.Fatal(err)
+		}
+		// Like GlyphBuf.Load, add the four phantom points after the glyph's
+		// points, and divide the glyph's points into contours.
+		n := int(nPoints) + 4
+		current := make([]Point, n)
+		for i := range current {
+			current[i] = Point{X: int32(i%8) * 64, Y: int32(i/8) * 64, Flags: uint32(i % 2)}
+		}
+		unhinted := append([]Point(nil), current...)
+		inFontUnits := append([]Point(nil), current...)
+		var ends []int
+		if nPoints != 0 {
+			nc := int(nContours)%int(nPoints) + 1
+			for i := 1; i <= nc; i++ {
+				ends = append(ends, i*int(nPoints)/nc)
+			}
+		}
+		// Errors are expected, as most programs are not valid.
+		h.run(program, current, unhinted, inFontUnits, ends)
+	})
+}
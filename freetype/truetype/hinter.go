@@ -0,0 +1,60 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// A Hinter loads and hints glyphs from one Font at one scale. Creating a
+// Hinter runs the font's fpgm and prep bytecode, and the resultant function
+// definitions, storage area, Control Value Table and graphics state are kept
+// for hinting each glyph, so that hinting a run of text only runs each
+// glyph's own bytecode.
+//
+// A Hinter is not safe for concurrent use by multiple goroutines. Each
+// goroutine that hints glyphs should have its own Hinter.
+type Hinter struct {
+	font    *Font
+	scale   int32
+	hinting Hinting
+	g       GlyphBuf
+}
+
+// NewHinter returns a Hinter for the given Font, scale and hinting policy.
+// scale is the number of 26.6 fixed point units in 1 em. A nil o means to use
+// the default HinterOptions. It returns an error if the font's fpgm or prep
+// bytecode fails.
+func NewHinter(f *Font, scale int32, h Hinting, o *HinterOptions) (*Hinter, error) {
+	x := &Hinter{
+		font:    f,
+		scale:   scale,
+		hinting: h,
+	}
+	x.g.SetHinterOptions(o)
+	if h != NoHinting {
+		x.g.setHintingMode(h)
+		if err := x.g.Prepare(f, scale); err != nil {
+			return nil, err
+		}
+	}
+	return x, nil
+}
+
+// Font returns the Hinter's Font.
+func (x *Hinter) Font() *Font {
+	return x.font
+}
+
+// Scale returns the Hinter's scale.
+func (x *Hinter) Scale() int32 {
+	return x.scale
+}
+
+// Run loads and hints the glyph with the given index. The returned GlyphBuf
+// is owned by the Hinter, and is overwritten by the next call to Run.
+func (x *Hinter) Run(i Index) (*GlyphBuf, error) {
+	if err := x.g.Load(x.font, x.scale, i, x.hinting); err != nil {
+		return nil, err
+	}
+	return &x.g, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// An IncrementalSource supplies a Font's glyphs one at a time, like C
+// Freetype's incremental loading interface. It is for fonts whose glyphs are
+// not stored in a complete TrueType file, such as a font embedded in a PDF or
+// PostScript document whose glyphs are streamed by the document's
+// interpreter.
+type IncrementalSource interface {
+	// GlyphData returns the data for the glyph with the given index, in the
+	// format of an entry in a glyf table. Empty data means a glyph with no
+	// contours, such as a space. The data of a compound glyph refers to
+	// other glyphs by index, which are also loaded from the source.
+	GlyphData(i Index) ([]byte, error)
+	// HMetric returns the unscaled horizontal metrics for the glyph with the
+	// given index, and whether they are known. If not, the metrics are read
+	// from the font's hmtx table.
+	HMetric(i Index) (h HMetric, ok bool)
+}
+
+// ParseIncremental returns a new Font for the given TTF data, whose glyphs
+// are supplied by src. The TTF data's glyf and loca tables are ignored, and
+// may be missing, as may its hhea and hmtx tables if src supplies every
+// glyph's metrics. The head and maxp tables are still required.
+func ParseIncremental(ttf []byte, src IncrementalSource) (*Font, error) {
+	return parse(ttf, 0, src, nil)
+}
+
+// glyphData returns the data for the glyph with the given index, from the
+// font's IncrementalSource, if it has one, or from its glyf table.
+func (f *Font) glyphData(i Index) ([]byte, error) {
+	if i < 0 || f.nGlyph <= int(i) {
+		return nil, FormatError{Reason: "glyph index out of range"}
+	}
+	if f.src != nil {
+		return f.src.GlyphData(i)
+	}
+	if f.glyf == nil && f.caps.IsCFF {
+		return nil, UnsupportedError{Feature: "CFF outlines"}
+	}
+	offset, length, ok := f.GlyphOffset(i)
+	if !ok {
+		return nil, f.locaError(i)
+	}
+	return f.glyf[offset : offset+length], nil
+}
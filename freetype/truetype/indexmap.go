@@ -0,0 +1,56 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// An IndexMap maps between the glyph indexes of a font and those of a subset
+// of that font. It lets text that refers to the original font's glyph
+// indexes, such as a document's content, be drawn with the subset font.
+//
+// The zero value is an empty mapping.
+type IndexMap struct {
+	// oldIndex maps from subset glyph indexes to original glyph indexes.
+	oldIndex []Index
+	// newIndex maps from original glyph indexes to subset glyph indexes.
+	newIndex map[Index]Index
+}
+
+// NewIndexMap returns an IndexMap for a subset font whose i'th glyph is the
+// original font's glyph old[i]. If an original glyph appears more than once
+// in old, it maps to the first of its subset glyphs.
+func NewIndexMap(old []Index) *IndexMap {
+	m := &IndexMap{
+		oldIndex: append([]Index(nil), old...),
+		newIndex: make(map[Index]Index, len(old)),
+	}
+	for i, o := range old {
+		if _, ok := m.newIndex[o]; !ok {
+			m.newIndex[o] = Index(i)
+		}
+	}
+	return m
+}
+
+// Len returns the number of glyphs in the subset font.
+func (m *IndexMap) Len() int {
+	return len(m.oldIndex)
+}
+
+// New returns the subset glyph index for the original glyph index i. ok is
+// false if the glyph is not in the subset, in which case the returned index
+// is 0, the missing glyph.
+func (m *IndexMap) New(i Index) (index Index, ok bool) {
+	index, ok = m.newIndex[i]
+	return index, ok
+}
+
+// Old returns the original glyph index for the subset glyph index i. ok is
+// false if i is out of range.
+func (m *IndexMap) Old(i Index) (index Index, ok bool) {
+	if i < 0 || len(m.oldIndex) <= int(i) {
+		return 0, false
+	}
+	return m.oldIndex[i], true
+}
@@ -0,0 +1,104 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// This file implements kerning from the AAT kerx table's pair list and
+// class-based subtables, which are documented at
+// https://developer.apple.com/fonts/TrueType-Reference-Manual/RM06/Chap6kerx.html
+
+// kerxSubtable is a kerx subtable of format 0, whose pairs are as for a kern
+// subtable, or of format 2, whose values are an array indexed by the sum of
+// the pair's left and right glyph classes.
+type kerxSubtable struct {
+	pairs kernSubtable
+	// format2 is a format 2 subtable, including its header, or nil for a
+	// format 0 subtable.
+	format2 []byte
+}
+
+// find returns the kerning value, in FUnits, for the glyph i0 followed by the
+// glyph i1, in a font with nGlyph glyphs.
+func (k kerxSubtable) find(i0, i1 Index, nGlyph int) (value int32, ok bool) {
+	if k.format2 == nil {
+		return k.pairs.find(uint32(i0)<<16 | uint32(i1))
+	}
+	b := k.format2
+	left, right, array := u32(b, 16), u32(b, 20), u32(b, 24)
+	if left >= uint32(len(b)) || right >= uint32(len(b)) || array >= uint32(len(b)) {
+		return 0, false
+	}
+	// Glyphs without a class are in class 0.
+	l, _ := aatLookup(b[left:], i0, nGlyph)
+	r, _ := aatLookup(b[right:], i1, nGlyph)
+	x := int(array) + 2*(int(l)+int(r))
+	if len(b) < x+2 {
+		return 0, false
+	}
+	return int32(int16(u16(b, x))), true
+}
+
+// parseKerx finds the kerx table's subtables that give horizontal kerning
+// from a pair list or class array. An invalid kerx table is ignored.
+func (f *Font) parseKerx() {
+	f.kerxSubtables = nil
+	if len(f.kerx) == 0 {
+		return
+	}
+	if len(f.kerx) < 8 || u16(f.kerx, 0) < 2 {
+		f.warn("truetype: ignoring invalid kerx table")
+		return
+	}
+	var subtables []kerxSubtable
+	n, offset := int(u32(f.kerx, 4)), 8
+	for i := 0; i < n; i++ {
+		if len(f.kerx)-offset < 12 {
+			f.warn("truetype: ignoring invalid kerx table")
+			return
+		}
+		length, coverage := int(u32(f.kerx, offset)), u32(f.kerx, offset+4)
+		if length < 12 || len(f.kerx)-offset < length {
+			f.warn("truetype: ignoring invalid kerx table")
+			return
+		}
+		b := f.kerx[offset : offset+length]
+		offset += length
+		// Skip vertical, cross-stream and variation kerning.
+		if coverage&0xe0000000 != 0 {
+			continue
+		}
+		switch format := coverage & 0xff; format {
+		case 0:
+			if len(b) < 28 || (len(b)-28)/6 < int(u32(b, 12)) {
+				f.warn("truetype: ignoring invalid kerx table")
+				return
+			}
+			subtables = append(subtables, kerxSubtable{
+				pairs: kernSubtable{pairs: b[28 : 28+6*int(u32(b, 12))]},
+			})
+		case 2:
+			if len(b) < 28 {
+				f.warn("truetype: ignoring invalid kerx table")
+				return
+			}
+			subtables = append(subtables, kerxSubtable{format2: b})
+		default:
+			f.warn("truetype: skipping unsupported kerx subtable", "format", format)
+		}
+	}
+	f.kerxSubtables = subtables
+}
+
+// kerxKerning returns the kerning, in FUnits, for the glyph i0 followed by
+// the glyph i1, from the kerx table.
+func (f *Font) kerxKerning(i0, i1 Index) int32 {
+	k := int32(0)
+	for _, t := range f.kerxSubtables {
+		if v, ok := t.find(i0, i1, f.nGlyph); ok {
+			k += v
+		}
+	}
+	return k
+}
@@ -0,0 +1,283 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// This file implements the parts of the OpenType layout tables, GPOS and
+// GSUB, that are common to both. They are documented at
+// https://www.microsoft.com/typography/otspec/chapter2.htm
+//
+// A font's layout tables are only used if they are valid, but an invalid
+// layout table is not an error, as the font can still be drawn without it.
+
+// A lookup is a GPOS or GSUB lookup table.
+type lookup struct {
+	// typ is the lookup type. An extension lookup's type is that of the
+	// subtables it wraps.
+	typ uint16
+	// flag is the lookup flag.
+	flag uint16
+	// subtables are the lookup's subtables, each sliced from its start to
+	// the end of the layout table.
+	subtables [][]byte
+}
+
+// offsetTable returns the part of b, from its start to the end of b, at the
+// 16-bit offset stored at b[x:]. It returns nil if the offset is zero or out
+// of range.
+func offsetTable(b []byte, x int) []byte {
+	if x < 0 || len(b)-x < 2 {
+		return nil
+	}
+	o := int(u16(b, x))
+	if o == 0 || o >= len(b) {
+		return nil
+	}
+	return b[o:]
+}
+
+// featureLookups returns the lookups, in lookup list order, of the layout
+// table's features with the given tags, for all scripts and languages.
+// extension is the lookup type of extension lookups: 9 for GPOS and 7 for
+// GSUB. ok is false if the table is present but invalid.
+func featureLookups(table []byte, extension uint16, tags ...string) (lookups []lookup, ok bool) {
+	all, features, ok := layoutFeatures(table, extension)
+	if !ok {
+		return nil, false
+	}
+	return selectLookups(all, features, tags), true
+}
+
+// layoutFeatures parses the lookups of all of the layout table's features,
+// for all scripts and languages. lookups has one element per lookup in the
+// lookup list, of which those that no feature uses are not parsed and have a
+// zero type, and features maps each feature tag to the indexes of its
+// lookups. extension and ok are as for featureLookups.
+func layoutFeatures(table []byte, extension uint16) (lookups []lookup, features map[string][]int, ok bool) {
+	if len(table) == 0 {
+		return nil, nil, true
+	}
+	if len(table) < 10 || u16(table, 0) != 1 {
+		return nil, nil, false
+	}
+	featureList := offsetTable(table, 6)
+	lookupList := offsetTable(table, 8)
+	if len(featureList) < 2 || len(lookupList) < 2 {
+		return nil, nil, false
+	}
+	nLookups := int(u16(lookupList, 0))
+	if len(lookupList) < 2+2*nLookups {
+		return nil, nil, false
+	}
+	nFeatures := int(u16(featureList, 0))
+	if len(featureList) < 2+6*nFeatures {
+		return nil, nil, false
+	}
+	features = make(map[string][]int)
+	use := make([]bool, nLookups)
+	for i := 0; i < nFeatures; i++ {
+		x := 2 + 6*i
+		feature := offsetTable(featureList, x+4)
+		if len(feature) < 4 {
+			return nil, nil, false
+		}
+		n := int(u16(feature, 2))
+		if len(feature) < 4+2*n {
+			return nil, nil, false
+		}
+		tag := string(featureList[x : x+4])
+		for j := 0; j < n; j++ {
+			if k := int(u16(feature, 4+2*j)); k < nLookups {
+				features[tag] = append(features[tag], k)
+				use[k] = true
+			}
+		}
+	}
+	lookups = make([]lookup, nLookups)
+	for i, u := range use {
+		if !u {
+			continue
+		}
+		if lookups[i], ok = parseLookup(offsetTable(lookupList, 2+2*i), extension); !ok {
+			return nil, nil, false
+		}
+	}
+	return lookups, features, true
+}
+
+// selectLookups returns the lookups, in lookup list order, of the features
+// with the given tags, as returned by layoutFeatures, skipping lookups with a
+// zero type. A lookup can be listed by more than one feature with the tags,
+// such as by one for each script, but is only returned once.
+func selectLookups(all []lookup, features map[string][]int, tags []string) []lookup {
+	use := make([]bool, len(all))
+	for _, tag := range tags {
+		for _, i := range features[tag] {
+			use[i] = true
+		}
+	}
+	var lookups []lookup
+	for i, u := range use {
+		if u && all[i].typ != 0 {
+			lookups = append(lookups, all[i])
+		}
+	}
+	return lookups
+}
+
+// parseLookup parses the lookup table b, resolving extension subtables.
+func parseLookup(b []byte, extension uint16) (l lookup, ok bool) {
+	if len(b) < 6 {
+		return lookup{}, false
+	}
+	l.typ, l.flag = u16(b, 0), u16(b, 2)
+	n := int(u16(b, 4))
+	if len(b) < 6+2*n {
+		return lookup{}, false
+	}
+	extensionType := uint16(0)
+	for i := 0; i < n; i++ {
+		s := offsetTable(b, 6+2*i)
+		if s == nil {
+			return lookup{}, false
+		}
+		if l.typ == extension {
+			// An extension subtable has a format, the wrapped lookup type and
+			// a 32-bit offset to the wrapped subtable. All of a lookup's
+			// extension subtables must wrap the same lookup type.
+			if len(s) < 8 || u16(s, 0) != 1 {
+				return lookup{}, false
+			}
+			typ := u16(s, 2)
+			if typ == extension || (i > 0 && typ != extensionType) {
+				return lookup{}, false
+			}
+			extensionType = typ
+			o := int64(u32(s, 4))
+			if o == 0 || o >= int64(len(s)) {
+				return lookup{}, false
+			}
+			s = s[o:]
+		}
+		l.subtables = append(l.subtables, s)
+	}
+	if l.typ == extension {
+		l.typ = extensionType
+	}
+	return l, true
+}
+
+// coverageIndex returns the index of the glyph in the coverage table b, or -1
+// if the glyph is not covered.
+func coverageIndex(b []byte, glyph Index) int {
+	if len(b) < 4 {
+		return -1
+	}
+	n := int(u16(b, 2))
+	switch u16(b, 0) {
+	case 1:
+		// Format 1 lists the covered glyphs, in increasing order.
+		if len(b) < 4+2*n {
+			return -1
+		}
+		for lo, hi := 0, n; lo < hi; {
+			i := (lo + hi) / 2
+			g := Index(u16(b, 4+2*i))
+			if g < glyph {
+				lo = i + 1
+			} else if g > glyph {
+				hi = i
+			} else {
+				return i
+			}
+		}
+	case 2:
+		// Format 2 lists ranges of covered glyphs, in increasing order, and
+		// each range's first coverage index.
+		if len(b) < 4+6*n {
+			return -1
+		}
+		for lo, hi := 0, n; lo < hi; {
+			i := (lo + hi) / 2
+			x := 4 + 6*i
+			if end := Index(u16(b, x+2)); end < glyph {
+				lo = i + 1
+			} else if start := Index(u16(b, x)); glyph < start {
+				hi = i
+			} else {
+				return int(u16(b, x+4)) + int(glyph-start)
+			}
+		}
+	}
+	return -1
+}
+
+// rangeCoverage calls fn with each glyph in the coverage table b, in
+// increasing order, and its coverage index.
+func rangeCoverage(b []byte, fn func(glyph Index, i int)) {
+	if len(b) < 4 {
+		return
+	}
+	n := int(u16(b, 2))
+	switch u16(b, 0) {
+	case 1:
+		if len(b) < 4+2*n {
+			return
+		}
+		for i := 0; i < n; i++ {
+			fn(Index(u16(b, 4+2*i)), i)
+		}
+	case 2:
+		if len(b) < 4+6*n {
+			return
+		}
+		for i := 0; i < n; i++ {
+			x := 4 + 6*i
+			start, end, c := int(u16(b, x)), int(u16(b, x+2)), int(u16(b, x+4))
+			for g := start; g <= end; g++ {
+				fn(Index(g), c+g-start)
+			}
+		}
+	}
+}
+
+// glyphClass returns the class of the glyph in the class definition table b.
+// Glyphs that b does not list are in class 0.
+func glyphClass(b []byte, glyph Index) int {
+	if len(b) < 4 {
+		return 0
+	}
+	switch u16(b, 0) {
+	case 1:
+		// Format 1 lists the classes of a consecutive run of glyphs.
+		if len(b) < 6 {
+			return 0
+		}
+		start, n := Index(u16(b, 2)), int(u16(b, 4))
+		if glyph < start || int(glyph-start) >= n || len(b) < 6+2*n {
+			return 0
+		}
+		return int(u16(b, 6+2*int(glyph-start)))
+	case 2:
+		// Format 2 lists ranges of glyphs, in increasing order, and their
+		// classes.
+		n := int(u16(b, 2))
+		if len(b) < 4+6*n {
+			return 0
+		}
+		for lo, hi := 0, n; lo < hi; {
+			i := (lo + hi) / 2
+			x := 4 + 6*i
+			if end := Index(u16(b, x+2)); end < glyph {
+				lo = i + 1
+			} else if start := Index(u16(b, x)); glyph < start {
+				hi = i
+			} else {
+				return int(u16(b, x+4))
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,128 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"sort"
+	"strings"
+)
+
+// A Logger is told of recoverable anomalies in a font, such as a table that
+// is repaired or ignored, or a layout lookup that is skipped because it is
+// unsupported. Such fonts can still be used, but may not render as their
+// designers intended, so services may want to monitor them.
+//
+// Warn's arguments are a message and alternating keys and values, as for the
+// log/slog package, whose *slog.Logger implements Logger. Some anomalies are
+// only found when a font's glyphs are hinted, so the Logger of a font that is
+// used by multiple goroutines must be safe for concurrent use.
+type Logger interface {
+	Warn(msg string, args ...interface{})
+}
+
+// Strictness is how a font's violations of the TrueType and OpenType
+// specifications are handled when it is parsed.
+type Strictness int
+
+const (
+	// DefaultStrictness rejects violations that prevent a font from being
+	// used, and ignores the invalid optional tables of an otherwise valid
+	// font.
+	DefaultStrictness Strictness = iota
+	// Strict rejects any violation that is detected, including the invalid
+	// optional tables ignored by default, overlapping tables, mismatched
+	// checksums and invalid loca entries. It suits services that accept
+	// untrusted fonts and want only well-formed ones.
+	Strict
+	// Permissive also repairs common violations in real-world fonts: a table
+	// that runs past the end of the data is truncated, a loca table that is
+	// missing its final entry ends at the end of the glyf table, and loca
+	// entries that are out of order or past the end of the glyf table are
+	// empty glyphs.
+	Permissive
+)
+
+// ParseOptions are options for parsing a font. A nil *ParseOptions means to
+// use the defaults.
+type ParseOptions struct {
+	// Logger, if non-nil, is told of recoverable anomalies in the font when
+	// it is parsed, and later when its glyphs are hinted.
+	Logger Logger
+	// Strictness is how violations of the specifications are handled.
+	Strictness Strictness
+	// Budget, if non-nil, is charged for the font's caches, and for those
+	// of the GlyphBufs that load its glyphs, which it may evict.
+	Budget Budget
+}
+
+// ParseWithOptions is like Parse, but with options.
+func ParseWithOptions(ttf []byte, o *ParseOptions) (*Font, error) {
+	return parse(ttf, 0, nil, o)
+}
+
+// warn reports an anomaly to the font's Logger, if it has one. A Strict font
+// being parsed also records the first anomaly as its parse error.
+func (f *Font) warn(msg string, args ...interface{}) {
+	if f.strictness == Strict && !f.parsed && f.strictErr == nil {
+		f.strictErr = FormatError{Reason: strings.TrimPrefix(msg, "truetype: ")}
+	}
+	if f.logger != nil {
+		f.logger.Warn(msg, args...)
+	}
+}
+
+// repairTableEntry returns the offset and length of a Permissive font's table
+// with the given tag, truncated if the table runs past the end of the data.
+func (f *Font) repairTableEntry(ttf []byte, tag string, entry []byte) []byte {
+	offset, length := u32(entry, 0), u32(entry, 4)
+	if offset > uint32(len(ttf)) || length <= uint32(len(ttf))-offset {
+		return entry
+	}
+	f.warn("truetype: truncating table that runs past the end of the data", "tag", tag)
+	entry = append([]byte(nil), entry...)
+	putU32(entry, 4, uint32(len(ttf))-offset)
+	return entry
+}
+
+// checkStrict returns an error if the tables in the TTF data's directory of n
+// tables overlap, or their checksums do not match. It is not used for the
+// fonts in a collection, whose tables may be shared.
+func checkStrict(ttf []byte, n int) error {
+	spans := make([]span, n)
+	for i := range spans {
+		x := 16*i + 12
+		spans[i] = span{u32(ttf, x+8), u32(ttf, x+12)}
+	}
+	sort.Sort(spanSlice(spans))
+	for i := 1; i < len(spans); i++ {
+		if prev := spans[i-1]; spans[i].offset-prev.offset < prev.length {
+			return FormatError{Offset: int(spans[i].offset), Reason: "overlapping tables"}
+		}
+	}
+	r, err := VerifyChecksums(ttf)
+	if err != nil {
+		return err
+	}
+	if tags := r.Mismatched(); len(tags) > 0 {
+		return FormatError{Table: tags[0], Reason: "bad checksum"}
+	}
+	if !r.OK() {
+		// The head table's checkSumAdjustment is at offset 8.
+		return FormatError{Table: "head", Offset: 8, Reason: "bad checksum"}
+	}
+	return nil
+}
+
+// span is the offset and length of a table in TTF data.
+type span struct {
+	offset, length uint32
+}
+
+type spanSlice []span
+
+func (s spanSlice) Len() int           { return len(s) }
+func (s spanSlice) Less(i, j int) bool { return s[i].offset < s[j].offset }
+func (s spanSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
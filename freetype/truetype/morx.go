@@ -0,0 +1,393 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// This file implements glyph substitution from the AAT morx table's
+// rearrangement, contextual, ligature and noncontextual subtables, which are
+// documented at
+// https://developer.apple.com/fonts/TrueType-Reference-Manual/RM06/Chap6morx.html
+
+const (
+	// morxRearrangement, morxContextual, morxLigature, morxNoncontextual and
+	// morxInsertion are morx subtable types.
+	morxRearrangement = 0
+	morxContextual    = 1
+	morxLigature      = 2
+	morxNoncontextual = 4
+	morxInsertion     = 5
+)
+
+// morxChain is a chain of morx subtables.
+type morxChain struct {
+	defaultFlags uint32
+	// features are the chain's feature entries, each of 12 bytes: the AAT
+	// feature type and setting, and the flags to enable and disable.
+	features  []byte
+	subtables []morxSubtable
+}
+
+// morxSubtable is a morx subtable, whose data follows its 12 byte header.
+type morxSubtable struct {
+	typ      uint8
+	backward bool
+	flags    uint32
+	data     []byte
+}
+
+// aatFeatures maps OpenType feature tags to the AAT feature types and
+// settings that are their nearest equivalents.
+var aatFeatures = map[string][2]uint16{
+	"rlig": {1, 0},  // Required ligatures.
+	"liga": {1, 2},  // Common ligatures.
+	"dlig": {1, 4},  // Rare ligatures.
+	"clig": {1, 18}, // Contextual ligatures.
+	"hlig": {1, 20}, // Historical ligatures.
+	"vert": {4, 0},  // Vertical forms.
+	"frac": {11, 2}, // Diagonal fractions.
+	"onum": {21, 0}, // Lower case numbers.
+	"lnum": {21, 1}, // Upper case numbers.
+	"smcp": {37, 1}, // Lower case small caps.
+	"c2sc": {38, 1}, // Upper case small caps.
+}
+
+// parseMorx finds the morx table's chains. An invalid morx table is ignored.
+func (f *Font) parseMorx() {
+	f.morxChains = nil
+	if len(f.morx) == 0 {
+		return
+	}
+	if len(f.morx) < 8 || u16(f.morx, 0) < 2 {
+		f.warn("truetype: ignoring invalid morx table")
+		return
+	}
+	var chains []morxChain
+	n, offset := int(u32(f.morx, 4)), 8
+	for i := 0; i < n; i++ {
+		if len(f.morx)-offset < 16 {
+			f.warn("truetype: ignoring invalid morx table")
+			return
+		}
+		length := int(u32(f.morx, offset+4))
+		nFeatures, nSubtables := int(u32(f.morx, offset+8)), int(u32(f.morx, offset+12))
+		if length < 16 || len(f.morx)-offset < length || (length-16)/12 < nFeatures {
+			f.warn("truetype: ignoring invalid morx table")
+			return
+		}
+		b := f.morx[offset : offset+length]
+		offset += length
+		c := morxChain{
+			defaultFlags: u32(b, 0),
+			features:     b[16 : 16+12*nFeatures],
+		}
+		x := 16 + 12*nFeatures
+		for j := 0; j < nSubtables; j++ {
+			if len(b)-x < 12 {
+				f.warn("truetype: ignoring invalid morx table")
+				return
+			}
+			length, coverage := int(u32(b, x)), u32(b, x+4)
+			if length < 12 || len(b)-x < length {
+				f.warn("truetype: ignoring invalid morx table")
+				return
+			}
+			s := morxSubtable{
+				typ:      uint8(coverage),
+				backward: coverage&0x40000000 != 0,
+				flags:    u32(b, x+8),
+				data:     b[x+12 : x+length],
+			}
+			x += length
+			// Skip subtables that only apply to vertical text.
+			if coverage&0xa0000000 == 0x80000000 {
+				continue
+			}
+			switch s.typ {
+			case morxRearrangement, morxContextual, morxLigature, morxNoncontextual:
+				c.subtables = append(c.subtables, s)
+			default:
+				f.warn("truetype: skipping unsupported morx subtable", "type", s.typ)
+			}
+		}
+		chains = append(chains, c)
+	}
+	f.morxChains = chains
+}
+
+// flags returns the chain's subtable flags for the given OpenType features,
+// which are added to the chain's default features.
+func (c *morxChain) flags(features []string) uint32 {
+	flags := c.defaultFlags
+	for _, tag := range features {
+		ts, ok := aatFeatures[tag]
+		if !ok {
+			continue
+		}
+		for x := 0; x < len(c.features); x += 12 {
+			if u16(c.features, x) == ts[0] && u16(c.features, x+2) == ts[1] {
+				flags = flags&u32(c.features, x+8) | u32(c.features, x+4)
+			}
+		}
+	}
+	return flags
+}
+
+// morxSubstitute applies the morx table's subtables for the chains' default
+// features and the given OpenType features, as for Substitute.
+func (f *Font) morxSubstitute(glyphs []Index, clusters []int, features []string) ([]Index, []int) {
+	if len(glyphs) == 0 {
+		return glyphs, clusters
+	}
+	for i := range f.morxChains {
+		c := &f.morxChains[i]
+		flags := c.flags(features)
+		for _, s := range c.subtables {
+			if s.flags&flags == 0 {
+				continue
+			}
+			if s.backward {
+				reverseGlyphs(glyphs, clusters)
+			}
+			switch s.typ {
+			case morxRearrangement:
+				f.morxRearrange(s.data, glyphs, clusters)
+			case morxContextual:
+				f.morxContextual(s.data, glyphs)
+			case morxLigature:
+				f.morxLigature(s.data, glyphs)
+			case morxNoncontextual:
+				for j, g := range glyphs {
+					if v, ok := aatLookup(s.data, g, f.nGlyph); ok && g != deletedGlyph {
+						glyphs[j] = Index(v)
+					}
+				}
+			}
+			if s.backward {
+				reverseGlyphs(glyphs, clusters)
+			}
+		}
+	}
+	// Remove the deleted glyphs, such as the components of ligatures after
+	// the first, which holds the ligature.
+	n := 0
+	for j, g := range glyphs {
+		if g == deletedGlyph {
+			continue
+		}
+		glyphs[n] = g
+		if clusters != nil {
+			clusters[n] = clusters[j]
+		}
+		n++
+	}
+	if clusters != nil {
+		clusters = clusters[:n]
+	}
+	return glyphs[:n], clusters
+}
+
+// reverseGlyphs reverses glyphs and, if non-nil, clusters.
+func reverseGlyphs(glyphs []Index, clusters []int) {
+	for i, j := 0, len(glyphs)-1; i < j; i, j = i+1, j-1 {
+		glyphs[i], glyphs[j] = glyphs[j], glyphs[i]
+		if clusters != nil {
+			clusters[i], clusters[j] = clusters[j], clusters[i]
+		}
+	}
+}
+
+// rearrangeVerbs are, for each rearrangement verb, the number of glyphs to
+// move from the start and from the end of the marked glyphs, in the high and
+// low nibbles. A nibble of 3 means 2 glyphs, which are also swapped.
+var rearrangeVerbs = [16]uint8{
+	0x00, 0x10, 0x01, 0x11, 0x20, 0x30, 0x02, 0x03,
+	0x12, 0x13, 0x21, 0x31, 0x22, 0x32, 0x23, 0x33,
+}
+
+// morxRearrange applies the rearrangement subtable b to glyphs and clusters.
+func (f *Font) morxRearrange(b []byte, glyphs []Index, clusters []int) {
+	const (
+		markFirst = 0x8000
+		markLast  = 0x2000
+		verb      = 0x000f
+	)
+	t, ok := parseStateTable(b, 4, f.nGlyph)
+	if !ok {
+		return
+	}
+	start, end := 0, 0
+	t.run(glyphs, func(i int, flags uint16, data []byte) {
+		if flags&markFirst != 0 {
+			start = i
+		}
+		if flags&markLast != 0 {
+			end = i + 1
+			if end > len(glyphs) {
+				end = len(glyphs)
+			}
+		}
+		if flags&verb == 0 || start >= end {
+			return
+		}
+		// Rearrange the positions of the marked glyphs, and then the glyphs
+		// and clusters at those positions.
+		perm := make([]int, end-start)
+		for j := range perm {
+			perm[j] = start + j
+		}
+		if !rearrangeVerb(perm, flags&verb) {
+			return
+		}
+		g := append([]Index(nil), glyphs[start:end]...)
+		for j, p := range perm {
+			glyphs[start+j] = g[p-start]
+		}
+		if clusters != nil {
+			c := append([]int(nil), clusters[start:end]...)
+			for j, p := range perm {
+				clusters[start+j] = c[p-start]
+			}
+		}
+	})
+}
+
+// rearrangeVerb applies the rearrangement verb to s. It returns false, leaving
+// s unchanged, if s is too short for the verb.
+func rearrangeVerb(s []int, verb uint16) bool {
+	m := rearrangeVerbs[verb]
+	l, r := int(m>>4), int(m&0x0f)
+	reverseL, reverseR := l == 3, r == 3
+	if l == 3 {
+		l = 2
+	}
+	if r == 3 {
+		r = 2
+	}
+	if len(s) < l+r {
+		return false
+	}
+	rearrange(s, l, r, reverseL, reverseR)
+	return true
+}
+
+// rearrange moves the first l and last r elements of s, which are swapped if
+// reverseL or reverseR, to the end and start of s.
+func rearrange(s []int, l, r int, reverseL, reverseR bool) {
+	var buf [4]int
+	copy(buf[:l], s[:l])
+	copy(buf[2:2+r], s[len(s)-r:])
+	copy(s[r:], s[l:len(s)-r])
+	copy(s[:r], buf[2:2+r])
+	copy(s[len(s)-l:], buf[:l])
+	if reverseL {
+		s[len(s)-1], s[len(s)-2] = s[len(s)-2], s[len(s)-1]
+	}
+	if reverseR {
+		s[0], s[1] = s[1], s[0]
+	}
+}
+
+// morxContextual applies the contextual subtable b to glyphs.
+func (f *Font) morxContextual(b []byte, glyphs []Index) {
+	const setMark = 0x8000
+	t, ok := parseStateTable(b, 8, f.nGlyph)
+	if !ok || len(b) < 20 || u32(b, 16) >= uint32(len(b)) {
+		return
+	}
+	substitutions := b[u32(b, 16):]
+	substitute := func(i int, table uint16) {
+		if table == 0xffff || len(substitutions) < 4*int(table)+4 {
+			return
+		}
+		x := u32(substitutions, 4*int(table))
+		if x >= uint32(len(substitutions)) {
+			return
+		}
+		if v, ok := aatLookup(substitutions[x:], glyphs[i], f.nGlyph); ok {
+			glyphs[i] = Index(v)
+		}
+	}
+	mark, marked := 0, false
+	t.run(glyphs, func(i int, flags uint16, data []byte) {
+		// At the end of the text, the current glyph is the last glyph.
+		if i == len(glyphs) {
+			i--
+		}
+		if marked {
+			substitute(mark, u16(data, 0))
+		}
+		substitute(i, u16(data, 2))
+		if flags&setMark != 0 {
+			mark, marked = i, true
+		}
+	})
+}
+
+// morxLigature applies the ligature subtable b to glyphs. A ligature replaces
+// its first component, and its other components are deleted.
+func (f *Font) morxLigature(b []byte, glyphs []Index) {
+	const (
+		setComponent  = 0x8000
+		performAction = 0x2000
+		actionLast    = 0x80000000
+		actionStore   = 0x40000000
+		// maxComponents is the size of the stack of components.
+		maxComponents = 64
+	)
+	t, ok := parseStateTable(b, 6, f.nGlyph)
+	if !ok || len(b) < 28 {
+		return
+	}
+	actions, components, ligatures := int(u32(b, 16)), int(u32(b, 20)), int(u32(b, 24))
+	var stack []int
+	t.run(glyphs, func(i int, flags uint16, data []byte) {
+		if flags&setComponent != 0 && i < len(glyphs) {
+			// A glyph that is processed again is only pushed once.
+			if n := len(stack); n == 0 || stack[n-1] != i {
+				if n == maxComponents {
+					stack = append(stack[:0], stack[1:]...)
+				}
+				stack = append(stack, i)
+			}
+		}
+		if flags&performAction == 0 {
+			return
+		}
+		x, ligature := actions+4*int(u16(data, 0)), 0
+		for cursor := len(stack); cursor > 0; {
+			if x < 0 || len(b) < x+4 {
+				return
+			}
+			action := u32(b, x)
+			x += 4
+			cursor--
+			p := stack[cursor]
+			// The action's offset is a signed 30 bit integer, added to the
+			// component's glyph index to index the component table.
+			y := components + 2*(int(glyphs[p])+int(int32(action<<2)>>2))
+			if y < 0 || len(b) < y+2 {
+				return
+			}
+			ligature += int(u16(b, y))
+			if action&(actionLast|actionStore) != 0 {
+				z := ligatures + 2*ligature
+				if z < 0 || len(b) < z+2 {
+					return
+				}
+				glyphs[p] = Index(u16(b, z))
+				// Delete the components after this one, and keep the
+				// ligature on the stack, as the component of later
+				// ligatures.
+				for _, q := range stack[cursor+1:] {
+					glyphs[q] = deletedGlyph
+				}
+				stack, ligature = stack[:cursor+1], 0
+			}
+			if action&actionLast != 0 {
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"unicode/utf16"
+)
+
+// A NameID identifies a string in a font's name table, such as its family
+// name. The IDs are documented at
+// https://www.microsoft.com/typography/otspec/name.htm
+type NameID uint16
+
+// These are the name IDs defined by the OpenType specification.
+const (
+	NameIDCopyright                      NameID = 0
+	NameIDFamily                         NameID = 1
+	NameIDSubfamily                      NameID = 2
+	NameIDUniqueID                       NameID = 3
+	NameIDFullName                       NameID = 4
+	NameIDVersion                        NameID = 5
+	NameIDPostScriptName                 NameID = 6
+	NameIDTrademark                      NameID = 7
+	NameIDManufacturer                   NameID = 8
+	NameIDDesigner                       NameID = 9
+	NameIDDescription                    NameID = 10
+	NameIDVendorURL                      NameID = 11
+	NameIDDesignerURL                    NameID = 12
+	NameIDLicense                        NameID = 13
+	NameIDLicenseURL                     NameID = 14
+	NameIDPreferredFamily                NameID = 16
+	NameIDPreferredSubfamily             NameID = 17
+	NameIDCompatibleFullName             NameID = 18
+	NameIDSampleText                     NameID = 19
+	NameIDPostScriptCIDName              NameID = 20
+	NameIDWWSFamily                      NameID = 21
+	NameIDWWSSubfamily                   NameID = 22
+	NameIDLightBackgroundPalette         NameID = 23
+	NameIDDarkBackgroundPalette          NameID = 24
+	NameIDVariationsPostScriptNamePrefix NameID = 25
+)
+
+// Name returns the font's string with the given ID, such as its family name,
+// or "" if it has none. Of the name table's records for the ID, those for
+// Windows in US English are preferred, then those for Windows in other
+// languages, then Unicode ones and then Macintosh Roman ones in English.
+// Records in other Macintosh encodings are ignored.
+func (f *Font) Name(id NameID) string {
+	if len(f.name) < 6 {
+		return ""
+	}
+	n, storage := int(u16(f.name, 2)), int(u16(f.name, 4))
+	if len(f.name) < 6+12*n || storage > len(f.name) {
+		return ""
+	}
+	bestPriority, best := 0, []byte(nil)
+	for i, x := 0, 6; i < n; i, x = i+1, x+12 {
+		if NameID(u16(f.name, x+6)) != id {
+			continue
+		}
+		p := namePriority(u16(f.name, x), u16(f.name, x+2), u16(f.name, x+4))
+		if p <= bestPriority {
+			continue
+		}
+		length, offset := int(u16(f.name, x+8)), storage+int(u16(f.name, x+10))
+		if offset+length > len(f.name) {
+			continue
+		}
+		bestPriority, best = p, f.name[offset:offset+length]
+	}
+	switch bestPriority {
+	case 0:
+		return ""
+	case 1:
+		return decodeMacRoman(best)
+	}
+	return decodeUTF16(best)
+}
+
+// namePriority returns how preferable a name record with the given platform,
+// encoding and language IDs is, or zero if it cannot be decoded. Records
+// with a priority of 1 are in Macintosh Roman, and the others are in UTF-16.
+func namePriority(platformID, encodingID, languageID uint16) int {
+	const (
+		platformUnicode   = 0
+		platformMacintosh = 1
+		platformMicrosoft = 3
+	)
+	switch platformID {
+	case platformUnicode:
+		return 2
+	case platformMacintosh:
+		// Encoding 0 is Roman and language 0 is English.
+		if encodingID == 0 && languageID == 0 {
+			return 1
+		}
+	case platformMicrosoft:
+		// Encodings 0, 1 and 10 are Symbol, UCS-2 and UCS-4, whose names
+		// are all in UTF-16. Language 0x0409 is US English.
+		if encodingID == 0 || encodingID == 1 || encodingID == 10 {
+			if languageID == 0x0409 {
+				return 4
+			}
+			return 3
+		}
+	}
+	return 0
+}
+
+// decodeUTF16 decodes big-endian UTF-16 text.
+func decodeUTF16(b []byte) string {
+	u := make([]uint16, len(b)/2)
+	for i := range u {
+		u[i] = u16(b, 2*i)
+	}
+	return string(utf16.Decode(u))
+}
+
+// decodeMacRoman decodes Macintosh Roman text.
+func decodeMacRoman(b []byte) string {
+	r := make([]rune, len(b))
+	for i, c := range b {
+		if c < 0x80 {
+			r[i] = rune(c)
+		} else {
+			r[i] = macRoman[c-0x80]
+		}
+	}
+	return string(r)
+}
+
+// macRoman is the Unicode code point of each Macintosh Roman byte from 0x80
+// to 0xff. The bytes below 0x80 are ASCII.
+var macRoman = [128]rune{
+	'\u00c4', '\u00c5', '\u00c7', '\u00c9', '\u00d1', '\u00d6', '\u00dc', '\u00e1', // 0x80 - 0x87
+	'\u00e0', '\u00e2', '\u00e4', '\u00e3', '\u00e5', '\u00e7', '\u00e9', '\u00e8', // 0x88 - 0x8f
+	'\u00ea', '\u00eb', '\u00ed', '\u00ec', '\u00ee', '\u00ef', '\u00f1', '\u00f3', // 0x90 - 0x97
+	'\u00f2', '\u00f4', '\u00f6', '\u00f5', '\u00fa', '\u00f9', '\u00fb', '\u00fc', // 0x98 - 0x9f
+	'\u2020', '\u00b0', '\u00a2', '\u00a3', '\u00a7', '\u2022', '\u00b6', '\u00df', // 0xa0 - 0xa7
+	'\u00ae', '\u00a9', '\u2122', '\u00b4', '\u00a8', '\u2260', '\u00c6', '\u00d8', // 0xa8 - 0xaf
+	'\u221e', '\u00b1', '\u2264', '\u2265', '\u00a5', '\u00b5', '\u2202', '\u2211', // 0xb0 - 0xb7
+	'\u220f', '\u03c0', '\u222b', '\u00aa', '\u00ba', '\u03a9', '\u00e6', '\u00f8', // 0xb8 - 0xbf
+	'\u00bf', '\u00a1', '\u00ac', '\u221a', '\u0192', '\u2248', '\u2206', '\u00ab', // 0xc0 - 0xc7
+	'\u00bb', '\u2026', '\u00a0', '\u00c0', '\u00c3', '\u00d5', '\u0152', '\u0153', // 0xc8 - 0xcf
+	'\u2013', '\u2014', '\u201c', '\u201d', '\u2018', '\u2019', '\u00f7', '\u25ca', // 0xd0 - 0xd7
+	'\u00ff', '\u0178', '\u2044', '\u20ac', '\u2039', '\u203a', '\ufb01', '\ufb02', // 0xd8 - 0xdf
+	'\u2021', '\u00b7', '\u201a', '\u201e', '\u2030', '\u00c2', '\u00ca', '\u00c1', // 0xe0 - 0xe7
+	'\u00cb', '\u00c8', '\u00cd', '\u00ce', '\u00cf', '\u00cc', '\u00d3', '\u00d4', // 0xe8 - 0xef
+	'\uf8ff', '\u00d2', '\u00da', '\u00db', '\u00d9', '\u0131', '\u02c6', '\u02dc', // 0xf0 - 0xf7
+	'\u00af', '\u02d8', '\u02d9', '\u02da', '\u00b8', '\u02dd', '\u02db', '\u02c7', // 0xf8 - 0xff
+}
@@ -0,0 +1,208 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// OpcodeCategory is the kind of a TrueType instruction, as per the grouping
+// in Apple's TrueType Reference Manual.
+type OpcodeCategory uint8
+
+const (
+	// CategoryUndefined is for opcodes that are not TrueType instructions,
+	// which fonts can define with IDEF.
+	CategoryUndefined OpcodeCategory = iota
+	// CategoryPush is for instructions that push data from the instruction
+	// stream onto the stack.
+	CategoryPush
+	// CategoryStorage is for instructions that read and write the storage
+	// area and the Control Value Table.
+	CategoryStorage
+	// CategoryGraphicsState is for instructions that get or set the
+	// graphics state, such as the vectors, reference points and round state.
+	CategoryGraphicsState
+	// CategoryOutline is for instructions that move, align, interpolate or
+	// flip the points of the outline.
+	CategoryOutline
+	// CategoryException is for the DELTA instructions, which move points or
+	// change Control Value Table entries at particular sizes.
+	CategoryException
+	// CategoryMeasurement is for instructions that measure the outline or
+	// get information about the rasterizer, such as MD and GETINFO.
+	CategoryMeasurement
+	// CategoryStack is for instructions that rearrange the stack.
+	CategoryStack
+	// CategoryFlow is for the conditional and jump instructions.
+	CategoryFlow
+	// CategoryLogical is for the comparison and logical instructions.
+	CategoryLogical
+	// CategoryArithmetic is for the arithmetic and rounding instructions.
+	CategoryArithmetic
+	// CategoryFunction is for instructions that define and call functions
+	// and instructions.
+	CategoryFunction
+	// CategoryDebug is for the DEBUG instruction.
+	CategoryDebug
+)
+
+var opcodeCategoryNames = [...]string{
+	CategoryUndefined:     "undefined",
+	CategoryPush:          "push",
+	CategoryStorage:       "storage",
+	CategoryGraphicsState: "graphics state",
+	CategoryOutline:       "outline",
+	CategoryException:     "exception",
+	CategoryMeasurement:   "measurement",
+	CategoryStack:         "stack",
+	CategoryFlow:          "flow",
+	CategoryLogical:       "logical",
+	CategoryArithmetic:    "arithmetic",
+	CategoryFunction:      "function",
+	CategoryDebug:         "debug",
+}
+
+func (c OpcodeCategory) String() string {
+	if int(c) < len(opcodeCategoryNames) {
+		return opcodeCategoryNames[c]
+	}
+	return "unknown"
+}
+
+// OpcodeInfo describes a TrueType instruction. It is for tools, such as
+// disassemblers and debuggers for hinting programs, that work with the
+// opcodes reported by a HinterOptions' Trace function or counted by a
+// Profile.
+type OpcodeInfo struct {
+	// Name is the instruction's mnemonic, such as "MDAP[1]", with any flag
+	// bits in brackets. It is empty for undefined opcodes.
+	Name string
+	// Pop and Push are the number of elements that the instruction pops from
+	// and pushes onto the stack.
+	Pop, Push int
+	// Variable is whether the instruction pops or pushes more elements than
+	// Pop and Push, depending on its operands, the loop variable or the
+	// instruction stream, as for CLEAR, SHP, DELTAP1 and NPUSHB.
+	Variable bool
+	// Category is the kind of instruction.
+	Category OpcodeCategory
+}
+
+// Opcode returns the description of the given opcode.
+func Opcode(opcode uint8) OpcodeInfo {
+	return OpcodeInfo{
+		Name:     opcodeNames[opcode],
+		Pop:      int(popCount[opcode]),
+		Push:     int(pushCount[opcode]),
+		Variable: variableCount(opcode),
+		Category: opcodeCategory(opcode),
+	}
+}
+
+// opcodeNames is the mnemonic of each opcode.
+var opcodeNames = [256]string{
+	"SVTCA[0]", "SVTCA[1]", "SPVTCA[0]", "SPVTCA[1]", "SFVTCA[0]", "SFVTCA[1]", "SPVTL[0]", "SPVTL[1]", // 0x00 - 0x07
+	"SFVTL[0]", "SFVTL[1]", "SPVFS", "SFVFS", "GPV", "GFV", "SFVTPV", "ISECT", // 0x08 - 0x0f
+	"SRP0", "SRP1", "SRP2", "SZP0", "SZP1", "SZP2", "SZPS", "SLOOP", // 0x10 - 0x17
+	"RTG", "RTHG", "SMD", "ELSE", "JMPR", "SCVTCI", "SSWCI", "SSW", // 0x18 - 0x1f
+	"DUP", "POP", "CLEAR", "SWAP", "DEPTH", "CINDEX", "MINDEX", "ALIGNPTS", // 0x20 - 0x27
+	"", "UTP", "LOOPCALL", "CALL", "FDEF", "ENDF", "MDAP[0]", "MDAP[1]", // 0x28 - 0x2f
+	"IUP[0]", "IUP[1]", "SHP[0]", "SHP[1]", "SHC[0]", "SHC[1]", "SHZ[0]", "SHZ[1]", // 0x30 - 0x37
+	"SHPIX", "IP", "MSIRP[0]", "MSIRP[1]", "ALIGNRP", "RTDG", "MIAP[0]", "MIAP[1]", // 0x38 - 0x3f
+	"NPUSHB", "NPUSHW", "WS", "RS", "WCVTP", "RCVT", "GC[0]", "GC[1]", // 0x40 - 0x47
+	"SCFS", "MD[0]", "MD[1]", "MPPEM", "MPS", "FLIPON", "FLIPOFF", "DEBUG", // 0x48 - 0x4f
+	"LT", "LTEQ", "GT", "GTEQ", "EQ", "NEQ", "ODD", "EVEN", // 0x50 - 0x57
+	"IF", "EIF", "AND", "OR", "NOT", "DELTAP1", "SDB", "SDS", // 0x58 - 0x5f
+	"ADD", "SUB", "DIV", "MUL", "ABS", "NEG", "FLOOR", "CEILING", // 0x60 - 0x67
+	"ROUND[00]", "ROUND[01]", "ROUND[10]", "ROUND[11]", "NROUND[00]", "NROUND[01]", "NROUND[10]", "NROUND[11]", // 0x68 - 0x6f
+	"WCVTF", "DELTAP2", "DELTAP3", "DELTAC1", "DELTAC2", "DELTAC3", "SROUND", "S45ROUND", // 0x70 - 0x77
+	"JROT", "JROF", "ROFF", "", "RUTG", "RDTG", "SANGW", "AA", // 0x78 - 0x7f
+	"FLIPPT", "FLIPRGON", "FLIPRGOFF", "", "", "SCANCTRL", "SDPVTL[0]", "SDPVTL[1]", // 0x80 - 0x87
+	"GETINFO", "IDEF", "ROLL", "MAX", "MIN", "SCANTYPE", "INSTCTRL", "", // 0x88 - 0x8f
+	"", "", "", "", "", "", "", "", // 0x90 - 0x97
+	"", "", "", "", "", "", "", "", // 0x98 - 0x9f
+	"", "", "", "", "", "", "", "", // 0xa0 - 0xa7
+	"", "", "", "", "", "", "", "", // 0xa8 - 0xaf
+	"PUSHB[000]", "PUSHB[001]", "PUSHB[010]", "PUSHB[011]", "PUSHB[100]", "PUSHB[101]", "PUSHB[110]", "PUSHB[111]", // 0xb0 - 0xb7
+	"PUSHW[000]", "PUSHW[001]", "PUSHW[010]", "PUSHW[011]", "PUSHW[100]", "PUSHW[101]", "PUSHW[110]", "PUSHW[111]", // 0xb8 - 0xbf
+	"MDRP[00000]", "MDRP[00001]", "MDRP[00010]", "MDRP[00011]", "MDRP[00100]", "MDRP[00101]", "MDRP[00110]", "MDRP[00111]", // 0xc0 - 0xc7
+	"MDRP[01000]", "MDRP[01001]", "MDRP[01010]", "MDRP[01011]", "MDRP[01100]", "MDRP[01101]", "MDRP[01110]", "MDRP[01111]", // 0xc8 - 0xcf
+	"MDRP[10000]", "MDRP[10001]", "MDRP[10010]", "MDRP[10011]", "MDRP[10100]", "MDRP[10101]", "MDRP[10110]", "MDRP[10111]", // 0xd0 - 0xd7
+	"MDRP[11000]", "MDRP[11001]", "MDRP[11010]", "MDRP[11011]", "MDRP[11100]", "MDRP[11101]", "MDRP[11110]", "MDRP[11111]", // 0xd8 - 0xdf
+	"MIRP[00000]", "MIRP[00001]", "MIRP[00010]", "MIRP[00011]", "MIRP[00100]", "MIRP[00101]", "MIRP[00110]", "MIRP[00111]", // 0xe0 - 0xe7
+	"MIRP[01000]", "MIRP[01001]", "MIRP[01010]", "MIRP[01011]", "MIRP[01100]", "MIRP[01101]", "MIRP[01110]", "MIRP[01111]", // 0xe8 - 0xef
+	"MIRP[10000]", "MIRP[10001]", "MIRP[10010]", "MIRP[10011]", "MIRP[10100]", "MIRP[10101]", "MIRP[10110]", "MIRP[10111]", // 0xf0 - 0xf7
+	"MIRP[11000]", "MIRP[11001]", "MIRP[11010]", "MIRP[11011]", "MIRP[11100]", "MIRP[11101]", "MIRP[11110]", "MIRP[11111]", // 0xf8 - 0xff
+}
+
+// pushCount is the number of stack elements that each opcode pushes.
+var pushCount = [256]uint8{
+	// 1, 2, 3, 4, 5, 6, 7, 8, 9, a, b, c, d, e, f
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 2, 0, 0, // 0x00 - 0x0f
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, // 0x10 - 0x1f
+	2, 0, 0, 2, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, // 0x20 - 0x2f
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, // 0x30 - 0x3f
+	0, 0, 0, 1, 0, 1, 1, 1, 0, 1, 1, 1, 1, 0, 0, 0, // 0x40 - 0x4f
+	1, 1, 1, 1, 1, 1, 1, 1, 0, 0, 1, 1, 1, 0, 0, 0, // 0x50 - 0x5f
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, // 0x60 - 0x6f
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, // 0x70 - 0x7f
+	0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 3, 1, 1, 0, 0, 0, // 0x80 - 0x8f
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, // 0x90 - 0x9f
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, // 0xa0 - 0xaf
+	1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8, // 0xb0 - 0xbf
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, // 0xc0 - 0xcf
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, // 0xd0 - 0xdf
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, // 0xe0 - 0xef
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, // 0xf0 - 0xff
+}
+
+// variableCount returns whether the opcode's stack effect depends on more
+// than the opcode.
+func variableCount(opcode uint8) bool {
+	switch opcode {
+	case opNPUSHB, opNPUSHW, opCLEAR, opSHP0, opSHP1, opSHPIX, opIP, opALIGNRP, opFLIPPT,
+		opDELTAP1, opDELTAP2, opDELTAP3, opDELTAC1, opDELTAC2, opDELTAC3:
+		return true
+	}
+	return false
+}
+
+// opcodeCategory returns the category of the opcode.
+func opcodeCategory(opcode uint8) OpcodeCategory {
+	switch {
+	case opcodeNames[opcode] == "":
+		return CategoryUndefined
+	case opcode >= opMDRP00000:
+		return CategoryOutline
+	case opcode >= opPUSHB000:
+		return CategoryPush
+	}
+	switch opcode {
+	case opNPUSHB, opNPUSHW:
+		return CategoryPush
+	case opWS, opRS, opWCVTP, opWCVTF, opRCVT:
+		return CategoryStorage
+	case opISECT, opALIGNPTS, opUTP, opMDAP0, opMDAP1, opIUP0, opIUP1, opSHP0, opSHP1,
+		opSHC0, opSHC1, opSHZ0, opSHZ1, opSHPIX, opIP, opMSIRP0, opMSIRP1, opALIGNRP,
+		opMIAP0, opMIAP1, opSCFS, opFLIPPT, opFLIPRGON, opFLIPRGOFF, opAA:
+		return CategoryOutline
+	case opDELTAP1, opDELTAP2, opDELTAP3, opDELTAC1, opDELTAC2, opDELTAC3:
+		return CategoryException
+	case opGC0, opGC1, opMD0, opMD1, opMPPEM, opMPS, opGETINFO:
+		return CategoryMeasurement
+	case opDUP, opPOP, opCLEAR, opSWAP, opDEPTH, opCINDEX, opMINDEX, opROLL:
+		return CategoryStack
+	case opIF, opELSE, opEIF, opJMPR, opJROT, opJROF:
+		return CategoryFlow
+	case opLT, opLTEQ, opGT, opGTEQ, opEQ, opNEQ, opODD, opEVEN, opAND, opOR, opNOT:
+		return CategoryLogical
+	case opADD, opSUB, opDIV, opMUL, opABS, opNEG, opFLOOR, opCEILING, opMAX, opMIN,
+		opROUND00, opROUND01, opROUND10, opROUND11, opNROUND00, opNROUND01, opNROUND10, opNROUND11:
+		return CategoryArithmetic
+	case opFDEF, opENDF, opCALL, opLOOPCALL, opIDEF:
+		return CategoryFunction
+	case opDEBUG:
+		return CategoryDebug
+	}
+	return CategoryGraphicsState
+}
@@ -0,0 +1,145 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// HinterOptions are options for the bytecode hinter. The zero value means to
+// use the defaults.
+type HinterOptions struct {
+	// EngineVersion and EngineFlags are the rasterizer version and
+	// capabilities reported to the font's programs by the GETINFO
+	// instruction. Many fonts take different code paths depending on them.
+	// If EngineVersion is zero, the version is 35, as for the Windows 98
+	// rasterizer and C Freetype's default, and EngineFlags is ignored and
+	// EngineGrayscale is reported.
+	EngineVersion int32
+	EngineFlags   EngineFlags
+
+	// MaxSteps, MaxCallDepth and MaxProgramSize limit the number of
+	// instructions executed by each program, the depth of nested function
+	// calls and the length of each program, in bytes. They protect against
+	// fonts whose hinting programs are malicious or buggy, such as ones that
+	// loop forever. Complex fonts, such as some CJK fonts, may need higher
+	// limits than the defaults, and sandboxed services may want lower ones.
+	// A zero value means the default, which is DefaultMaxSteps,
+	// DefaultMaxCallDepth or DefaultMaxProgramSize.
+	MaxSteps       int
+	MaxCallDepth   int
+	MaxProgramSize int
+
+	// Trace, if non-nil, is called before each instruction is executed. It
+	// can be used to single-step hinting programs, or to compare them against
+	// C Freetype's tracing output when debugging rendering differences.
+	Trace func(*TraceEvent)
+
+	// Profile, if non-nil, records execution statistics, such as for finding
+	// out which of a font's functions dominate the hinting time.
+	Profile *Profile
+}
+
+// These constants are the default hinter limits.
+const (
+	DefaultMaxSteps       = 100000
+	DefaultMaxCallDepth   = 32
+	DefaultMaxProgramSize = 50000
+)
+
+// EngineFlags are rasterizer capabilities, as reported by the GETINFO
+// instruction. They are documented at
+// https://www.microsoft.com/typography/otspec/ttinst.htm
+type EngineFlags uint32
+
+const (
+	// EngineGrayscale means that glyphs are rendered in grayscale.
+	EngineGrayscale EngineFlags = 1 << iota
+	// EngineSubpixel means that ClearType subpixel rendering is enabled.
+	EngineSubpixel
+	// EngineCompatibleWidths means that ClearType compatible widths are
+	// enabled.
+	EngineCompatibleWidths
+	// EngineSymmetricSmoothing means that ClearType symmetric smoothing is
+	// enabled.
+	EngineSymmetricSmoothing
+	// EngineBGR means that the subpixels are in BGR, not RGB, order.
+	EngineBGR
+	// EngineSubpixelPositioned means that glyphs are positioned at subpixel
+	// offsets.
+	EngineSubpixelPositioned
+	// EngineSymmetricRendering means that ClearType symmetric rendering is
+	// enabled.
+	EngineSymmetricRendering
+	// EngineGrayClearType means that ClearType is rendered in gray.
+	EngineGrayClearType
+
+	// numEngineFlags is the number of EngineFlags.
+	numEngineFlags = iota
+)
+
+// A TraceEvent describes the hinter's state before executing an instruction.
+type TraceEvent struct {
+	// Program is the bytecode being run, such as a function, the font's
+	// fpgm or prep, or a glyph's program. PC is the offset of the instruction
+	// in Program, and Opcode is the instruction's opcode.
+	Program []byte
+	PC      int
+	Opcode  uint8
+	// Stack is the interpreter's stack, bottom first. It is only valid
+	// during the Trace call, and must not be modified.
+	Stack []int32
+	// GS is the graphics state.
+	GS GraphicsState
+}
+
+// GraphicsState is the bytecode interpreter's graphics state, as described at
+// https://developer.apple.com/fonts/TTRefMan/RM04/Chap4.html
+type GraphicsState struct {
+	// ProjectionVector, FreedomVector and DualProjectionVector are unit
+	// vectors, as 2.14 fixed point numbers.
+	ProjectionVector, FreedomVector, DualProjectionVector [2]int32
+	// RefPoints are the reference points rp0, rp1 and rp2. ZonePointers are
+	// the zone pointers zp0, zp1 and zp2, where 0 is the twilight zone and 1
+	// is the glyph zone.
+	RefPoints, ZonePointers [3]int32
+	// ControlValueCutIn, SingleWidthCutIn, SingleWidth and MinDistance are
+	// 26.6 fixed point numbers.
+	ControlValueCutIn, SingleWidthCutIn, SingleWidth, MinDistance int32
+	DeltaBase, DeltaShift                                         int32
+	Loop                                                          int32
+	// RoundPeriod, RoundPhase and RoundThreshold are the rounding state, as
+	// 26.6 fixed point numbers. A zero RoundPeriod means that rounding is
+	// off.
+	RoundPeriod, RoundPhase, RoundThreshold int32
+	RoundSuper45                            bool
+	AutoFlip                                bool
+	ScanControl                             bool
+	ScanType                                int32
+	InstructControl                         int32
+}
+
+// export returns the exported form of gs.
+func (gs *graphicsState) export() GraphicsState {
+	return GraphicsState{
+		ProjectionVector:     [2]int32{int32(gs.pv[0]), int32(gs.pv[1])},
+		FreedomVector:        [2]int32{int32(gs.fv[0]), int32(gs.fv[1])},
+		DualProjectionVector: [2]int32{int32(gs.dv[0]), int32(gs.dv[1])},
+		RefPoints:            gs.rp,
+		ZonePointers:         gs.zp,
+		ControlValueCutIn:    int32(gs.controlValueCutIn),
+		SingleWidthCutIn:     int32(gs.singleWidthCutIn),
+		SingleWidth:          int32(gs.singleWidth),
+		MinDistance:          int32(gs.minDist),
+		DeltaBase:            gs.deltaBase,
+		DeltaShift:           gs.deltaShift,
+		Loop:                 gs.loop,
+		RoundPeriod:          int32(gs.roundPeriod),
+		RoundPhase:           int32(gs.roundPhase),
+		RoundThreshold:       int32(gs.roundThreshold),
+		RoundSuper45:         gs.roundSuper45,
+		AutoFlip:             gs.autoFlip,
+		ScanControl:          gs.scanControl,
+		ScanType:             gs.scanType,
+		InstructControl:      gs.instructControl,
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// OS2 holds the fields of a font's OS/2 table, which has its typographic
+// metrics, weight, width and style, and the Unicode ranges and code pages
+// that it supports. Distances are in FUnits.
+//
+// The table has grown over time, from 68 bytes, and the fields that are
+// missing from an older version of the table are zero. The table is
+// documented at http://www.microsoft.com/typography/otspec/os2.htm
+type OS2 struct {
+	Version       uint16
+	XAvgCharWidth int16
+	// WeightClass is the weight, from 100 (thin) to 900 (black), where 400
+	// is regular and 700 is bold. WidthClass is the width, from 1 (ultra
+	// condensed) to 9 (ultra expanded), where 5 is normal.
+	WeightClass uint16
+	WidthClass  uint16
+	// Type is the font's embedding licensing rights.
+	Type uint16
+	// The subscript and superscript sizes are the recommended em sizes, and
+	// their offsets are the recommended offsets of their origins from the
+	// baseline origin. Subscript Y offsets are positive downwards.
+	SubscriptXSize     int16
+	SubscriptYSize     int16
+	SubscriptXOffset   int16
+	SubscriptYOffset   int16
+	SuperscriptXSize   int16
+	SuperscriptYSize   int16
+	SuperscriptXOffset int16
+	SuperscriptYOffset int16
+	// StrikeoutSize is the thickness of the strikeout stroke, and
+	// StrikeoutPosition is the position of its top above the baseline.
+	StrikeoutSize     int16
+	StrikeoutPosition int16
+	FamilyClass       int16
+	Panose            [10]byte
+	// UnicodeRange is a bit set of the Unicode blocks that the font covers,
+	// as per its UnicodeRangeBit method.
+	UnicodeRange [4]uint32
+	VendorID     [4]byte
+	// Selection holds the style bits, such as SelectionItalic.
+	Selection      uint16
+	FirstCharIndex uint16
+	LastCharIndex  uint16
+	// TypoAscender, TypoDescender and TypoLineGap are the typographic
+	// metrics, which are typically used instead of the hhea table's metrics
+	// if Selection has the SelectionUseTypoMetrics bit. They, and the Win
+	// metrics, are missing from the original 68 byte table.
+	TypoAscender  int16
+	TypoDescender int16
+	TypoLineGap   int16
+	WinAscent     uint16
+	WinDescent    uint16
+	// CodePageRange is a bit set of the code pages that the font covers,
+	// from version 1 of the table.
+	CodePageRange [2]uint32
+	// XHeight and CapHeight are the heights of lowercase and uppercase
+	// letters, from version 2 of the table.
+	XHeight     int16
+	CapHeight   int16
+	DefaultChar uint16
+	BreakChar   uint16
+	MaxContext  uint16
+	// LowerOpticalPointSize and UpperOpticalPointSize are the range of point
+	// sizes, in twentieths of a point, that the font is designed for, from
+	// version 5 of the table.
+	LowerOpticalPointSize uint16
+	UpperOpticalPointSize uint16
+}
+
+// These are the bits of an OS2's Selection field.
+const (
+	SelectionItalic         = 1 << 0
+	SelectionUnderscore     = 1 << 1
+	SelectionNegative       = 1 << 2
+	SelectionOutlined       = 1 << 3
+	SelectionStrikeout      = 1 << 4
+	SelectionBold           = 1 << 5
+	SelectionRegular        = 1 << 6
+	SelectionUseTypoMetrics = 1 << 7
+	SelectionWWS            = 1 << 8
+	SelectionOblique        = 1 << 9
+)
+
+// UnicodeRangeBit returns whether the given bit, from 0 to 127, of the OS/2
+// table's Unicode ranges is set. The bits are assigned to Unicode blocks, such
+// as bit 0 for Basic Latin and bit 9 for Cyrillic, by the specification.
+func (o OS2) UnicodeRangeBit(bit int) bool {
+	if bit < 0 || bit >= 128 {
+		return false
+	}
+	return o.UnicodeRange[bit/32]&(1<<uint(bit%32)) != 0
+}
+
+// OS2 returns the font's OS/2 table. ok is false if the font has no OS/2
+// table, or if it is too short.
+func (f *Font) OS2() (o OS2, ok bool) {
+	b := f.os2
+	if len(b) < 68 {
+		return OS2{}, false
+	}
+	o.Version = u16(b, 0)
+	o.XAvgCharWidth = int16(u16(b, 2))
+	o.WeightClass = u16(b, 4)
+	o.WidthClass = u16(b, 6)
+	o.Type = u16(b, 8)
+	o.SubscriptXSize = int16(u16(b, 10))
+	o.SubscriptYSize = int16(u16(b, 12))
+	o.SubscriptXOffset = int16(u16(b, 14))
+	o.SubscriptYOffset = int16(u16(b, 16))
+	o.SuperscriptXSize = int16(u16(b, 18))
+	o.SuperscriptYSize = int16(u16(b, 20))
+	o.SuperscriptXOffset = int16(u16(b, 22))
+	o.SuperscriptYOffset = int16(u16(b, 24))
+	o.StrikeoutSize = int16(u16(b, 26))
+	o.StrikeoutPosition = int16(u16(b, 28))
+	o.FamilyClass = int16(u16(b, 30))
+	copy(o.Panose[:], b[32:42])
+	for i := range o.UnicodeRange {
+		o.UnicodeRange[i] = u32(b, 42+4*i)
+	}
+	copy(o.VendorID[:], b[58:62])
+	o.Selection = u16(b, 62)
+	o.FirstCharIndex = u16(b, 64)
+	o.LastCharIndex = u16(b, 66)
+	if len(b) < 78 {
+		return o, true
+	}
+	o.TypoAscender = int16(u16(b, 68))
+	o.TypoDescender = int16(u16(b, 70))
+	o.TypoLineGap = int16(u16(b, 72))
+	o.WinAscent = u16(b, 74)
+	o.WinDescent = u16(b, 76)
+	if o.Version < 1 || len(b) < 86 {
+		return o, true
+	}
+	o.CodePageRange[0] = u32(b, 78)
+	o.CodePageRange[1] = u32(b, 82)
+	if o.Version < 2 || len(b) < 96 {
+		return o, true
+	}
+	o.XHeight = int16(u16(b, 86))
+	o.CapHeight = int16(u16(b, 88))
+	o.DefaultChar = u16(b, 90)
+	o.BreakChar = u16(b, 92)
+	o.MaxContext = u16(b, 94)
+	if o.Version < 5 || len(b) < 100 {
+		return o, true
+	}
+	o.LowerOpticalPointSize = u16(b, 96)
+	o.UpperOpticalPointSize = u16(b, 98)
+	return o, true
+}
@@ -0,0 +1,71 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// CompareOutlines compares two outlines, such as those of a glyph loaded with
+// and without hinting, or before and after a change to the hinter. The
+// outlines match if they have the same contour ends and, for each pair of
+// corresponding points, the same on-curve flag and co-ordinates that differ by
+// no more than tolerance. The internal bits of the Points' Flags are ignored.
+//
+// If the outlines match, CompareOutlines returns -1 and true. Otherwise, it
+// returns the index of the first mismatched point and false. If the contour
+// ends differ, the index is that of the end of the first mismatched contour,
+// or the length of the shorter outline's points.
+func CompareOutlines(a, b *GlyphBuf, tolerance int32) (index int, equal bool) {
+	n := len(a.End)
+	if n > len(b.End) {
+		n = len(b.End)
+	}
+	for i := 0; i < n; i++ {
+		if a.End[i] != b.End[i] {
+			if a.End[i] < b.End[i] {
+				return a.End[i], false
+			}
+			return b.End[i], false
+		}
+	}
+	if len(a.End) != len(b.End) || len(a.Point) != len(b.Point) {
+		if len(a.Point) < len(b.Point) {
+			return len(a.Point), false
+		}
+		return len(b.Point), false
+	}
+	for i, p := range a.Point {
+		q := b.Point[i]
+		if p.Flags&flagOnCurve != q.Flags&flagOnCurve ||
+			abs32(p.X-q.X) > tolerance || abs32(p.Y-q.Y) > tolerance {
+			return i, false
+		}
+	}
+	return -1, true
+}
+
+// SnapPoints moves each point that is within tolerance, in both X and Y, of
+// an earlier point onto that earlier point, so that near-coincident points
+// become exactly coincident. It returns the number of points moved.
+func SnapPoints(p []Point, tolerance int32) int {
+	moved := 0
+	for i := range p {
+		for j := 0; j < i; j++ {
+			if abs32(p[i].X-p[j].X) <= tolerance && abs32(p[i].Y-p[j].Y) <= tolerance {
+				if p[i].X != p[j].X || p[i].Y != p[j].Y {
+					p[i].X, p[i].Y = p[j].X, p[j].Y
+					moved++
+				}
+				break
+			}
+		}
+	}
+	return moved
+}
+
+func abs32(x int32) int32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,175 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"strconv"
+)
+
+// PathOp is the operation of a PathCommand.
+type PathOp uint8
+
+const (
+	// PathMoveTo starts a contour at Args[0].
+	PathMoveTo PathOp = iota
+	// PathLineTo draws a line to Args[0].
+	PathLineTo
+	// PathQuadTo draws a quadratic Bézier curve, with control point Args[0],
+	// to Args[1].
+	PathQuadTo
+	// PathCubicTo draws a cubic Bézier curve, with control points Args[0]
+	// and Args[1], to Args[2].
+	PathCubicTo
+	// PathClose closes the contour.
+	PathClose
+)
+
+// A PathCommand is a step in drawing a glyph's contours. The Flags of its
+// Args are zero, and the Args after those that its Op uses are zero.
+type PathCommand struct {
+	Op   PathOp
+	Args [3]Point
+}
+
+// Path appends the commands that draw the glyph's contours to dst, and
+// returns the result. Each contour is a PathMoveTo, then lines and curves
+// ending at its start, then a PathClose. The implied on-curve points between
+// two off-curve points are made explicit, and rounded down.
+//
+// The co-ordinates are those of the Points, with Y going upwards. A glyph
+// loaded with NoHinting at a scale of the font's FUnitsPerEm has its Points
+// in font units.
+func (g *GlyphBuf) Path(dst []PathCommand) []PathCommand {
+	return appendPath(dst, g.Point, g.End, 0)
+}
+
+// SVGPath returns the glyph's contours, as drawn by Path, as the data of an
+// SVG path element's "d" attribute. Y is negated, as SVG's Y goes downwards,
+// so that the glyph's origin is at (0, 0) and its baseline is along Y = 0.
+// The implied on-curve points between two off-curve points are not rounded.
+func (g *GlyphBuf) SVGPath() string {
+	// Double the co-ordinates, so that the implied points are exact.
+	cmds := appendPath(nil, g.Point, g.End, 1)
+	var b []byte
+	for i, c := range cmds {
+		n := 0
+		switch c.Op {
+		case PathMoveTo:
+			b, n = append(b, 'M'), 1
+		case PathLineTo:
+			if i+1 < len(cmds) && cmds[i+1].Op == PathClose {
+				// The close path command draws the line.
+				continue
+			}
+			b, n = append(b, 'L'), 1
+		case PathQuadTo:
+			b, n = append(b, 'Q'), 2
+		case PathCubicTo:
+			b, n = append(b, 'C'), 3
+		case PathClose:
+			b = append(b, 'Z')
+		}
+		for j, p := range c.Args[:n] {
+			if j != 0 {
+				b = append(b, ' ')
+			}
+			b = appendHalf(b, p.X)
+			b = append(b, ' ')
+			b = appendHalf(b, -p.Y)
+		}
+	}
+	return string(b)
+}
+
+// appendHalf appends the decimal representation of x/2 to b.
+func appendHalf(b []byte, x int32) []byte {
+	if x < 0 {
+		b, x = append(b, '-'), -x
+	}
+	b = strconv.AppendInt(b, int64(x>>1), 10)
+	if x&1 != 0 {
+		b = append(b, ".5"...)
+	}
+	return b
+}
+
+// appendPath appends the commands that draw the contours of the given points
+// and contour ends to dst, with the co-ordinates shifted left by shift.
+func appendPath(dst []PathCommand, ps []Point, ends []int, shift uint) []PathCommand {
+	at := func(p Point) Point {
+		return Point{X: p.X << shift, Y: p.Y << shift}
+	}
+	mid := func(p, q Point) Point {
+		return Point{X: (p.X + q.X) >> 1, Y: (p.Y + q.Y) >> 1}
+	}
+	e0 := 0
+	for _, e1 := range ends {
+		if e1 > len(ps) {
+			break
+		}
+		contour := ps[e0:e1]
+		e0 = e1
+		if len(contour) == 0 {
+			continue
+		}
+		// As for the rasterizer, a contour that starts with an off-curve point
+		// starts at its last point if that is on-curve, or else at the implied
+		// point between its last and first points.
+		start, others := at(contour[0]), contour[1:]
+		if contour[0].Flags&flagOnCurve == 0 {
+			last := at(contour[len(contour)-1])
+			if contour[len(contour)-1].Flags&flagOnCurve != 0 {
+				start, others = last, contour[:len(contour)-1]
+			} else {
+				start, others = mid(start, last), contour
+			}
+		}
+		dst = append(dst, PathCommand{Op: PathMoveTo, Args: [3]Point{start}})
+		q0, on0, closed := start, true, false
+		for i := 0; i < len(others); i++ {
+			p := others[i]
+			q := at(p)
+			if p.Flags&FlagCubic != 0 {
+				// The second control point and the end point default to
+				// the start point, at the end of the contour.
+				q1, q2 := start, start
+				if i+1 < len(others) {
+					q1 = at(others[i+1])
+				}
+				if i+2 < len(others) {
+					q2 = at(others[i+2])
+				}
+				dst = append(dst, PathCommand{Op: PathCubicTo, Args: [3]Point{q, q1, q2}})
+				if i+2 >= len(others) {
+					closed = true
+					break
+				}
+				i += 2
+				q0, on0 = q2, true
+				continue
+			}
+			on := p.Flags&flagOnCurve != 0
+			switch {
+			case on && on0:
+				dst = append(dst, PathCommand{Op: PathLineTo, Args: [3]Point{q}})
+			case on:
+				dst = append(dst, PathCommand{Op: PathQuadTo, Args: [3]Point{q0, q}})
+			case !on0:
+				dst = append(dst, PathCommand{Op: PathQuadTo, Args: [3]Point{q0, mid(q0, q)}})
+			}
+			q0, on0 = q, on
+		}
+		switch {
+		case closed:
+		case !on0:
+			dst = append(dst, PathCommand{Op: PathQuadTo, Args: [3]Point{q0, start}})
+		case q0 != start:
+			dst = append(dst, PathCommand{Op: PathLineTo, Args: [3]Point{start}})
+		}
+		dst = append(dst, PathCommand{Op: PathClose})
+	}
+	return dst
+}
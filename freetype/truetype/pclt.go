@@ -0,0 +1,50 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// PCLT holds the fields of a font's PCL 5 (PCLT) table, for use by printers
+// and legacy PCL pipelines. Distances are in FUnits.
+//
+// The table is documented at http://www.microsoft.com/typography/otspec/pclt.htm
+type PCLT struct {
+	Version             uint32
+	FontNumber          uint32
+	Pitch               uint16
+	XHeight             uint16
+	Style               uint16
+	TypeFamily          uint16
+	CapHeight           uint16
+	SymbolSet           uint16
+	Typeface            [16]byte
+	CharacterComplement [8]byte
+	FileName            [6]byte
+	StrokeWeight        int8
+	WidthType           int8
+	SerifStyle          uint8
+}
+
+// PCLT returns the font's PCLT table. ok is false if the font has no PCLT
+// table, or if it is too short.
+func (f *Font) PCLT() (p PCLT, ok bool) {
+	if len(f.pclt) < 54 {
+		return PCLT{}, false
+	}
+	p.Version = u32(f.pclt, 0)
+	p.FontNumber = u32(f.pclt, 4)
+	p.Pitch = u16(f.pclt, 8)
+	p.XHeight = u16(f.pclt, 10)
+	p.Style = u16(f.pclt, 12)
+	p.TypeFamily = u16(f.pclt, 14)
+	p.CapHeight = u16(f.pclt, 16)
+	p.SymbolSet = u16(f.pclt, 18)
+	copy(p.Typeface[:], f.pclt[20:36])
+	copy(p.CharacterComplement[:], f.pclt[36:44])
+	copy(p.FileName[:], f.pclt[44:50])
+	p.StrokeWeight = int8(f.pclt[50])
+	p.WidthType = int8(f.pclt[51])
+	p.SerifStyle = f.pclt[52]
+	return p, true
+}
@@ -0,0 +1,127 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// macGlyphNames are the names of the 258 glyphs in the standard Macintosh
+// TrueType glyph order, used by versions 1.0 and 2.0 of the post table.
+var macGlyphNames = [258]string{
+	".notdef", ".null", "nonmarkingreturn", "space", "exclam", "quotedbl",
+	"numbersign", "dollar", "percent", "ampersand", "quotesingle", "parenleft",
+	"parenright", "asterisk", "plus", "comma", "hyphen", "period", "slash",
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight",
+	"nine", "colon", "semicolon", "less", "equal", "greater", "question", "at",
+	"A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N", "O",
+	"P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z", "bracketleft",
+	"backslash", "bracketright", "asciicircum", "underscore", "grave",
+	"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o",
+	"p", "q", "r", "s", "t", "u", "v", "w", "x", "y", "z", "braceleft", "bar",
+	"braceright", "asciitilde", "Adieresis", "Aring", "Ccedilla", "Eacute",
+	"Ntilde", "Odieresis", "Udieresis", "aacute", "agrave", "acircumflex",
+	"adieresis", "atilde", "aring", "ccedilla", "eacute", "egrave",
+	"ecircumflex", "edieresis", "iacute", "igrave", "icircumflex", "idieresis",
+	"ntilde", "oacute", "ograve", "ocircumflex", "odieresis", "otilde",
+	"uacute", "ugrave", "ucircumflex", "udieresis", "dagger", "degree", "cent",
+	"sterling", "section", "bullet", "paragraph", "germandbls", "registered",
+	"copyright", "trademark", "acute", "dieresis", "notequal", "AE", "Oslash",
+	"infinity", "plusminus", "lessequal", "greaterequal", "yen", "mu",
+	"partialdiff", "summation", "product", "pi", "integral", "ordfeminine",
+	"ordmasculine", "Omega", "ae", "oslash", "questiondown", "exclamdown",
+	"logicalnot", "radical", "florin", "approxequal", "Delta", "guillemotleft",
+	"guillemotright", "ellipsis", "nonbreakingspace", "Agrave", "Atilde",
+	"Otilde", "OE", "oe", "endash", "emdash", "quotedblleft", "quotedblright",
+	"quoteleft", "quoteright", "divide", "lozenge", "ydieresis", "Ydieresis",
+	"fraction", "currency", "guilsinglleft", "guilsinglright", "fi", "fl",
+	"daggerdbl", "periodcentered", "quotesinglbase", "quotedblbase",
+	"perthousand", "Acircumflex", "Ecircumflex", "Aacute", "Edieresis",
+	"Egrave", "Iacute", "Icircumflex", "Idieresis", "Igrave", "Oacute",
+	"Ocircumflex", "apple", "Ograve", "Uacute", "Ucircumflex", "Ugrave",
+	"dotlessi", "circumflex", "tilde", "macron", "breve", "dotaccent", "ring",
+	"cedilla", "hungarumlaut", "ogonek", "caron", "Lslash", "lslash", "Scaron",
+	"scaron", "Zcaron", "zcaron", "brokenbar", "Eth", "eth", "Yacute",
+	"yacute", "Thorn", "thorn", "minus", "multiply", "onesuperior",
+	"twosuperior", "threesuperior", "onehalf", "onequarter", "threequarters",
+	"franc", "Gbreve", "gbreve", "Idotaccent", "Scedilla", "scedilla",
+	"Cacute", "cacute", "Ccaron", "ccaron", "dcroat",
+}
+
+// GlyphName returns the PostScript name of the glyph with the given index,
+// from the font's post table. ok is false if the font has no glyph names,
+// such as when it has a version 3.0 post table, or if i is out of range. The
+// names are decoded when GlyphName is first called.
+//
+// The table is documented at http://www.microsoft.com/typography/otspec/post.htm
+func (f *Font) GlyphName(i Index) (name string, ok bool) {
+	names := f.glyphNames()
+	if i < 0 || len(names) <= int(i) || names[i] == "" {
+		return "", false
+	}
+	return names[i], true
+}
+
+// glyphNames returns the font's glyph names, indexed by glyph, decoding them
+// when they are first needed. A glyph without a name has an empty name. The
+// returned slice must not be modified.
+func (f *Font) glyphNames() []string {
+	return f.postNames.get(f.budget, func() (interface{}, int64) {
+		names := decodeGlyphNames(f.post, f.nGlyph)
+		size := int64(24)
+		if len(f.post) >= 4 && u32(f.post, 0) == 0x00020000 {
+			// Version 1 names are shared, but version 2 names are decoded.
+			size += int64(16 * len(names))
+			for _, name := range names {
+				size += int64(len(name))
+			}
+		}
+		return names, size
+	}).([]string)
+}
+
+// decodeGlyphNames returns the names of the first nGlyph glyphs in the post
+// table b.
+func decodeGlyphNames(b []byte, nGlyph int) []string {
+	if len(b) < 32 {
+		return nil
+	}
+	switch u32(b, 0) {
+	case 0x00010000:
+		if nGlyph > len(macGlyphNames) {
+			nGlyph = len(macGlyphNames)
+		}
+		return macGlyphNames[:nGlyph]
+	case 0x00020000:
+		if len(b) < 34 {
+			return nil
+		}
+		n := int(u16(b, 32))
+		if len(b) < 34+2*n {
+			return nil
+		}
+		// The remaining names are Pascal strings, after the name indexes.
+		var extra []string
+		for x := 34 + 2*n; x < len(b); {
+			length := int(b[x])
+			if len(b) < x+1+length {
+				break
+			}
+			extra = append(extra, string(b[x+1:x+1+length]))
+			x += 1 + length
+		}
+		if n > nGlyph {
+			n = nGlyph
+		}
+		names := make([]string, n)
+		for i := range names {
+			j := int(u16(b, 34+2*i))
+			if j < len(macGlyphNames) {
+				names[i] = macGlyphNames[j]
+			} else if j -= len(macGlyphNames); j < len(extra) {
+				names[i] = extra[j]
+			}
+		}
+		return names
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"time"
+)
+
+// A Profile records where the hinter spends its time: how many times each
+// instruction is executed, and how many times each function is called and for
+// how long. To profile hinting, set the Profile field of the HinterOptions.
+// The counts accumulate over every program that is run, including the font's
+// fpgm and prep programs, until Reset is called.
+//
+// A Profile may be shared by GlyphBufs that are used sequentially, but not by
+// ones that are used concurrently.
+type Profile struct {
+	// Opcodes is the number of times each instruction was executed, indexed
+	// by opcode.
+	Opcodes [256]uint64
+	// Functions are the call statistics of each function, as defined by the
+	// FDEF instruction, by function number.
+	Functions map[int32]*FunctionProfile
+}
+
+// A FunctionProfile is the call statistics of a function.
+type FunctionProfile struct {
+	// Calls is the number of calls that returned. A LOOPCALL counts as one
+	// call per iteration.
+	Calls uint64
+	// Time is the total time spent in those calls, including the time spent
+	// in any nested calls.
+	Time time.Duration
+}
+
+// Reset clears p's statistics.
+func (p *Profile) Reset() {
+	p.Opcodes = [256]uint64{}
+	p.Functions = nil
+}
+
+// Steps returns the total number of instructions executed.
+func (p *Profile) Steps() uint64 {
+	n := uint64(0)
+	for _, c := range p.Opcodes {
+		n += c
+	}
+	return n
+}
+
+// addCall records a call of function f that took the time since start.
+func (p *Profile) addCall(f int32, start time.Time) {
+	if p.Functions == nil {
+		p.Functions = make(map[int32]*FunctionProfile)
+	}
+	fp := p.Functions[f]
+	if fp == nil {
+		fp = &FunctionProfile{}
+		p.Functions[f] = fp
+	}
+	fp.Calls++
+	fp.Time += time.Since(start)
+}
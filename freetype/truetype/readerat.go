@@ -0,0 +1,118 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"io"
+)
+
+// ParseReaderAt returns a new Font for the TTF data of the given size, read
+// from r. Unlike Parse, it does not read the whole font into memory. The
+// glyf table, which is most of a large font such as a CJK font, is left in r,
+// and each glyph's data is read from r when the glyph is loaded. The other
+// tables are read when the font is parsed. r must not change while the Font
+// is in use, and errors reading from r are returned when loading glyphs.
+//
+// Only the glyf table is paged. A font with CFF outlines, as many large CJK
+// fonts have, is read whole, as its CFF table's charstrings are read from
+// memory, and so ParseReaderAt saves no memory for it over Parse.
+//
+// A WOFF or WOFF2 font is compressed, and so is read whole. As for
+// ParseIncremental, a Font parsed from an io.ReaderAt cannot be subset, and
+// GlyphOffset is not ok for its glyphs.
+func ParseReaderAt(r io.ReaderAt, size int64) (*Font, error) {
+	readAt := func(offset, length int64) ([]byte, error) {
+		if offset < 0 || length < 0 || size < offset+length {
+			return nil, FormatError{Reason: "TTF data is too short"}
+		}
+		b := make([]byte, length)
+		if n, err := r.ReadAt(b, offset); n < len(b) {
+			return nil, err
+		}
+		return b, nil
+	}
+	header, err := readAt(0, 12)
+	if err != nil {
+		return nil, err
+	}
+	base := int64(0)
+	switch u32(header, 0) {
+	case 0x74746366: // "ttcf" as a big-endian uint32.
+		// As for Parse, use the first font in a TrueType collection.
+		b, err := readAt(8, 8)
+		if err != nil {
+			return nil, err
+		}
+		if u32(b, 0) == 0 {
+			return nil, FormatError{Offset: 8, Reason: "bad number of TTC fonts"}
+		}
+		base = int64(u32(b, 4))
+		if header, err = readAt(base, 12); err != nil {
+			return nil, err
+		}
+	case 0x774f4646, 0x774f4632: // "wOFF" and "wOF2" as big-endian uint32s.
+		ttf, err := readAt(0, size)
+		if err != nil {
+			return nil, err
+		}
+		return Parse(ttf)
+	}
+	n := int64(u16(header, 4))
+	dir, err := readAt(base+12, 16*n)
+	if err != nil {
+		return nil, err
+	}
+	tables, src := map[string][]byte{}, (*readerAtSource)(nil)
+	for i := 0; i < int(n); i++ {
+		x := 16 * i
+		tag, offset, length := string(dir[x:x+4]), int64(u32(dir, x+8)), int64(u32(dir, x+12))
+		if tag == "glyf" {
+			if size < offset+length {
+				return nil, FormatError{Reason: "TTF data is too short"}
+			}
+			src = &readerAtSource{r: r, offset: offset, length: length}
+			continue
+		}
+		if tables[tag], err = readAt(offset, length); err != nil {
+			return nil, err
+		}
+	}
+	ttf := writeSFNT(u32(header, 0), tables)
+	if src == nil {
+		return Parse(ttf)
+	}
+	f, err := parse(ttf, 0, src, nil)
+	if err != nil {
+		return nil, err
+	}
+	src.font = f
+	return f, nil
+}
+
+// readerAtSource is the IncrementalSource of a Font parsed by ParseReaderAt.
+// It reads glyphs from the glyf table, at the given offset and length in r.
+type readerAtSource struct {
+	r              io.ReaderAt
+	offset, length int64
+	font           *Font
+}
+
+func (s *readerAtSource) GlyphData(i Index) ([]byte, error) {
+	g0, g1, ok := s.font.locaEntry(i, uint32(s.length))
+	if !ok {
+		return nil, s.font.locaError(i)
+	}
+	b := make([]byte, g1-g0)
+	if n, err := s.r.ReadAt(b, s.offset+int64(g0)); n < len(b) {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (s *readerAtSource) HMetric(i Index) (HMetric, bool) {
+	// The metrics are read from the font's hmtx table.
+	return HMetric{}, false
+}
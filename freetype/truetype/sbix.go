@@ -0,0 +1,85 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// An SbixGlyph is a glyph's embedded image from the font's sbix table, which
+// holds bitmap glyphs, such as Apple's color emoji, in strikes of a number of
+// sizes.
+type SbixGlyph struct {
+	// PPEM and PPI are the pixels per em and the pixels per inch that the
+	// image's strike was designed for.
+	PPEM, PPI uint16
+	// OriginX and OriginY are the offset, in pixels, of the image's bottom
+	// left corner from the glyph's origin.
+	OriginX, OriginY int16
+	// Type is the image's format, such as "png ", "jpg " or "tiff".
+	Type string
+	// Data is the image's data, such as a PNG file. It must not be modified.
+	Data []byte
+}
+
+// SbixGlyph returns the embedded image of the glyph with the given index, from
+// the font's sbix strike that best suits the given scale, which is the number
+// of 26.6 fixed point units in 1 em. That is the smallest strike that is at
+// least as large, or else the largest strike, and the image should be scaled
+// from the strike's PPEM to fit. ok is false if the font has no image for the
+// glyph in that strike.
+//
+// The table is documented at https://developer.apple.com/fonts/TrueType-Reference-Manual/RM06/Chap6sbix.html
+func (f *Font) SbixGlyph(scale int32, i Index) (g SbixGlyph, ok bool) {
+	b := f.sbix
+	if len(b) < 8 || int(i) >= f.nGlyph {
+		return SbixGlyph{}, false
+	}
+	n := int(u32(b, 4))
+	if n == 0 || len(b) < 8+4*n {
+		return SbixGlyph{}, false
+	}
+	ppem, strike, best := (scale+32)>>6, []byte(nil), uint16(0)
+	for j := 0; j < n; j++ {
+		offset := u32(b, 8+4*j)
+		if uint64(len(b)) < uint64(offset)+4+4*uint64(f.nGlyph+1) {
+			continue
+		}
+		s := b[offset:]
+		p := u16(s, 0)
+		switch {
+		case strike == nil,
+			int32(best) < ppem && p > best,
+			int32(p) >= ppem && p < best:
+			strike, best = s, p
+		}
+	}
+	if strike == nil {
+		return SbixGlyph{}, false
+	}
+	// A "dupe" image is the image of another glyph, in the same strike.
+	for dupe := 0; dupe < 2; dupe++ {
+		g0, g1 := u32(strike, 4+4*int(i)), u32(strike, 8+4*int(i))
+		if uint64(g1) < uint64(g0)+8 || uint64(len(strike)) < uint64(g1) {
+			return SbixGlyph{}, false
+		}
+		data := strike[g0:g1]
+		g = SbixGlyph{
+			PPEM:    u16(strike, 0),
+			PPI:     u16(strike, 2),
+			OriginX: int16(u16(data, 0)),
+			OriginY: int16(u16(data, 2)),
+			Type:    string(data[4:8]),
+			Data:    data[8:],
+		}
+		if g.Type != "dupe" {
+			return g, true
+		}
+		if len(g.Data) < 2 {
+			return SbixGlyph{}, false
+		}
+		if i = Index(u16(g.Data, 0)); int(i) >= f.nGlyph {
+			return SbixGlyph{}, false
+		}
+	}
+	return SbixGlyph{}, false
+}
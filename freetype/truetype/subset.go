@@ -0,0 +1,413 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"sort"
+)
+
+// Subset returns a TrueType font, as TTF data, that contains only the glyphs
+// that f maps the given runes to, and the glyphs that they are composed of,
+// along with the IndexMap from f's glyph indexes to those of the subset font.
+// The subset font's glyph 0 is f's glyph 0, the missing glyph, and its other
+// glyphs are in the same order as in f.
+//
+// The subset font has a newly generated cmap, glyf, loca and hmtx table. Its
+// head, hhea, maxp, OS/2 and post tables are copied from f and updated as
+// needed, and its name, cvt, fpgm, prep and VDMX tables, which do not depend
+// on glyph indexes, are copied unchanged. Other tables, such as kern, are
+// dropped.
+func (f *Font) Subset(runes []rune) (ttf []byte, m *IndexMap, err error) {
+	return f.subset(runes, nil)
+}
+
+// SubsetFeatures is like Subset, but the subset font also contains the glyphs
+// that the GSUB substitutions for the given features can replace the runes'
+// glyphs with, as per SubstitutionClosure. The subset font has no GSUB table,
+// so the substitutions are not made by drawing text with it, but text that is
+// shaped with f, such as by Substitute, can be drawn with the subset font
+// through the IndexMap.
+func (f *Font) SubsetFeatures(runes []rune, features ...string) (ttf []byte, m *IndexMap, err error) {
+	var glyphs []Index
+	for _, r := range runes {
+		if i := f.Index(r); i != 0 {
+			glyphs = append(glyphs, i)
+		}
+	}
+	return f.subset(runes, f.SubstitutionClosure(glyphs, features...))
+}
+
+// subset returns a subset font with the glyphs of the given runes, and the
+// given other glyphs.
+func (f *Font) subset(runes []rune, glyphs []Index) (ttf []byte, m *IndexMap, err error) {
+	if f.src != nil {
+		return nil, nil, UnsupportedError{Feature: "subsetting an incremental font"}
+	}
+	if f.hhea == nil || f.maxp == nil || len(f.maxp) < 6 {
+		return nil, nil, FormatError{Reason: "missing hhea or maxp table"}
+	}
+
+	// Find the glyphs, including the components of compound glyphs.
+	runeIndex := map[rune]Index{}
+	included := map[Index]bool{0: true}
+	var queue []Index
+	for _, r := range runes {
+		i := f.Index(r)
+		if i == 0 {
+			continue
+		}
+		runeIndex[r] = i
+		if !included[i] {
+			included[i] = true
+			queue = append(queue, i)
+		}
+	}
+	for _, i := range glyphs {
+		if int(i) < f.nGlyph && !included[i] {
+			included[i] = true
+			queue = append(queue, i)
+		}
+	}
+	for len(queue) > 0 {
+		i := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		data, err := f.glyphData(i)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, err = walkComponents(data, func(x int) {
+			if c := Index(u16(data, x)); !included[c] {
+				included[c] = true
+				queue = append(queue, c)
+			}
+		})
+		if err != nil {
+			return nil, nil, f.glyfError(i, "bad compound glyph")
+		}
+	}
+	old := make([]Index, 0, len(included))
+	for i := range included {
+		old = append(old, i)
+	}
+	sort.Sort(indexSlice(old))
+	m = NewIndexMap(old)
+
+	// Build the glyf, loca and hmtx tables. The loca table is always in the
+	// long format.
+	var glyf, loca, hmtx []byte
+	for _, o := range old {
+		data, err := f.glyphData(o)
+		if err != nil {
+			return nil, nil, err
+		}
+		loca = appendU32(loca, uint32(len(glyf)))
+		start := len(glyf)
+		glyf = append(glyf, data...)
+		// Renumber compound glyphs' components.
+		g := glyf[start:]
+		walkComponents(g, func(x int) {
+			c, _ := m.New(Index(u16(g, x)))
+			putU16(g, x, uint16(c))
+		})
+		for len(glyf)%4 != 0 {
+			glyf = append(glyf, 0)
+		}
+		h := f.unscaledHMetric(o)
+		hmtx = appendU16(hmtx, uint16(h.AdvanceWidth))
+		hmtx = appendU16(hmtx, uint16(h.LeftSideBearing))
+	}
+	loca = appendU32(loca, uint32(len(glyf)))
+
+	tables := map[string][]byte{
+		"cmap": subsetCmap(runeIndex, m),
+		"glyf": glyf,
+		"hmtx": hmtx,
+		"loca": loca,
+	}
+	head := append([]byte(nil), f.head...)
+	putU16(head, 50, 1)
+	tables["head"] = head
+	hhea := append([]byte(nil), f.hhea...)
+	putU16(hhea, 34, uint16(len(old)))
+	tables["hhea"] = hhea
+	maxp := append([]byte(nil), f.maxp...)
+	putU16(maxp, 4, uint16(len(old)))
+	tables["maxp"] = maxp
+	if _, ok := f.OS2(); ok {
+		// Update usFirstCharIndex and usLastCharIndex.
+		os2 := append([]byte(nil), f.os2...)
+		first, last := rune(0xffff), rune(0)
+		for r := range runeIndex {
+			if r < first {
+				first = r
+			}
+			if r > last {
+				last = r
+			}
+		}
+		if last > 0xffff {
+			last = 0xffff
+		}
+		if first > last {
+			first, last = 0, 0
+		}
+		putU16(os2, 64, uint16(first))
+		putU16(os2, 66, uint16(last))
+		tables["OS/2"] = os2
+	}
+	if len(f.post) >= 32 {
+		// Version 3.0 of the post table has no glyph names.
+		post := append([]byte(nil), f.post[:32]...)
+		putU32(post, 0, 0x00030000)
+		tables["post"] = post
+	}
+	for tag, b := range map[string][]byte{
+		"name": f.name,
+		"cvt ": f.cvt,
+		"fpgm": f.fpgm,
+		"prep": f.prep,
+		"VDMX": f.vdmx,
+	} {
+		if b != nil {
+			tables[tag] = b
+		}
+	}
+	return writeSFNT(0x00010000, tables), m, nil
+}
+
+// walkComponents calls fn with the offset of each component glyph index of
+// the glyph with the given glyf data, if it is a compound glyph. It returns
+// the offset of the end of the components, which is zero for a simple glyph.
+func walkComponents(data []byte, fn func(x int)) (end int, err error) {
+	// Flags for decoding a compound glyph. These flags are documented at
+	// http://developer.apple.com/fonts/TTRefMan/RM06/Chap6glyf.html.
+	const (
+		flagArg1And2AreWords   = 1 << 0
+		flagWeHaveAScale       = 1 << 3
+		flagMoreComponents     = 1 << 5
+		flagWeHaveAnXAndYScale = 1 << 6
+		flagWeHaveATwoByTwo    = 1 << 7
+	)
+	if len(data) < 10 || int16(u16(data, 0)) >= 0 {
+		return 0, nil
+	}
+	for x := 10; ; {
+		if len(data) < x+4 {
+			return 0, FormatError{Table: "glyf", Reason: "bad compound glyph"}
+		}
+		flags := u16(data, x)
+		fn(x + 2)
+		x += 4
+		if flags&flagArg1And2AreWords != 0 {
+			x += 4
+		} else {
+			x += 2
+		}
+		switch {
+		case flags&flagWeHaveAScale != 0:
+			x += 2
+		case flags&flagWeHaveAnXAndYScale != 0:
+			x += 4
+		case flags&flagWeHaveATwoByTwo != 0:
+			x += 8
+		}
+		if flags&flagMoreComponents == 0 {
+			if len(data) < x {
+				return 0, FormatError{Table: "glyf", Reason: "bad compound glyph"}
+			}
+			return x, nil
+		}
+	}
+}
+
+// subsetCmap returns a cmap table that maps the given runes to the subset
+// glyph indexes of their glyphs. It has a format 12 subtable for all runes
+// and, if it fits, a format 4 subtable for those in the Basic Multilingual
+// Plane.
+func subsetCmap(runeIndex map[rune]Index, m *IndexMap) []byte {
+	runes := make([]rune, 0, len(runeIndex))
+	for r := range runeIndex {
+		runes = append(runes, r)
+	}
+	sort.Sort(runeSlice(runes))
+
+	// Group the runes into ranges that map to consecutive glyphs.
+	type group struct {
+		start, end rune
+		glyph      Index
+	}
+	var groups, bmp []group
+	for _, r := range runes {
+		i, _ := m.New(runeIndex[r])
+		if n := len(groups); n > 0 {
+			g := &groups[n-1]
+			if g.end+1 == r && int(g.glyph)+int(r-g.start) == int(i) {
+				g.end = r
+				continue
+			}
+		}
+		groups = append(groups, group{r, r, i})
+	}
+	for _, g := range groups {
+		if g.start > 0xfffe {
+			break
+		}
+		if g.end > 0xfffe {
+			g.end = 0xfffe
+		}
+		bmp = append(bmp, g)
+	}
+
+	format12 := []byte{0, 12, 0, 0}
+	format12 = appendU32(format12, uint32(16+12*len(groups)))
+	format12 = appendU32(format12, 0)
+	format12 = appendU32(format12, uint32(len(groups)))
+	for _, g := range groups {
+		format12 = appendU32(format12, uint32(g.start))
+		format12 = appendU32(format12, uint32(g.end))
+		format12 = appendU32(format12, uint32(g.glyph))
+	}
+
+	// The format 4 subtable's segments are the BMP groups and the final
+	// segment for 0xFFFF that is required by the format.
+	var format4 []byte
+	if segCount := len(bmp) + 1; 16+8*segCount <= 0xffff {
+		searchRange, entrySelector := 2, 0
+		for searchRange*2 <= 2*segCount {
+			searchRange, entrySelector = searchRange*2, entrySelector+1
+		}
+		format4 = []byte{0, 4}
+		format4 = appendU16(format4, uint16(16+8*segCount))
+		format4 = appendU16(format4, 0)
+		format4 = appendU16(format4, uint16(2*segCount))
+		format4 = appendU16(format4, uint16(searchRange))
+		format4 = appendU16(format4, uint16(entrySelector))
+		format4 = appendU16(format4, uint16(2*segCount-searchRange))
+		for _, g := range bmp {
+			format4 = appendU16(format4, uint16(g.end))
+		}
+		format4 = appendU16(format4, 0xffff)
+		format4 = appendU16(format4, 0)
+		for _, g := range bmp {
+			format4 = appendU16(format4, uint16(g.start))
+		}
+		format4 = appendU16(format4, 0xffff)
+		for _, g := range bmp {
+			format4 = appendU16(format4, uint16(int(g.glyph)-int(g.start)))
+		}
+		format4 = appendU16(format4, 1)
+		for i := 0; i < segCount; i++ {
+			format4 = appendU16(format4, 0)
+		}
+	}
+
+	// The subtables are for the Microsoft platform, with the UCS-2 encoding
+	// for format 4 and the UCS-4 encoding for format 12.
+	b := []byte{0, 0}
+	if format4 == nil {
+		b = appendU16(b, 1)
+		b = appendU32(b, 0x0003000a)
+		b = appendU32(b, 12)
+		return append(b, format12...)
+	}
+	b = appendU16(b, 2)
+	b = appendU32(b, 0x00030001)
+	b = appendU32(b, 20)
+	b = appendU32(b, 0x0003000a)
+	b = appendU32(b, uint32(20+len(format4)))
+	b = append(b, format4...)
+	return append(b, format12...)
+}
+
+// writeSFNT returns the TTF data for a font with the given version, such as
+// 0x00010000 or "OTTO", and tables, keyed by their tags. It sets the checksum
+// adjustment in the head table, if there is one.
+func writeSFNT(version uint32, tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	n := len(tags)
+	searchRange, entrySelector := 16, 0
+	for searchRange*2 <= 16*n {
+		searchRange, entrySelector = searchRange*2, entrySelector+1
+	}
+	b := appendU32(nil, version)
+	b = appendU16(b, uint16(n))
+	b = appendU16(b, uint16(searchRange))
+	b = appendU16(b, uint16(entrySelector))
+	b = appendU16(b, uint16(16*n-searchRange))
+	offset, headOffset := 12+16*n, -1
+	for _, tag := range tags {
+		t := tables[tag]
+		if tag == "head" && len(t) >= 12 {
+			// The checksum adjustment is zero when computing the checksums.
+			t = append([]byte(nil), t...)
+			putU32(t, 8, 0)
+			tables[tag] = t
+			headOffset = offset
+		}
+		b = append(b, tag...)
+		b = appendU32(b, checksum(t))
+		b = appendU32(b, uint32(offset))
+		b = appendU32(b, uint32(len(t)))
+		offset += (len(t) + 3) &^ 3
+	}
+	for _, tag := range tags {
+		b = append(b, tables[tag]...)
+		for len(b)%4 != 0 {
+			b = append(b, 0)
+		}
+	}
+	if headOffset >= 0 {
+		putU32(b, headOffset+8, 0xb1b0afba-checksum(b))
+	}
+	return b
+}
+
+// checksum returns the sum of b as big-endian uint32s, padded with zeroes.
+func checksum(b []byte) (sum uint32) {
+	for i := 0; i < len(b); i += 4 {
+		var x [4]byte
+		copy(x[:], b[i:])
+		sum += u32(x[:], 0)
+	}
+	return sum
+}
+
+// appendU16 appends the big-endian uint16 x to b.
+func appendU16(b []byte, x uint16) []byte {
+	return append(b, byte(x>>8), byte(x))
+}
+
+// appendU32 appends the big-endian uint32 x to b.
+func appendU32(b []byte, x uint32) []byte {
+	return append(b, byte(x>>24), byte(x>>16), byte(x>>8), byte(x))
+}
+
+// putU16 sets the big-endian uint16 at b[i:] to x.
+func putU16(b []byte, i int, x uint16) {
+	b[i], b[i+1] = byte(x>>8), byte(x)
+}
+
+// putU32 sets the big-endian uint32 at b[i:] to x.
+func putU32(b []byte, i int, x uint32) {
+	b[i], b[i+1], b[i+2], b[i+3] = byte(x>>24), byte(x>>16), byte(x>>8), byte(x)
+}
+
+type indexSlice []Index
+
+func (s indexSlice) Len() int           { return len(s) }
+func (s indexSlice) Less(i, j int) bool { return s[i] < s[j] }
+func (s indexSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type runeSlice []rune
+
+func (s runeSlice) Len() int           { return len(s) }
+func (s runeSlice) Less(i, j int) bool { return s[i] < s[j] }
+func (s runeSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,175 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ligatureNames are the decompositions of the ligature glyph names that
+// predate the "f_f_i" naming convention.
+var ligatureNames = map[string]string{
+	"ff":  "ff",
+	"fi":  "fi",
+	"fl":  "fl",
+	"ffi": "ffi",
+	"ffl": "ffl",
+}
+
+// ToUnicode returns a best-effort mapping from the font's glyph indexes to the
+// text that each glyph represents, such as for extracting or searching text
+// that was drawn by glyph index. A ligature glyph maps to the text of all of
+// its components.
+//
+// Glyphs are mapped by their character map entries, as per Runes, preferring
+// the lowest rune outside of the Private Use Areas when a glyph has more than
+// one. Glyphs without a character map entry, such as ligatures and
+// alternates, are mapped by their glyph names, following the Adobe Glyph List
+// conventions: a name like "uni00410042", "u1F600", "f_f_i" or "A.sc" maps to
+// "AB", "\U0001F600", "ffi" or "A", where a name's components may name another
+// of the font's glyphs. The remaining glyphs that the GSUB table's single or
+// ligature substitutions substitute for mapped glyphs, such as unnamed small
+// capitals and ligatures, map to the text of the glyphs that they replace.
+// Glyphs that cannot be mapped are not in the returned map.
+func (f *Font) ToUnicode() map[Index]string {
+	m := make(map[Index]string)
+	for i, runes := range f.runes() {
+		// The runes are in increasing order.
+		r := runes[0]
+		for _, q := range runes {
+			if !isPrivateUse(q) {
+				r = q
+				break
+			}
+		}
+		m[i] = string(r)
+	}
+
+	// Map the remaining glyphs by name.
+	names := f.glyphNames()
+	byName := make(map[string]Index)
+	for i, name := range names {
+		if _, dup := byName[name]; !dup && name != "" {
+			byName[name] = Index(i)
+		}
+	}
+	for i, name := range names {
+		if _, ok := m[Index(i)]; ok {
+			continue
+		}
+		if s, ok := nameToText(name, byName, m); ok {
+			m[Index(i)] = s
+		}
+	}
+
+	// Map the remaining glyphs by the substitutions that produce them.
+	for _, l := range f.gsubLayout().lookups {
+		for _, s := range l.subtables {
+			switch l.typ {
+			case gsubSingle:
+				rangeCoverage(offsetTable(s, 2), func(g Index, _ int) {
+					if sub, ok := singleSubstitute(s, g); ok {
+						setText(m, sub, []Index{g})
+					}
+				})
+			case gsubLigature:
+				rangeLigatures(s, func(lig Index, components []Index) {
+					setText(m, lig, components)
+				})
+			}
+		}
+	}
+	return m
+}
+
+// setText maps the glyph i to the text of the given glyphs, unless i is
+// already mapped or any of the given glyphs is not.
+func setText(m map[Index]string, i Index, glyphs []Index) {
+	if _, ok := m[i]; ok {
+		return
+	}
+	text := ""
+	for _, g := range glyphs {
+		s, ok := m[g]
+		if !ok {
+			return
+		}
+		text += s
+	}
+	m[i] = text
+}
+
+// isPrivateUse returns whether r is in one of Unicode's Private Use Areas.
+func isPrivateUse(r rune) bool {
+	return (0xe000 <= r && r <= 0xf8ff) || 0xf0000 <= r
+}
+
+// nameToText returns the text for the given glyph name. byName maps glyph
+// names to indexes, and m maps indexes to text, so that a name's components
+// may refer to other glyphs.
+func nameToText(name string, byName map[string]Index, m map[Index]string) (string, bool) {
+	// Drop any suffix, such as the ".sc" in "A.sc".
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		name = name[:i]
+	}
+	if name == "" {
+		return "", false
+	}
+	text := ""
+	for _, c := range strings.Split(name, "_") {
+		s, ok := componentToText(c, byName, m)
+		if !ok {
+			return "", false
+		}
+		text += s
+	}
+	return text, true
+}
+
+func componentToText(c string, byName map[string]Index, m map[Index]string) (string, bool) {
+	if s, ok := ligatureNames[c]; ok {
+		return s, true
+	}
+	if i, ok := byName[c]; ok {
+		if s, ok := m[i]; ok {
+			return s, true
+		}
+	}
+	// A "uni" name is one or more groups of four hex digits, and a "u" name
+	// is four to six hex digits.
+	if strings.HasPrefix(c, "uni") && len(c) > 3 && (len(c)-3)%4 == 0 {
+		s := ""
+		for x := 3; x < len(c); x += 4 {
+			r, ok := parseHexRune(c[x : x+4])
+			if !ok {
+				return "", false
+			}
+			s += string(r)
+		}
+		return s, true
+	}
+	if strings.HasPrefix(c, "u") && 5 <= len(c) && len(c) <= 7 {
+		if r, ok := parseHexRune(c[1:]); ok {
+			return string(r), true
+		}
+	}
+	return "", false
+}
+
+// parseHexRune parses s as upper case hex digits, as the glyph naming
+// conventions require, and returns the resultant rune if it is a valid Unicode
+// scalar value.
+func parseHexRune(s string) (rune, bool) {
+	if strings.ToUpper(s) != s {
+		return 0, false
+	}
+	x, err := strconv.ParseUint(s, 16, 32)
+	if err != nil || x > 0x10ffff || (0xd800 <= x && x < 0xe000) {
+		return 0, false
+	}
+	return rune(x), true
+}
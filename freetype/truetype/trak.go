@@ -0,0 +1,102 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// Tracks returns the tracks of the font's AAT trak table, for horizontal
+// text, in the order of the table. Each is a 16.16 fixed point number, where 0
+// is the normal track, and -1 and +1 are typically the tight and loose tracks.
+func (f *Font) Tracks() []int32 {
+	data, ok := f.trakData()
+	if !ok {
+		return nil
+	}
+	tracks := make([]int32, u16(data, 0))
+	for i := range tracks {
+		tracks[i] = int32(u32(data, 8+8*i))
+	}
+	return tracks
+}
+
+// Tracking returns the adjustment to the spacing between glyphs, for the given
+// track, such as 0 for the normal track, and point size, from the font's AAT
+// trak table. The adjustment is in the same units as scale, which is the
+// number of units in 1 em, and is added to each glyph's advance width. It is
+// typically negative, to tighten large text, and positive, to loosen small
+// text. The track is a 16.16 fixed point number, and the point size is a 26.6
+// fixed point number. ok is false if the font has no such track.
+//
+// The values for the point sizes of the table are interpolated, or
+// extrapolated, linearly.
+//
+// The table is documented at https://developer.apple.com/fonts/TrueType-Reference-Manual/RM06/Chap6trak.html
+func (f *Font) Tracking(scale, pointSize, track int32) (tracking int32, ok bool) {
+	data, ok := f.trakData()
+	if !ok {
+		return 0, false
+	}
+	nTracks, nSizes, sizes := int(u16(data, 0)), int(u16(data, 2)), int(u32(data, 4))
+	if nSizes == 0 || len(f.trak) < sizes+4*nSizes {
+		return 0, false
+	}
+	for i := 0; i < nTracks; i++ {
+		x := 8 + 8*i
+		if int32(u32(data, x)) != track {
+			continue
+		}
+		values := int(u16(data, x+6))
+		if len(f.trak) < values+2*nSizes {
+			return 0, false
+		}
+		value := func(j int) int64 {
+			return int64(int16(u16(f.trak, values+2*j)))
+		}
+		size := func(j int) int64 {
+			return int64(int32(u32(f.trak, sizes+4*j)))
+		}
+		v := value(0)
+		if nSizes > 1 {
+			// Interpolate between the first size that is no smaller than the
+			// point size and the size before it.
+			j := 1
+			for j < nSizes-1 && size(j) < int64(pointSize)<<10 {
+				j++
+			}
+			s0, s1, v0, v1 := size(j-1), size(j), value(j-1), value(j)
+			if s0 != s1 {
+				d := (v1 - v0) * (int64(pointSize)<<10 - s0)
+				// Round to the nearest FUnit.
+				if d >= 0 {
+					d += (s1 - s0) / 2
+				} else {
+					d -= (s1 - s0) / 2
+				}
+				v = v0 + d/(s1-s0)
+			} else {
+				v = v0
+			}
+		}
+		return f.scale(scale * int32(v)), true
+	}
+	return 0, false
+}
+
+// trakData returns the trak table's track data for horizontal text, from its
+// start to the end of the table, after checking that its track table entries
+// are in range. ok is false if the font has no valid trak table.
+func (f *Font) trakData() (data []byte, ok bool) {
+	if len(f.trak) < 12 || u32(f.trak, 0) != 0x00010000 || u16(f.trak, 4) != 0 {
+		return nil, false
+	}
+	x := int(u16(f.trak, 6))
+	if x == 0 || len(f.trak) < x+8 {
+		return nil, false
+	}
+	data = f.trak[x:]
+	if len(data) < 8+8*int(u16(data, 0)) {
+		return nil, false
+	}
+	return data, true
+}
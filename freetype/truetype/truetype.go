@@ -20,6 +20,7 @@ package truetype
 
 import (
 	"fmt"
+	"unicode"
 )
 
 // An Index is a Font's index of a rune.
@@ -42,18 +43,62 @@ type VMetric struct {
 }
 
 // A FormatError reports that the input is not a valid TrueType font.
-type FormatError string
+type FormatError struct {
+	// Table is the tag of the invalid table, such as "cmap", or empty if the
+	// problem is not with a particular table, such as a bad table directory.
+	Table string
+	// Offset is the offset, in bytes, of the invalid data, such as a cmap
+	// subtable, from the start of Table, or of the font data if Table is
+	// empty. It is zero if unknown.
+	Offset int
+	// Reason describes the problem.
+	Reason string
+}
 
 func (e FormatError) Error() string {
-	return "freetype: invalid TrueType format: " + string(e)
+	return "freetype: invalid TrueType format: " + e.Reason
 }
 
 // An UnsupportedError reports that the input uses a valid but unimplemented
-// TrueType feature.
-type UnsupportedError string
+// TrueType feature. Unlike a FormatError, it does not mean that the font is
+// broken.
+type UnsupportedError struct {
+	// Feature describes the feature, such as "CFF outlines".
+	Feature string
+}
 
 func (e UnsupportedError) Error() string {
-	return "freetype: unsupported TrueType feature: " + string(e)
+	return "freetype: unsupported TrueType feature: " + e.Feature
+}
+
+// A LimitError reports that a font's hinting bytecode or glyph data exceeded
+// one of the limits declared in the font's maxp table, such as by defining
+// more functions than maxFunctionDefs. The value is the name of the maxp
+// field: "maxFunctionDefs", "maxStackElements", "maxStorage" or
+// "maxTwilightPoints", or, as reported by Validate, "maxComponentDepth",
+// "maxContours", "maxPoints" or "maxSizeOfInstructions". As C Freetype does,
+// the hinter allows fonts that are not Strict some slack: a function number
+// may exceed maxFunctionDefs, and storage past maxStorage reads as zero.
+type LimitError string
+
+func (e LimitError) Error() string {
+	return "truetype: exceeded maxp limit: " + string(e)
+}
+
+// A HintingError reports that a font's hinting bytecode failed, such as by
+// popping more values than are on the stack.
+type HintingError struct {
+	// PC is the offset of the failed instruction in its program, which is a
+	// function, the font's fpgm or prep, or a glyph's program, and Opcode is
+	// the instruction's opcode, whose name is given by the Opcode function.
+	PC     int
+	Opcode uint8
+	// Reason describes the problem.
+	Reason string
+}
+
+func (e HintingError) Error() string {
+	return fmt.Sprintf("truetype: hinting: %s (pc %d, opcode %#02x)", e.Reason, e.PC, e.Opcode)
 }
 
 // u32 returns the big-endian uint32 at b[i:].
@@ -70,15 +115,15 @@ func u16(b []byte, i int) uint16 {
 func readTable(ttf []byte, offsetLength []byte) ([]byte, error) {
 	offset := int(u32(offsetLength, 0))
 	if offset < 0 {
-		return nil, FormatError(fmt.Sprintf("offset too large: %d", uint32(offset)))
+		return nil, FormatError{Reason: fmt.Sprintf("offset too large: %d", uint32(offset))}
 	}
 	length := int(u32(offsetLength, 4))
 	if length < 0 {
-		return nil, FormatError(fmt.Sprintf("length too large: %d", uint32(length)))
+		return nil, FormatError{Reason: fmt.Sprintf("length too large: %d", uint32(length))}
 	}
 	end := offset + length
 	if end < 0 || end > len(ttf) {
-		return nil, FormatError(fmt.Sprintf("offset + length too large: %d", uint32(offset)+uint32(length)))
+		return nil, FormatError{Reason: fmt.Sprintf("offset + length too large: %d", uint32(offset)+uint32(length))}
 	}
 	return ttf[offset:end], nil
 }
@@ -89,90 +134,281 @@ const (
 	locaOffsetFormatLong
 )
 
-// A cm holds a parsed cmap entry.
+// A cm holds a parsed cmap entry, which maps the character codes from start
+// to end. If offset is zero, a code's glyph index is the code plus delta, or
+// delta itself if many is set. Otherwise, it is the uint16 at
+// offset+2*(code-start) in cmapIndexes, plus delta if that is non-zero.
 type cm struct {
 	start, end, delta, offset uint32
+	// many is whether the entry maps many codes to one glyph, as a format 13
+	// subtable does.
+	many bool
 }
 
 // A Font represents a Truetype font.
+//
+// A Font is immutable once it is parsed, except by SelectCmap, so its methods
+// may be called by multiple goroutines at once. Loading a glyph's outline
+// needs a GlyphBuf, which holds the mutable state, for each goroutine.
 type Font struct {
 	// Tables sliced from the TTF data. The different tables are documented
 	// at http://developer.apple.com/fonts/TTRefMan/RM06/Chap6.html
-	cmap, cvt, fpgm, glyf, hdmx, head, hhea, hmtx, kern, loca, maxp, os2, prep, vmtx []byte
+	avar, cff, cmap, cvt, ebdt, eblc, fpgm, fvar, gasp, gdef, glyf, gpos, gsub, gvar, hdmx, head, hhea, hmtx, kern, kerx, loca, ltsh, maxp, morx, name, os2, pclt, post, prep, sbix, trak, vdmx, vhea, vmtx, vorg []byte
+
+	// tables is every table sliced from the TTF data, keyed by tag.
+	tables map[string][]byte
 
 	cmapIndexes []byte
+	// cmapVariants is the cmap's format 14 subtable, if it has a valid one.
+	cmapVariants []byte
+	// cmapSubtable is the subtable that cm was parsed from.
+	cmapSubtable CmapSubtable
+	// symbolBase is the offset that Index adds to a single byte character
+	// code that is not in a Symbol cmap subtable, or zero for other subtables.
+	symbolBase uint32
+	// cmapMacRoman is whether cm maps Macintosh Roman codes rather than
+	// runes.
+	cmapMacRoman bool
+	// latin1 is Index's result for each rune below 0x100, or nil if it has
+	// not been computed, so that Index need not search cm for the most
+	// common runes.
+	latin1 []Index
 
 	// Cached values derived from the raw ttf data.
-	cm                      []cm
-	locaOffsetFormat        int
-	nGlyph, nHMetric, nKern int
-	fUnitsPerEm             int32
-	bounds                  Bounds
+	cm               []cm
+	locaOffsetFormat int
+	nGlyph, nHMetric int
+	// nVMetric is the number of full vertical metrics in the vmtx table.
+	nVMetric      int
+	kernSubtables []kernSubtable
+	// gposKern is the GPOS table's pair adjustment lookups for kerning.
+	gposKern []lookup
+	// gposMark is the GPOS table's mark attachment lookups.
+	gposMark []lookup
+	// gsubCache holds the GSUB table's *gsubLayout.
+	gsubCache *lazyCache
+	// kerxSubtables are the kerx table's supported subtables, and morxChains
+	// are the morx table's chains.
+	kerxSubtables []kerxSubtable
+	morxChains    []morxChain
+	// axes and instances are the fvar table's variation axes and named
+	// instances, and avarMaps is the avar table's segment maps for the axes.
+	axes      []VariationAxis
+	instances []NamedInstance
+	avarMaps  [][]int16
+	// cffOutlines is the CFF table's glyph outlines, for a font without a
+	// glyf table.
+	cffOutlines *cffFont
+	fUnitsPerEm int32
+	bounds      Bounds
+	// Values from the hhea section.
+	ascent, descent, lineGap int32
+	// Values from the vhea section.
+	vAscent, vDescent, vLineGap int32
 	// Values from the maxp section.
-	maxTwilightPoints, maxStorage, maxFunctionDefs, maxStackElements uint16
+	maxTwilightPoints, maxStorage, maxFunctionDefs, maxInstructionDefs, maxStackElements uint16
+	// maxComponentDepth is the maximum nesting of compound glyphs, or zero
+	// if the font does not declare it.
+	maxComponentDepth uint16
+	// maxPoints and maxContours are the largest numbers of points and
+	// contours of any simple or compound glyph.
+	maxPoints, maxContours uint16
+	// caps is what the font supports, as per its tables.
+	caps Capabilities
+	// logger, if non-nil, is told of recoverable anomalies in the font.
+	logger Logger
+	// strictness is how violations of the specifications are handled, and
+	// strictErr is the first anomaly of a Strict font being parsed.
+	strictness Strictness
+	strictErr  error
+	// parsed is whether parse has finished. No field is modified afterwards,
+	// except by SelectCmap.
+	parsed bool
+	// runeMap holds the reverse of the character map, for Runes, and
+	// postNames holds the glyph names, for GlyphName. They are nil for Fonts
+	// not made by parse, which do not cache them.
+	runeMap, postNames *lazyCache
+	// budget, if non-nil, is charged for the font's caches.
+	budget Budget
+	// src, if non-nil, supplies the glyph data and metrics instead of the
+	// glyf, loca and hmtx tables.
+	src IncrementalSource
 }
 
-func (f *Font) parseCmap() error {
+// cmapEncodingPriority returns how preferable a cmap subtable with the
+// given platform and encoding IDs is, or zero if it cannot be used. Subtables
+// that cover all of Unicode, which are typically format 12, are preferred
+// over those that only cover the Basic Multilingual Plane, so that runes
+// outside the BMP, such as emoji, can be mapped. The many-to-one format 13
+// subtables of last resort fonts, which map whole ranges of runes to one
+// glyph, are only used if there is no other Unicode subtable. Macintosh Roman
+// subtables, of old Mac fonts, and the legacy East Asian encodings are a last
+// resort, for fonts that have no other subtable.
+func cmapEncodingPriority(pidPsid uint32) int {
+	// A 32-bit encoding consists of a most-significant 16-bit Platform ID and a
+	// least-significant 16-bit Platform Specific ID. The magic numbers are
+	// specified at https://www.microsoft.com/typography/otspec/name.htm
 	const (
-		cmapFormat4         = 4
-		cmapFormat12        = 12
-		languageIndependent = 0
-
-		// A 32-bit encoding consists of a most-significant 16-bit Platform ID and a
-		// least-significant 16-bit Platform Specific ID. The magic numbers are
-		// specified at https://www.microsoft.com/typography/otspec/name.htm
+		unicode10Encoding       = 0x00000000 // PID = 0 (Unicode), PSID = 0 (Unicode 1.0)
+		unicode11Encoding       = 0x00000001 // PID = 0 (Unicode), PSID = 1 (Unicode 1.1)
+		unicodeISOEncoding      = 0x00000002 // PID = 0 (Unicode), PSID = 2 (ISO/IEC 10646)
 		unicodeEncoding         = 0x00000003 // PID = 0 (Unicode), PSID = 3 (Unicode 2.0)
+		unicodeFullEncoding     = 0x00000004 // PID = 0 (Unicode), PSID = 4 (Unicode 2.0, full repertoire)
+		unicodeFull13Encoding   = 0x00000006 // PID = 0 (Unicode), PSID = 6 (Unicode full repertoire)
+		macintoshRomanEncoding  = 0x00010000 // PID = 1 (Macintosh), PSID = 0 (Roman)
 		microsoftSymbolEncoding = 0x00030000 // PID = 3 (Microsoft), PSID = 0 (Symbol)
 		microsoftUCS2Encoding   = 0x00030001 // PID = 3 (Microsoft), PSID = 1 (UCS-2)
 		microsoftUCS4Encoding   = 0x0003000a // PID = 3 (Microsoft), PSID = 10 (UCS-4)
+		microsoftShiftJIS       = 0x00030002 // PID = 3 (Microsoft), PSID = 2 (ShiftJIS)
+		microsoftWansung        = 0x00030005 // PID = 3 (Microsoft), PSID = 5 (Wansung)
 	)
+	switch {
+	case pidPsid == unicodeFullEncoding, pidPsid == microsoftUCS4Encoding:
+		return 7
+	case pidPsid == unicode10Encoding, pidPsid == unicode11Encoding, pidPsid == unicodeISOEncoding, pidPsid == unicodeEncoding:
+		// We prefer the Unicode cmap encoding. Failing to find that, we fall
+		// back onto the Microsoft cmap encoding.
+		return 6
+	case pidPsid == microsoftUCS2Encoding:
+		return 5
+	case pidPsid == unicodeFull13Encoding:
+		return 4
+	case pidPsid == microsoftSymbolEncoding:
+		return 3
+	case pidPsid == macintoshRomanEncoding:
+		return 2
+	case microsoftShiftJIS <= pidPsid && pidPsid <= microsoftWansung:
+		// ShiftJIS, PRC, Big5 and Wansung, which are typically format 2.
+		return 1
+	}
+	return 0
+}
 
+func (f *Font) parseCmap() error {
 	if len(f.cmap) < 4 {
-		return FormatError("cmap too short")
+		return FormatError{Table: "cmap", Reason: "cmap too short"}
 	}
 	nsubtab := int(u16(f.cmap, 2))
 	if len(f.cmap) < 8*nsubtab+4 {
-		return FormatError("cmap too short")
+		return FormatError{Table: "cmap", Offset: 4, Reason: "cmap too short"}
 	}
-	offset, found, x := 0, false, 4
-	for i := 0; i < nsubtab; i++ {
+	// Try the usable subtables, most preferable first, falling back to the
+	// next one if a subtable's format is unsupported. entries holds the
+	// offsets of the subtables' encoding records, by priority.
+	var entries [8][]int
+	f.cmapVariants = nil
+	for i, x := 0, 4; i < nsubtab; i, x = i+1, x+8 {
 		// We read the 16-bit Platform ID and 16-bit Platform Specific ID as a single uint32.
 		// All values are big-endian.
 		pidPsid, o := u32(f.cmap, x), u32(f.cmap, x+4)
-		x += 8
-		// We prefer the Unicode cmap encoding. Failing to find that, we fall
-		// back onto the Microsoft cmap encoding.
-		if pidPsid == unicodeEncoding {
-			offset, found = int(o), true
-			break
+		if p := cmapEncodingPriority(pidPsid); p > 0 {
+			entries[p] = append(entries[p], x)
+		}
+		// PID = 0 (Unicode), PSID = 5 (Unicode Variation Sequences) is the
+		// format 14 subtable, which supplements the subtable used by Index.
+		if pidPsid == 0x00000005 {
+			f.cmapVariants = parseCmapVariants(f.cmap, int(o))
+			if f.cmapVariants == nil {
+				f.warn("truetype: ignoring invalid cmap format 14 subtable")
+			}
+		}
+	}
+	var err error = UnsupportedError{Feature: "cmap encoding"}
+	for p := len(entries) - 1; p > 0; p-- {
+		for _, x := range entries[p] {
+			err = f.parseCmapSubtable(int(u32(f.cmap, x+4)))
+			if err == nil {
+				f.setCmapSubtable(f.cmapSubtableAt(x))
+			}
+			if _, ok := err.(UnsupportedError); !ok {
+				return err
+			}
+		}
+	}
+	return err
+}
 
-		} else if pidPsid == microsoftSymbolEncoding ||
-			pidPsid == microsoftUCS2Encoding ||
-			pidPsid == microsoftUCS4Encoding {
+// setCmapSubtable records that cm was parsed from the cmap subtable s.
+func (f *Font) setCmapSubtable(s CmapSubtable) {
+	f.cmapSubtable = s
+	f.symbolBase = f.cmapSymbolBase()
+	f.cmapMacRoman = s.PlatformID == 1 && s.EncodingID == 0
+	f.latin1 = nil
+	latin1 := make([]Index, 0x100)
+	for r := range latin1 {
+		latin1[r] = f.Index(rune(r))
+	}
+	f.latin1 = latin1
+}
 
-			offset, found = int(o), true
-			// We don't break out of the for loop, so that Unicode can override Microsoft.
+// cmapSymbolBase returns the offset of the character codes of the cmap
+// subtable in use, if it is a Microsoft Symbol subtable. Such a subtable
+// conventionally maps a symbol font's codes from 0x20 to 0xFF to the Private
+// Use Area, from 0xF020 to 0xF0FF. As on Windows, the OS/2 table's first
+// character index gives the actual offset, if it is not zero.
+func (f *Font) cmapSymbolBase() uint32 {
+	if f.cmapSubtable.PlatformID != 3 || f.cmapSubtable.EncodingID != 0 {
+		return 0
+	}
+	if o, ok := f.OS2(); ok {
+		if base := uint32(o.FirstCharIndex) &^ 0xff; base != 0 {
+			return base
 		}
 	}
-	if !found {
-		return UnsupportedError("cmap encoding")
+	return 0xf000
+}
+
+// cmapSubtableAt returns the CmapSubtable for the encoding record at offset
+// x in the cmap table.
+func (f *Font) cmapSubtableAt(x int) CmapSubtable {
+	s := CmapSubtable{PlatformID: u16(f.cmap, x), EncodingID: u16(f.cmap, x+2)}
+	if o := int64(u32(f.cmap, x+4)); o+2 <= int64(len(f.cmap)) {
+		s.Format = u16(f.cmap, int(o))
 	}
-	if offset <= 0 || offset > len(f.cmap) {
-		return FormatError("bad cmap offset")
+	return s
+}
+
+// parseCmapSubtable parses the cmap subtable at the given offset.
+func (f *Font) parseCmapSubtable(offset int) (err error) {
+	// A FormatError's Offset is that of the subtable.
+	defer func(subtable int) {
+		if e, ok := err.(FormatError); ok && e.Offset == 0 {
+			e.Offset = subtable
+			err = e
+		}
+	}(offset)
+
+	const (
+		cmapFormat0         = 0
+		cmapFormat2         = 2
+		cmapFormat4         = 4
+		cmapFormat6         = 6
+		cmapFormat12        = 12
+		cmapFormat13        = 13
+		languageIndependent = 0
+	)
+
+	if offset <= 0 || offset+2 > len(f.cmap) {
+		return FormatError{Table: "cmap", Reason: "bad cmap offset"}
 	}
+	f.cm, f.cmapIndexes = nil, nil
 
 	cmapFormat := u16(f.cmap, offset)
 	switch cmapFormat {
 	case cmapFormat4:
 		language := u16(f.cmap, offset+4)
 		if language != languageIndependent {
-			return UnsupportedError(fmt.Sprintf("language: %d", language))
+			return UnsupportedError{Feature: fmt.Sprintf("language: %d", language)}
 		}
 		segCountX2 := int(u16(f.cmap, offset+6))
 		if segCountX2%2 == 1 {
-			return FormatError(fmt.Sprintf("bad segCountX2: %d", segCountX2))
+			return FormatError{Table: "cmap", Offset: offset, Reason: fmt.Sprintf("bad segCountX2: %d", segCountX2)}
 		}
 		segCount := segCountX2 / 2
+		if len(f.cmap)-offset < 16+4*segCountX2 {
+			return FormatError{Table: "cmap", Offset: offset, Reason: "cmap too short"}
+		}
+		subtable := offset
 		offset += 14
 		f.cm = make([]cm, segCount)
 		for i := 0; i < segCount; i++ {
@@ -189,43 +425,95 @@ func (f *Font) parseCmap() error {
 			offset += 2
 		}
 		for i := 0; i < segCount; i++ {
-			f.cm[i].offset = uint32(u16(f.cmap, offset))
+			// The idRangeOffset is relative to its own position.
+			if ro := u16(f.cmap, offset); ro != 0 {
+				f.cm[i].offset = uint32(offset-subtable) + uint32(ro)
+			}
 			offset += 2
 		}
-		f.cmapIndexes = f.cmap[offset:]
+		f.cmapIndexes = f.cmap[subtable:]
+		return nil
+
+	case cmapFormat0:
+		return f.parseCmapFormat0(offset)
+
+	case cmapFormat2:
+		return f.parseCmapFormat2(offset)
+
+	case cmapFormat6:
+		if len(f.cmap)-offset < 10 {
+			return FormatError{Table: "cmap", Offset: offset, Reason: "cmap too short"}
+		}
+		language := u16(f.cmap, offset+4)
+		if language != languageIndependent {
+			return UnsupportedError{Feature: fmt.Sprintf("language: %d", language)}
+		}
+		firstCode, entryCount := uint32(u16(f.cmap, offset+6)), int(u16(f.cmap, offset+8))
+		if len(f.cmap)-offset < 10+2*entryCount {
+			return FormatError{Table: "cmap", Offset: offset, Reason: "cmap too short"}
+		}
+		if entryCount > 0 {
+			f.cm = []cm{{start: firstCode, end: firstCode + uint32(entryCount) - 1, offset: 10}}
+			f.cmapIndexes = f.cmap[offset:]
+		}
 		return nil
 
-	case cmapFormat12:
+	case cmapFormat12, cmapFormat13:
+		// Format 13 has the same layout as format 12, but maps each group's
+		// codes to the one glyph, rather than to consecutive glyphs.
+		if len(f.cmap)-offset < 16 {
+			return FormatError{Table: "cmap", Offset: offset, Reason: "cmap too short"}
+		}
 		if u16(f.cmap, offset+2) != 0 {
-			return FormatError(fmt.Sprintf("cmap format: % x", f.cmap[offset:offset+4]))
+			return FormatError{Table: "cmap", Offset: offset, Reason: fmt.Sprintf("cmap format: % x", f.cmap[offset:offset+4])}
 		}
-		length := u32(f.cmap, offset+4)
+		length := int64(u32(f.cmap, offset+4))
 		language := u32(f.cmap, offset+8)
 		if language != languageIndependent {
-			return UnsupportedError(fmt.Sprintf("language: %d", language))
+			return UnsupportedError{Feature: fmt.Sprintf("language: %d", language)}
 		}
-		nGroups := u32(f.cmap, offset+12)
+		nGroups := int64(u32(f.cmap, offset+12))
 		if length != 12*nGroups+16 {
-			return FormatError("inconsistent cmap length")
+			return FormatError{Table: "cmap", Offset: offset, Reason: "inconsistent cmap length"}
+		}
+		if length > int64(len(f.cmap)-offset) {
+			return FormatError{Table: "cmap", Offset: offset, Reason: "cmap too short"}
 		}
 		offset += 16
-		f.cm = make([]cm, nGroups)
-		for i := uint32(0); i < nGroups; i++ {
-			f.cm[i].start = u32(f.cmap, offset+0)
-			f.cm[i].end = u32(f.cmap, offset+4)
-			f.cm[i].delta = u32(f.cmap, offset+8) - f.cm[i].start
+		cms := make([]cm, nGroups)
+		for i := range cms {
+			start, end := u32(f.cmap, offset+0), u32(f.cmap, offset+4)
+			// Index does a binary search, so the groups must be sorted and
+			// must not overlap.
+			if end < start || (i > 0 && start <= cms[i-1].end) {
+				return FormatError{Table: "cmap", Offset: offset, Reason: "bad cmap group"}
+			}
+			cms[i].start = start
+			cms[i].end = end
+			if cmapFormat == cmapFormat13 {
+				cms[i].delta, cms[i].many = u32(f.cmap, offset+8), true
+			} else {
+				cms[i].delta = u32(f.cmap, offset+8) - start
+			}
 			offset += 12
 		}
+		f.cm = cms
 		return nil
 	}
-	return UnsupportedError(fmt.Sprintf("cmap format: %d", cmapFormat))
+	return UnsupportedError{Feature: fmt.Sprintf("cmap format: %d", cmapFormat)}
 }
 
 func (f *Font) parseHead() error {
-	if len(f.head) != 54 {
-		return FormatError(fmt.Sprintf("bad head length: %d", len(f.head)))
+	// Later minor versions of the head, hhea and maxp tables may append
+	// fields, so the tables may be longer than expected.
+	if len(f.head) < 54 {
+		return FormatError{Table: "head", Reason: fmt.Sprintf("bad head length: %d", len(f.head))}
 	}
 	f.fUnitsPerEm = int32(u16(f.head, 18))
+	if f.fUnitsPerEm == 0 {
+		// Scaling divides by the number of FUnits per em.
+		return FormatError{Table: "head", Offset: 18, Reason: "bad unitsPerEm: 0"}
+	}
 	f.bounds.XMin = int32(int16(u16(f.head, 36)))
 	f.bounds.YMin = int32(int16(u16(f.head, 38)))
 	f.bounds.XMax = int32(int16(u16(f.head, 40)))
@@ -236,75 +524,202 @@ func (f *Font) parseHead() error {
 	case 1:
 		f.locaOffsetFormat = locaOffsetFormatLong
 	default:
-		return FormatError(fmt.Sprintf("bad indexToLocFormat: %d", i))
+		return FormatError{Table: "head", Offset: 50, Reason: fmt.Sprintf("bad indexToLocFormat: %d", i)}
 	}
 	return nil
 }
 
 func (f *Font) parseHhea() error {
-	if len(f.hhea) != 36 {
-		return FormatError(fmt.Sprintf("bad hhea length: %d", len(f.hhea)))
+	if len(f.hhea) < 36 {
+		return FormatError{Table: "hhea", Reason: fmt.Sprintf("bad hhea length: %d", len(f.hhea))}
 	}
+	f.ascent = int32(int16(u16(f.hhea, 4)))
+	f.descent = int32(int16(u16(f.hhea, 6)))
+	f.lineGap = int32(int16(u16(f.hhea, 8)))
 	f.nHMetric = int(u16(f.hhea, 34))
 	if 4*f.nHMetric+2*(f.nGlyph-f.nHMetric) != len(f.hmtx) {
-		return FormatError(fmt.Sprintf("bad hmtx length: %d", len(f.hmtx)))
+		return FormatError{Table: "hmtx", Reason: fmt.Sprintf("bad hmtx length: %d", len(f.hmtx))}
 	}
 	return nil
 }
 
-func (f *Font) parseKern() error {
-	// Apple's TrueType documentation (http://developer.apple.com/fonts/TTRefMan/RM06/Chap6kern.html) says:
-	// "Previous versions of the 'kern' table defined both the version and nTables fields in the header
-	// as UInt16 values and not UInt32 values. Use of the older format on the Mac OS is discouraged
-	// (although AAT can sense an old kerning table and still make correct use of it). Microsoft
-	// Windows still uses the older format for the 'kern' table and will not recognize the newer one.
-	// Fonts targeted for the Mac OS only should use the new format; fonts targeted for both the Mac OS
-	// and Windows should use the old format."
-	// Since we expect that almost all fonts aim to be Windows-compatible, we only parse the "older" format,
-	// just like the C Freetype implementation.
-	if len(f.kern) == 0 {
-		if f.nKern != 0 {
-			return FormatError("bad kern table length")
+// parseVhea parses the vhea table, which gives the number of full metrics in
+// the vmtx table. Vertical metrics are optional, so an invalid vhea or vmtx
+// table is ignored rather than an error.
+func (f *Font) parseVhea() {
+	if f.vmtx == nil {
+		return
+	}
+	if f.vhea == nil {
+		// Without a vhea table, assume that every entry is a full metric.
+		f.nVMetric = len(f.vmtx) / 4
+		if f.nVMetric > f.nGlyph {
+			f.nVMetric = f.nGlyph
 		}
-		return nil
+		return
 	}
-	if len(f.kern) < 18 {
-		return FormatError("kern data too short")
+	if len(f.vhea) < 36 {
+		f.warn("truetype: ignoring invalid vhea table", "length", len(f.vhea))
+		f.vhea, f.vmtx = nil, nil
+		return
 	}
-	version, offset := u16(f.kern, 0), 2
-	if version != 0 {
-		return UnsupportedError(fmt.Sprintf("kern version: %d", version))
+	n := int(u16(f.vhea, 34))
+	if n == 0 || n > f.nGlyph || 4*n+2*(f.nGlyph-n) > len(f.vmtx) {
+		f.warn("truetype: ignoring invalid vmtx table", "length", len(f.vmtx), "numOfLongVerMetrics", n)
+		f.vhea, f.vmtx = nil, nil
+		return
+	}
+	f.vAscent = int32(int16(u16(f.vhea, 4)))
+	f.vDescent = int32(int16(u16(f.vhea, 6)))
+	f.vLineGap = int32(int16(u16(f.vhea, 8)))
+	f.nVMetric = n
+}
+
+// kernSubtable is a format 0 kern subtable's pairs, sorted by their left and
+// right glyph indexes, with 6 bytes per pair: left, right and value.
+type kernSubtable struct {
+	pairs []byte
+	// override is whether the subtable's values replace, rather than add to,
+	// those of the preceding subtables.
+	override bool
+}
+
+// find returns the kerning value, in FUnits, for the pair of glyph indexes
+// packed into g.
+func (k kernSubtable) find(g uint32) (value int32, ok bool) {
+	lo, hi := 0, len(k.pairs)/6
+	for lo < hi {
+		i := (lo + hi) / 2
+		ig := u32(k.pairs, 6*i)
+		if ig < g {
+			lo = i + 1
+		} else if ig > g {
+			hi = i
+		} else {
+			return int32(int16(u16(k.pairs, 6*i+4))), true
+		}
 	}
-	n, offset := u16(f.kern, offset), offset+2
-	if n != 1 {
-		return UnsupportedError(fmt.Sprintf("kern nTables: %d", n))
+	return 0, false
+}
+
+func (f *Font) parseKern() error {
+	// There are two versions of the kern table header. Microsoft's
+	// (https://www.microsoft.com/typography/otspec/kern.htm) has a 16-bit
+	// version and nTables, and Apple's
+	// (https://developer.apple.com/fonts/TrueType-Reference-Manual/RM06/Chap6kern.html)
+	// has 32-bit ones. Their subtable headers, and the meaning of their
+	// coverage bits, also differ. We only use format 0 subtables that give
+	// horizontal kerning, just like the C Freetype implementation, and ignore
+	// any others.
+	f.kernSubtables = nil
+	if len(f.kern) == 0 {
+		return nil
 	}
-	offset += 2
-	length, offset := int(u16(f.kern, offset)), offset+2
-	coverage, offset := u16(f.kern, offset), offset+2
-	if coverage != 0x0001 {
-		// We only support horizontal kerning.
-		return UnsupportedError(fmt.Sprintf("kern coverage: 0x%04x", coverage))
+	if len(f.kern) < 4 {
+		return FormatError{Table: "kern", Reason: "kern data too short"}
 	}
-	f.nKern, offset = int(u16(f.kern, offset)), offset+2
-	if 6*f.nKern != length-14 {
-		return FormatError("bad kern table length")
+	var n, offset, headerSize int
+	apple := u32(f.kern, 0) == 0x00010000
+	if apple {
+		if len(f.kern) < 8 {
+			return FormatError{Table: "kern", Reason: "kern data too short"}
+		}
+		n, offset, headerSize = int(u32(f.kern, 4)), 8, 8
+	} else if version := u16(f.kern, 0); version == 0 {
+		n, offset, headerSize = int(u16(f.kern, 2)), 4, 6
+	} else {
+		return UnsupportedError{Feature: fmt.Sprintf("kern version: %d", version)}
+	}
+	for i := 0; i < n; i++ {
+		if len(f.kern)-offset < headerSize {
+			return FormatError{Table: "kern", Offset: offset, Reason: "kern data too short"}
+		}
+		var length, format int
+		var use, override bool
+		if apple {
+			length = int(u32(f.kern, offset))
+			coverage := u16(f.kern, offset+4)
+			format = int(coverage & 0xff)
+			// Skip vertical, cross-stream and variation kerning.
+			use = coverage&0xe000 == 0
+		} else {
+			length = int(u16(f.kern, offset+2))
+			coverage := u16(f.kern, offset+4)
+			format = int(coverage >> 8)
+			// Skip vertical, minimum and cross-stream kerning.
+			use = coverage&0x0007 == 0x0001
+			override = coverage&0x0008 != 0
+		}
+		if format == 0 {
+			x := offset + headerSize
+			if len(f.kern)-x < 8 {
+				return FormatError{Table: "kern", Offset: x, Reason: "kern data too short"}
+			}
+			nPairs := int(u16(f.kern, x))
+			x += 8
+			if len(f.kern)-x < 6*nPairs {
+				return FormatError{Table: "kern", Offset: x - 8, Reason: "bad kern table length"}
+			}
+			if use {
+				f.kernSubtables = append(f.kernSubtables, kernSubtable{
+					pairs:    f.kern[x : x+6*nPairs],
+					override: override,
+				})
+			}
+			// Microsoft's 16-bit subtable length overflows for subtables with
+			// more than 10920 pairs, so we trust nPairs, not the length.
+			if n := x + 6*nPairs - offset; n != length {
+				f.warn("truetype: repairing kern subtable length", "length", length, "want", n)
+				length = n
+			}
+		} else if use {
+			f.warn("truetype: skipping unsupported kern subtable", "format", format)
+		}
+		if length < headerSize || len(f.kern)-offset < length {
+			return FormatError{Table: "kern", Offset: offset, Reason: "bad kern table length"}
+		}
+		offset += length
 	}
 	return nil
 }
 
 func (f *Font) parseMaxp() error {
-	if len(f.maxp) != 32 {
-		return FormatError(fmt.Sprintf("bad maxp length: %d", len(f.maxp)))
+	if len(f.maxp) < 6 {
+		return FormatError{Table: "maxp", Reason: fmt.Sprintf("bad maxp length: %d", len(f.maxp))}
 	}
 	f.nGlyph = int(u16(f.maxp, 4))
+	// Version 0.5, used by fonts with CFF outlines, has only the number of
+	// glyphs. Version 1.0 adds the limits for TrueType hinting.
+	if u32(f.maxp, 0) == 0x00005000 {
+		return nil
+	}
+	if len(f.maxp) < 32 {
+		return FormatError{Table: "maxp", Reason: fmt.Sprintf("bad maxp length: %d", len(f.maxp))}
+	}
 	f.maxTwilightPoints = u16(f.maxp, 16)
 	f.maxStorage = u16(f.maxp, 18)
 	f.maxFunctionDefs = u16(f.maxp, 20)
+	f.maxInstructionDefs = u16(f.maxp, 22)
 	f.maxStackElements = u16(f.maxp, 24)
+	f.maxComponentDepth = u16(f.maxp, 30)
+	f.maxPoints, f.maxContours = u16(f.maxp, 6), u16(f.maxp, 8)
+	if n := u16(f.maxp, 10); n > f.maxPoints {
+		f.maxPoints = n
+	}
+	if n := u16(f.maxp, 12); n > f.maxContours {
+		f.maxContours = n
+	}
 	return nil
 }
 
+// MaxGlyphSize returns the largest numbers of points and contours of any of
+// the font's glyphs, as declared in its maxp table, such as for sizing
+// buffers for its outlines. They are zero for a font with CFF outlines, whose
+// maxp table does not declare them.
+func (f *Font) MaxGlyphSize() (points, contours int) {
+	return int(f.maxPoints), int(f.maxContours)
+}
+
 // scale returns x divided by f.fUnitsPerEm, rounded to the nearest integer.
 func (f *Font) scale(x int32) int32 {
 	if x >= 0 {
@@ -330,9 +745,207 @@ func (f *Font) FUnitsPerEm() int32 {
 	return f.fUnitsPerEm
 }
 
-// Index returns a Font's index for the given rune.
+// Table returns the raw data of the font's table with the given tag, such as
+// "GSUB" or "DSIG", including tables that this package does not parse. A
+// tag's trailing spaces are significant, as in "cvt ". The data is shared
+// with the font, and must not be modified. The glyf table of a font parsed by
+// ParseReaderAt is not available, as it is not read into memory.
+func (f *Font) Table(tag string) ([]byte, bool) {
+	t, ok := f.tables[tag]
+	return t, ok
+}
+
+// NumGlyphs returns the number of glyphs in a Font, as given by its maxp
+// table.
+func (f *Font) NumGlyphs() int {
+	return f.nGlyph
+}
+
+// NumHMetrics returns the number of full horizontal metrics in a Font's hmtx
+// table, as given by its hhea table. Glyphs whose index is NumHMetrics or
+// more have only a left side bearing in the hmtx table, and share the advance
+// width of the last full metric.
+func (f *Font) NumHMetrics() int {
+	return f.nHMetric
+}
+
+// IndexToLocFormat returns the format of a Font's loca table, as given by its
+// head table: 0 for 16-bit offsets, in units of 2 bytes, and 1 for 32-bit
+// offsets.
+func (f *Font) IndexToLocFormat() int {
+	if f.locaOffsetFormat == locaOffsetFormatLong {
+		return 1
+	}
+	return 0
+}
+
+// GlyphOffset returns the offset and length, in bytes, of the glyph with the
+// given index within a Font's glyf table, as given by its loca table. A glyph
+// with no contours, such as a space, has zero length. ok is false if the index
+// is out of range or the loca entries are invalid, such as pointing beyond
+// the end of the glyf table.
+func (f *Font) GlyphOffset(i Index) (offset, length uint32, ok bool) {
+	g0, g1, ok := f.locaEntry(i, uint32(len(f.glyf)))
+	if !ok {
+		return 0, 0, false
+	}
+	return g0, g1 - g0, true
+}
+
+// locaError returns a FormatError for the loca entry of the glyph with the
+// given index.
+func (f *Font) locaError(i Index) FormatError {
+	size := 2
+	if f.locaOffsetFormat == locaOffsetFormatLong {
+		size = 4
+	}
+	return FormatError{Table: "loca", Offset: size * int(i), Reason: "bad loca table"}
+}
+
+// glyfError returns a FormatError for the glyf data of the glyph with the
+// given index. Its Offset is that of the glyph, if known.
+func (f *Font) glyfError(i Index, reason string) FormatError {
+	offset, _, _ := f.GlyphOffset(i)
+	return FormatError{Table: "glyf", Offset: int(offset), Reason: reason}
+}
+
+// locaEntry returns the start and end offsets of the glyph with the given
+// index, as given by the loca table, in a glyf table of the given length. A
+// Permissive font's invalid entries are repaired: a missing final entry is
+// the end of the glyf table, and entries beyond the end of the glyf table or
+// before the previous entry are empty glyphs.
+func (f *Font) locaEntry(i Index, glyfLen uint32) (g0, g1 uint32, ok bool) {
+	if i < 0 || f.nGlyph <= int(i) {
+		return 0, 0, false
+	}
+	permissive := f.strictness == Permissive
+	if f.locaOffsetFormat == locaOffsetFormatShort {
+		if len(f.loca) < 2*int(i)+2 || !permissive && len(f.loca) < 2*int(i)+4 {
+			return 0, 0, false
+		}
+		g0, g1 = 2*uint32(u16(f.loca, 2*int(i))), glyfLen
+		if len(f.loca) >= 2*int(i)+4 {
+			g1 = 2 * uint32(u16(f.loca, 2*int(i)+2))
+		}
+	} else {
+		if len(f.loca) < 4*int(i)+4 || !permissive && len(f.loca) < 4*int(i)+8 {
+			return 0, 0, false
+		}
+		g0, g1 = u32(f.loca, 4*int(i)), glyfLen
+		if len(f.loca) >= 4*int(i)+8 {
+			g1 = u32(f.loca, 4*int(i)+4)
+		}
+	}
+	if permissive && (g1 > glyfLen || g0 > g1) {
+		if g0 > glyfLen {
+			g0 = glyfLen
+		}
+		g1 = g0
+	}
+	return g0, g1, g0 <= g1 && g1 <= glyfLen
+}
+
+// HasCharmap returns whether the font has a usable character map. If not,
+// Index always returns 0 and the font's glyphs can only be addressed by
+// their glyph index.
+func (f *Font) HasCharmap() bool {
+	return len(f.cm) != 0
+}
+
+// A CmapSubtable identifies one of the subtables of a font's cmap table,
+// each of which maps the character codes of one encoding to glyph indexes.
+// The platform and encoding IDs are documented at
+// https://www.microsoft.com/typography/otspec/name.htm. For example, the
+// Microsoft Unicode BMP subtable has platform ID 3 and encoding ID 1, and the
+// Macintosh Roman subtable has platform ID 1 and encoding ID 0.
+type CmapSubtable struct {
+	PlatformID, EncodingID uint16
+	// Format is the subtable's format number, such as 4 or 12.
+	Format uint16
+}
+
+// CmapSubtables returns the subtables of the font's cmap table, in the order
+// that they are listed in the font, including those that Index cannot use.
+func (f *Font) CmapSubtables() []CmapSubtable {
+	if len(f.cmap) < 4 {
+		return nil
+	}
+	n := int(u16(f.cmap, 2))
+	if len(f.cmap) < 8*n+4 {
+		return nil
+	}
+	s := make([]CmapSubtable, n)
+	for i := range s {
+		s[i] = f.cmapSubtableAt(4 + 8*i)
+	}
+	return s
+}
+
+// Cmap returns the cmap subtable that Index uses. ok is false if the font
+// has no usable character map.
+func (f *Font) Cmap() (s CmapSubtable, ok bool) {
+	if !f.HasCharmap() {
+		return CmapSubtable{}, false
+	}
+	return f.cmapSubtable, true
+}
+
+// SelectCmap makes Index use the first of the font's cmap subtables that
+// matches s, instead of the subtable chosen when the font was parsed, which
+// prefers Unicode encodings. For example, selecting the Symbol subtable,
+// with platform ID 3 and encoding ID 0, makes Index map a symbol font's
+// character codes. If there is no such subtable, or its format is
+// unsupported, SelectCmap returns an error and Index is unchanged.
+//
+// SelectCmap must not be called concurrently with other methods of f.
+func (f *Font) SelectCmap(s CmapSubtable) error {
+	for i, t := range f.CmapSubtables() {
+		if t != s {
+			continue
+		}
+		x := 4 + 8*i
+		cm, cmapIndexes := f.cm, f.cmapIndexes
+		if err := f.parseCmapSubtable(int(u32(f.cmap, x+4))); err != nil {
+			f.cm, f.cmapIndexes = cm, cmapIndexes
+			return err
+		}
+		f.setCmapSubtable(t)
+		if f.runeMap != nil {
+			f.runeMap.drop()
+		}
+		return nil
+	}
+	return UnsupportedError{Feature: fmt.Sprintf("cmap subtable (%d, %d) format %d", s.PlatformID, s.EncodingID, s.Format)}
+}
+
+// Index returns a Font's index for the given rune. If the font's only
+// character map is for a legacy East Asian encoding, such as Shift-JIS, x is
+// a character code in that encoding rather than a Unicode code point.
+//
+// If the character map is a Symbol subtable, as for fonts such as Wingdings,
+// x may also be one of the font's documented single byte character codes,
+// such as 0x4A, which the subtable maps at 0xF04A in the Private Use Area. If
+// it is a Macintosh Roman subtable, x is converted to that encoding.
 func (f *Font) Index(x rune) Index {
-	c := uint32(x)
+	if uint32(x) < uint32(len(f.latin1)) {
+		return f.latin1[x]
+	}
+	if f.cmapMacRoman {
+		c, ok := macRomanCode(x)
+		if !ok {
+			return 0
+		}
+		return f.index(uint32(c))
+	}
+	i := f.index(uint32(x))
+	if i == 0 && f.symbolBase != 0 && 0 <= x && x <= 0xff {
+		i = f.index(f.symbolBase + uint32(x))
+	}
+	return i
+}
+
+// index returns the glyph index that the character map maps c to.
+func (f *Font) index(c uint32) Index {
 	for i, j := 0, len(f.cm); i < j; {
 		h := i + (j-i)/2
 		cm := &f.cm[h]
@@ -340,16 +953,72 @@ func (f *Font) Index(x rune) Index {
 			j = h
 		} else if cm.end < c {
 			i = h + 1
+		} else if cm.many {
+			return Index(cm.delta)
 		} else if cm.offset == 0 {
 			return Index(c + cm.delta)
 		} else {
-			offset := int(cm.offset) + 2*(h-len(f.cm)+int(c-cm.start))
-			return Index(u16(f.cmapIndexes, offset))
+			offset := int(cm.offset) + 2*int(c-cm.start)
+			if offset+2 > len(f.cmapIndexes) {
+				return 0
+			}
+			i := Index(u16(f.cmapIndexes, offset))
+			if i != 0 {
+				i += Index(cm.delta)
+			}
+			return i
 		}
 	}
 	return 0
 }
 
+// RangeCharmap calls fn for each rune that the font's character map maps to a
+// glyph, with that glyph's index, in increasing order of rune, until fn
+// returns false. Runes that map to glyph 0, the missing glyph, are skipped.
+func (f *Font) RangeCharmap(fn func(r rune, i Index) bool) {
+	if f.cmapMacRoman {
+		f.rangeMacRoman(fn)
+		return
+	}
+	for _, cm := range f.cm {
+		end := cm.end
+		if end > unicode.MaxRune {
+			end = unicode.MaxRune
+		}
+		for c := cm.start; c <= end; c++ {
+			if i := f.index(c); i != 0 && !fn(rune(c), i) {
+				return
+			}
+		}
+	}
+}
+
+// Runes returns the runes that the font's character map maps to the glyph
+// with the given index, in increasing order, such as for extracting text that
+// was drawn by glyph index. Most glyphs have one rune or none, but a glyph may
+// be shared, such as by the Latin A and the Greek Alpha. The reverse mapping
+// is built when Runes is first called.
+func (f *Font) Runes(i Index) []rune {
+	return append([]rune(nil), f.runes()[i]...)
+}
+
+// runes returns the reverse of the font's character map, building it when it
+// is first needed. The returned map must not be modified.
+func (f *Font) runes() map[Index][]rune {
+	return f.runeMap.get(f.budget, func() (interface{}, int64) {
+		m, size := make(map[Index][]rune), int64(0)
+		f.RangeCharmap(func(r rune, i Index) bool {
+			if m[i] == nil {
+				size += 48
+			}
+			m[i] = append(m[i], r)
+			size += 4
+			return true
+		})
+		return m, size
+	}).(map[Index][]rune)
+}
+
 // unscaledHMetric returns the unscaled horizontal metrics for the glyph with
 // the given index.
 func (f *Font) unscaledHMetric(i Index) (h HMetric) {
@@ -357,6 +1026,14 @@ func (f *Font) unscaledHMetric(i Index) (h HMetric) {
 	if j < 0 || f.nGlyph <= j {
 		return HMetric{}
 	}
+	if f.src != nil {
+		if h, ok := f.src.HMetric(i); ok {
+			return h
+		}
+	}
+	if f.nHMetric == 0 {
+		return HMetric{}
+	}
 	if j >= f.nHMetric {
 		p := 4 * (f.nHMetric - 1)
 		return HMetric{
@@ -378,6 +1055,81 @@ func (f *Font) HMetric(scale int32, i Index) HMetric {
 	return h
 }
 
+// GlyphMetrics are a glyph's horizontal metrics and bounding box.
+type GlyphMetrics struct {
+	// AdvanceWidth is the glyph's advance width. LeftSideBearing and
+	// RightSideBearing are the distances from the glyph's origin to the left
+	// of its bounding box, and from the right of its bounding box to its
+	// advance width.
+	AdvanceWidth, LeftSideBearing, RightSideBearing int32
+	// B is the glyph's bounding box, relative to its origin.
+	B Bounds
+}
+
+// GlyphMetrics returns the metrics of the glyph with the given index, without
+// loading its outline. They are taken from the font's hmtx table and the
+// nominal bounding box in the glyph's glyf data, and so do not account for
+// hinting, and may differ slightly from the bounds of a loaded glyph if that
+// bounding box is imprecise. The charstrings of a font with CFF outlines,
+// which have no nominal bounding box, are decoded but not scaled.
+func (f *Font) GlyphMetrics(scale int32, i Index) (GlyphMetrics, error) {
+	var xMin, yMin, xMax, yMax int32
+	empty := true
+	if f.cffOutlines != nil && f.src == nil {
+		var d cffDecoder
+		if err := d.decode(f.cffOutlines, i); err != nil {
+			return GlyphMetrics{}, err
+		}
+		empty = len(d.points) == 0
+		for j, p := range d.points {
+			if j == 0 || xMin > p.X {
+				xMin = p.X
+			}
+			if j == 0 || yMin > p.Y {
+				yMin = p.Y
+			}
+			if j == 0 || xMax < p.X {
+				xMax = p.X
+			}
+			if j == 0 || yMax < p.Y {
+				yMax = p.Y
+			}
+		}
+	} else {
+		glyf, err := f.glyphData(i)
+		if err != nil {
+			return GlyphMetrics{}, err
+		}
+		if len(glyf) >= 10 {
+			empty = false
+			xMin = int32(int16(u16(glyf, 2)))
+			yMin = int32(int16(u16(glyf, 4)))
+			xMax = int32(int16(u16(glyf, 6)))
+			yMax = int32(int16(u16(glyf, 8)))
+		}
+	}
+	// As for a loaded glyph, the glyph is placed so that its left side
+	// bearing is that of the hmtx table, rather than its xMin. An empty glyph
+	// has an empty bounding box.
+	h := f.unscaledHMetric(i)
+	xMax += h.LeftSideBearing - xMin
+	m := GlyphMetrics{
+		AdvanceWidth:     f.scale(scale * h.AdvanceWidth),
+		LeftSideBearing:  f.scale(scale * h.LeftSideBearing),
+		RightSideBearing: f.scale(scale * (h.AdvanceWidth - xMax)),
+		B: Bounds{
+			XMin: f.scale(scale * h.LeftSideBearing),
+			YMin: f.scale(scale * yMin),
+			XMax: f.scale(scale * xMax),
+			YMax: f.scale(scale * yMax),
+		},
+	}
+	if empty {
+		m.B = Bounds{}
+	}
+	return m, nil
+}
+
 // unscaledVMetric returns the unscaled vertical metrics for the glyph with
 // the given index. yMax is the top of the glyph's bounding box.
 func (f *Font) unscaledVMetric(i Index, yMax int32) (v VMetric) {
@@ -385,23 +1137,25 @@ func (f *Font) unscaledVMetric(i Index, yMax int32) (v VMetric) {
 	if j < 0 || f.nGlyph <= j {
 		return VMetric{}
 	}
-	if 4*j+4 <= len(f.vmtx) {
+	if f.nVMetric > 0 {
+		if j >= f.nVMetric {
+			p := 4 * (f.nVMetric - 1)
+			return VMetric{
+				AdvanceHeight:  int32(u16(f.vmtx, p)),
+				TopSideBearing: int32(int16(u16(f.vmtx, p+2*(j-f.nVMetric)+4))),
+			}
+		}
 		return VMetric{
 			AdvanceHeight:  int32(u16(f.vmtx, 4*j)),
 			TopSideBearing: int32(int16(u16(f.vmtx, 4*j+2))),
 		}
 	}
-	// The OS/2 table has grown over time.
-	// https://developer.apple.com/fonts/TTRefMan/RM06/Chap6OS2.html
-	// says that it was originally 68 bytes. Optional fields, including
-	// the ascender and descender, are described at
-	// http://www.microsoft.com/typography/otspec/os2.htm
-	if len(f.os2) >= 72 {
-		sTypoAscender := int32(int16(u16(f.os2, 68)))
-		sTypoDescender := int32(int16(u16(f.os2, 70)))
+	// The original 68 byte OS/2 table has no typographic ascender and
+	// descender, which are then zero.
+	if o, ok := f.OS2(); ok && (o.TypoAscender != 0 || o.TypoDescender != 0) {
 		return VMetric{
-			AdvanceHeight:  sTypoAscender - sTypoDescender,
-			TopSideBearing: sTypoAscender - yMax,
+			AdvanceHeight:  int32(o.TypoAscender) - int32(o.TypoDescender),
+			TopSideBearing: int32(o.TypoAscender) - yMax,
 		}
 	}
 	return VMetric{
@@ -410,7 +1164,10 @@ func (f *Font) unscaledVMetric(i Index, yMax int32) (v VMetric) {
 	}
 }
 
-// VMetric returns the vertical metrics for the glyph with the given index.
+// VMetric returns the vertical metrics for the glyph with the given index,
+// as given by the font's vhea and vmtx tables. A font without those tables
+// has synthesized metrics, derived from its OS/2 table's typographic ascender
+// and descender.
 func (f *Font) VMetric(scale int32, i Index) VMetric {
 	// TODO: should 0 be bounds.YMax?
 	v := f.unscaledVMetric(i, 0)
@@ -419,37 +1176,71 @@ func (f *Font) VMetric(scale int32, i Index) VMetric {
 	return v
 }
 
-// Kerning returns the kerning for the given glyph pair.
-func (f *Font) Kerning(scale int32, i0, i1 Index) int32 {
-	if f.nKern == 0 {
-		return 0
+// LineMetrics returns the font's typographic ascent, descent and line gap, as
+// given by its hhea table. The ascent is the distance from the baseline to the
+// top of the font's tallest glyphs, and is positive. The descent is the
+// distance from the baseline to the bottom of its lowest glyphs, and is
+// typically negative. The distance between consecutive baselines is the
+// ascent minus the descent plus the line gap.
+func (f *Font) LineMetrics(scale int32) (ascent, descent, lineGap int32) {
+	return f.scale(scale * f.ascent), f.scale(scale * f.descent), f.scale(scale * f.lineGap)
+}
+
+// VLineMetrics returns the font's vertical ascent, descent and line gap, as
+// given by its vhea table, for laying out vertical text. The ascent and
+// descent are the distances from the center of a vertical line to its right
+// and left edges. They are zero if the font has no vertical metrics.
+func (f *Font) VLineMetrics(scale int32) (ascent, descent, lineGap int32) {
+	return f.scale(scale * f.vAscent), f.scale(scale * f.vDescent), f.scale(scale * f.vLineGap)
+}
+
+// Kern returns the horizontal kerning adjustment for the glyph i0 followed
+// by the glyph i1, in the same units as scale, which is the number of units
+// in 1 em. For a scale in 26.6 fixed point pixels, the adjustment is too. It
+// is typically negative, such as for "AV", and is added to i0's advance width.
+//
+// The adjustment is from the pair adjustment lookups of the GPOS table's
+// "kern" feature or, if there are none, from the AAT kerx table's pair list
+// and class subtables or, if there are none, from the kern table, as fonts
+// with several typically have a kern table only for older software.
+func (f *Font) Kern(scale int32, i0, i1 Index) int32 {
+	if f.gposKern != nil {
+		return f.scale(scale * f.gposKerning(i0, i1))
+	}
+	if f.kerxSubtables != nil {
+		return f.scale(scale * f.kerxKerning(i0, i1))
 	}
 	g := uint32(i0)<<16 | uint32(i1)
-	lo, hi := 0, f.nKern
-	for lo < hi {
-		i := (lo + hi) / 2
-		ig := u32(f.kern, 18+6*i)
-		if ig < g {
-			lo = i + 1
-		} else if ig > g {
-			hi = i
-		} else {
-			return f.scale(scale * int32(int16(u16(f.kern, 22+6*i))))
+	k := int32(0)
+	for _, t := range f.kernSubtables {
+		if v, ok := t.find(g); ok {
+			if t.override {
+				k = v
+			} else {
+				k += v
+			}
 		}
 	}
-	return 0
+	return f.scale(scale * k)
+}
+
+// Kerning returns the kerning for the given glyph pair. It is equivalent to
+// Kern.
+func (f *Font) Kerning(scale int32, i0, i1 Index) int32 {
+	return f.Kern(scale, i0, i1)
 }
 
-// Parse returns a new Font for the given TTF or TTC data.
+// Parse returns a new Font for the given TTF, TTC, WOFF or WOFF2 data. WOFF
+// and WOFF2 data that decodes to more than 128 MiB is unsupported.
 //
 // For TrueType Collections, the first font in the collection is parsed.
 func Parse(ttf []byte) (font *Font, err error) {
-	return parse(ttf, 0)
+	return parse(ttf, 0, nil, nil)
 }
 
-func parse(ttf []byte, offset int) (font *Font, err error) {
+func parse(ttf []byte, offset int, src IncrementalSource, o *ParseOptions) (font *Font, err error) {
 	if len(ttf)-offset < 12 {
-		err = FormatError("TTF data is too short")
+		err = FormatError{Offset: offset, Reason: "TTF data is too short"}
 		return
 	}
 	originalOffset := offset
@@ -457,24 +1248,26 @@ func parse(ttf []byte, offset int) (font *Font, err error) {
 	switch magic {
 	case 0x00010000:
 		// No-op.
+	case 0x4f54544f: // "OTTO" as a big-endian uint32.
+		// OpenType fonts with CFF outlines, whose glyphs have cubic curves.
 	case 0x74746366: // "ttcf" as a big-endian uint32.
 		if originalOffset != 0 {
-			err = FormatError("recursive TTC")
+			err = FormatError{Offset: originalOffset, Reason: "recursive TTC"}
 			return
 		}
 		ttcVersion, offset := u32(ttf, offset), offset+4
 		if ttcVersion != 0x00010000 {
 			// TODO: support TTC version 2.0, once I have such a .ttc file to test with.
-			err = FormatError("bad TTC version")
+			err = FormatError{Offset: 4, Reason: "bad TTC version"}
 			return
 		}
 		numFonts, offset := int(u32(ttf, offset)), offset+4
 		if numFonts <= 0 {
-			err = FormatError("bad number of TTC fonts")
+			err = FormatError{Offset: 8, Reason: "bad number of TTC fonts"}
 			return
 		}
 		if len(ttf[offset:])/4 < numFonts {
-			err = FormatError("TTC offset table is too short")
+			err = FormatError{Offset: 12, Reason: "TTC offset table is too short"}
 			return
 		}
 		// TODO: provide an API to select which font in a TrueType collection to return,
@@ -482,54 +1275,138 @@ func parse(ttf []byte, offset int) (font *Font, err error) {
 		// so users of this package can select the font in a TTC by name.
 		offset = int(u32(ttf, offset))
 		if offset <= 0 || offset > len(ttf) {
-			err = FormatError("bad TTC offset")
+			err = FormatError{Offset: 12, Reason: "bad TTC offset"}
 			return
 		}
-		return parse(ttf, offset)
+		return parse(ttf, offset, src, o)
+	case 0x774f4646: // "wOFF" as a big-endian uint32.
+		if originalOffset != 0 {
+			err = FormatError{Offset: originalOffset, Reason: "WOFF in TTC"}
+			return
+		}
+		if ttf, err = decodeWOFF(ttf); err != nil {
+			return
+		}
+		return parse(ttf, 0, src, o)
+	case 0x774f4632: // "wOF2" as a big-endian uint32.
+		if originalOffset != 0 {
+			err = FormatError{Offset: originalOffset, Reason: "WOFF2 in TTC"}
+			return
+		}
+		if ttf, err = decodeWOFF2(ttf); err != nil {
+			return
+		}
+		return parse(ttf, 0, src, o)
 	default:
-		err = FormatError("bad TTF version")
+		err = FormatError{Offset: originalOffset, Reason: "bad TTF version"}
 		return
 	}
 	n, offset := int(u16(ttf, offset)), offset+2
 	if len(ttf) < 16*n+12 {
-		err = FormatError("TTF data is too short")
+		err = FormatError{Offset: originalOffset + 12, Reason: "TTF data is too short"}
 		return
 	}
-	f := new(Font)
+	f := &Font{tables: make(map[string][]byte, n), runeMap: &lazyCache{}, postNames: &lazyCache{}}
+	if o != nil {
+		f.logger = o.Logger
+		f.strictness = o.Strictness
+		f.budget = o.Budget
+	}
 	// Assign the table slices.
 	for i := 0; i < n; i++ {
 		x := 16*i + 12
-		switch string(ttf[x : x+4]) {
+		tag, entry := string(ttf[x:x+4]), ttf[x+8:x+16]
+		if f.strictness == Permissive {
+			entry = f.repairTableEntry(ttf, tag, entry)
+		}
+		f.caps.addTable(tag)
+		switch tag {
+		case "avar":
+			f.avar, err = readTable(ttf, entry)
+		case "CFF ":
+			f.cff, err = readTable(ttf, entry)
 		case "cmap":
-			f.cmap, err = readTable(ttf, ttf[x+8:x+16])
+			f.cmap, err = readTable(ttf, entry)
 		case "cvt ":
-			f.cvt, err = readTable(ttf, ttf[x+8:x+16])
+			f.cvt, err = readTable(ttf, entry)
 		case "fpgm":
-			f.fpgm, err = readTable(ttf, ttf[x+8:x+16])
+			f.fpgm, err = readTable(ttf, entry)
+		case "EBDT":
+			f.ebdt, err = readTable(ttf, entry)
+		case "EBLC":
+			f.eblc, err = readTable(ttf, entry)
+		case "fvar":
+			f.fvar, err = readTable(ttf, entry)
+		case "gasp":
+			f.gasp, err = readTable(ttf, entry)
+		case "GDEF":
+			f.gdef, err = readTable(ttf, entry)
 		case "glyf":
-			f.glyf, err = readTable(ttf, ttf[x+8:x+16])
+			f.glyf, err = readTable(ttf, entry)
+		case "GPOS":
+			f.gpos, err = readTable(ttf, entry)
+		case "GSUB":
+			f.gsub, err = readTable(ttf, entry)
+		case "gvar":
+			f.gvar, err = readTable(ttf, entry)
 		case "hdmx":
-			f.hdmx, err = readTable(ttf, ttf[x+8:x+16])
+			f.hdmx, err = readTable(ttf, entry)
 		case "head":
-			f.head, err = readTable(ttf, ttf[x+8:x+16])
+			f.head, err = readTable(ttf, entry)
 		case "hhea":
-			f.hhea, err = readTable(ttf, ttf[x+8:x+16])
+			f.hhea, err = readTable(ttf, entry)
 		case "hmtx":
-			f.hmtx, err = readTable(ttf, ttf[x+8:x+16])
+			f.hmtx, err = readTable(ttf, entry)
 		case "kern":
-			f.kern, err = readTable(ttf, ttf[x+8:x+16])
+			f.kern, err = readTable(ttf, entry)
+		case "kerx":
+			f.kerx, err = readTable(ttf, entry)
 		case "loca":
-			f.loca, err = readTable(ttf, ttf[x+8:x+16])
+			f.loca, err = readTable(ttf, entry)
+		case "LTSH":
+			f.ltsh, err = readTable(ttf, entry)
 		case "maxp":
-			f.maxp, err = readTable(ttf, ttf[x+8:x+16])
+			f.maxp, err = readTable(ttf, entry)
+		case "morx":
+			f.morx, err = readTable(ttf, entry)
+		case "name":
+			f.name, err = readTable(ttf, entry)
 		case "OS/2":
-			f.os2, err = readTable(ttf, ttf[x+8:x+16])
+			f.os2, err = readTable(ttf, entry)
+		case "PCLT":
+			f.pclt, err = readTable(ttf, entry)
+		case "post":
+			f.post, err = readTable(ttf, entry)
 		case "prep":
-			f.prep, err = readTable(ttf, ttf[x+8:x+16])
+			f.prep, err = readTable(ttf, entry)
+		case "sbix":
+			f.sbix, err = readTable(ttf, entry)
+		case "trak":
+			f.trak, err = readTable(ttf, entry)
+		case "VDMX":
+			f.vdmx, err = readTable(ttf, entry)
+		case "vhea":
+			f.vhea, err = readTable(ttf, entry)
 		case "vmtx":
-			f.vmtx, err = readTable(ttf, ttf[x+8:x+16])
+			f.vmtx, err = readTable(ttf, entry)
+		case "VORG":
+			f.vorg, err = readTable(ttf, entry)
 		}
 		if err != nil {
+			if e, ok := err.(FormatError); ok {
+				e.Table = tag
+				err = e
+			}
+			return
+		}
+		// Every table, including those that this package does not parse,
+		// is kept for Table and Write.
+		if t, err := readTable(ttf, entry); err == nil {
+			f.tables[tag] = t
+		}
+	}
+	if f.strictness == Strict && originalOffset == 0 {
+		if err = checkStrict(ttf, n); err != nil {
 			return
 		}
 	}
@@ -540,15 +1417,61 @@ func parse(ttf []byte, offset int) (font *Font, err error) {
 	if err = f.parseMaxp(); err != nil {
 		return
 	}
-	if err = f.parseCmap(); err != nil {
-		return
+	if f.strictness == Strict && f.glyf != nil {
+		for i := 0; i < f.nGlyph; i++ {
+			if _, _, ok := f.GlyphOffset(Index(i)); !ok {
+				err = f.locaError(Index(i))
+				return
+			}
+		}
+	}
+	// A font without a usable cmap, such as a symbolic subset font embedded
+	// in a PDF, can still be drawn by glyph index, so a missing cmap table or
+	// an unsupported cmap encoding is not an error. Such a font maps every
+	// rune to glyph 0.
+	if f.cmap != nil {
+		if err = f.parseCmap(); err != nil {
+			if _, ok := err.(UnsupportedError); !ok {
+				return
+			}
+			f.warn("truetype: ignoring unsupported cmap", "error", err)
+			f.cm, f.cmapIndexes, err = nil, nil, nil
+		}
 	}
 	if err = f.parseKern(); err != nil {
 		return
 	}
-	if err = f.parseHhea(); err != nil {
+	// A font whose CFF table is of an unsupported kind can still be used
+	// for its metrics and character map, but its glyphs cannot be loaded.
+	if f.cff != nil && f.glyf == nil {
+		if f.cffOutlines, err = parseCFF(f.cff); err != nil {
+			if _, ok := err.(UnsupportedError); !ok {
+				return
+			}
+			f.warn("truetype: ignoring unsupported CFF table", "error", err)
+			err = nil
+		}
+	}
+	f.parseGPOS()
+	f.parseGSUB()
+	f.parseKerx()
+	f.parseMorx()
+	f.parseHdmx()
+	if f.hhea != nil || src == nil {
+		if err = f.parseHhea(); err != nil {
+			return
+		}
+	}
+	f.parseVhea()
+	f.parseFvar()
+	f.parseAvar()
+	f.parseGvar()
+	if f.strictErr != nil {
+		err = f.strictErr
 		return
 	}
+	f.src = src
+	f.parsed = true
 	font = f
 	return
 }
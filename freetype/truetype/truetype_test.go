@@ -7,13 +7,18 @@ package truetype
 
 import (
 	"bufio"
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
+	"unicode"
 )
 
 func parseTestdataFont(name string) (font *Font, testdataIsOptional bool, err error) {
@@ -364,3 +369,3517 @@ func TestScalingSansHinting(t *testing.T) {
 func TestScalingWithHinting(t *testing.T) {
 	testScaling(t, FullHinting)
 }
+
+func TestVDMX(t *testing.T) {
+	f := &Font{
+		vdmx: []byte{
+			0x00, 0x01, // version
+			0x00, 0x01, // numRecs
+			0x00, 0x02, // numRatios
+			0x01, 0x02, 0x01, 0x01, // ratio 2:1, which does not match 1:1
+			0x01, 0x00, 0x00, 0x00, // catch-all ratio
+			0x00, 0x12, // offset to group for ratio 0
+			0x00, 0x12, // offset to group for ratio 1
+			0x00, 0x02, // recs
+			0x0a, 0x0c, // startsz, endsz
+			0x00, 0x0a, 0x00, 0x09, 0xff, 0xfe, // ppem 10: yMax 9, yMin -2
+			0x00, 0x0c, 0x00, 0x0b, 0xff, 0xfd, // ppem 12: yMax 11, yMin -3
+		},
+	}
+	testCases := []struct {
+		scale      int32
+		yMax, yMin int32
+		ok         bool
+	}{
+		{10 << 6, 9 << 6, -2 << 6, true},
+		{11 << 6, 0, 0, false},
+		{12 << 6, 11 << 6, -3 << 6, true},
+		{13 << 6, 0, 0, false},
+		{12<<6 + 1, 0, 0, false},
+	}
+	for _, tc := range testCases {
+		yMax, yMin, ok := f.VDMX(tc.scale)
+		if yMax != tc.yMax || yMin != tc.yMin || ok != tc.ok {
+			t.Errorf("scale=%d: got %d, %d, %t, want %d, %d, %t",
+				tc.scale, yMax, yMin, ok, tc.yMax, tc.yMin, tc.ok)
+		}
+	}
+}
+
+func TestDeviceAdvance(t *testing.T) {
+	head := make([]byte, 54)
+	head[17] = 0x10 // instructions may alter advance widths
+	f := &Font{
+		fUnitsPerEm: 1000,
+		nGlyph:      2,
+		nHMetric:    2,
+		head:        head,
+		hmtx:        u16s(500, 0, 600, 0),
+		hdmx: []byte{
+			0x00, 0x00, // version
+			0x00, 0x01, // numRecords
+			0x00, 0x00, 0x00, 0x04, // sizeDeviceRecord
+			0x0a, 0x07, 0x05, 0x07, // ppem 10: widths 5 and 7
+		},
+		ltsh: []byte{
+			0x00, 0x00, // version
+			0x00, 0x02, // numGlyphs
+			0x00, 0x14, // yPels
+		},
+	}
+	f.parseHdmx()
+	if f.hdmx == nil || f.ltsh == nil {
+		t.Fatalf("parseHdmx ignored valid tables")
+	}
+	testCases := []struct {
+		scale   int32
+		i       Index
+		advance int32
+		ok      bool
+	}{
+		{10 << 6, 0, 5 << 6, true},
+		{10 << 6, 1, 7 << 6, true},
+		{10<<6 + 1, 0, 0, false},
+		{12 << 6, 0, 0, false},
+		{19 << 6, 1, 0, false},
+		{20 << 6, 1, 12 << 6, true},
+		{20 << 6, 2, 0, false},
+	}
+	for _, tc := range testCases {
+		advance, ok := f.DeviceAdvance(tc.scale, tc.i)
+		if advance != tc.advance || ok != tc.ok {
+			t.Errorf("scale=%d, i=%d: got %d, %t, want %d, %t",
+				tc.scale, tc.i, advance, ok, tc.advance, tc.ok)
+		}
+	}
+
+	// Without the head flag, every advance scales linearly.
+	head[17] = 0
+	if advance, ok := f.DeviceAdvance(12<<6, 0); advance != 6<<6 || !ok {
+		t.Errorf("linear: got %d, %t, want %d, true", advance, ok, 6<<6)
+	}
+
+	// A record too short for every glyph is invalid.
+	f.hdmx = f.hdmx[:len(f.hdmx)-1]
+	f.hdmx[7] = 0x03
+	f.parseHdmx()
+	if f.hdmx != nil {
+		t.Errorf("parseHdmx: short record was not ignored")
+	}
+}
+
+func TestNoCharmap(t *testing.T) {
+	b, err := ioutil.ReadFile("../../testdata/luxisr.ttf")
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	// Rename the cmap table's directory entry so that the font has no
+	// character map.
+	n := int(u16(b, 4))
+	for i := 0; i < n; i++ {
+		x := 16*i + 12
+		if string(b[x:x+4]) == "cmap" {
+			copy(b[x:x+4], "xmap")
+		}
+	}
+	font, err := Parse(b)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if font.HasCharmap() {
+		t.Errorf("HasCharmap: got true, want false")
+	}
+	if got := font.Index('A'); got != 0 {
+		t.Errorf("Index('A'): got %d, want 0", got)
+	}
+	g := NewGlyphBuf()
+	if err := g.Load(font, font.FUnitsPerEm(), 36, NoHinting); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+}
+
+// cmapTable returns a cmap table with the given (platform ID, platform
+// specific ID, subtable) entries.
+func cmapTable(entries ...interface{}) []byte {
+	n := len(entries) / 2
+	b := []byte{0, 0, 0, byte(n)}
+	offset := 4 + 8*n
+	var subtables []byte
+	for i := 0; i < n; i++ {
+		o := offset + len(subtables)
+		pidPsid := entries[2*i].(uint32)
+		b = append(b, byte(pidPsid>>24), byte(pidPsid>>16), byte(pidPsid>>8), byte(pidPsid),
+			byte(o>>24), byte(o>>16), byte(o>>8), byte(o))
+		subtables = append(subtables, entries[2*i+1].([]byte)...)
+	}
+	return append(b, subtables...)
+}
+
+// cmapFormat12Subtable returns a format 12 subtable with the given (start,
+// end, start glyph) groups.
+func cmapFormat12Subtable(groups ...uint32) []byte {
+	n := uint32(len(groups) / 3)
+	length := 16 + 12*n
+	b := []byte{0, 12, 0, 0}
+	for _, x := range append([]uint32{length, 0, n}, groups...) {
+		b = append(b, byte(x>>24), byte(x>>16), byte(x>>8), byte(x))
+	}
+	return b
+}
+
+func TestCmapFormat12(t *testing.T) {
+	// The format 4 subtable maps 'A' to glyph 5, and the format 12 subtable
+	// maps 'A' to glyph 7 and U+1F600 to U+1F602 to glyphs 20 to 22.
+	format4 := []byte{
+		0, 4, 0, 32, 0, 0, // Format, length and language.
+		0, 4, 0, 4, 0, 1, 0, 0, // segCountX2, searchRange, entrySelector and rangeShift.
+		0, 'A', 0xff, 0xff, // End codes.
+		0, 0, // Reserved pad.
+		0, 'A', 0xff, 0xff, // Start codes.
+		0xff, 0xc4, 0, 1, // Deltas: 5-'A' and 1.
+		0, 0, 0, 0, // Range offsets.
+	}
+	format12 := cmapFormat12Subtable('A', 'A', 7, 0x1f600, 0x1f602, 20)
+	testCases := []struct {
+		desc  string
+		cmap  []byte
+		index map[rune]Index
+	}{
+		{
+			"format 12 only",
+			cmapTable(uint32(0x0003000a), format12),
+			map[rune]Index{'A': 7, 'B': 0, 0x1f600: 20, 0x1f602: 22, 0x1f603: 0},
+		},
+		{
+			"format 12 preferred over format 4",
+			cmapTable(uint32(0x00030001), format4, uint32(0x0003000a), format12),
+			map[rune]Index{'A': 7, 0x1f601: 21},
+		},
+		{
+			"format 4 only",
+			cmapTable(uint32(0x00030001), format4),
+			map[rune]Index{'A': 5, 0x1f601: 0},
+		},
+	}
+	for _, tc := range testCases {
+		f := &Font{cmap: tc.cmap}
+		if err := f.parseCmap(); err != nil {
+			t.Errorf("%s: parseCmap: %v", tc.desc, err)
+			continue
+		}
+		for r, want := range tc.index {
+			if got := f.Index(r); got != want {
+				t.Errorf("%s: Index(%U): got %d, want %d", tc.desc, r, got, want)
+			}
+		}
+	}
+
+	// Truncated and unsorted format 12 subtables are rejected, rather than
+	// panicking or mapping runes incorrectly.
+	bad := [][]byte{
+		cmapTable(uint32(0x0003000a), format12[:len(format12)-4]),
+		cmapTable(uint32(0x0003000a), cmapFormat12Subtable(0x1f600, 0x1f602, 20, 'A', 'A', 7)),
+	}
+	for i, b := range bad {
+		f := &Font{cmap: b}
+		if err := f.parseCmap(); err == nil {
+			t.Errorf("bad cmap #%d: got nil error, want non-nil", i)
+		}
+	}
+}
+
+func TestCmapFormat13(t *testing.T) {
+	// The format 13 subtable maps the ASCII runes to glyph 1, and every other
+	// rune to glyph 2, as a last resort font might. It has the same layout as
+	// a format 12 subtable.
+	format13 := cmapFormat12Subtable(0, 0x7f, 1, 0x80, unicode.MaxRune, 2)
+	format13[1] = 13
+	format12 := cmapFormat12Subtable('A', 'A', 7, 0x1f600, 0x1f602, 20)
+	testCases := []struct {
+		desc  string
+		cmap  []byte
+		index map[rune]Index
+	}{
+		{
+			"format 13 only",
+			cmapTable(uint32(0x00000006), format13),
+			map[rune]Index{0: 1, 'A': 1, 0x7f: 1, 0xe9: 2, 0x4e00: 2, 0x1f600: 2, unicode.MaxRune: 2},
+		},
+		{
+			"format 12 preferred over format 13",
+			cmapTable(uint32(0x00000006), format13, uint32(0x0003000a), format12),
+			map[rune]Index{'A': 7, 'B': 0, 0x1f601: 21},
+		},
+	}
+	for _, tc := range testCases {
+		f := &Font{cmap: tc.cmap}
+		if err := f.parseCmap(); err != nil {
+			t.Errorf("%s: parseCmap: %v", tc.desc, err)
+			continue
+		}
+		for r, want := range tc.index {
+			if got := f.Index(r); got != want {
+				t.Errorf("%s: Index(%U): got %d, want %d", tc.desc, r, got, want)
+			}
+		}
+	}
+
+	format13 = cmapFormat12Subtable(0x20, 0x22, 3)
+	format13[1] = 13
+	f := &Font{cmap: cmapTable(uint32(0x00000006), format13)}
+	if err := f.parseCmap(); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	f.RangeCharmap(func(r rune, i Index) bool {
+		got = append(got, fmt.Sprintf("%U:%d", r, i))
+		return true
+	})
+	if want := "[U+0020:3 U+0021:3 U+0022:3]"; fmt.Sprint(got) != want {
+		t.Errorf("RangeCharmap: got %v, want %s", got, want)
+	}
+}
+
+func TestCmapFormats2And6(t *testing.T) {
+	format6 := []byte{
+		0, 6, 0, 16, 0, 0, // Format, length and language.
+		0, 0x20, 0, 3, // firstCode and entryCount.
+		0, 5, 0, 0, 0, 7, // Glyph indexes.
+	}
+
+	// The format 2 subtable maps the single byte codes 0x41 and 0x42 to glyphs
+	// 10 and 11 and, with the lead byte 0x81, the two byte code 0x8140 to
+	// glyph 1 plus an idDelta of 100.
+	format2 := make([]byte, 542)
+	copy(format2, []byte{0, 2, 2, 30, 0, 0})
+	format2[6+2*0x81+1] = 8
+	copy(format2[518:], []byte{
+		0, 0x41, 0, 2, 0, 0, 0, 10, // subHeader 0.
+		0, 0x40, 0, 2, 0, 100, 0, 6, // subHeader 1.
+		0, 10, 0, 11, 0, 1, 0, 0, // Glyph indexes.
+	})
+
+	testCases := []struct {
+		desc  string
+		cmap  []byte
+		index map[rune]Index
+	}{
+		{
+			"format 6",
+			cmapTable(uint32(0x00000003), format6),
+			map[rune]Index{0x1f: 0, 0x20: 5, 0x21: 0, 0x22: 7, 0x23: 0},
+		},
+		{
+			"format 2",
+			cmapTable(uint32(0x00030002), format2),
+			map[rune]Index{0x40: 0, 0x41: 10, 0x42: 11, 0x43: 0, 0x81: 0, 0x8140: 101, 0x8141: 0, 0x8240: 0},
+		},
+		{
+			"Unicode preferred over Shift-JIS",
+			cmapTable(uint32(0x00000003), format6, uint32(0x00030002), format2),
+			map[rune]Index{0x20: 5, 0x41: 0, 0x8140: 0},
+		},
+	}
+	for _, tc := range testCases {
+		f := &Font{cmap: tc.cmap}
+		if err := f.parseCmap(); err != nil {
+			t.Errorf("%s: parseCmap: %v", tc.desc, err)
+			continue
+		}
+		for r, want := range tc.index {
+			if got := f.Index(r); got != want {
+				t.Errorf("%s: Index(%#x): got %d, want %d", tc.desc, r, got, want)
+			}
+		}
+	}
+
+	// Truncated subtables are rejected.
+	for i, b := range [][]byte{
+		cmapTable(uint32(0x00000003), format6[:14]),
+		cmapTable(uint32(0x00030002), format2[:520]),
+	} {
+		f := &Font{cmap: b}
+		if err := f.parseCmap(); err == nil {
+			t.Errorf("bad cmap #%d: got nil error, want non-nil", i)
+		}
+	}
+}
+
+func TestSelectCmap(t *testing.T) {
+	format6 := []byte{
+		0, 6, 0, 14, 0, 0, // Format, length and language.
+		0, 'A', 0, 2, // firstCode and entryCount.
+		0, 3, 0, 4, // Glyph indexes.
+	}
+	f := &Font{cmap: cmapTable(
+		uint32(0x00010000), format6,
+		uint32(0x0003000a), cmapFormat12Subtable('A', 'B', 7),
+	)}
+	if err := f.parseCmap(); err != nil {
+		t.Fatalf("parseCmap: %v", err)
+	}
+	mac := CmapSubtable{PlatformID: 1, EncodingID: 0, Format: 6}
+	ucs4 := CmapSubtable{PlatformID: 3, EncodingID: 10, Format: 12}
+	if got, want := fmt.Sprint(f.CmapSubtables()), fmt.Sprint([]CmapSubtable{mac, ucs4}); got != want {
+		t.Errorf("CmapSubtables: got %s, want %s", got, want)
+	}
+	if got, ok := f.Cmap(); got != ucs4 || !ok {
+		t.Errorf("Cmap: got %v, %t, want %v, true", got, ok, ucs4)
+	}
+	if got := f.Index('B'); got != 8 {
+		t.Errorf("Index('B'): got %d, want 8", got)
+	}
+
+	if err := f.SelectCmap(mac); err != nil {
+		t.Fatalf("SelectCmap(%v): %v", mac, err)
+	}
+	if got, ok := f.Cmap(); got != mac || !ok {
+		t.Errorf("Cmap: got %v, %t, want %v, true", got, ok, mac)
+	}
+	if got := f.Index('B'); got != 4 {
+		t.Errorf("after SelectCmap: Index('B'): got %d, want 4", got)
+	}
+
+	// Selecting a missing subtable is an error, and leaves Index unchanged.
+	if err := f.SelectCmap(CmapSubtable{3, 1, 4}); err == nil {
+		t.Errorf("SelectCmap(3, 1, 4): got nil error, want non-nil")
+	}
+	if got := f.Index('B'); got != 4 {
+		t.Errorf("after failed SelectCmap: Index('B'): got %d, want 4", got)
+	}
+}
+
+func TestMacRomanCmap(t *testing.T) {
+	format0 := make([]byte, 6+256)
+	format0[2], format0[3] = 0x01, 0x06 // The length, 262.
+	format0[6+'A'], format0[6+'B'] = 3, 4
+	format0[6+0x80] = 5 // A with diaeresis.
+	format0[6+0xdb] = 6 // The euro sign.
+	f := &Font{cmap: cmapTable(uint32(0x00010000), format0)}
+	if err := f.parseCmap(); err != nil {
+		t.Fatalf("parseCmap: %v", err)
+	}
+	testCases := []struct {
+		r    rune
+		want Index
+	}{
+		{'@', 0},
+		{'A', 3},
+		{'B', 4},
+		{'\u00c4', 5},
+		{'\u20ac', 6},
+		{0x80, 0},
+		{0xdb, 0},
+	}
+	for _, tc := range testCases {
+		if got := f.Index(tc.r); got != tc.want {
+			t.Errorf("Index(%U): got %d, want %d", tc.r, got, tc.want)
+		}
+	}
+	var got []rune
+	f.RangeCharmap(func(r rune, i Index) bool {
+		got = append(got, r)
+		return true
+	})
+	if want := []rune{'A', 'B', '\u00c4', '\u20ac'}; string(got) != string(want) {
+		t.Errorf("RangeCharmap: got %q, want %q", got, want)
+	}
+
+	// A Unicode subtable is preferred.
+	f = &Font{cmap: cmapTable(
+		uint32(0x00010000), format0,
+		uint32(0x00030001), cmapFormat12Subtable('A', 'A', 7),
+	)}
+	if err := f.parseCmap(); err != nil {
+		t.Fatalf("parseCmap: %v", err)
+	}
+	if got := f.Index('A'); got != 7 {
+		t.Errorf("with UCS-2: Index('A'): got %d, want 7", got)
+	}
+}
+
+func TestSymbolCmap(t *testing.T) {
+	f := &Font{cmap: cmapTable(
+		uint32(0x00030000), cmapFormat12Subtable(0xf041, 0xf042, 5),
+	)}
+	if err := f.parseCmap(); err != nil {
+		t.Fatalf("parseCmap: %v", err)
+	}
+	testCases := []struct {
+		r    rune
+		want Index
+	}{
+		{0x40, 0},
+		{0x41, 5},
+		{0x42, 6},
+		{0xf041, 5},
+		{0x1f041, 0},
+	}
+	for _, tc := range testCases {
+		if got := f.Index(tc.r); got != tc.want {
+			t.Errorf("Index(%#x): got %d, want %d", tc.r, got, tc.want)
+		}
+	}
+
+	// The OS/2 table's first character index overrides the 0xF000 offset.
+	f = &Font{
+		cmap: cmapTable(uint32(0x00030000), cmapFormat12Subtable(0xf141, 0xf141, 5)),
+		os2:  make([]byte, 68),
+	}
+	f.os2[64], f.os2[65] = 0xf1, 0x20
+	if err := f.parseCmap(); err != nil {
+		t.Fatalf("parseCmap: %v", err)
+	}
+	if got := f.Index(0x41); got != 5 {
+		t.Errorf("with OS/2: Index(0x41): got %d, want 5", got)
+	}
+
+	// Other subtables have no offset.
+	f = &Font{cmap: cmapTable(uint32(0x00030001), cmapFormat12Subtable(0xf041, 0xf041, 5))}
+	if err := f.parseCmap(); err != nil {
+		t.Fatalf("parseCmap: %v", err)
+	}
+	if got := f.Index(0x41); got != 0 {
+		t.Errorf("UCS-2: Index(0x41): got %d, want 0", got)
+	}
+}
+
+func TestCmapFormatError(t *testing.T) {
+	f := &Font{cmap: cmapTable(uint32(0x00030001), []byte{0, 6, 0, 0})}
+	want := FormatError{Table: "cmap", Offset: 12, Reason: "cmap too short"}
+	if err := f.parseCmap(); err != want {
+		t.Errorf("got %#v, want %#v", err, want)
+	}
+	f = &Font{cmap: cmapTable(uint32(0x00030001), []byte{0, 99})}
+	if _, ok := f.parseCmap().(UnsupportedError); !ok {
+		t.Errorf("format 99: got %v, want an UnsupportedError", f.parseCmap())
+	}
+}
+
+func TestCmapFormat14(t *testing.T) {
+	u24 := func(x uint32) []byte { return []byte{byte(x >> 16), byte(x >> 8), byte(x)} }
+	u32 := func(x uint32) []byte { return []byte{byte(x >> 24), byte(x >> 16), byte(x >> 8), byte(x)} }
+	// The format 14 subtable has two variation selector records, each 11
+	// bytes long, after its 10 byte header, and then the records' UVS tables.
+	var format14 []byte
+	format14 = append(format14, 0, 14)
+	format14 = append(format14, u32(63)...)
+	format14 = append(format14, u32(2)...)
+	// U+FE0F has a default UVS table at offset 32 and a non-default UVS
+	// table at offset 40.
+	format14 = append(format14, u24(0xfe0f)...)
+	format14 = append(format14, u32(32)...)
+	format14 = append(format14, u32(40)...)
+	// U+E0100 has a non-default UVS table at offset 49.
+	format14 = append(format14, u24(0xe0100)...)
+	format14 = append(format14, u32(0)...)
+	format14 = append(format14, u32(49)...)
+	// 'A' to 'C' with U+FE0F use the default glyphs.
+	format14 = append(format14, u32(1)...)
+	format14 = append(format14, u24('A')...)
+	format14 = append(format14, 2)
+	// U+1F600 with U+FE0F uses glyph 30.
+	format14 = append(format14, u32(1)...)
+	format14 = append(format14, u24(0x1f600)...)
+	format14 = append(format14, 0, 30)
+	// 'A' and 'B' with U+E0100 use glyphs 31 and 32.
+	format14 = append(format14, u32(2)...)
+	format14 = append(format14, u24('A')...)
+	format14 = append(format14, 0, 31)
+	format14 = append(format14, u24('B')...)
+	format14 = append(format14, 0, 32)
+	if len(format14) != 63 {
+		t.Fatalf("format 14 subtable length: got %d, want 63", len(format14))
+	}
+
+	f := &Font{cmap: cmapTable(
+		uint32(0x00000005), format14,
+		uint32(0x0003000a), cmapFormat12Subtable('A', 'D', 7, 0x1f600, 0x1f600, 20),
+	)}
+	if err := f.parseCmap(); err != nil {
+		t.Fatalf("parseCmap: %v", err)
+	}
+	testCases := []struct {
+		r, selector rune
+		want        Index
+		wantOK      bool
+	}{
+		{'A', 0xfe0f, 7, true},
+		{'C', 0xfe0f, 9, true},
+		{'D', 0xfe0f, 10, false},
+		{0x1f600, 0xfe0f, 30, true},
+		{0x1f600, 0xfe0e, 20, false},
+		{'A', 0xe0100, 31, true},
+		{'B', 0xe0100, 32, true},
+		{'C', 0xe0100, 9, false},
+	}
+	for _, tc := range testCases {
+		got, ok := f.IndexVariant(tc.r, tc.selector)
+		if got != tc.want || ok != tc.wantOK {
+			t.Errorf("IndexVariant(%U, %U): got %d, %t, want %d, %t", tc.r, tc.selector, got, ok, tc.want, tc.wantOK)
+		}
+	}
+
+	// A truncated format 14 subtable is ignored.
+	f = &Font{cmap: cmapTable(
+		uint32(0x00000005), format14[:60],
+		uint32(0x0003000a), cmapFormat12Subtable('A', 'D', 7),
+	)}
+	if err := f.parseCmap(); err != nil {
+		t.Fatalf("parseCmap: %v", err)
+	}
+	if got, ok := f.IndexVariant('A', 0xe0100); got != 7 || ok {
+		t.Errorf("truncated: IndexVariant('A', U+E0100): got %d, %t, want 7, false", got, ok)
+	}
+}
+
+func TestCompareOutlines(t *testing.T) {
+	font, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, b := NewGlyphBuf(), NewGlyphBuf()
+	if err := a.Load(font, 12*64, 36, NoHinting); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Load(font, 12*64, 36, FullHinting); err != nil {
+		t.Fatal(err)
+	}
+	if _, equal := CompareOutlines(a, a, 0); !equal {
+		t.Errorf("CompareOutlines(a, a, 0): got false, want true")
+	}
+	if _, equal := CompareOutlines(a, b, 0); equal {
+		t.Errorf("CompareOutlines(unhinted, hinted, 0): got true, want false")
+	}
+	// Hinting moves a point by at most half a pixel in each direction, give
+	// or take rounding.
+	if i, equal := CompareOutlines(a, b, 64); !equal {
+		t.Errorf("CompareOutlines(unhinted, hinted, 64): mismatch at point %d", i)
+	}
+
+	p := []Point{{X: 0, Y: 0}, {X: 100, Y: 0}, {X: 1, Y: -1}, {X: 100, Y: 2}}
+	if got, want := SnapPoints(p, 1), 1; got != want {
+		t.Errorf("SnapPoints: got %d moved, want %d", got, want)
+	}
+	if p[2] != p[0] || p[3] == p[1] {
+		t.Errorf("SnapPoints: got %v", p)
+	}
+}
+
+func TestIndexMap(t *testing.T) {
+	m := NewIndexMap([]Index{0, 36, 57, 36})
+	if got, want := m.Len(), 4; got != want {
+		t.Errorf("Len: got %d, want %d", got, want)
+	}
+	for _, tc := range []struct {
+		old, new Index
+		ok       bool
+	}{
+		{0, 0, true},
+		{36, 1, true},
+		{57, 2, true},
+		{58, 0, false},
+	} {
+		if got, ok := m.New(tc.old); got != tc.new || ok != tc.ok {
+			t.Errorf("New(%d): got %d, %t, want %d, %t", tc.old, got, ok, tc.new, tc.ok)
+		}
+	}
+	if got, ok := m.Old(3); got != 36 || !ok {
+		t.Errorf("Old(3): got %d, %t, want 36, true", got, ok)
+	}
+	if _, ok := m.Old(4); ok {
+		t.Errorf("Old(4): got ok, want !ok")
+	}
+}
+
+func TestGlyphName(t *testing.T) {
+	// A version 2.0 post table names glyphs 1 and 2 with its own names, in
+	// the opposite order to those names.
+	post := make([]byte, 32)
+	putU32(post, 0, 0x00020000)
+	post = append(post, u16s(3, 0, 259, 258)...)
+	post = append(post, "\x03foo\x03bar"...)
+	f := &Font{post: post, nGlyph: 4}
+	for i, want := range []string{".notdef", "bar", "foo", ""} {
+		if got, ok := f.GlyphName(Index(i)); got != want || ok != (want != "") {
+			t.Errorf("GlyphName(%d): got %q, %t, want %q", i, got, ok, want)
+		}
+	}
+}
+
+func TestToUnicode(t *testing.T) {
+	font, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := font.GlyphName(36); got != "A" || !ok {
+		t.Errorf("GlyphName(36): got %q, %t, want \"A\", true", got, ok)
+	}
+	m := font.ToUnicode()
+	for i, want := range map[Index]string{3: " ", 36: "A", 101: "É", 193: "ﬂ"} {
+		if got := m[i]; got != want {
+			t.Errorf("glyph %d: got %q, want %q", i, got, want)
+		}
+	}
+
+	// Without glyph names, the glyphs that GSUB substitutions produce are
+	// mapped to the text of the glyphs that they replace. x and y are glyphs
+	// that the character map does not map.
+	font.post, font.postNames = nil, &lazyCache{}
+	m = font.ToUnicode()
+	var unmapped []int
+	for i := 1; i < font.NumGlyphs() && len(unmapped) < 2; i++ {
+		if _, ok := m[Index(i)]; !ok {
+			unmapped = append(unmapped, i)
+		}
+	}
+	if len(unmapped) < 2 {
+		t.Fatalf("got %d unmapped glyphs, want at least 2", len(unmapped))
+	}
+	x, y := unmapped[0], unmapped[1]
+	f, i := int(font.Index('f')), int(font.Index('i'))
+	font.gsub = gposTable(
+		[]interface{}{"liga", []int{0}, "smcp", []int{1}},
+		lookupTable(4, ligatureSubst([]int{f, x, f, i})),
+		lookupTable(1, append(u16s(2, 8, 1, y), u16s(1, 1, int(font.Index('a')))...)),
+	)
+	font.parseGSUB()
+	m = font.ToUnicode()
+	if got, want := m[Index(x)], "ffi"; got != want {
+		t.Errorf("ligature: got %q, want %q", got, want)
+	}
+	if got, want := m[Index(y)], "a"; got != want {
+		t.Errorf("single substitution: got %q, want %q", got, want)
+	}
+
+	byName := map[string]Index{"A": 36, "B": 37}
+	text := map[Index]string{36: "A"}
+	for name, want := range map[string]string{
+		"A.sc":        "A",
+		"A_A.sc":      "AA",
+		"ffi":         "ffi",
+		"uni00410042": "AB",
+		"u1F600":      "\U0001F600",
+		"A_uni0042":   "AB",
+		"B":           "",
+		"uni00e9":     "",
+		"uniD800":     "",
+	} {
+		got, _ := nameToText(name, byName, text)
+		if got != want {
+			t.Errorf("nameToText(%q): got %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestWidthScale(t *testing.T) {
+	font, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := font.Index('H')
+	for _, h := range []Hinting{NoHinting, FullHinting} {
+		g0 := NewGlyphBuf()
+		if err := g0.Load(font, 64<<6, i, h); err != nil {
+			t.Fatalf("h=%d: Load: %v", h, err)
+		}
+		g1 := NewGlyphBuf()
+		g1.SetWidthScale(1 << 15)
+		if err := g1.Load(font, 64<<6, i, h); err != nil {
+			t.Fatalf("h=%d: Load with width scale: %v", h, err)
+		}
+		if len(g0.Point) != len(g1.Point) {
+			t.Fatalf("h=%d: got %d points, want %d", h, len(g1.Point), len(g0.Point))
+		}
+		// Horizontal co-ordinates are halved, give or take rounding to the
+		// pixel grid, and vertical ones are unchanged.
+		for j := range g0.Point {
+			p0, p1 := g0.Point[j], g1.Point[j]
+			if d := abs32(p0.X/2 - p1.X); d > 64 {
+				t.Errorf("h=%d: point %d: got x=%d, want approximately %d", h, j, p1.X, p0.X/2)
+			}
+			if h == NoHinting && p0.Y != p1.Y {
+				t.Errorf("h=%d: point %d: got y=%d, want %d", h, j, p1.Y, p0.Y)
+			}
+		}
+		if d := abs32(g0.AdvanceWidth/2 - g1.AdvanceWidth); d > 64 {
+			t.Errorf("h=%d: advance width: got %d, want approximately %d", h, g1.AdvanceWidth, g0.AdvanceWidth/2)
+		}
+	}
+}
+
+func TestVerticalHinting(t *testing.T) {
+	font, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range "Hoxg" {
+		i := font.Index(r)
+		var g [3]*GlyphBuf
+		for j, h := range []Hinting{NoHinting, FullHinting, VerticalHinting} {
+			g[j] = NewGlyphBuf()
+			if err := g[j].Load(font, 12<<6, i, h); err != nil {
+				t.Fatalf("%c: hinting %d: %v", r, h, err)
+			}
+		}
+		unhinted, full, vertical := g[0], g[1], g[2]
+		if vertical.AdvanceWidth != unhinted.AdvanceWidth {
+			t.Errorf("%c: advance width: got %d, want %d", r, vertical.AdvanceWidth, unhinted.AdvanceWidth)
+		}
+		for j, p := range vertical.Point {
+			if p.X != unhinted.Point[j].X {
+				t.Errorf("%c: point %d: got x=%d, want %d", r, j, p.X, unhinted.Point[j].X)
+			}
+			if p.Y != full.Point[j].Y {
+				t.Errorf("%c: point %d: got y=%d, want %d", r, j, p.Y, full.Point[j].Y)
+			}
+		}
+	}
+}
+
+func TestAutoHinting(t *testing.T) {
+	font, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ppem := range []int32{9, 12, 16, 23} {
+		var xHeight int32
+		for _, r := range "xoHE" {
+			i := font.Index(r)
+			unhinted, auto := NewGlyphBuf(), NewGlyphBuf()
+			if err := unhinted.Load(font, ppem<<6, i, NoHinting); err != nil {
+				t.Fatal(err)
+			}
+			if err := auto.Load(font, ppem<<6, i, AutoHinting); err != nil {
+				t.Fatal(err)
+			}
+			if auto.AdvanceWidth != unhinted.AdvanceWidth {
+				t.Errorf("%dppem %c: advance width: got %d, want %d", ppem, r, auto.AdvanceWidth, unhinted.AdvanceWidth)
+			}
+			var yMin, yMax int32
+			for j, p := range auto.Point {
+				if p.X != unhinted.Point[j].X {
+					t.Errorf("%dppem %c: point %d: got x=%d, want %d", ppem, r, j, p.X, unhinted.Point[j].X)
+				}
+				if j == 0 || p.Y < yMin {
+					yMin = p.Y
+				}
+				if j == 0 || p.Y > yMax {
+					yMax = p.Y
+				}
+			}
+			// The glyphs sit on the baseline, and their tops are on the
+			// pixel grid.
+			if yMin != 0 || yMax&63 != 0 {
+				t.Errorf("%dppem %c: got y range [%d, %d], want whole pixels from 0", ppem, r, yMin, yMax)
+			}
+			// Without the overshoot, the round 'o' lines up with the 'x'.
+			switch r {
+			case 'x':
+				xHeight = yMax
+			case 'o':
+				if yMax < xHeight || yMax > xHeight+64 {
+					t.Errorf("%dppem o: got height %d, want within a pixel above the x-height %d", ppem, yMax, xHeight)
+				}
+			}
+		}
+	}
+}
+
+func TestRawTableValues(t *testing.T) {
+	font, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := font.NumGlyphs(), 391; got != want {
+		t.Errorf("NumGlyphs: got %d, want %d", got, want)
+	}
+	if got, want := font.NumHMetrics(), 391; got != want {
+		t.Errorf("NumHMetrics: got %d, want %d", got, want)
+	}
+	if got, want := font.IndexToLocFormat(), 0; got != want {
+		t.Errorf("IndexToLocFormat: got %d, want %d", got, want)
+	}
+	if _, n, ok := font.GlyphOffset(font.Index(' ')); !ok || n != 0 {
+		t.Errorf("GlyphOffset(space): got length %d, %t, want 0, true", n, ok)
+	}
+	// The glyphs' data is contiguous.
+	next := uint32(0)
+	for i := 0; i < font.NumGlyphs(); i++ {
+		offset, n, ok := font.GlyphOffset(Index(i))
+		if !ok || offset != next {
+			t.Fatalf("GlyphOffset(%d): got %d, %t, want %d, true", i, offset, ok, next)
+		}
+		next = offset + n
+	}
+	if _, _, ok := font.GlyphOffset(Index(font.NumGlyphs())); ok {
+		t.Errorf("GlyphOffset(NumGlyphs): got ok, want not ok")
+	}
+}
+
+// testIncrementalSource supplies the glyphs of a Font that has a glyf table.
+type testIncrementalSource struct {
+	f *Font
+}
+
+func (s testIncrementalSource) GlyphData(i Index) ([]byte, error) {
+	offset, length, ok := s.f.GlyphOffset(i)
+	if !ok {
+		return nil, FormatError{Reason: "bad glyph"}
+	}
+	return s.f.glyf[offset : offset+length], nil
+}
+
+func (s testIncrementalSource) HMetric(i Index) (HMetric, bool) {
+	return s.f.HMetric(s.f.FUnitsPerEm(), i), true
+}
+
+func TestParseIncremental(t *testing.T) {
+	b, err := ioutil.ReadFile("../../testdata/luxisr.ttf")
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	font, err := Parse(b)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	// Rename the glyf, loca, hhea and hmtx tables' directory entries so that
+	// the incremental font has no glyph data or metrics of its own.
+	b = append([]byte(nil), b...)
+	n := int(u16(b, 4))
+	for i := 0; i < n; i++ {
+		x := 16*i + 12
+		switch string(b[x : x+4]) {
+		case "glyf", "loca", "hhea", "hmtx":
+			b[x] = 'x'
+		}
+	}
+	incFont, err := ParseIncremental(b, testIncrementalSource{font})
+	if err != nil {
+		t.Fatalf("ParseIncremental: %v", err)
+	}
+	g0, g1 := NewGlyphBuf(), NewGlyphBuf()
+	for i := Index(0); int(i) < font.NumGlyphs(); i++ {
+		for _, h := range []Hinting{NoHinting, FullHinting} {
+			if err := g0.Load(font, 12<<6, i, h); err != nil {
+				t.Fatalf("glyph %d: Load: %v", i, err)
+			}
+			if err := g1.Load(incFont, 12<<6, i, h); err != nil {
+				t.Fatalf("glyph %d: incremental Load: %v", i, err)
+			}
+			if got, want := fmt.Sprint(g1.AdvanceWidth, g1.B, g1.Point, g1.End),
+				fmt.Sprint(g0.AdvanceWidth, g0.B, g0.Point, g0.End); got != want {
+				t.Fatalf("glyph %d, hinting %d:\ngot  %s\nwant %s", i, h, got, want)
+			}
+		}
+	}
+}
+
+func TestHinter(t *testing.T) {
+	font, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Count the number of times that the font's prep bytecode is started.
+	preps := 0
+	opts := &HinterOptions{
+		Trace: func(e *TraceEvent) {
+			if e.PC == 0 && len(e.Program) == len(font.prep) && &e.Program[0] == &font.prep[0] {
+				preps++
+			}
+		},
+	}
+	x, err := NewHinter(font, 12<<6, FullHinting, opts)
+	if err != nil {
+		t.Fatalf("NewHinter: %v", err)
+	}
+	g := NewGlyphBuf()
+	for _, r := range "The quick brown fox" {
+		i := font.Index(r)
+		got, err := x.Run(i)
+		if err != nil {
+			t.Fatalf("%c: Run: %v", r, err)
+		}
+		if err := g.Load(font, 12<<6, i, FullHinting); err != nil {
+			t.Fatalf("%c: Load: %v", r, err)
+		}
+		if got, want := fmt.Sprint(got.AdvanceWidth, got.Point), fmt.Sprint(g.AdvanceWidth, g.Point); got != want {
+			t.Errorf("%c:\ngot  %s\nwant %s", r, got, want)
+		}
+	}
+	if preps != 1 {
+		t.Errorf("prep ran %d times, want 1", preps)
+	}
+}
+
+func TestSubset(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 'É' is a compound glyph, made of 'E' and an acute accent.
+	const text = "Hello, Élan!"
+	b, m, err := f.Subset([]rune(text))
+	if err != nil {
+		t.Fatalf("Subset: %v", err)
+	}
+	g, err := Parse(b)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if g.NumGlyphs() != m.Len() {
+		t.Errorf("NumGlyphs: got %d, want %d", g.NumGlyphs(), m.Len())
+	}
+	if g.NumGlyphs() >= f.NumGlyphs() {
+		t.Errorf("NumGlyphs: got %d, want fewer than %d", g.NumGlyphs(), f.NumGlyphs())
+	}
+	if got := g.Index('z'); got != 0 {
+		t.Errorf("Index('z'): got %d, want 0", got)
+	}
+	gb0, gb1 := NewGlyphBuf(), NewGlyphBuf()
+	for _, r := range text {
+		i0 := f.Index(r)
+		i1 := g.Index(r)
+		if want, ok := m.New(i0); !ok || i1 != want {
+			t.Errorf("Index(%q): got %d, want %d", r, i1, want)
+			continue
+		}
+		for _, h := range []Hinting{NoHinting, FullHinting} {
+			if err := gb0.Load(f, 12*64, i0, h); err != nil {
+				t.Fatalf("Load(%q): %v", r, err)
+			}
+			if err := gb1.Load(g, 12*64, i1, h); err != nil {
+				t.Fatalf("Load(%q) from the subset: %v", r, err)
+			}
+			if gb0.AdvanceWidth != gb1.AdvanceWidth || fmt.Sprint(gb0.Point) != fmt.Sprint(gb1.Point) {
+				t.Errorf("%q, hinting %d: the subset's glyph differs from the original", r, h)
+			}
+		}
+	}
+
+	// The checksum of the whole font, including the head table's checksum
+	// adjustment, is the magic number.
+	if got := checksum(b); got != 0xb1b0afba {
+		t.Errorf("checksum: got %#08x, want 0xb1b0afba", got)
+	}
+
+	// With a GSUB table that makes "fi" a ligature, SubsetFeatures also
+	// keeps the ligature's glyph.
+	fi, lig := []Index{f.Index('f'), f.Index('i')}, f.Index('&')
+	f.gsub = gposTable(
+		[]interface{}{"liga", []int{0}},
+		lookupTable(4, ligatureSubst([]int{int(fi[0]), int(lig), int(fi[1])})),
+	)
+	f.parseGSUB()
+	if _, m, err = f.Subset([]rune("fi")); err != nil {
+		t.Fatalf("Subset: %v", err)
+	} else if _, ok := m.New(lig); ok {
+		t.Errorf("Subset: the ligature is included")
+	}
+	if _, m, err = f.SubsetFeatures([]rune("fi"), "liga"); err != nil {
+		t.Fatalf("SubsetFeatures: %v", err)
+	} else if _, ok := m.New(lig); !ok {
+		t.Errorf("SubsetFeatures: the ligature is not included")
+	}
+}
+
+func TestKern(t *testing.T) {
+	// pairs returns a format 0 subtable's body for the given (left, right,
+	// value) pairs.
+	pairs := func(p ...int) []byte {
+		n := len(p) / 3
+		b := []byte{0, byte(n), 0, 0, 0, 0, 0, 0}
+		for _, x := range p {
+			b = append(b, byte(x>>8), byte(x))
+		}
+		return b
+	}
+	msSubtable := func(coverage uint16, body []byte) []byte {
+		n := 6 + len(body)
+		return append([]byte{0, 0, byte(n >> 8), byte(n), byte(coverage >> 8), byte(coverage)}, body...)
+	}
+	appleSubtable := func(coverage uint16, body []byte) []byte {
+		n := 8 + len(body)
+		return append([]byte{0, 0, byte(n >> 8), byte(n), byte(coverage >> 8), byte(coverage), 0, 0}, body...)
+	}
+	testCases := []struct {
+		desc string
+		kern []byte
+		want map[[2]Index]int32
+	}{
+		{
+			"Microsoft header, one subtable",
+			append([]byte{0, 0, 0, 1}, msSubtable(0x0001, pairs(1, 2, -50, 3, 4, 20))...),
+			map[[2]Index]int32{{1, 2}: -50, {3, 4}: 20, {2, 1}: 0},
+		},
+		{
+			"Microsoft header, additive, vertical and override subtables",
+			append(append(append(append([]byte{0, 0, 0, 4},
+				msSubtable(0x0001, pairs(1, 2, -50, 3, 4, 20))...),
+				msSubtable(0x0001, pairs(1, 2, -10))...),
+				msSubtable(0x0000, pairs(3, 4, 99))...),
+				msSubtable(0x0009, pairs(3, 4, 5))...),
+			map[[2]Index]int32{{1, 2}: -60, {3, 4}: 5},
+		},
+		{
+			"Apple header, horizontal and cross-stream subtables",
+			append(append([]byte{0, 1, 0, 0, 0, 0, 0, 2},
+				appleSubtable(0x0000, pairs(1, 2, -50))...),
+				appleSubtable(0x4000, pairs(1, 2, 99))...),
+			map[[2]Index]int32{{1, 2}: -50},
+		},
+		{
+			"Microsoft header, format 2 subtable is ignored",
+			append(append([]byte{0, 0, 0, 2},
+				msSubtable(0x0201, []byte{0, 0, 0, 0, 0, 0, 0, 0})...),
+				msSubtable(0x0001, pairs(1, 2, -50))...),
+			map[[2]Index]int32{{1, 2}: -50},
+		},
+	}
+	for _, tc := range testCases {
+		f := &Font{kern: tc.kern, fUnitsPerEm: 1000}
+		if err := f.parseKern(); err != nil {
+			t.Errorf("%s: parseKern: %v", tc.desc, err)
+			continue
+		}
+		for p, want := range tc.want {
+			if got := f.Kern(1000, p[0], p[1]); got != want {
+				t.Errorf("%s: Kern(%d, %d): got %d, want %d", tc.desc, p[0], p[1], got, want)
+			}
+		}
+	}
+
+	// A truncated subtable is rejected, rather than panicking.
+	truncated := append([]byte{0, 0, 0, 1}, msSubtable(0x0001, pairs(1, 2, -50))...)
+	f := &Font{kern: truncated[:len(truncated)-2], fUnitsPerEm: 1000}
+	if err := f.parseKern(); err == nil {
+		t.Errorf("truncated kern: got nil error, want non-nil")
+	}
+}
+
+// u16s returns the big-endian encoding of the given 16-bit values.
+func u16s(x ...int) []byte {
+	b := make([]byte, 0, 2*len(x))
+	for _, v := range x {
+		b = append(b, byte(v>>8), byte(v))
+	}
+	return b
+}
+
+// offsetList returns a count, a list of 16-bit offsets to the given tables,
+// from the start of the list plus the given header size, and the tables.
+func offsetList(header int, tables ...[]byte) []byte {
+	b := u16s(len(tables))
+	offset := header + 2 + 2*len(tables)
+	var data []byte
+	for _, t := range tables {
+		b = append(b, u16s(offset+len(data))...)
+		data = append(data, t...)
+	}
+	return append(b, data...)
+}
+
+// gposTable returns a GPOS table with the given features, which are (tag,
+// lookup indexes) pairs, and lookups.
+func gposTable(features []interface{}, lookups ...[]byte) []byte {
+	n := len(features) / 2
+	featureList := u16s(n)
+	var featureData []byte
+	for i := 0; i < n; i++ {
+		indexes := features[2*i+1].([]int)
+		featureList = append(featureList, features[2*i].(string)...)
+		featureList = append(featureList, u16s(2+6*n+len(featureData))...)
+		featureData = append(featureData, u16s(append([]int{0, len(indexes)}, indexes...)...)...)
+	}
+	featureList = append(featureList, featureData...)
+	b := u16s(1, 0, 0, 10, 10+len(featureList))
+	b = append(b, featureList...)
+	return append(b, offsetList(0, lookups...)...)
+}
+
+// lookupTable returns a lookup table of the given type with the given
+// subtables.
+func lookupTable(typ int, subtables ...[]byte) []byte {
+	return append(u16s(typ, 0), offsetList(4, subtables...)...)
+}
+
+// pairPosFormat1 returns a format 1 pair adjustment subtable that adjusts the
+// XAdvance of the first glyph for each (second glyph, value) pair.
+func pairPosFormat1(first int, pairs ...int) []byte {
+	n := len(pairs) / 2
+	b := u16s(1, 14+4*n, 4, 0, 1, 12)
+	b = append(b, u16s(append([]int{n}, pairs...)...)...)
+	return append(b, u16s(1, 1, first)...)
+}
+
+func TestGPOSKern(t *testing.T) {
+	// The format 2 subtable covers glyphs 1 to 5. Glyph 5 is in first glyph
+	// class 1 and glyphs 6 and 7 are in second glyph class 1.
+	pairPosFormat2 := u16s(
+		2, 24, 4, 0, 34, 42, 2, 2, // Format, offsets, value formats and class counts.
+		0, -10, 0, -20, // Values for classes (0, 0), (0, 1), (1, 0) and (1, 1).
+		2, 1, 1, 5, 0, // Coverage.
+		1, 5, 1, 1, // First glyph class definitions.
+		2, 1, 6, 7, 1, // Second glyph class definitions.
+	)
+	extension := append(u16s(1, 2, 0, 8), pairPosFormat1(1, 2, -5)...)
+	gpos := gposTable(
+		[]interface{}{"kern", []int{0, 1}, "mark", []int{2}},
+		lookupTable(2, pairPosFormat1(1, 2, -50, 4, -30), pairPosFormat2),
+		lookupTable(9, extension),
+		lookupTable(2, pairPosFormat1(1, 2, -1000)),
+	)
+	// The kern table is ignored, as the GPOS table has kerning.
+	kern := append([]byte{0, 0, 0, 1, 0, 0, 0, 20, 0, 1}, u16s(1, 0, 0, 0, 1, 2, 99)...)
+	f := &Font{gpos: gpos, kern: kern, fUnitsPerEm: 1000}
+	if err := f.parseKern(); err != nil {
+		t.Fatalf("parseKern: %v", err)
+	}
+	f.parseGPOS()
+	testCases := []struct {
+		i0, i1 Index
+		want   int32
+	}{
+		{1, 2, -55},
+		{1, 4, -30},
+		{1, 6, -10},
+		{3, 6, -10},
+		{5, 7, -20},
+		{5, 2, 0},
+		{9, 9, 0},
+	}
+	for _, tc := range testCases {
+		if got := f.Kern(1000, tc.i0, tc.i1); got != tc.want {
+			t.Errorf("Kern(%d, %d): got %d, want %d", tc.i0, tc.i1, got, tc.want)
+		}
+	}
+
+	// A truncated GPOS table is ignored, falling back to the kern table.
+	f.gpos = gpos[:30]
+	f.parseGPOS()
+	if got, want := f.Kern(1000, 1, 2), int32(99); got != want {
+		t.Errorf("truncated GPOS: Kern(1, 2): got %d, want %d", got, want)
+	}
+}
+
+// ligatureSubst returns a ligature substitution subtable whose ligatures are
+// listed as (first glyph, ligature glyph, remaining components) slices. The
+// first glyphs must be in increasing order, and a first glyph's ligatures must
+// be adjacent.
+func ligatureSubst(ligatures ...[]int) []byte {
+	var (
+		firsts []int
+		sets   [][]byte
+		ligs   [][]byte
+	)
+	for i, l := range ligatures {
+		ligs = append(ligs, u16s(append([]int{l[1], len(l) - 1}, l[2:]...)...))
+		if i == len(ligatures)-1 || ligatures[i+1][0] != l[0] {
+			firsts = append(firsts, l[0])
+			sets = append(sets, offsetList(0, ligs...))
+			ligs = nil
+		}
+	}
+	list := offsetList(4, sets...)
+	b := append(u16s(1, 4+len(list)), list...)
+	return append(b, u16s(append([]int{1, len(firsts)}, firsts...)...)...)
+}
+
+func TestSubstitute(t *testing.T) {
+	singleSubst := append(u16s(1, 6, 100), u16s(1, 1, 5)...)
+	gsub := gposTable(
+		[]interface{}{"liga", []int{0}, "smcp", []int{1}},
+		lookupTable(4, ligatureSubst(
+			[]int{1, 10, 1, 2}, // ffi
+			[]int{1, 11, 1},    // ff
+			[]int{1, 12, 2},    // fi
+			[]int{3, 13, 4},
+		)),
+		lookupTable(1, singleSubst),
+	)
+	f := &Font{gsub: gsub}
+	f.parseGSUB()
+
+	glyphs := []Index{1, 1, 2, 5, 1, 2, 3, 4, 1}
+	clusters := []int{0, 1, 2, 3, 4, 5, 6, 7, 8}
+	glyphs, clusters = f.Substitute(glyphs, clusters)
+	if got, want := fmt.Sprint(glyphs), "[10 5 12 13 1]"; got != want {
+		t.Errorf("glyphs: got %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(clusters), "[0 3 4 6 8]"; got != want {
+		t.Errorf("clusters: got %s, want %s", got, want)
+	}
+
+	glyphs, _ = f.Substitute([]Index{1, 5, 6}, nil, "smcp")
+	if got, want := fmt.Sprint(glyphs), "[1 105 6]"; got != want {
+		t.Errorf("smcp: got %s, want %s", got, want)
+	}
+
+	// The closure adds the ligatures whose components are all present, and
+	// the single substitutions of the named features.
+	if got, want := fmt.Sprint(f.SubstitutionClosure([]Index{2, 1, 5})), "[1 2 5 10 11 12]"; got != want {
+		t.Errorf("closure: got %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(f.SubstitutionClosure([]Index{3, 5}, "smcp")), "[3 5 105]"; got != want {
+		t.Errorf("smcp closure: got %s, want %s", got, want)
+	}
+
+	// A truncated GSUB table is ignored.
+	f.gsub = gsub[:30]
+	f.parseGSUB()
+	glyphs, _ = f.Substitute([]Index{1, 2}, nil)
+	if got, want := fmt.Sprint(glyphs), "[1 2]"; got != want {
+		t.Errorf("truncated GSUB: got %s, want %s", got, want)
+	}
+}
+
+// testLogger records the messages that it is told of.
+type testLogger []string
+
+func (l *testLogger) Warn(msg string, args ...interface{}) {
+	*l = append(*l, strings.TrimSpace(fmt.Sprintln(append([]interface{}{msg}, args...)...)))
+}
+
+func TestLogger(t *testing.T) {
+	b, err := ioutil.ReadFile("../../testdata/luxisr.ttf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var l testLogger
+	f, err := ParseWithOptions(b, &ParseOptions{Logger: &l})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l) != 0 {
+		t.Errorf("luxisr: got warnings %q, want none", l)
+	}
+
+	// A lookup type that is not supported is skipped, and a truncated table
+	// is ignored.
+	f.gsub = gposTable(
+		[]interface{}{"liga", []int{0, 1}},
+		lookupTable(4, ligatureSubst([]int{1, 10, 2})),
+		lookupTable(6),
+	)
+	f.parseGSUB()
+	f.gpos = f.gsub[:30]
+	f.parseGPOS()
+	want := []string{
+		"truetype: skipping unsupported GSUB lookup features [liga] type 6",
+		"truetype: ignoring invalid GPOS table",
+	}
+	if got := fmt.Sprintf("%q", l); got != fmt.Sprintf("%q", want) {
+		t.Errorf("got warnings %s, want %q", got, want)
+	}
+
+	// The GSUB table is only parsed once, so substituting is not told of
+	// its anomalies again.
+	for i := 0; i < 2; i++ {
+		if got, _ := f.Substitute([]Index{1, 2}, nil, "liga"); fmt.Sprint(got) != "[10]" {
+			t.Errorf("Substitute: got %v, want [10]", got)
+		}
+	}
+	if len(l) != len(want) {
+		t.Errorf("after Substitute: got warnings %q, want %q", l, want)
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	b, err := ioutil.ReadFile("../../testdata/luxisr.ttf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.Capabilities(), (Capabilities{HasHinting: true, HasVertical: true}); got != want {
+		t.Errorf("luxisr: got %+v, want %+v", got, want)
+	}
+
+	// Make an OpenType font with CFF outlines, by renaming the glyf table's
+	// directory entry, and a version 0.5 maxp table.
+	b = append([]byte(nil), b...)
+	copy(b, "OTTO")
+	n := int(u16(b, 4))
+	for i := 0; i < n; i++ {
+		x := 16*i + 12
+		switch string(b[x : x+4]) {
+		case "glyf":
+			copy(b[x:], "CFF ")
+		case "maxp":
+			copy(b[u32(b, x+8):], []byte{0, 0, 0x50, 0})
+			copy(b[x+12:], []byte{0, 0, 0, 6})
+		}
+	}
+	f, err = Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.Capabilities(), (Capabilities{HasHinting: true, IsCFF: true, HasVertical: true}); got != want {
+		t.Errorf("CFF: got %+v, want %+v", got, want)
+	}
+	if got, want := f.NumGlyphs(), 391; got != want {
+		t.Errorf("CFF: NumGlyphs: got %d, want %d", got, want)
+	}
+	if f.maxStackElements != 0 {
+		t.Errorf("CFF: got maxStackElements %d, want 0", f.maxStackElements)
+	}
+	// The renamed glyf table is not of a supported CFF version, so the
+	// font's glyphs cannot be loaded.
+	if err := NewGlyphBuf().Load(f, 12<<6, f.Index('A'), NoHinting); err != (UnsupportedError{Feature: "CFF outlines"}) {
+		t.Errorf("CFF: Load: got %v, want %v", err, UnsupportedError{Feature: "CFF outlines"})
+	}
+
+	// Tables from later minor versions, with extra fields, are accepted.
+	f = &Font{
+		head: append(append([]byte(nil), f.head...), 0, 0),
+		hhea: append(append([]byte(nil), f.hhea...), 0, 0),
+		hmtx: f.hmtx,
+		maxp: make([]byte, 34),
+	}
+	copy(f.maxp, []byte{0, 1, 0, 0, 0x01, 0x87})
+	if err := f.parseHead(); err != nil {
+		t.Errorf("parseHead: %v", err)
+	}
+	if err := f.parseMaxp(); err != nil {
+		t.Errorf("parseMaxp: %v", err)
+	}
+	if err := f.parseHhea(); err != nil {
+		t.Errorf("parseHhea: %v", err)
+	}
+}
+
+// anchorMatrix returns a base array, mark2 array or ligature attach table,
+// with one row of anchors, given as x, y pairs, per base glyph, mark glyph or
+// ligature component.
+func anchorMatrix(rows ...[]int) []byte {
+	n := 0
+	for _, r := range rows {
+		n += len(r) / 2
+	}
+	b := u16s(len(rows))
+	var anchors []byte
+	for _, r := range rows {
+		for i := 0; i < len(r); i += 2 {
+			b = append(b, u16s(2+2*n+len(anchors))...)
+			anchors = append(anchors, u16s(1, r[i], r[i+1])...)
+		}
+	}
+	return append(b, anchors...)
+}
+
+// markAttachSubtable returns a mark attachment subtable for the covered marks
+// and bases. Each mark has a class and an anchor, as class, x, y triples.
+func markAttachSubtable(marks, bases []int, nClasses int, markRecords []int, baseArray []byte) []byte {
+	n := len(markRecords) / 3
+	markArray := u16s(n)
+	for i := 0; i < n; i++ {
+		markArray = append(markArray, u16s(markRecords[3*i], 2+4*n+6*i)...)
+	}
+	for i := 0; i < n; i++ {
+		markArray = append(markArray, u16s(1, markRecords[3*i+1], markRecords[3*i+2])...)
+	}
+	markCoverage := u16s(append([]int{1, len(marks)}, marks...)...)
+	baseCoverage := u16s(append([]int{1, len(bases)}, bases...)...)
+	x := 12 + len(markCoverage)
+	b := u16s(1, 12, x, nClasses, x+len(baseCoverage), x+len(baseCoverage)+len(markArray))
+	b = append(b, markCoverage...)
+	b = append(b, baseCoverage...)
+	b = append(b, markArray...)
+	return append(b, baseArray...)
+}
+
+func TestPositionMarks(t *testing.T) {
+	// Glyph 1 is a base, glyphs 2 and 3 are marks and glyph 5 is a ligature
+	// of two components.
+	ligatureArray := append(u16s(1, 4), anchorMatrix([]int{100, 400}, []int{400, 450})...)
+	gpos := gposTable(
+		[]interface{}{"mark", []int{0, 2}, "mkmk", []int{1}},
+		lookupTable(4, markAttachSubtable([]int{2, 3}, []int{1}, 2,
+			[]int{0, 10, 20, 1, 15, -5}, anchorMatrix([]int{300, 500, 250, -10}))),
+		lookupTable(6, markAttachSubtable([]int{2}, []int{2}, 1,
+			[]int{0, 10, 20}, anchorMatrix([]int{10, 220}))),
+		lookupTable(5, markAttachSubtable([]int{2}, []int{5}, 1,
+			[]int{0, 10, 20}, ligatureArray)),
+	)
+	f := &Font{gpos: gpos, fUnitsPerEm: 1000}
+	f.parseGPOS()
+	got := f.PositionMarks(2000, []Index{1, 2, 2, 3, 5, 2, 4, 2})
+	want := []MarkPosition{
+		{-1, 0, 0},
+		{0, 580, 960},
+		{1, 0, 400},
+		{0, 470, -10},
+		{-1, 0, 0},
+		{4, 780, 860},
+		{-1, 0, 0},
+		{-1, 0, 0},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// With a GDEF table that classes only glyph 2 as a mark, glyph 3 is a
+	// base, so the mark after it is not attached.
+	f.gdef = u16s(1, 0, 6, 1, 2, 1, 3)
+	got = f.PositionMarks(1000, []Index{1, 3, 2})
+	want = []MarkPosition{{-1, 0, 0}, {0, 235, -5}, {-1, 0, 0}}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("GDEF: got %v, want %v", got, want)
+	}
+}
+
+func TestName(t *testing.T) {
+	font, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		id   NameID
+		want string
+	}{
+		{NameIDFamily, "Luxi Sans"},
+		{NameIDSubfamily, "Regular"},
+		{NameIDVersion, "1.2 : October 12, 2001"},
+		{NameIDDesigner, "Kris Holmes and Charles Bigelow"},
+		{NameIDLicense, ""},
+	}
+	for _, tc := range testCases {
+		if got := font.Name(tc.id); got != tc.want {
+			t.Errorf("Name(%d): got %q, want %q", tc.id, got, tc.want)
+		}
+	}
+
+	// Name 1 has a Macintosh Roman record and a Windows record in French,
+	// which is preferred. Name 2 only has a Macintosh Roman record, and name
+	// 3 only has a Macintosh record in Japanese.
+	storage := []byte("Caf\x8e")
+	storage = append(storage, u16s('C', 'a', 'f', 0xe9, 0xd83d, 0xde00)...)
+	f := &Font{name: append(u16s(
+		0, 4, 54,
+		1, 0, 0, 1, 4, 0,
+		3, 1, 0x040c, 1, 12, 4,
+		1, 0, 0, 2, 4, 0,
+		1, 1, 11, 3, 4, 0,
+	), storage...)}
+	for id, want := range []string{"", "Café\U0001f600", "Café", ""} {
+		if got := f.Name(NameID(id)); got != want {
+			t.Errorf("Name(%d): got %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestOS2(t *testing.T) {
+	font, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, ok := font.OS2()
+	if !ok {
+		t.Fatal("OS2: got !ok")
+	}
+	got := fmt.Sprintln(o.Version, o.WeightClass, o.WidthClass, o.Selection,
+		o.TypoAscender, o.TypoDescender, o.TypoLineGap, o.WinAscent, o.WinDescent,
+		o.FirstCharIndex, o.LastCharIndex, string(o.VendorID[:]), o.CodePageRange,
+		o.SubscriptYSize, o.SubscriptYOffset, o.SuperscriptYSize, o.SuperscriptYOffset,
+		o.StrikeoutSize, o.StrikeoutPosition)
+	want := "2 400 5 64 1604 -420 167 1935 432 32 64258 B&H  [147 0] 1331 283 1331 977 0 0\n"
+	if got != want {
+		t.Errorf("OS2:\ngot  %swant %s", got, want)
+	}
+	if o.Selection&SelectionRegular == 0 || o.Selection&SelectionItalic != 0 {
+		t.Errorf("Selection: got %#x", o.Selection)
+	}
+	for bit, want := range []bool{true, true, true, false} {
+		if got := o.UnicodeRangeBit(bit); got != want {
+			t.Errorf("UnicodeRangeBit(%d): got %t, want %t", bit, got, want)
+		}
+	}
+
+	// A version 0 table, of the original 68 bytes, has no typographic metrics.
+	f := &Font{os2: make([]byte, 68)}
+	f.os2[4], f.os2[5] = 0x01, 0x90
+	if o, ok := f.OS2(); !ok || o.WeightClass != 400 || o.TypoAscender != 0 {
+		t.Errorf("68 byte table: got %v, %t", o, ok)
+	}
+	f.os2 = f.os2[:67]
+	if _, ok := f.OS2(); ok {
+		t.Error("67 byte table: got ok")
+	}
+
+	// A version 5 table has optical point sizes.
+	f.os2 = make([]byte, 100)
+	putU16(f.os2, 0, 5)
+	putU16(f.os2, 96, 160)
+	putU16(f.os2, 98, 480)
+	if o, ok := f.OS2(); !ok || o.LowerOpticalPointSize != 160 || o.UpperOpticalPointSize != 480 {
+		t.Errorf("version 5 table: got %v, %t", o, ok)
+	}
+}
+
+func TestPCLT(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := f.PCLT(); ok {
+		t.Fatal("luxisr: got ok")
+	}
+
+	pclt := make([]byte, 54)
+	putU32(pclt, 0, 0x00010000)
+	putU32(pclt, 4, 0x80000123)
+	putU16(pclt, 8, 569)
+	putU16(pclt, 10, 1082)
+	putU16(pclt, 12, 1)
+	putU16(pclt, 14, 4099)
+	putU16(pclt, 16, 1466)
+	putU16(pclt, 18, 277)
+	copy(pclt[20:], "Luxi Sans       ")
+	copy(pclt[36:], "\xff\xff\xff\xff\xff\xff\xff\xfe")
+	copy(pclt[44:], "LXS00R")
+	pclt[50] = 0xfe
+	pclt[51] = 0xff
+	pclt[52] = 0x40
+	ttf, err := f.Write(&WriteOptions{Tables: map[string][]byte{"PCLT": pclt}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = Parse(ttf); err != nil {
+		t.Fatal(err)
+	}
+	p, ok := f.PCLT()
+	if !ok {
+		t.Fatal("PCLT: got !ok")
+	}
+	got := fmt.Sprintf("%#x %#x %d %d %d %d %d %d %q %x %q %d %d %#x",
+		p.Version, p.FontNumber, p.Pitch, p.XHeight, p.Style, p.TypeFamily,
+		p.CapHeight, p.SymbolSet, p.Typeface[:], p.CharacterComplement[:],
+		p.FileName[:], p.StrokeWeight, p.WidthType, p.SerifStyle)
+	want := `0x10000 0x80000123 569 1082 1 4099 1466 277 "Luxi Sans       " fffffffffffffffe "LXS00R" -2 -1 0x40`
+	if got != want {
+		t.Errorf("PCLT:\ngot  %s\nwant %s", got, want)
+	}
+
+	f.pclt = f.pclt[:53]
+	if _, ok := f.PCLT(); ok {
+		t.Error("53 byte table: got ok")
+	}
+}
+
+func TestVMetric(t *testing.T) {
+	font, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fupe := font.FUnitsPerEm()
+	if a, d, g := font.VLineMetrics(fupe); a != 2033 || d != 432 || g != 0 {
+		t.Errorf("VLineMetrics: got %d, %d, %d, want 2033, 432, 0", a, d, g)
+	}
+
+	// Glyphs 1 and 2 have only a top side bearing, and share the advance
+	// height of glyph 0.
+	vhea := make([]byte, 36)
+	vhea[35] = 1
+	f := &Font{nGlyph: 3, vhea: vhea, vmtx: u16s(1000, 10, 20, 0xfff6)}
+	f.parseVhea()
+	for i, want := range []VMetric{{1000, 10}, {1000, 20}, {1000, -10}, {}} {
+		if got := f.unscaledVMetric(Index(i), 0); got != want {
+			t.Errorf("glyph %d: got %v, want %v", i, got, want)
+		}
+	}
+
+	// A vmtx table that is too short is ignored.
+	f = &Font{nGlyph: 3, vhea: vhea, vmtx: u16s(1000, 10, 20)}
+	f.parseVhea()
+	if f.vmtx != nil || f.Capabilities().HasVertical {
+		t.Error("short vmtx: got vertical metrics")
+	}
+}
+
+func TestVertOriginY(t *testing.T) {
+	f := &Font{fUnitsPerEm: 1000, vorg: u16s(
+		1, 0, 880, 2,
+		3, 900,
+		7, 0xfc18,
+	)}
+	for i, want := range map[Index]int32{0: 880, 3: 900, 5: 880, 7: -1000, 8: 880} {
+		if got, ok := f.VertOriginY(1000, i); got != want || !ok {
+			t.Errorf("glyph %d: got %d, %t, want %d, true", i, got, ok, want)
+		}
+	}
+	// The result is scaled.
+	if got, _ := f.VertOriginY(2000, 3); got != 1800 {
+		t.Errorf("scaled: got %d, want 1800", got)
+	}
+	// A truncated table, or none, is ignored.
+	f.vorg = f.vorg[:14]
+	if _, ok := f.VertOriginY(1000, 0); ok {
+		t.Error("truncated VORG: got ok")
+	}
+}
+
+// testGlyphSource supplies glyphs from a map, with a fixed advance width.
+type testGlyphSource map[Index][]byte
+
+func (s testGlyphSource) GlyphData(i Index) ([]byte, error) {
+	return s[i], nil
+}
+
+func (s testGlyphSource) HMetric(i Index) (HMetric, bool) {
+	return HMetric{AdvanceWidth: 1000}, true
+}
+
+func TestLoadCompound(t *testing.T) {
+	simple := append(u16s(1, 0, 0, 100, 100, 2, 0), 1, 1, 1)
+	simple = append(simple, u16s(0, 100, 0, 0, 0, 100)...)
+	src := testGlyphSource{
+		0: simple,
+		// Glyph 1 is glyph 0 offset by (10, 20), then glyph 0 scaled by half
+		// so that its point 0 matches the compound glyph's point 2.
+		1: u16s(0xffff, 0, 0, 0, 0,
+			0x23, 0, 10, 20,
+			0x09, 0, 2, 0, 0x2000,
+		),
+		// Glyph 2 nests glyph 1.
+		2: u16s(0xffff, 0, 0, 0, 0, 0x03, 1, 0, 0),
+		// Glyph 3 matches a point beyond those of the compound glyph so far.
+		3: u16s(0xffff, 0, 0, 0, 0, 0x01, 0, 7, 0),
+	}
+	f := &Font{fUnitsPerEm: 1000, nGlyph: 4, src: src, maxComponentDepth: 2}
+	want := "[{10 20 1} {110 20 1} {110 120 1} {110 120 1} {160 120 1} {160 170 1}] [3 6]"
+	g := NewGlyphBuf()
+	for _, i := range []Index{1, 2} {
+		if err := g.Load(f, 1000, i, NoHinting); err != nil {
+			t.Fatalf("glyph %d: Load: %v", i, err)
+		}
+		if got := fmt.Sprint(g.Point, g.End); got != want {
+			t.Errorf("glyph %d:\ngot  %s\nwant %s", i, got, want)
+		}
+	}
+	if err := g.Load(f, 1000, 3, NoHinting); err != (FormatError{Table: "glyf", Reason: "compound glyph point index"}) {
+		t.Errorf("glyph 3: got %v, want a point index error", err)
+	}
+	// Many fonts declare too small a maxComponentDepth, which, like C
+	// Freetype, Load ignores. Validate reports it.
+	f.maxComponentDepth = 1
+	if err := g.Load(f, 1000, 2, NoHinting); err != nil {
+		t.Errorf("maxComponentDepth 1: Load: %v", err)
+	}
+}
+
+// cffIndexData returns a CFF INDEX of the given objects, with 1 byte offsets.
+func cffIndexData(objs ...[]byte) []byte {
+	if len(objs) == 0 {
+		return []byte{0, 0}
+	}
+	b := []byte{byte(len(objs) >> 8), byte(len(objs)), 1, 1}
+	data := []byte(nil)
+	for _, o := range objs {
+		data = append(data, o...)
+		b = append(b, byte(1+len(data)))
+	}
+	return append(b, data...)
+}
+
+// cffDictInt returns a CFF DICT operand for v, in its 5 byte form.
+func cffDictInt(v int) []byte {
+	return []byte{29, byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+}
+
+func TestLoadCFF(t *testing.T) {
+	// Charstring operands from -107 to 107 are that number plus 139.
+	gsubrs := cffIndexData(
+		// rlineto 0 -100, return.
+		[]byte{139, 39, 5, 11},
+	)
+	charStrings := cffIndexData(
+		// endchar.
+		[]byte{14},
+		// A width of 50 and an hstem, hintmask, rmoveto 10 20, hlineto
+		// 100, vlineto 100, callsubr 0, callgsubr 0 and endchar.
+		[]byte{189, 139, 149, 1, 19, 0xff, 149, 159, 21, 239, 6, 239, 7, 32, 10, 32, 29, 14},
+	)
+	// The local subroutine is rrcurveto -10 10 -80 0 -10 -10, return.
+	subrs := cffIndexData([]byte{129, 149, 59, 139, 129, 129, 8, 11})
+	private := append(cffDictInt(6), 19)
+
+	header := []byte{1, 0, 4, 1}
+	names := cffIndexData([]byte("A"))
+	const topDictLen = 17
+	csOffset := len(header) + len(names) + 4 + 1 + topDictLen + 2 + len(gsubrs)
+	privateOffset := csOffset + len(charStrings)
+	topDict := append(cffDictInt(csOffset), 17)
+	topDict = append(topDict, cffDictInt(len(private))...)
+	topDict = append(append(topDict, cffDictInt(privateOffset)...), 18)
+
+	cff := append(header, names...)
+	cff = append(cff, cffIndexData(topDict)...)
+	cff = append(cff, cffIndexData()...)
+	cff = append(cff, gsubrs...)
+	cff = append(cff, charStrings...)
+	cff = append(cff, private...)
+	cff = append(cff, subrs...)
+
+	c, err := parseCFF(cff)
+	if err != nil {
+		t.Fatalf("parseCFF: %v", err)
+	}
+	f := &Font{
+		fUnitsPerEm: 1000,
+		nGlyph:      2,
+		nHMetric:    2,
+		hmtx:        u16s(500, 0, 500, 10),
+		cffOutlines: c,
+	}
+	g := NewGlyphBuf()
+	if err := g.Load(f, 1000, 0, NoHinting); err != nil || len(g.Point) != 0 {
+		t.Errorf("glyph 0: got %v, %v, want no points", g.Point, err)
+	}
+	if err := g.Load(f, 1000, 1, NoHinting); err != nil {
+		t.Fatalf("glyph 1: Load: %v", err)
+	}
+	got := fmt.Sprint(g.Point, g.End, g.AdvanceWidth)
+	want := "[{10 20 1} {110 20 1} {110 120 1} {100 130 256} {20 130 256} {10 120 1}] [6] 500"
+	if got != want {
+		t.Errorf("glyph 1:\ngot  %s\nwant %s", got, want)
+	}
+
+	// A subroutine that calls itself nests too deeply.
+	if c.subrs[0], _, err = parseCFFIndex(cffIndexData([]byte{32, 10}), 0); err != nil {
+		t.Fatalf("parseCFFIndex: %v", err)
+	}
+	// The error's offset is that of the glyph's charstring.
+	wantErr := FormatError{Table: "CFF ", Offset: c.charStrings.objectOffset(1), Reason: "CFF subroutine calls too deep"}
+	if err := g.Load(f, 1000, 1, NoHinting); err != wantErr {
+		t.Errorf("recursive subroutine: got %#v, want %#v", err, wantErr)
+	}
+}
+
+// encodeWOFF returns the WOFF 1.0 encoding of the given TTF data, with the
+// tables compressed if compress is true.
+func encodeWOFF(ttf []byte, compress bool) []byte {
+	n := int(u16(ttf, 4))
+	woff := make([]byte, 44+20*n)
+	copy(woff, "wOFF")
+	copy(woff[4:], ttf[:4])
+	putU16(woff, 12, uint16(n))
+	totalSfntSize := 12 + 16*n
+	for i := 0; i < n; i++ {
+		d, e := ttf[12+16*i:], woff[44+20*i:]
+		data := ttf[u32(d, 8) : u32(d, 8)+u32(d, 12)]
+		totalSfntSize += (len(data) + 3) &^ 3
+		if compress {
+			var buf bytes.Buffer
+			w := zlib.NewWriter(&buf)
+			w.Write(data)
+			w.Close()
+			if buf.Len() < len(data) {
+				data = buf.Bytes()
+			}
+		}
+		copy(e, d[:4])
+		putU32(e, 4, uint32(len(woff)))
+		putU32(e, 8, uint32(len(data)))
+		putU32(e, 12, u32(d, 12))
+		putU32(e, 16, u32(d, 4))
+		woff = append(woff, data...)
+		for len(woff)%4 != 0 {
+			woff = append(woff, 0)
+		}
+	}
+	putU32(woff, 8, uint32(len(woff)))
+	putU32(woff, 16, uint32(totalSfntSize))
+	return woff
+}
+
+func TestParseWOFF(t *testing.T) {
+	ttf, err := ioutil.ReadFile("../../testdata/luxisr.ttf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f0, err := Parse(ttf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g0, g1 := NewGlyphBuf(), NewGlyphBuf()
+	for _, compress := range []bool{false, true} {
+		woff := encodeWOFF(ttf, compress)
+		f1, err := Parse(woff)
+		if err != nil {
+			t.Errorf("compress=%t: Parse: %v", compress, err)
+			continue
+		}
+		if f1.NumGlyphs() != f0.NumGlyphs() || f1.Name(NameIDFamily) != "Luxi Sans" {
+			t.Errorf("compress=%t: got %d glyphs, family %q", compress, f1.NumGlyphs(), f1.Name(NameIDFamily))
+		}
+		i := f0.Index('A')
+		if err := g0.Load(f0, 12<<6, i, FullHinting); err != nil {
+			t.Fatal(err)
+		}
+		if err := g1.Load(f1, 12<<6, f1.Index('A'), FullHinting); err != nil {
+			t.Errorf("compress=%t: Load: %v", compress, err)
+			continue
+		}
+		if _, ok := CompareOutlines(g0, g1, 0); !ok {
+			t.Errorf("compress=%t: outlines differ", compress)
+		}
+
+		// A compressed table that is longer than stated is rejected.
+		if compress {
+			putU32(woff, 44+12, u32(woff, 44+12)-1)
+			if _, err := Parse(woff); err == nil {
+				t.Error("bad table length: got nil error")
+			}
+		}
+	}
+
+	// The decoded size must be as stated, and not too large, before any
+	// table is decompressed.
+	woff := encodeWOFF(ttf, true)
+	putU32(woff, 16, u32(woff, 16)+4)
+	if _, err := Parse(woff); err != (FormatError{Offset: 16, Reason: "bad WOFF totalSfntSize"}) {
+		t.Errorf("bad totalSfntSize: got %v", err)
+	}
+	woff = encodeWOFF(ttf, true)
+	putU32(woff, 44+12, u32(woff, 44+12)+1<<30)
+	putU32(woff, 16, u32(woff, 16)+1<<30)
+	if _, err := Parse(woff); err == nil {
+		t.Error("huge table: got nil error")
+	} else if _, ok := err.(UnsupportedError); !ok {
+		t.Errorf("huge table: got %v, want an UnsupportedError", err)
+	}
+}
+
+// transformGlyf returns the WOFF2 transform of the font's glyf and loca
+// tables. Every point's deltas have the longest triplet encoding.
+func transformGlyf(f *Font) []byte {
+	var streams [7][]byte
+	n := f.NumGlyphs()
+	bboxBitmap := make([]byte, 4*((n+31)/32))
+	u255 := func(b []byte, v int) []byte {
+		return append(b, 253, byte(v>>8), byte(v))
+	}
+	for i := 0; i < n; i++ {
+		data, _ := f.glyphData(Index(i))
+		if len(data) < 10 {
+			streams[0] = appendU16(streams[0], 0)
+			continue
+		}
+		ne := int(int16(u16(data, 0)))
+		streams[0] = append(streams[0], data[:2]...)
+		if ne < 0 {
+			// Copy the components verbatim, and their instructions.
+			bboxBitmap[i>>3] |= 0x80 >> uint(i&7)
+			streams[5] = append(streams[5], data[2:10]...)
+			end, instructions := 0, false
+			walkComponents(data, func(x int) {
+				flags := u16(data, x-2)
+				end = x + 4
+				if flags&1 != 0 {
+					end += 2
+				}
+				switch {
+				case flags&(1<<3) != 0:
+					end += 2
+				case flags&(1<<6) != 0:
+					end += 4
+				case flags&(1<<7) != 0:
+					end += 8
+				}
+				instructions = instructions || flags&(1<<8) != 0
+			})
+			streams[4] = append(streams[4], data[10:end]...)
+			if instructions {
+				m := int(u16(data, end))
+				streams[3] = u255(streams[3], m)
+				streams[6] = append(streams[6], data[end+2:end+2+m]...)
+			}
+			continue
+		}
+		g := &GlyphBuf{}
+		// The testdata fonts' glyphs are valid.
+		program, _ := g.loadSimple(data, ne)
+		start := 0
+		for _, e := range g.End {
+			streams[1] = u255(streams[1], e-start)
+			start = e
+		}
+		x, y := int32(0), int32(0)
+		for _, p := range g.Point {
+			dx, dy := p.X-x, p.Y-y
+			flag := byte(124)
+			if dx >= 0 {
+				flag |= 1
+			}
+			if dy >= 0 {
+				flag |= 2
+			}
+			if p.Flags&flagOnCurve == 0 {
+				flag |= 0x80
+			}
+			streams[2] = append(streams[2], flag)
+			streams[3] = appendU16(streams[3], uint16(abs32(dx)))
+			streams[3] = appendU16(streams[3], uint16(abs32(dy)))
+			x, y = p.X, p.Y
+		}
+		streams[3] = u255(streams[3], len(program))
+		streams[6] = append(streams[6], program...)
+	}
+	streams[5] = append(bboxBitmap, streams[5]...)
+
+	b := u16s(0, 0, n, f.IndexToLocFormat())
+	for _, s := range streams {
+		b = appendU32(b, uint32(len(s)))
+	}
+	for _, s := range streams {
+		b = append(b, s...)
+	}
+	return b
+}
+
+// encodeWOFF2 returns the WOFF2 encoding of the given TTF data, with its
+// glyf, loca and hmtx tables transformed. The tables are stored in
+// uncompressed Brotli meta-blocks.
+func encodeWOFF2(ttf []byte, f *Font) []byte {
+	var dir, data []byte
+	n := int(u16(ttf, 4))
+	for i := 0; i < n; i++ {
+		d := ttf[12+16*i:]
+		tag := string(d[:4])
+		table := ttf[u32(d, 8) : u32(d, 8)+u32(d, 12)]
+		flags := byte(0x3f)
+		for j, t := range woff2Tags {
+			if t == tag {
+				flags = byte(j)
+			}
+		}
+		transformed := []byte(nil)
+		switch tag {
+		case "glyf":
+			transformed = transformGlyf(f)
+		case "loca":
+			transformed = []byte{}
+		case "hmtx":
+			// Separate the advance widths from the left side bearings.
+			flags |= 0x40
+			transformed = []byte{0}
+			for j := 0; j < f.NumHMetrics(); j++ {
+				transformed = append(transformed, table[4*j:4*j+2]...)
+			}
+			for j := 0; j < f.NumHMetrics(); j++ {
+				transformed = append(transformed, table[4*j+2:4*j+4]...)
+			}
+			transformed = append(transformed, table[4*f.NumHMetrics():]...)
+		}
+		dir = append(dir, flags)
+		if flags&0x3f == 0x3f {
+			dir = append(dir, tag...)
+		}
+		dir = appendUIntBase128(dir, len(table))
+		if transformed != nil {
+			dir = appendUIntBase128(dir, len(transformed))
+			table = transformed
+		}
+		data = append(data, table...)
+	}
+	woff := make([]byte, 48)
+	copy(woff, "wOF2")
+	copy(woff[4:], ttf[:4])
+	putU16(woff, 12, uint16(n))
+	data = storeBrotli(data)
+	putU32(woff, 20, uint32(len(data)))
+	woff = append(append(woff, dir...), data...)
+	putU32(woff, 8, uint32(len(woff)))
+	return woff
+}
+
+// storeBrotli returns Brotli data that stores b in uncompressed meta-blocks of
+// up to 64 KiB.
+func storeBrotli(b []byte) []byte {
+	// The first byte's low bit is 0 for a 64 KiB window.
+	s := []byte{0}
+	bits := uint(1)
+	for ; len(b) > 0; bits = 8 {
+		n := len(b)
+		if n > 1<<16 {
+			n = 1 << 16
+		}
+		// The header's bits are ISLAST = 0, MNIBBLES = 4, MLEN - 1 and
+		// ISUNCOMPRESSED = 1, and it is padded to a byte boundary.
+		h := uint32(n-1)<<3 | 1<<19
+		for i := uint(0); i < 20; i, bits = i+1, bits+1 {
+			if bits == 8 {
+				s, bits = append(s, 0), 0
+			}
+			s[len(s)-1] |= byte(h>>i&1) << bits
+		}
+		s = append(s, b[:n]...)
+		b = b[n:]
+	}
+	if bits == 8 {
+		s, bits = append(s, 0), 0
+	}
+	// ISLAST and ISLASTEMPTY.
+	s[len(s)-1] |= 3 << bits
+	return s
+}
+
+func TestParseWOFF2(t *testing.T) {
+	ttf, err := ioutil.ReadFile("../../testdata/luxisr.ttf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f0, err := Parse(ttf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	woff := encodeWOFF2(ttf, f0)
+	f1, err := Parse(woff)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if f1.NumGlyphs() != f0.NumGlyphs() || f1.Name(NameIDFamily) != "Luxi Sans" {
+		t.Errorf("got %d glyphs, family %q", f1.NumGlyphs(), f1.Name(NameIDFamily))
+	}
+	g0, g1 := NewGlyphBuf(), NewGlyphBuf()
+	for i := Index(0); int(i) < f0.NumGlyphs(); i++ {
+		if got, want := f1.HMetric(2048, i), f0.HMetric(2048, i); got != want {
+			t.Errorf("glyph %d: HMetric: got %v, want %v", i, got, want)
+		}
+		for _, h := range []Hinting{NoHinting, FullHinting} {
+			if err := g0.Load(f0, 12<<6, i, h); err != nil {
+				t.Fatalf("glyph %d: %v", i, err)
+			}
+			if err := g1.Load(f1, 12<<6, i, h); err != nil {
+				t.Errorf("glyph %d: Load: %v", i, err)
+				continue
+			}
+			if j, ok := CompareOutlines(g0, g1, 0); !ok || g0.AdvanceWidth != g1.AdvanceWidth {
+				t.Errorf("glyph %d, hinting %v: outlines differ at point %d", i, h, j)
+			}
+		}
+	}
+
+	// A truncated transformed glyf table is rejected.
+	if _, _, _, err := reconstructGlyf(transformGlyf(f0)[:100]); err == nil {
+		t.Error("truncated glyf: got nil error")
+	}
+
+	// The encoder above only uses the longest triplets.
+	triplets := []struct {
+		flag   int
+		data   []byte
+		dx, dy int32
+	}{
+		{1, []byte{5}, 0, 5},
+		{10, []byte{5}, -5, 0},
+		{20, []byte{0x12}, -2, -3},
+		{87, []byte{9, 7}, 10, 8},
+		{123, []byte{0x12, 0x34, 0x56}, 0x123, 0x456},
+	}
+	for _, tc := range triplets {
+		s := &woff2Stream{b: tc.data}
+		if dx, dy := woff2Triplet(tc.flag, s); dx != tc.dx || dy != tc.dy || len(s.b) != 0 {
+			t.Errorf("triplet %d: got %d, %d, want %d, %d", tc.flag, dx, dy, tc.dx, tc.dy)
+		}
+	}
+}
+
+func TestBrotli(t *testing.T) {
+	gpl, err := ioutil.ReadFile("../../licenses/gpl.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ttf, err := ioutil.ReadFile("../../testdata/luxisr.ttf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name string
+		want []byte
+	}{
+		{"gpl-q11.br", gpl},
+		{"gpl-q1.br", gpl},
+		{"luxisr-q5-w10.br", ttf},
+	}
+	for _, tc := range testCases {
+		b, err := ioutil.ReadFile("../../testdata/" + tc.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := decodeBrotli(b, len(tc.want))
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if !bytes.Equal(got, tc.want) {
+			t.Errorf("%s: decompressed data differs", tc.name)
+		}
+		if _, err := decodeBrotli(b, len(tc.want)-1); err == nil {
+			t.Errorf("%s: maxLen too small: got nil error", tc.name)
+		}
+		if _, err := decodeBrotli(b[:len(b)-1], len(tc.want)); err == nil {
+			t.Errorf("%s: truncated: got nil error", tc.name)
+		}
+	}
+
+	// Stored meta-blocks, and no meta-blocks at all.
+	for _, n := range []int{0, 1, 1 << 16, 1<<16 + 1} {
+		if got, err := decodeBrotli(storeBrotli(ttf[:n]), n); err != nil || !bytes.Equal(got, ttf[:n]) {
+			t.Errorf("stored %d bytes: got %d bytes, %v", n, len(got), err)
+		}
+	}
+	// Compressed data decompresses to the original, including data that is
+	// incompressible or that takes more than one meta-block.
+	random := make([]byte, 1<<16)
+	for i := range random {
+		random[i] = byte(i * i * 2654435761 >> 13)
+	}
+	long := bytes.Repeat(gpl, brotliMaxMetaBlock/len(gpl)+2)
+	for _, b := range [][]byte{nil, {'a'}, []byte("abcabcabcabcabcabcd"), gpl, ttf, random, make([]byte, 1<<20+3), long} {
+		got, err := decodeBrotli(encodeBrotli(b), len(b))
+		if err != nil || !bytes.Equal(got, b) {
+			t.Errorf("compressed %d bytes: got %d bytes, %v", len(b), len(got), err)
+		}
+	}
+	if n, max := len(encodeBrotli(gpl)), len(gpl)/2; n > max {
+		t.Errorf("compressed gpl.txt: got %d bytes, want at most %d", n, max)
+	}
+
+	// A window size of 17 + 0 bits is invalid.
+	if _, err := decodeBrotli([]byte{0x11, 0x03}, 1); err == nil {
+		t.Error("bad window size: got nil error")
+	}
+
+	if got, want := fmt.Sprintf("%x", sha256.Sum256([]byte(brotliDictionary))),
+		"20e42eb1b511c21806d4d227d07e5dd06877d8ce7b3a817f378f313653f35c70"; got != want {
+		t.Errorf("dictionary checksum: got %s, want %s", got, want)
+	}
+}
+
+func TestVariationAxes(t *testing.T) {
+	// The wght axis is from 100 to 900, default 400, and the hidden wdth axis
+	// is from 50 to 200, default 100. There are two named instances, and only
+	// the second has a PostScript name.
+	fvar := u16s(1, 0, 16, 2, 2, 20, 2, 14)
+	fvar = append(fvar, "wght"...)
+	fvar = append(fvar, u16s(100, 0, 400, 0, 900, 0, 0, 256)...)
+	fvar = append(fvar, "wdth"...)
+	fvar = append(fvar, u16s(50, 0, 100, 0, 200, 0, 1, 257)...)
+	fvar = append(fvar, u16s(258, 0, 700, 0, 100, 0, 0xffff)...)
+	fvar = append(fvar, u16s(259, 0, 400, 0, 50, 0, 260)...)
+	// The avar table maps the wght axis's -0.5 to -0.25.
+	avar := u16s(1, 0, 0, 2, 3, 0xc000, 0xc000, 0xe000, 0xf000, 0x4000, 0x4000, 0)
+	f := &Font{fvar: fvar, avar: avar}
+	f.parseFvar()
+	f.parseAvar()
+	wantAxes := []VariationAxis{
+		{Tag: "wght", Min: 100 << 16, Default: 400 << 16, Max: 900 << 16, NameID: 256},
+		{Tag: "wdth", Min: 50 << 16, Default: 100 << 16, Max: 200 << 16, Hidden: true, NameID: 257},
+	}
+	if got := f.VariationAxes(); !reflect.DeepEqual(got, wantAxes) {
+		t.Errorf("axes: got %v, want %v", got, wantAxes)
+	}
+	wantInstances := []NamedInstance{
+		{SubfamilyNameID: 258, Coords: []int32{700 << 16, 100 << 16}},
+		{SubfamilyNameID: 259, PostScriptNameID: 260, Coords: []int32{400 << 16, 50 << 16}},
+	}
+	if got := f.NamedInstances(); !reflect.DeepEqual(got, wantInstances) {
+		t.Errorf("instances: got %v, want %v", got, wantInstances)
+	}
+	testCases := []struct {
+		coords []int32
+		want   []int16
+	}{
+		{nil, []int16{0, 0}},
+		{[]int32{900 << 16, 200 << 16}, []int16{0x4000, 0x4000}},
+		{[]int32{250 << 16, 75 << 16}, []int16{-0x1000, -0x2000}},
+		{[]int32{175 << 16, 500 << 16}, []int16{-0x2800, 0x4000}},
+		{[]int32{0, 0}, []int16{-0x4000, -0x4000}},
+	}
+	for _, tc := range testCases {
+		if got := f.NormalizeVariation(tc.coords); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("coords %v: got %v, want %v", tc.coords, got, tc.want)
+		}
+	}
+	// A truncated fvar table is ignored.
+	f = &Font{fvar: fvar[:60]}
+	f.parseFvar()
+	if got := f.VariationAxes(); got != nil {
+		t.Errorf("truncated fvar: got %v, want nil", got)
+	}
+}
+
+func TestGlyphVariation(t *testing.T) {
+	// Glyph 0 is a five point contour. Its first tuple variation, at the
+	// shared peak of 1, moves points 0 and 2, and the other points' deltas
+	// are inferred. Its second, with an intermediate region from 0 to 1 and
+	// a peak of 0.5, widens its advance width by 40.
+	glyph0 := append(u16s(1, 0, 0, 100, 100, 4, 0), 1, 1, 1, 1, 1)
+	glyph0 = append(glyph0, u16s(0, 100, 0, 0xffce, 0xffce, 0, 0, 100, 0, 0)...)
+	// Glyph 1 is glyph 0 offset by (10, 20), and its variation moves the
+	// offset by (10, -6).
+	glyph1 := u16s(0xffff, 0, 0, 0, 0, 0x03, 0, 10, 20)
+	vary0 := u16s(2, 18, 10, 0x2000, 5, 0xc000, 0x2000, 0, 0x4000)
+	vary0 = append(vary0, 2, 0x01, 0, 2, 0x01, 0, 20, 0x01, 0, 40)
+	vary0 = append(vary0, 0x85, 0, 40, 0x81, 0x88, 0)
+	vary1 := u16s(1, 8, 6, 0)
+	vary1 = append(vary1, 0x00, 10, 0x83, 0x00, 0xfa, 0x83)
+	gvar := u16s(1, 0, 1, 1, 0, 26, 2, 0, 0, 28, 0, 17, 24, 0x4000)
+	gvar = append(append(gvar, vary0...), vary1...)
+
+	f := &Font{
+		fUnitsPerEm: 1000,
+		nGlyph:      2,
+		src:         testGlyphSource{0: glyph0, 1: glyph1},
+		axes:        []VariationAxis{{Tag: "wght", Min: -1 << 16, Max: 1 << 16}},
+		gvar:        gvar,
+	}
+	f.parseGvar()
+	if f.gvar == nil {
+		t.Fatal("parseGvar: the gvar table was ignored")
+	}
+	testCases := []struct {
+		coord int16
+		i     Index
+		want  string
+	}{
+		{0, 0, "[{0 0 1} {100 0 1} {100 100 1} {50 100 1} {0 100 1}] 1000"},
+		{0x4000, 0, "[{0 0 1} {120 0 1} {120 140 1} {60 140 1} {0 140 1}] 1000"},
+		{0x2000, 0, "[{0 0 1} {110 0 1} {110 120 1} {55 120 1} {0 120 1}] 1040"},
+		{-0x4000, 0, "[{0 0 1} {100 0 1} {100 100 1} {50 100 1} {0 100 1}] 1000"},
+		{0x4000, 1, "[{20 14 1} {140 14 1} {140 154 1} {80 154 1} {20 154 1}] 1000"},
+	}
+	g := NewGlyphBuf()
+	for _, tc := range testCases {
+		g.SetVariation([]int16{tc.coord})
+		if err := g.Load(f, 1000, tc.i, NoHinting); err != nil {
+			t.Errorf("coord %#x, glyph %d: Load: %v", tc.coord, tc.i, err)
+			continue
+		}
+		if got := fmt.Sprint(g.Point, " ", g.AdvanceWidth); got != tc.want {
+			t.Errorf("coord %#x, glyph %d:\ngot  %s\nwant %s", tc.coord, tc.i, got, tc.want)
+		}
+	}
+
+	// The hdmx table's advance widths are of the default instance, so they
+	// are not used for other instances. At 10 ppem, the 1040 unit advance
+	// width of the 0x2000 instance rounds to 10 pixels.
+	f.hdmx = []byte{0, 0, 0, 1, 0, 0, 0, 4, 10, 7, 7, 7}
+	for _, tc := range []struct {
+		coord int16
+		want  int32
+	}{{0, 7 << 6}, {0x2000, 10 << 6}} {
+		g.SetVariation([]int16{tc.coord})
+		if err := g.Load(f, 10<<6, 0, FullHinting); err != nil {
+			t.Errorf("coord %#x: hinted Load: %v", tc.coord, err)
+			continue
+		}
+		if g.AdvanceWidth != tc.want {
+			t.Errorf("coord %#x: got hinted advance %d, want %d", tc.coord, g.AdvanceWidth, tc.want)
+		}
+	}
+	// The autohinter measures its blue zones at the instance's variation.
+	if err := g.Load(f, 10<<6, 0, AutoHinting); err != nil {
+		t.Fatalf("autohinted Load: %v", err)
+	}
+	if got := fmt.Sprint(g.auto.ref.coords); got != "[8192]" {
+		t.Errorf("autohinter reference coords: got %s, want [8192]", got)
+	}
+}
+
+func TestSbixGlyph(t *testing.T) {
+	// The sbix table has strikes of 20 and 40 ppem. In the first, glyph 1 is
+	// a dupe of glyph 0, and in the second, it has no image.
+	sbix := u16s(1, 1, 0, 2, 0, 16, 0, 54)
+	sbix = append(sbix, u16s(20, 72, 0, 16, 0, 28, 0, 38, 1, 2)...)
+	sbix = append(sbix, "png abcd"...)
+	sbix = append(sbix, u16s(0, 0)...)
+	sbix = append(sbix, "dupe\x00\x00"...)
+	sbix = append(sbix, u16s(40, 144, 0, 16, 0, 28, 0, 28, 3, 4)...)
+	sbix = append(sbix, "png efgh"...)
+	f := &Font{nGlyph: 2, sbix: sbix}
+	testCases := []struct {
+		ppem int32
+		i    Index
+		want string
+	}{
+		{10, 0, "20 72 1 2 png  abcd"},
+		{20, 0, "20 72 1 2 png  abcd"},
+		{30, 0, "40 144 3 4 png  efgh"},
+		{60, 0, "40 144 3 4 png  efgh"},
+		{20, 1, "20 72 1 2 png  abcd"},
+		{40, 1, ""},
+		{20, 2, ""},
+	}
+	for _, tc := range testCases {
+		got := ""
+		if g, ok := f.SbixGlyph(tc.ppem<<6, tc.i); ok {
+			got = fmt.Sprint(g.PPEM, g.PPI, g.OriginX, g.OriginY, " ", g.Type, " ", string(g.Data))
+		}
+		if got != tc.want {
+			t.Errorf("ppem %d, glyph %d: got %q, want %q", tc.ppem, tc.i, got, tc.want)
+		}
+	}
+
+	// An image offset near 1<<32 must not wrap around to pass the bounds
+	// check.
+	sbix = u16s(1, 1, 0, 1, 0, 12)
+	sbix = append(sbix, u16s(20, 72, 0xffff, 0xfffc, 0, 40)...)
+	sbix = append(sbix, make([]byte, 40)...)
+	f = &Font{nGlyph: 1, sbix: sbix}
+	if g, ok := f.SbixGlyph(20<<6, 0); ok {
+		t.Errorf("wrapped offset: got %v, want no image", g)
+	}
+}
+
+func TestBitmapGlyph(t *testing.T) {
+	// The EBLC table has one 12 ppem strike. Glyphs 1 and 2 have byte aligned
+	// images with their own small metrics, and glyph 3 has a bit aligned
+	// image with the subtable's big metrics.
+	eblc := u16s(2, 0, 0, 1, 0, 56, 0, 0, 0, 2, 0, 0)
+	eblc = append(eblc, make([]byte, 24)...)
+	eblc = append(eblc, u16s(1, 3)...)
+	eblc = append(eblc, 12, 12, 1, 1)
+	eblc = append(eblc, u16s(1, 2, 0, 16, 3, 3, 0, 36)...)
+	eblc = append(eblc, u16s(1, 1, 0, 4, 0, 0, 0, 7, 0, 14)...)
+	eblc = append(eblc, u16s(2, 5, 0, 18, 0, 2)...)
+	eblc = append(eblc, 2, 5, 1, 2, 6, 0, 0, 0)
+	ebdt := u16s(2, 0)
+	ebdt = append(ebdt, 2, 3, 0, 2, 4, 0xa0, 0x40)
+	ebdt = append(ebdt, 1, 10, 0, 1, 11, 0xff, 0xc0)
+	ebdt = append(ebdt, 0xaa, 0x80)
+	f := &Font{eblc: eblc, ebdt: ebdt}
+	testCases := []struct {
+		ppem int32
+		i    Index
+		want string
+	}{
+		{12, 1, "3x2 0,2 4 1 [160 64]"},
+		{12, 2, "10x1 0,1 11 2 [255 192]"},
+		{12, 3, "5x2 1,2 6 1 [168 80]"},
+		{12, 4, ""},
+		{13, 1, ""},
+	}
+	for _, tc := range testCases {
+		got := ""
+		if g, ok := f.BitmapGlyph(tc.ppem<<6, tc.i); ok {
+			got = fmt.Sprintf("%dx%d %d,%d %d %d %v", g.Width, g.Height, g.BearingX, g.BearingY, g.Advance, g.Stride, g.Data)
+		}
+		if got != tc.want {
+			t.Errorf("ppem %d, glyph %d: got %q, want %q", tc.ppem, tc.i, got, tc.want)
+		}
+	}
+}
+
+func TestGasp(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		ppem int32
+		want GaspBehavior
+	}{
+		{7, GaspDoGray},
+		{8, GaspDoGray},
+		{12, GaspGridFit},
+		{16, GaspGridFit},
+		{17, GaspGridFit | GaspDoGray},
+		{1000, GaspGridFit | GaspDoGray},
+	}
+	for _, tc := range testCases {
+		if got, ok := f.Gasp(tc.ppem << 6); got != tc.want || !ok {
+			t.Errorf("ppem %d: got %#x, %t, want %#x, true", tc.ppem, got, ok, tc.want)
+		}
+	}
+	if _, ok := (&Font{}).Gasp(12 << 6); ok {
+		t.Error("no gasp table: got ok")
+	}
+}
+
+// countingReaderAt counts the bytes read from a bytes.Reader.
+type countingReaderAt struct {
+	r *bytes.Reader
+	n int
+}
+
+func (c *countingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	n, err := c.r.ReadAt(p, off)
+	c.n += n
+	return n, err
+}
+
+func TestParseReaderAt(t *testing.T) {
+	b, err := ioutil.ReadFile("../../testdata/luxisr.ttf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &countingReaderAt{r: bytes.NewReader(b)}
+	f, err := ParseReaderAt(r, int64(len(b)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The glyf table is not read by parsing.
+	if glyf := len(want.glyf); r.n > len(b)-glyf {
+		t.Errorf("read %d bytes of %d, with a %d byte glyf table", r.n, len(b), glyf)
+	}
+	g0, g1 := NewGlyphBuf(), NewGlyphBuf()
+	for i := Index(0); i < Index(want.NumGlyphs()); i++ {
+		for _, h := range []Hinting{NoHinting, FullHinting} {
+			if err := g0.Load(want, 12<<6, i, h); err != nil {
+				t.Fatalf("glyph %d: Load: %v", i, err)
+			}
+			if err := g1.Load(f, 12<<6, i, h); err != nil {
+				t.Fatalf("glyph %d: Load from io.ReaderAt: %v", i, err)
+			}
+			if !reflect.DeepEqual(g0.Point, g1.Point) || g0.AdvanceWidth != g1.AdvanceWidth {
+				t.Fatalf("glyph %d, hinting %d: got %v, %d, want %v, %d",
+					i, h, g1.Point, g1.AdvanceWidth, g0.Point, g0.AdvanceWidth)
+			}
+		}
+	}
+	// A truncated font is an error.
+	if _, err := ParseReaderAt(bytes.NewReader(b[:len(b)/2]), int64(len(b)/2)); err == nil {
+		t.Error("truncated: got no error")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		desc string
+		o    *WriteOptions
+	}{
+		{"unchanged", nil},
+		{"stripped", &WriteOptions{StripHinting: true}},
+		{"renamed", &WriteOptions{Names: map[NameID]string{NameIDFamily: "Écrit", NameIDCopyright: ""}}},
+		{"merged", &WriteOptions{Tables: map[string][]byte{"kern": nil, "zzzz": {1, 2, 3}}}},
+	}
+	for _, tc := range testCases {
+		ttf, err := f.Write(tc.o)
+		if err != nil {
+			t.Errorf("%s: Write: %v", tc.desc, err)
+			continue
+		}
+		// The tables are padded, and the checksum of the whole font is the
+		// magic number that the head table's checksum adjustment aims for.
+		if len(ttf)%4 != 0 || checksum(ttf) != 0xb1b0afba {
+			t.Errorf("%s: got length %d and checksum %#x", tc.desc, len(ttf), checksum(ttf))
+		}
+		g, err := Parse(ttf)
+		if err != nil {
+			t.Errorf("%s: Parse: %v", tc.desc, err)
+			continue
+		}
+		for x := 12; x < 12+16*len(g.tables); x += 16 {
+			tag, b := string(ttf[x:x+4]), g.tables[string(ttf[x:x+4])]
+			if got := u32(ttf, x+4); got != checksum(b) && tag != "head" {
+				t.Errorf("%s: %q: got checksum %#x, want %#x", tc.desc, tag, got, checksum(b))
+			}
+		}
+		// The glyphs are unchanged, when unhinted.
+		g0, g1 := NewGlyphBuf(), NewGlyphBuf()
+		for i := Index(0); i < Index(f.NumGlyphs()); i++ {
+			if err := g0.Load(f, 12<<6, i, NoHinting); err != nil {
+				t.Fatalf("%s: glyph %d: Load: %v", tc.desc, i, err)
+			}
+			if err := g1.Load(g, 12<<6, i, NoHinting); err != nil {
+				t.Fatalf("%s: glyph %d: Load written font: %v", tc.desc, i, err)
+			}
+			if !reflect.DeepEqual(g0.Point, g1.Point) {
+				t.Fatalf("%s: glyph %d: got %v, want %v", tc.desc, i, g1.Point, g0.Point)
+			}
+		}
+		switch tc.desc {
+		case "stripped":
+			if g.fpgm != nil || g.prep != nil || g.cvt != nil {
+				t.Errorf("%s: got hinting tables", tc.desc)
+			}
+			if len(g.glyf) >= len(f.glyf) {
+				t.Errorf("%s: got glyf length %d, want less than %d", tc.desc, len(g.glyf), len(f.glyf))
+			}
+		case "renamed":
+			if got := g.Name(NameIDFamily); got != "Écrit" {
+				t.Errorf("%s: family: got %q", tc.desc, got)
+			}
+			if got := g.Name(NameIDCopyright); got != "" {
+				t.Errorf("%s: copyright: got %q", tc.desc, got)
+			}
+			if got, want := g.Name(NameIDSubfamily), f.Name(NameIDSubfamily); got != want {
+				t.Errorf("%s: subfamily: got %q, want %q", tc.desc, got, want)
+			}
+		case "merged":
+			if g.kern != nil || string(g.tables["zzzz"]) != "\x01\x02\x03" {
+				t.Errorf("%s: got kern %t, zzzz %v", tc.desc, g.kern != nil, g.tables["zzzz"])
+			}
+		}
+	}
+	if _, err := (&Font{src: testGlyphSource{}}).Write(nil); err == nil {
+		t.Error("incremental font: got no error")
+	}
+
+	// WOFF2 data decodes to the TTF data that is otherwise written.
+	ttf, err := f.Write(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	woff, err := f.Write(&WriteOptions{WOFF2: true})
+	if err != nil {
+		t.Fatalf("WOFF2: Write: %v", err)
+	}
+	if string(woff[:4]) != "wOF2" || len(woff)%4 != 0 || len(woff) >= len(ttf)*3/4 {
+		t.Errorf("WOFF2: got signature %q and length %d, for %d bytes of TTF data", woff[:4], len(woff), len(ttf))
+	}
+	if got, err := decodeWOFF2(woff); err != nil || !bytes.Equal(got, ttf) {
+		t.Errorf("WOFF2: got %d bytes, %v, want %d bytes", len(got), err, len(ttf))
+	}
+}
+
+func TestVerifyChecksums(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ttf, err := f.Write(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := VerifyChecksums(ttf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.OK() || !r.HasHead || len(r.Tables) != len(f.tables) {
+		t.Errorf("written font: got OK %t, HasHead %t, %d tables", r.OK(), r.HasHead, len(r.Tables))
+	}
+	// Corrupt a byte of the glyf table.
+	offset, _, _ := f.GlyphOffset(f.Index('A'))
+	for x := 12; x < 12+16*int(u16(ttf, 4)); x += 16 {
+		if string(ttf[x:x+4]) == "glyf" {
+			ttf[int(u32(ttf, x+8)+offset)+10]++
+		}
+	}
+	r, err = VerifyChecksums(ttf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(r.Mismatched()); r.OK() || got != "[glyf]" || r.Recorded == r.Computed {
+		t.Errorf("corrupted font: got OK %t, mismatched %s, adjustment %#x, want %#x",
+			r.OK(), got, r.Recorded, r.Computed)
+	}
+	if _, err := VerifyChecksums(ttf[:100]); err == nil {
+		t.Error("truncated font: got no error")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ttf, err := f.Write(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := Validate(ttf); got != nil {
+		t.Errorf("written font: got %v, want none", got)
+	}
+	if got := Validate(ttf[:100]); got == nil {
+		t.Error("truncated font: got no findings")
+	}
+
+	// Make the offset of the glyph after 'A' less than that of 'A'.
+	i, size := int(f.Index('A')), 2
+	if f.locaOffsetFormat == locaOffsetFormatLong {
+		size = 4
+	}
+	loca := append([]byte(nil), f.loca...)
+	copy(loca[size*(i+1):size*(i+2)], make([]byte, size))
+	// Map U+0041 to U+0043 to glyphs past the last glyph.
+	cmap := cmapTable(uint32(0x0003000a), cmapFormat12Subtable(0x41, 0x43, uint32(f.nGlyph)-1))
+	ttf, err = f.Write(&WriteOptions{Tables: map[string][]byte{"loca": loca, "cmap": cmap}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []error{
+		FormatError{Table: "loca", Offset: size * (i + 1), Reason: fmt.Sprintf("offsets of glyph %d decrease", i)},
+		FormatError{Table: "cmap", Offset: 12, Reason: fmt.Sprintf("cmap subtable maps U+0042 to a glyph out of range: %d", f.nGlyph)},
+	}
+	got := Validate(ttf)
+	for _, w := range want {
+		found := false
+		for _, g := range got {
+			found = found || g == w
+		}
+		if !found {
+			t.Errorf("corrupted font: got %v, want %v among them", got, w)
+		}
+	}
+
+	// Every encoding record shares one subtable, which maps every code, so
+	// that checking each record, and each code, would take minutes.
+	const n = 0xffff
+	cmap = u16s(0, n)
+	for i := 0; i < n; i++ {
+		cmap = append(cmap, u16s(3, 10, (4+8*n)>>16, (4+8*n)&0xffff)...)
+	}
+	cmap = append(cmap, cmapFormat12Subtable(0, unicode.MaxRune, 0)...)
+	ttf, err = f.Write(&WriteOptions{Tables: map[string][]byte{"cmap": cmap}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []error{
+		FormatError{Table: "cmap", Offset: 4 + 8*n, Reason: fmt.Sprintf("cmap subtable maps %U to a glyph out of range: %d", f.nGlyph, f.nGlyph)},
+	}
+	if got := Validate(ttf); !reflect.DeepEqual(got, want) {
+		t.Errorf("shared subtable: got %v, want %v", got, want)
+	}
+
+	// Nest the compound glyph for U+00E9 in that for U+00E1, deeper than the
+	// maxp table's maxComponentDepth of 1. The font still loads.
+	glyf := append([]byte(nil), f.glyf...)
+	offset, _, _ := f.GlyphOffset(f.Index('\u00e1'))
+	putU16(glyf[offset:], 12, uint16(f.Index('\u00e9')))
+	maxp := append([]byte(nil), f.maxp...)
+	putU16(maxp, 30, 1)
+	ttf, err = f.Write(&WriteOptions{Tables: map[string][]byte{"glyf": glyf, "maxp": maxp}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := Validate(ttf), []error{LimitError("maxComponentDepth")}; !reflect.DeepEqual(got, want) {
+		t.Errorf("nested compound glyph: got %v, want %v", got, want)
+	}
+	g, err := Parse(ttf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewGlyphBuf().Load(g, 12<<6, g.Index('\u00e1'), FullHinting); err != nil {
+		t.Errorf("nested compound glyph: Load: %v", err)
+	}
+}
+
+func TestLoadMalformed(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// corrupt returns the font with the glyf data of the glyph for r
+	// modified by fn.
+	corrupt := func(r rune, fn func(data []byte)) *Font {
+		glyf := append([]byte(nil), f.glyf...)
+		offset, length, _ := f.GlyphOffset(f.Index(r))
+		fn(glyf[offset : offset+length])
+		ttf, err := f.Write(&WriteOptions{Tables: map[string][]byte{"glyf": glyf}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		g, err := Parse(ttf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return g
+	}
+	testCases := []struct {
+		desc string
+		r    rune
+		fn   func(data []byte)
+		want FormatError
+	}{{
+		"too many points",
+		'A',
+		func(data []byte) { data[10]++ },
+		FormatError{Table: "glyf", Reason: "bad simple glyph"},
+	}, {
+		"decreasing contour end points",
+		'A',
+		func(data []byte) { putU16(data, 12, 0) },
+		FormatError{Table: "glyf", Reason: "bad simple glyph"},
+	}, {
+		"too long instructions",
+		'A',
+		func(data []byte) { putU16(data, 10+2*int(u16(data, 0)), 0xffff) },
+		FormatError{Table: "glyf", Reason: "bad simple glyph"},
+	}, {
+		"too short compound glyph",
+		'\u00e1',
+		func(data []byte) {
+			// Set every component's flagMoreComponents.
+			walkComponents(data, func(x int) { putU16(data, x-2, u16(data, x-2)|1<<5) })
+		},
+		FormatError{Table: "glyf", Reason: "bad compound glyph"},
+	}}
+	for _, tc := range testCases {
+		g := corrupt(tc.r, tc.fn)
+		// The error's offset is that of the glyph.
+		offset, _, _ := g.GlyphOffset(g.Index(tc.r))
+		tc.want.Offset = int(offset)
+		for _, h := range []Hinting{NoHinting, FullHinting} {
+			if err := NewGlyphBuf().Load(g, 12<<6, g.Index(tc.r), h); err != tc.want {
+				t.Errorf("%s, hinting %d: got %#v, want %#v", tc.desc, h, err, tc.want)
+			}
+		}
+	}
+
+	head := append([]byte(nil), f.head...)
+	putU16(head, 18, 0)
+	ttf, err := f.Write(&WriteOptions{Tables: map[string][]byte{"head": head}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Parse(ttf); err != (FormatError{Table: "head", Offset: 18, Reason: "bad unitsPerEm: 0"}) {
+		t.Errorf("zero unitsPerEm: got %v", err)
+	}
+}
+
+func TestParseStrictness(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ttf, err := f.Write(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// shortLoca's loca table is missing its final entry.
+	shortLoca, err := f.Write(&WriteOptions{Tables: map[string][]byte{
+		"loca": f.loca[:len(f.loca)-2],
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// longGlyf's glyf table runs past the end of the data.
+	longGlyf := append([]byte(nil), ttf...)
+	for x := 12; x < 12+16*int(u16(longGlyf, 4)); x += 16 {
+		if string(longGlyf[x:x+4]) == "glyf" {
+			putU32(longGlyf, x+12, uint32(len(longGlyf))-u32(longGlyf, x+8)+1000)
+		}
+	}
+	// badChecksum has a corrupted byte in its glyf table: the last
+	// co-ordinate byte of 'A', which is still a valid glyph.
+	badChecksum := append([]byte(nil), ttf...)
+	offset, length, _ := f.GlyphOffset(f.Index('A'))
+	for x := 12; x < 12+16*int(u16(badChecksum, 4)); x += 16 {
+		if string(badChecksum[x:x+4]) == "glyf" {
+			badChecksum[int(u32(badChecksum, x+8)+offset+length)-1]++
+		}
+	}
+
+	last := Index(f.nGlyph - 1)
+	testCases := []struct {
+		desc       string
+		ttf        []byte
+		strictness Strictness
+		wantErr    bool
+		wantLast   bool
+	}{
+		{"valid, default", ttf, DefaultStrictness, false, true},
+		{"valid, strict", ttf, Strict, false, true},
+		{"valid, permissive", ttf, Permissive, false, true},
+		{"short loca, default", shortLoca, DefaultStrictness, false, false},
+		{"short loca, strict", shortLoca, Strict, true, false},
+		{"short loca, permissive", shortLoca, Permissive, false, true},
+		{"long glyf, default", longGlyf, DefaultStrictness, true, false},
+		{"long glyf, strict", longGlyf, Strict, true, false},
+		{"long glyf, permissive", longGlyf, Permissive, false, true},
+		{"bad checksum, default", badChecksum, DefaultStrictness, false, true},
+		{"bad checksum, strict", badChecksum, Strict, true, false},
+		{"bad checksum, permissive", badChecksum, Permissive, false, true},
+	}
+	for _, tc := range testCases {
+		g, err := ParseWithOptions(tc.ttf, &ParseOptions{Strictness: tc.strictness})
+		if gotErr := err != nil; gotErr != tc.wantErr {
+			t.Errorf("%s: got error %v, want error %t", tc.desc, err, tc.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if _, _, ok := g.GlyphOffset(last); ok != tc.wantLast {
+			t.Errorf("%s: last glyph: got ok %t, want %t", tc.desc, ok, tc.wantLast)
+		}
+		if err := NewGlyphBuf().Load(g, g.FUnitsPerEm(), g.Index('A'), NoHinting); err != nil {
+			t.Errorf("%s: loading 'A': %v", tc.desc, err)
+		}
+	}
+
+	want := FormatError{Table: "glyf", Reason: "bad checksum"}
+	if _, err := ParseWithOptions(badChecksum, &ParseOptions{Strictness: Strict}); err != want {
+		t.Errorf("bad checksum: got error %v, want %v", err, want)
+	}
+
+	// Anomalies that are found after parsing, such as when hinting, are only
+	// logged, and do not modify a Strict font used by multiple goroutines.
+	g, err := ParseWithOptions(ttf, &ParseOptions{Strictness: Strict})
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan bool)
+	for i := 0; i < 4; i++ {
+		go func() {
+			g.warn("truetype: hinting: ignoring INSTCTRL outside of the prep program")
+			done <- true
+		}()
+	}
+	for i := 0; i < 4; i++ {
+		<-done
+	}
+	if g.strictErr != nil {
+		t.Errorf("anomaly after parsing: got strictErr %v, want nil", g.strictErr)
+	}
+}
+
+func TestConcurrentLoad(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const scale = 12 << 6
+	want := make([][]Point, f.NumGlyphs())
+	g := NewGlyphBuf()
+	for i := range want {
+		if err := g.Load(f, scale, Index(i), FullHinting); err != nil {
+			t.Fatalf("Load(%d): %v", i, err)
+		}
+		want[i] = append([]Point{}, g.Point...)
+	}
+
+	errc := make(chan error, 4)
+	for j := 0; j < cap(errc); j++ {
+		go func() {
+			g := GetGlyphBuf()
+			defer PutGlyphBuf(g)
+			for i := range want {
+				if err := g.Load(f, scale, Index(i), FullHinting); err != nil {
+					errc <- fmt.Errorf("Load(%d): %v", i, err)
+					return
+				}
+				if !reflect.DeepEqual(g.Point, want[i]) {
+					errc <- fmt.Errorf("glyph %d: points differ", i)
+					return
+				}
+				f.Index(rune(i))
+				f.Kern(scale, Index(i), Index(i+1))
+			}
+			errc <- nil
+		}()
+	}
+	for j := 0; j < cap(errc); j++ {
+		if err := <-errc; err != nil {
+			t.Error(err)
+		}
+	}
+
+	// PutGlyphBuf resets a GlyphBuf's settings.
+	g = GetGlyphBuf()
+	g.SetWidthScale(1 << 15)
+	g.SetPointSize(12 << 6)
+	g.SetVariation([]int16{0x4000})
+	g.SetHinterOptions(&HinterOptions{EngineVersion: 40})
+	PutGlyphBuf(g)
+	if g.widthScale != 0 || g.hinter.pointSize != 0 || len(g.coords) != 0 || g.hinter.opts.EngineVersion != 0 {
+		t.Errorf("PutGlyphBuf did not reset the GlyphBuf's settings")
+	}
+}
+
+func TestGlyphBufAllocs(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	points, contours := f.MaxGlyphSize()
+	if points == 0 || contours == 0 {
+		t.Fatalf("MaxGlyphSize: got %d, %d, want non-zero", points, contours)
+	}
+	g := &GlyphBuf{}
+	if err := g.Load(f, 12<<6, f.Index('A'), NoHinting); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	want := append([]Point(nil), g.Point...)
+	g.Reserve(f)
+	if cap(g.Point) < points+4 || cap(g.End) < contours {
+		t.Errorf("Reserve: got capacities %d, %d, want at least %d, %d", cap(g.Point), cap(g.End), points+4, contours)
+	}
+	if !reflect.DeepEqual(g.Point, want) {
+		t.Errorf("Reserve did not keep the loaded glyph")
+	}
+
+	for _, h := range []Hinting{NoHinting, FullHinting, AutoHinting} {
+		load := func() {
+			for _, scale := range []int32{12 << 6, 16 << 6} {
+				for i := 0; i < f.NumGlyphs(); i++ {
+					if err := g.Load(f, scale, Index(i), h); err != nil {
+						t.Fatalf("hinting %d: Load(%d): %v", h, i, err)
+					}
+				}
+			}
+		}
+		// The first run hints at each size for the first time.
+		load()
+		if n := testing.AllocsPerRun(5, load); n != 0 {
+			t.Errorf("hinting %d: got %v allocations, want 0", h, n)
+		}
+	}
+}
+
+func TestGlyphPath(t *testing.T) {
+	g := &GlyphBuf{
+		Point: []Point{
+			// A square.
+			{0, 0, 1}, {0, 10, 1}, {10, 10, 1}, {10, 0, 1},
+			// A curve of ``off'' points, with implied points between them.
+			{0, 0, 0}, {5, 10, 0}, {11, 0, 0},
+			// A cubic curve that ends at its start.
+			{20, 0, 1}, {20, 10, FlagCubic}, {30, 10, FlagCubic},
+		},
+		End: []int{4, 7, 7, 10},
+	}
+	want := []PathCommand{
+		{PathMoveTo, [3]Point{{0, 0, 0}}},
+		{PathLineTo, [3]Point{{0, 10, 0}}},
+		{PathLineTo, [3]Point{{10, 10, 0}}},
+		{PathLineTo, [3]Point{{10, 0, 0}}},
+		{PathLineTo, [3]Point{{0, 0, 0}}},
+		{PathClose, [3]Point{}},
+		{PathMoveTo, [3]Point{{5, 0, 0}}},
+		{PathQuadTo, [3]Point{{0, 0, 0}, {2, 5, 0}}},
+		{PathQuadTo, [3]Point{{5, 10, 0}, {8, 5, 0}}},
+		{PathQuadTo, [3]Point{{11, 0, 0}, {5, 0, 0}}},
+		{PathClose, [3]Point{}},
+		{PathMoveTo, [3]Point{{20, 0, 0}}},
+		{PathCubicTo, [3]Point{{20, 10, 0}, {30, 10, 0}, {20, 0, 0}}},
+		{PathClose, [3]Point{}},
+	}
+	if got := g.Path(nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("Path:\ngot  %v\nwant %v", got, want)
+	}
+	const wantSVG = "M0 0L0 -10L10 -10L10 0Z" +
+		"M5.5 0Q0 0 2.5 -5Q5 -10 8 -5Q11 0 5.5 0Z" +
+		"M20 0C20 -10 30 -10 20 0Z"
+	if got := g.SVGPath(); got != wantSVG {
+		t.Errorf("SVGPath:\ngot  %s\nwant %s", got, wantSVG)
+	}
+
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Load(f, f.FUnitsPerEm(), f.Index('O'), NoHinting); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Count(g.SVGPath(), "M"); got != len(g.End) {
+		t.Errorf("'O': got %d contours, want %d", got, len(g.End))
+	}
+}
+
+func TestGlyphMetrics(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fupe := f.FUnitsPerEm()
+	g := NewGlyphBuf()
+	for _, r := range " .AOgjÅ" {
+		i := f.Index(r)
+		m, err := f.GlyphMetrics(fupe, i)
+		if err != nil {
+			t.Errorf("%q: %v", r, err)
+			continue
+		}
+		if err := g.Load(f, fupe, i, NoHinting); err != nil {
+			t.Errorf("%q: Load: %v", r, err)
+			continue
+		}
+		if m.AdvanceWidth != g.AdvanceWidth || m.B != g.B {
+			t.Errorf("%q: got advance %d, bounds %v, want %d, %v", r, m.AdvanceWidth, m.B, g.AdvanceWidth, g.B)
+		}
+		if h := f.HMetric(fupe, i); m.LeftSideBearing != h.LeftSideBearing {
+			t.Errorf("%q: got left side bearing %d, want %d", r, m.LeftSideBearing, h.LeftSideBearing)
+		}
+		if got, want := m.RightSideBearing, m.AdvanceWidth-m.B.XMax; r != ' ' && got != want {
+			t.Errorf("%q: got right side bearing %d, want %d", r, got, want)
+		}
+	}
+	if _, err := f.GlyphMetrics(fupe, Index(f.NumGlyphs())); err == nil {
+		t.Error("out of range index: got no error")
+	}
+}
+
+func TestIndexLatin1(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.latin1) != 0x100 {
+		t.Fatalf("len(latin1): got %d, want %d", len(f.latin1), 0x100)
+	}
+	slow := *f
+	slow.latin1 = nil
+	for r := rune(-1); r < 0x400; r++ {
+		if got, want := f.Index(r), slow.Index(r); got != want {
+			t.Errorf("Index(%U): got %d, want %d", r, got, want)
+		}
+	}
+}
+
+func benchmarkIndex(b *testing.B, latin1 bool, runes []rune) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !latin1 {
+		f.latin1 = nil
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, r := range runes {
+			f.Index(r)
+		}
+	}
+}
+
+var benchmarkIndexText = []rune("The quick brown fox jumps over the lazy dog. Ça coûte 5 €.")
+
+func BenchmarkIndex(b *testing.B)         { benchmarkIndex(b, true, benchmarkIndexText) }
+func BenchmarkIndexSegments(b *testing.B) { benchmarkIndex(b, false, benchmarkIndexText) }
+
+func TestRangeCharmap(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []rune
+	f.RangeCharmap(func(r rune, i Index) bool {
+		if j := f.Index(r); i != j {
+			t.Errorf("%U: got index %d, want %d", r, i, j)
+		}
+		got = append(got, r)
+		return true
+	})
+	var want []rune
+	for r := rune(0); r <= 0xffff; r++ {
+		if f.Index(r) != 0 {
+			want = append(want, r)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %d runes, want %d", len(got), len(want))
+	}
+
+	n := 0
+	f.RangeCharmap(func(r rune, i Index) bool {
+		n++
+		return n < 3
+	})
+	if n != 3 {
+		t.Errorf("stopping early: got %d calls, want 3", n)
+	}
+}
+
+func TestRunes(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	f.RangeCharmap(func(r rune, i Index) bool {
+		found := false
+		for _, s := range f.Runes(i) {
+			found = found || s == r
+		}
+		if !found {
+			t.Errorf("%U: Runes(%d) = %q does not contain it", r, i, f.Runes(i))
+		}
+		n++
+		return true
+	})
+	total := 0
+	for i := 0; i < f.NumGlyphs(); i++ {
+		runes := f.Runes(Index(i))
+		for j := 1; j < len(runes); j++ {
+			if runes[j-1] >= runes[j] {
+				t.Errorf("glyph %d: runes %q are not in increasing order", i, runes)
+			}
+		}
+		total += len(runes)
+	}
+	if total != n {
+		t.Errorf("got %d runes in total, want %d", total, n)
+	}
+	if got := f.Runes(0); len(got) != 0 {
+		t.Errorf("missing glyph: got %q, want none", got)
+	}
+	// The result can be modified without affecting later calls.
+	i := f.Index('A')
+	f.Runes(i)[0] = 'B'
+	if got := f.Runes(i); !reflect.DeepEqual(got, []rune{'A'}) {
+		t.Errorf("'A': got %q, want %q", got, "A")
+	}
+}
+
+func TestTable(t *testing.T) {
+	f, _, err := parseTestdataFont("luxisr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tag := range []string{"head", "cvt ", "kern", "gasp"} {
+		got, ok := f.Table(tag)
+		if !ok || !bytes.Equal(got, f.tables[tag]) {
+			t.Errorf("%q: got %d bytes, ok %t", tag, len(got), ok)
+		}
+	}
+	if got, _ := f.Table("head"); len(got) != 54 || u32(got, 12) != 0x5f0f3cf5 {
+		t.Errorf("head: got %d bytes, want 54 with the magic number", len(got))
+	}
+	for _, tag := range []string{"DSIG", "cvt", "GSUB"} {
+		if _, ok := f.Table(tag); ok {
+			t.Errorf("%q: got ok, want not", tag)
+		}
+	}
+}
+
+func TestKerx(t *testing.T) {
+	// subtable returns a kerx subtable with the given coverage and body.
+	subtable := func(coverage int, body []byte) []byte {
+		return append(u16s(0, 12+len(body), coverage>>16, coverage&0xffff, 0, 0), body...)
+	}
+	format0 := subtable(0, append(u16s(0, 2, 0, 12, 0, 1, 0, 0), u16s(1, 2, -50, 3, 4, 20)...))
+	// The format 2 subtable's left class table puts glyphs 5 and 6 in row 1,
+	// whose index is 2, the number of columns. Its right class table puts
+	// glyphs 7 and 8 in column 1.
+	format2 := subtable(2, u16s(
+		0, 4, 0, 28, 0, 46, 0, 56, // Row width and offsets.
+		2, 6, 1, 6, 0, 0, 6, 5, 2, // Left class lookup table, of format 2.
+		8, 7, 2, 1, 1, // Right class lookup table, of format 8.
+		0, -10, 0, -20, // Values for rows 0 and 1, and columns 0 and 1.
+	))
+	vertical := subtable(0x80000000, append(u16s(0, 1, 0, 6, 0, 0, 0, 0), u16s(1, 2, 99)...))
+	format4 := subtable(4, make([]byte, 16))
+	kerx := append(u16s(2, 0, 0, 4), format0...)
+	kerx = append(kerx, format2...)
+	kerx = append(kerx, vertical...)
+	kerx = append(kerx, format4...)
+	// The kern table is ignored, as the kerx table has kerning.
+	kern := append([]byte{0, 0, 0, 1, 0, 0, 0, 20, 0, 1}, u16s(1, 0, 0, 0, 9, 9, 99)...)
+
+	var l testLogger
+	f := &Font{kerx: kerx, kern: kern, fUnitsPerEm: 1000, nGlyph: 20, logger: &l}
+	if err := f.parseKern(); err != nil {
+		t.Fatalf("parseKern: %v", err)
+	}
+	f.parseKerx()
+	testCases := []struct {
+		i0, i1 Index
+		want   int32
+	}{
+		{1, 2, -50},
+		{3, 4, 20},
+		{1, 7, -10},
+		{5, 8, -20},
+		{5, 2, 0},
+		{9, 9, 0},
+	}
+	for _, tc := range testCases {
+		if got := f.Kern(1000, tc.i0, tc.i1); got != tc.want {
+			t.Errorf("Kern(%d, %d): got %d, want %d", tc.i0, tc.i1, got, tc.want)
+		}
+	}
+	if got, want := fmt.Sprint(l), "[truetype: skipping unsupported kerx subtable format 4]"; got != want {
+		t.Errorf("logged: got %s, want %s", got, want)
+	}
+
+	// A truncated kerx table is ignored, falling back to the kern table.
+	f.kerx = kerx[:40]
+	f.parseKerx()
+	if got, want := f.Kern(1000, 9, 9), int32(99); got != want {
+		t.Errorf("truncated kerx: Kern(9, 9): got %d, want %d", got, want)
+	}
+}
+
+// morxStateSubtable returns the body of a morx subtable with an extended
+// state table. Its glyph classes are (glyph, class) pairs, in increasing
+// order of glyph, its states list an entry index for each class, and its
+// entries list their 16-bit fields. The given tables follow the state table,
+// and their offsets follow the state table's header.
+func morxStateSubtable(classes []int, states, entries [][]int, tables ...[]byte) []byte {
+	lookup := append(u16s(6, 4, len(classes)/2, 0, 0, 0), u16s(classes...)...)
+	var stateArray, entryTable []byte
+	for _, s := range states {
+		stateArray = append(stateArray, u16s(s...)...)
+	}
+	for _, e := range entries {
+		entryTable = append(entryTable, u16s(e...)...)
+	}
+	x := 16 + 4*len(tables)
+	b := u16s(0, len(states[0]), 0, x, 0, x+len(lookup), 0, x+len(lookup)+len(stateArray))
+	x += len(lookup) + len(stateArray) + len(entryTable)
+	for _, t := range tables {
+		b = append(b, u16s(0, x)...)
+		x += len(t)
+	}
+	b = append(b, lookup...)
+	b = append(b, stateArray...)
+	b = append(b, entryTable...)
+	for _, t := range tables {
+		b = append(b, t...)
+	}
+	return b
+}
+
+func TestMorx(t *testing.T) {
+	// subtable returns a morx subtable with the given type, flags and body.
+	subtable := func(typ, flags int, body []byte) []byte {
+		return append(u16s(0, 12+len(body), 0, typ, flags>>16, flags&0xffff), body...)
+	}
+	// The rearrangement subtable swaps glyph 7 followed by glyph 8.
+	rearrangement := morxStateSubtable(
+		[]int{7, 4, 8, 5},
+		[][]int{{0, 0, 0, 0, 1, 0}, {0, 0, 0, 0, 1, 0}, {0, 0, 0, 0, 1, 2}},
+		[][]int{{0, 0}, {2, 0x8000}, {0, 0x2001}},
+	)
+	// The contextual subtable replaces glyph 3 followed by glyph 4 with
+	// glyphs 13 and 14.
+	contextual := morxStateSubtable(
+		[]int{3, 4, 4, 5},
+		[][]int{{0, 0, 0, 0, 1, 0}, {0, 0, 0, 0, 1, 0}, {0, 0, 0, 0, 1, 2}},
+		[][]int{{0, 0, 0xffff, 0xffff}, {2, 0x8000, 0xffff, 0xffff}, {0, 0, 1, 0}},
+		append(u16s(0, 8, 0, 24), u16s(6, 4, 1, 0, 0, 0, 4, 14, 6, 4, 1, 0, 0, 0, 3, 13)...),
+	)
+	// The ligature subtable replaces glyph 1 followed by glyph 2, as for "fi",
+	// with glyph 10.
+	ligature := morxStateSubtable(
+		[]int{1, 4, 2, 5},
+		[][]int{{0, 0, 0, 0, 1, 0}, {0, 0, 0, 0, 1, 0}, {0, 0, 0, 0, 1, 2}},
+		[][]int{{0, 0, 0}, {2, 0x8000, 0}, {0, 0xa000, 0}},
+		u16s(0, 0, 0x8000, 0), // Actions.
+		u16s(0, 0, 1),         // Components.
+		u16s(0, 10),           // Ligatures.
+	)
+	// The noncontextual subtable replaces glyph 5 with glyph 6, for the small
+	// caps feature.
+	noncontextual := u16s(8, 5, 1, 6)
+
+	subtables := subtable(0, 8, rearrangement)
+	subtables = append(subtables, subtable(1, 2, contextual)...)
+	subtables = append(subtables, subtable(2, 1, ligature)...)
+	subtables = append(subtables, subtable(4, 4, noncontextual)...)
+	subtables = append(subtables, subtable(5, 1, make([]byte, 20))...)
+	smcp := u16s(37, 1, 0, 4, 0xffff, 0xffff)
+	chain := append(u16s(0, 1|2|8, 0, 16+len(smcp)+len(subtables), 0, 1, 0, 5), smcp...)
+	morx := append(u16s(2, 0, 0, 1), append(chain, subtables...)...)
+
+	var l testLogger
+	f := &Font{morx: morx, nGlyph: 20, logger: &l}
+	f.parseMorx()
+	if got, want := fmt.Sprint(l), "[truetype: skipping unsupported morx subtable type 5]"; got != want {
+		t.Errorf("logged: got %s, want %s", got, want)
+	}
+
+	glyphs, clusters := f.Substitute([]Index{1, 2, 3, 1, 1, 2}, []int{0, 1, 2, 3, 4, 5})
+	if got, want := fmt.Sprint(glyphs), "[10 3 1 10]"; got != want {
+		t.Errorf("glyphs: got %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(clusters), "[0 2 3 4]"; got != want {
+		t.Errorf("clusters: got %s, want %s", got, want)
+	}
+	testCases := []struct {
+		features []string
+		glyphs   []Index
+		want     string
+	}{
+		{nil, []Index{5, 3, 4, 7, 8}, "[5 13 14 8 7]"},
+		{[]string{"smcp"}, []Index{5, 3, 4, 7, 8}, "[6 13 14 8 7]"},
+		{nil, []Index{4, 3, 8, 7}, "[4 3 8 7]"},
+		{nil, []Index{}, "[]"},
+	}
+	for _, tc := range testCases {
+		glyphs, _ := f.Substitute(tc.glyphs, nil, tc.features...)
+		if got := fmt.Sprint(glyphs); got != tc.want {
+			t.Errorf("%v %v: got %s, want %s", tc.features, tc.glyphs, got, tc.want)
+		}
+	}
+
+	// The morx table is ignored if the font has a GSUB table.
+	f.gsub = []byte{0}
+	glyphs, _ = f.Substitute([]Index{1, 2}, nil)
+	if got, want := fmt.Sprint(glyphs), "[1 2]"; got != want {
+		t.Errorf("with GSUB: got %s, want %s", got, want)
+	}
+}
+
+func TestRearrangeVerbs(t *testing.T) {
+	// The verbs are as documented in Apple's TrueType Reference Manual, with
+	// x being the glyphs, of any number, between those that move.
+	testCases := []struct {
+		verb     int
+		from, to string
+	}{
+		{0, "ABxyCD", "ABxyCD"},
+		{1, "Axy", "xyA"},
+		{2, "xyD", "Dxy"},
+		{3, "AxyD", "DxyA"},
+		{4, "ABxy", "xyAB"},
+		{5, "ABxy", "xyBA"},
+		{6, "xyCD", "CDxy"},
+		{7, "xyCD", "DCxy"},
+		{8, "AxyCD", "CDxyA"},
+		{9, "AxyCD", "DCxyA"},
+		{10, "ABxyD", "DxyAB"},
+		{11, "ABxyD", "DxyBA"},
+		{12, "ABxyCD", "CDxyAB"},
+		{13, "ABxyCD", "CDxyBA"},
+		{14, "ABxyCD", "DCxyAB"},
+		{15, "ABxyCD", "DCxyBA"},
+	}
+	for _, tc := range testCases {
+		s := make([]int, len(tc.from))
+		for i := range s {
+			s[i] = int(tc.from[i])
+		}
+		if !rearrangeVerb(s, uint16(tc.verb)) {
+			t.Errorf("verb %d: got false", tc.verb)
+			continue
+		}
+		b := make([]byte, len(s))
+		for i, c := range s {
+			b[i] = byte(c)
+		}
+		if got := string(b); got != tc.to {
+			t.Errorf("verb %d: got %s, want %s", tc.verb, got, tc.to)
+		}
+	}
+	if s := []int{1, 2, 3}; rearrangeVerb(s, 12) || fmt.Sprint(s) != "[1 2 3]" {
+		t.Errorf("verb 12 of 3 glyphs: got true or %v", s)
+	}
+}
+
+func TestTracking(t *testing.T) {
+	trak := u16s(
+		1, 0, 0, 12, 0, 0, // Header.
+		3, 3, 0, 44, // Track data.
+		0xffff, 0, 0, 56, 0, 0, 0, 62, 1, 0, 0, 68, // Tight, normal and loose tracks.
+		9, 0, 12, 0, 24, 0, // Sizes of 9, 12 and 24 points.
+		-10, -20, -60, 10, 0, -40, 30, 20, 0, // Values.
+	)
+	f := &Font{trak: trak, fUnitsPerEm: 1000}
+	if got, want := fmt.Sprint(f.Tracks()), "[-65536 0 65536]"; got != want {
+		t.Errorf("Tracks: got %s, want %s", got, want)
+	}
+	testCases := []struct {
+		scale, pointSize, track int32
+		want                    int32
+		wantOK                  bool
+	}{
+		{1000, 12 << 6, 0, 0, true},
+		{1000, 18 << 6, 0, -20, true},
+		{1000, 6 << 6, 0, 20, true},
+		{1000, 48 << 6, 0, -120, true},
+		{1000, 10 << 6, 0, 7, true},
+		{1000, 12 << 6, 1 << 16, 20, true},
+		{1000, 24 << 6, -1 << 16, -60, true},
+		{768, 18 << 6, 0, -15, true},
+		{1000, 12 << 6, 1 << 15, 0, false},
+	}
+	for _, tc := range testCases {
+		got, ok := f.Tracking(tc.scale, tc.pointSize, tc.track)
+		if got != tc.want || ok != tc.wantOK {
+			t.Errorf("Tracking(%d, %d, %#x): got %d, %t, want %d, %t",
+				tc.scale, tc.pointSize, tc.track, got, ok, tc.want, tc.wantOK)
+		}
+	}
+	if _, ok := (&Font{trak: trak[:40], fUnitsPerEm: 1000}).Tracking(1000, 12<<6, 0); ok {
+		t.Error("truncated trak: got ok")
+	}
+}
+
+// FuzzParse parses arbitrary data as a font and, if it parses, loads and
+// measures its glyphs. Malformed fonts may fail to parse or load, but must not
+// panic or hang. The seed corpus is a subset of the luxisr font, copies of it
+// that are truncated at, or whose table directory entries point past, each of
+// its tables, and the corrupted fonts in testdata/fuzz/FuzzParse. Run it with:
+//
+//	go test -run=NONE -fuzz=FuzzParse
+func FuzzParse(f *testing.F) {
+	font, testdataIsOptional, err := parseTestdataFont("luxisr")
+	if err != nil {
+		if testdataIsOptional {
+			f.Skip(err)
+		}
+		f.Fatal(err)
+	}
+	ttf, _, err := font.Subset([]rune("Agá"))
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(ttf)
+	for x := 12; x < 12+16*int(u16(ttf, 4)); x += 16 {
+		f.Add(ttf[:u32(ttf, x+8)+u32(ttf, x+12)/2])
+		b := append([]byte(nil), ttf...)
+		putU32(b, x+12, u32(b, x+12)+0x100)
+		f.Add(b)
+	}
+	// Add an sbix table, whose one strike has a PNG image for every glyph.
+	subset, err := Parse(ttf)
+	if err != nil {
+		f.Fatal(err)
+	}
+	n := subset.NumGlyphs()
+	sbix := u16s(1, 1, 0, 1, 0, 12, 20, 72)
+	for i := 0; i <= n; i++ {
+		sbix = append(sbix, u16s(0, 4+4*(n+1)+12*i)...)
+	}
+	for i := 0; i < n; i++ {
+		sbix = append(sbix, u16s(0, 0)...)
+		sbix = append(sbix, "png abcd"...)
+	}
+	b, err := subset.Write(&WriteOptions{Tables: map[string][]byte{"sbix": sbix}})
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(b)
+
+	f.Fuzz(func(t *testing.T, ttf []byte) {
+		Validate(ttf)
+		for _, strictness := range []Strictness{DefaultStrictness, Permissive} {
+			font, err := ParseWithOptions(ttf, &ParseOptions{Strictness: strictness})
+			if err != nil {
+				continue
+			}
+			const scale = 12 << 6
+			n := font.NumGlyphs()
+			if n > 16 {
+				n = 16
+			}
+			g := NewGlyphBuf()
+			for i := Index(0); int(i) < n; i++ {
+				for _, h := range []Hinting{NoHinting, FullHinting} {
+					// Errors are expected, as most glyphs are not valid.
+					g.Load(font, scale, i, h)
+				}
+				font.GlyphMetrics(scale, i)
+				font.VMetric(scale, i)
+				font.DeviceAdvance(scale, i)
+				font.GlyphName(i)
+				font.Kern(scale, i, 0)
+				font.SbixGlyph(scale, i)
+			}
+			font.RangeCharmap(func(r rune, i Index) bool {
+				return r < 0x100
+			})
+			font.Index('A')
+			font.Name(NameIDFamily)
+			font.Substitute([]Index{0, 1, 2}, nil, "liga")
+			font.PositionMarks(scale, []Index{0, 1, 2})
+			font.Subset([]rune("Ag"))
+			font.Write(&WriteOptions{StripHinting: true})
+		}
+	})
+}
@@ -0,0 +1,439 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Validate checks the structure of the given TTF, WOFF or WOFF2 data, in the
+// manner of the OpenType Sanitizer, such as for a service that screens fonts
+// from untrusted users before rendering them. It returns the problems that it
+// finds, in the order that it checks for them, or nil if it finds none. The
+// checks are for:
+//
+//   - table directory entries that are out of bounds, duplicated or
+//     overlapping, and missing required tables,
+//   - the errors that Parse would return, and the invalid tables that it would
+//     ignore,
+//   - loca entries that decrease or that point past the end of the glyf
+//     table,
+//   - glyphs whose contours, instructions or components are malformed, that
+//     exceed the limits of the maxp table, or that are compound glyphs that
+//     refer to themselves, and
+//   - cmap subtables that are malformed, whose segments are not sorted, or
+//     that map to glyphs that do not exist. The glyph indexes of only the
+//     first million codes that are mapped by glyph index arrays are checked.
+//
+// Each problem is a FormatError, with the table and offset of the problem if
+// they are known, or a LimitError, for glyphs that exceed a limit of the maxp
+// table, which is reported once. TrueType collections are not supported, and
+// are reported as an UnsupportedError. Validate does not verify the font's
+// checksums, which VerifyChecksums does, or run its hinting programs.
+func Validate(ttf []byte) []error {
+	v := &validator{limits: make(map[LimitError]bool)}
+	if len(ttf) >= 4 {
+		var err error
+		switch u32(ttf, 0) {
+		case 0x74746366: // "ttcf" as a big-endian uint32.
+			return []error{UnsupportedError{Feature: "validating a TTC font"}}
+		case 0x774f4646: // "wOFF" as a big-endian uint32.
+			ttf, err = decodeWOFF(ttf)
+		case 0x774f4632: // "wOF2" as a big-endian uint32.
+			ttf, err = decodeWOFF2(ttf)
+		}
+		if err != nil {
+			return []error{err}
+		}
+	}
+	if !v.checkDirectory(ttf) {
+		return v.findings
+	}
+	f, err := parse(ttf, 0, nil, &ParseOptions{Logger: v})
+	if err != nil {
+		v.findings = append(v.findings, err)
+		return v.findings
+	}
+	if f.glyf != nil {
+		v.checkGlyphs(f)
+	}
+	if f.cmap != nil {
+		v.checkCmap(f)
+	}
+	return v.findings
+}
+
+// validator accumulates the findings of Validate. It is the Logger of the
+// parsed font, so that the tables that Parse ignores are reported.
+type validator struct {
+	findings []error
+	// limits are the LimitErrors that have been reported.
+	limits map[LimitError]bool
+	// cmapCodes is the number of cmap codes whose glyph indexes have been
+	// checked.
+	cmapCodes int
+}
+
+func (v *validator) Warn(msg string, args ...interface{}) {
+	// Skipped layout lookups and subtables are unsupported, not invalid.
+	if strings.HasPrefix(msg, "truetype: ignoring invalid ") {
+		v.findings = append(v.findings, FormatError{Reason: strings.TrimPrefix(msg, "truetype: ")})
+	}
+}
+
+// add reports a FormatError.
+func (v *validator) add(table string, offset int, format string, args ...interface{}) {
+	v.findings = append(v.findings, FormatError{Table: table, Offset: offset, Reason: fmt.Sprintf(format, args...)})
+}
+
+// limit reports a LimitError, if it has not already been reported.
+func (v *validator) limit(e LimitError) {
+	if !v.limits[e] {
+		v.limits[e] = true
+		v.findings = append(v.findings, e)
+	}
+}
+
+// checkDirectory checks the table directory. It returns whether the
+// directory can be parsed.
+func (v *validator) checkDirectory(ttf []byte) bool {
+	if len(ttf) < 12 {
+		v.add("", 0, "TTF data is too short")
+		return false
+	}
+	switch u32(ttf, 0) {
+	case 0x00010000, 0x4f54544f, 0x74727565: // 1.0, "OTTO" and "true".
+	default:
+		v.add("", 0, "bad TTF version")
+		return false
+	}
+	n := int(u16(ttf, 4))
+	if len(ttf) < 12+16*n {
+		v.add("", 4, "table directory is too short for %d tables", n)
+		return false
+	}
+	ok, sorted := true, true
+	seen := make(map[string]bool, n)
+	var spans []span
+	for i := 0; i < n; i++ {
+		x := 12 + 16*i
+		tag := string(ttf[x : x+4])
+		if seen[tag] {
+			v.add("", x, "duplicate %q table", tag)
+			ok = false
+		}
+		seen[tag] = true
+		if i > 0 && tag < string(ttf[x-16:x-12]) && sorted {
+			v.add("", x, "table directory is not sorted by tag")
+			sorted = false
+		}
+		if _, err := readTable(ttf, ttf[x+8:x+16]); err != nil {
+			v.add("", x, "%q table is out of bounds", tag)
+			ok = false
+			continue
+		}
+		spans = append(spans, span{u32(ttf, x+8), u32(ttf, x+12)})
+	}
+	sort.Sort(spanSlice(spans))
+	for i := 1; i < len(spans); i++ {
+		if prev := spans[i-1]; spans[i].offset-prev.offset < prev.length {
+			v.add("", int(spans[i].offset), "overlapping tables")
+		}
+	}
+	for _, tag := range []string{"head", "maxp", "hhea", "hmtx"} {
+		if !seen[tag] {
+			v.add("", 0, "missing %q table", tag)
+		}
+	}
+	if !seen["CFF "] && (!seen["glyf"] || !seen["loca"]) {
+		v.add("", 0, "missing glyf and loca tables or CFF table")
+	}
+	return ok
+}
+
+// checkGlyphs checks the loca table and the glyphs of the glyf table.
+func (v *validator) checkGlyphs(f *Font) {
+	size := 2
+	if f.locaOffsetFormat == locaOffsetFormatLong {
+		size = 4
+	}
+	if len(f.loca) < size*(f.nGlyph+1) {
+		v.add("loca", 0, "loca table is too short for %d glyphs", f.nGlyph)
+		return
+	}
+	entry := func(i int) uint32 {
+		if size == 2 {
+			return 2 * uint32(u16(f.loca, 2*i))
+		}
+		return u32(f.loca, 4*i)
+	}
+	var maxInstructions, maxPoints, maxContours int
+	if len(f.maxp) >= 32 {
+		maxPoints, maxContours = int(u16(f.maxp, 6)), int(u16(f.maxp, 8))
+		maxInstructions = int(u16(f.maxp, 26))
+	}
+	// components are the component glyphs of each compound glyph.
+	components := make(map[int][]int)
+	for i := 0; i < f.nGlyph; i++ {
+		g0, g1 := entry(i), entry(i+1)
+		if g1 < g0 {
+			v.add("loca", size*(i+1), "offsets of glyph %d decrease", i)
+			continue
+		}
+		if g1 > uint32(len(f.glyf)) {
+			v.add("loca", size*(i+1), "glyph %d is past the end of the glyf table", i)
+			continue
+		}
+		data, offset := f.glyf[g0:g1], int(g0)
+		if len(data) == 0 {
+			continue
+		}
+		if len(data) < 10 {
+			v.add("glyf", offset, "glyph %d is too short", i)
+			continue
+		}
+		var instructions int
+		switch ne := int(int16(u16(data, 0))); {
+		case ne >= 0:
+			np, n, ok := v.checkSimpleGlyph(i, data, offset)
+			if !ok {
+				continue
+			}
+			if maxPoints != 0 && np > maxPoints {
+				v.limit("maxPoints")
+			}
+			if maxContours != 0 && ne > maxContours {
+				v.limit("maxContours")
+			}
+			instructions = n
+		case ne == -1:
+			const flagWeHaveInstructions = 1 << 8
+			var flags uint16
+			end, err := walkComponents(data, func(x int) {
+				flags = u16(data, x-2)
+				c := int(u16(data, x))
+				if c >= f.nGlyph {
+					v.add("glyf", offset+x, "glyph %d has a component out of range: %d", i, c)
+					return
+				}
+				components[i] = append(components[i], c)
+			})
+			if err != nil {
+				v.add("glyf", offset, "glyph %d has bad components", i)
+				continue
+			}
+			if flags&flagWeHaveInstructions != 0 {
+				if len(data) < end+2 || len(data) < end+2+int(u16(data, end)) {
+					v.add("glyf", offset+end, "glyph %d has bad instructions", i)
+					continue
+				}
+				instructions = int(u16(data, end))
+			}
+		default:
+			v.add("glyf", offset, "glyph %d has a negative number of contours", i)
+			continue
+		}
+		if maxInstructions != 0 && instructions > maxInstructions {
+			v.limit("maxSizeOfInstructions")
+		}
+	}
+	v.checkComponents(f, components)
+}
+
+// checkSimpleGlyph checks the simple glyph i, whose glyf data, at the given
+// offset in the glyf table, is data. It returns the glyph's number of points
+// and the length of its instructions. ok is false if the glyph is invalid.
+func (v *validator) checkSimpleGlyph(i int, data []byte, offset int) (np, instructions int, ok bool) {
+	ne := int(u16(data, 0))
+	x := 10 + 2*ne
+	if len(data) < x+2 {
+		v.add("glyf", offset, "glyph %d is too short for %d contours", i, ne)
+		return 0, 0, false
+	}
+	for j := 0; j < ne; j++ {
+		end := int(u16(data, 10+2*j))
+		if end < np {
+			v.add("glyf", offset+10+2*j, "glyph %d has decreasing contour end points", i)
+			return 0, 0, false
+		}
+		np = end + 1
+	}
+	instructions = int(u16(data, x))
+	x += 2 + instructions
+	if len(data) < x {
+		v.add("glyf", offset, "glyph %d has bad instructions", i)
+		return 0, 0, false
+	}
+	// Count the bytes of the points' flags and co-ordinates.
+	xLen, yLen := 0, 0
+	for p := 0; p < np; {
+		if len(data) <= x {
+			v.add("glyf", offset, "glyph %d has too few flags", i)
+			return 0, 0, false
+		}
+		c, repeat := data[x], 1
+		x++
+		if c&flagRepeat != 0 {
+			if len(data) <= x {
+				v.add("glyf", offset, "glyph %d has too few flags", i)
+				return 0, 0, false
+			}
+			repeat += int(data[x])
+			x++
+		}
+		if c&flagXShortVector != 0 {
+			xLen += repeat
+		} else if c&flagThisXIsSame == 0 {
+			xLen += 2 * repeat
+		}
+		if c&flagYShortVector != 0 {
+			yLen += repeat
+		} else if c&flagThisYIsSame == 0 {
+			yLen += 2 * repeat
+		}
+		p += repeat
+	}
+	if len(data) < x+xLen+yLen {
+		v.add("glyf", offset, "glyph %d has too few co-ordinates", i)
+		return 0, 0, false
+	}
+	return np, instructions, true
+}
+
+// checkComponents checks that no compound glyph, whose component glyphs are
+// given by components, refers to itself or is nested deeper than the maxp
+// table's maxComponentDepth.
+func (v *validator) checkComponents(f *Font, components map[int][]int) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[int]int)
+	depths := make(map[int]int)
+	// depth returns the nesting depth of glyph i, which is zero for a simple
+	// glyph, or -1 if it refers to itself.
+	var depth func(i int) int
+	depth = func(i int) int {
+		switch state[i] {
+		case visiting:
+			return -1
+		case visited:
+			return depths[i]
+		}
+		state[i] = visiting
+		d := 0
+		for _, c := range components[i] {
+			cd := depth(c)
+			if cd < 0 {
+				d = -1
+				break
+			}
+			if cd+1 > d {
+				d = cd + 1
+			}
+		}
+		state[i], depths[i] = visited, d
+		return d
+	}
+	for i := 0; i < f.nGlyph; i++ {
+		if components[i] == nil {
+			continue
+		}
+		switch d := depth(i); {
+		case d < 0:
+			v.add("glyf", 0, "glyph %d is recursive", i)
+		case f.maxComponentDepth != 0 && d > int(f.maxComponentDepth):
+			v.limit("maxComponentDepth")
+		}
+	}
+}
+
+// checkCmap checks each of the cmap table's subtables.
+func (v *validator) checkCmap(f *Font) {
+	if len(f.cmap) < 4 || len(f.cmap) < 4+8*int(u16(f.cmap, 2)) {
+		// Parse has already reported this.
+		return
+	}
+	// Many encoding records may share a subtable, which is checked once.
+	checked := make(map[uint32]bool)
+	for i, n := 0, int(u16(f.cmap, 2)); i < n; i++ {
+		x := 4 + 8*i
+		offset := u32(f.cmap, x+4)
+		// The format 14 subtable supplements another subtable.
+		if u32(f.cmap, x) != 0x00000005 && !checked[offset] {
+			checked[offset] = true
+			v.checkCmapSubtable(f, int(offset))
+		}
+	}
+}
+
+// maxCmapCodes is the number of codes, mapped by cmap subtables' glyph index
+// arrays, whose glyph indexes Validate checks. Subtables may overlap, so that
+// their arrays are not bounded by the size of the cmap table.
+const maxCmapCodes = 1 << 20
+
+// checkCmapSubtable checks the cmap subtable at the given offset.
+func (v *validator) checkCmapSubtable(f *Font, offset int) {
+	g := &Font{cmap: f.cmap}
+	if err := g.parseCmapSubtable(offset); err != nil {
+		if _, ok := err.(UnsupportedError); !ok {
+			v.findings = append(v.findings, err)
+		}
+		return
+	}
+	for j, cm := range g.cm {
+		if cm.end < cm.start || (j > 0 && cm.start <= g.cm[j-1].end) {
+			v.add("cmap", offset, "cmap subtable's segments are not sorted")
+			return
+		}
+		if cm.offset != 0 && int64(cm.offset)+2*int64(cm.end-cm.start)+2 > int64(len(g.cmapIndexes)) {
+			v.add("cmap", offset, "cmap subtable's glyph indexes are out of bounds")
+			return
+		}
+	}
+	for _, cm := range g.cm {
+		end := cm.end
+		if end > unicode.MaxRune {
+			end = unicode.MaxRune
+		}
+		if end < cm.start {
+			continue
+		}
+		switch {
+		case cm.many:
+			// Every code maps to the same glyph.
+			end = cm.start
+		case cm.offset == 0:
+			// The glyph indexes, c + cm.delta modulo 1<<16, increase from
+			// that of cm.start until they wrap around, so the first that is
+			// out of range, if any, can be calculated.
+			first := uint32(g.index(cm.start))
+			if first >= uint32(f.nGlyph) {
+				end = cm.start
+			} else if n := uint32(f.nGlyph) - first; end-cm.start >= n {
+				end = cm.start + n
+			}
+			if j := g.index(end); int(j) >= f.nGlyph {
+				v.add("cmap", offset, "cmap subtable maps %U to a glyph out of range: %d", end, j)
+				return
+			}
+			continue
+		}
+		for c := cm.start; c <= end; c++ {
+			if v.cmapCodes++; v.cmapCodes > maxCmapCodes {
+				return
+			}
+			if j := g.index(c); int(j) >= f.nGlyph {
+				v.add("cmap", offset, "cmap subtable maps %U to a glyph out of range: %d", c, j)
+				return
+			}
+		}
+	}
+}
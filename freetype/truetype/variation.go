@@ -0,0 +1,197 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// A VariationAxis is one of a variable font's design axes, such as its weight
+// or width. Its values are 16.16 fixed point numbers, in the axis's units,
+// such as 400<<16 for a regular weight.
+//
+// The fvar table that it comes from is documented at
+// https://www.microsoft.com/typography/otspec/fvar.htm
+type VariationAxis struct {
+	// Tag identifies the axis, such as "wght" or "wdth".
+	Tag               string
+	Min, Default, Max int32
+	// Hidden is whether the axis is not meant to be shown to users.
+	Hidden bool
+	// NameID and Name are the ID and string of the axis's name.
+	NameID NameID
+	Name   string
+}
+
+// A NamedInstance is a variable font's predefined set of axis values, such as
+// its "Bold Condensed" instance.
+type NamedInstance struct {
+	// SubfamilyNameID and Name are the ID and string of the instance's
+	// subfamily name.
+	SubfamilyNameID NameID
+	Name            string
+	// PostScriptNameID is the ID of the instance's PostScript name, or zero
+	// if it has none.
+	PostScriptNameID NameID
+	// Coords are the instance's axis values, in the order of the font's
+	// VariationAxes.
+	Coords []int32
+}
+
+// parseFvar parses the fvar table's axes and named instances. An invalid
+// table is ignored, and the font is treated as not variable.
+func (f *Font) parseFvar() {
+	f.axes, f.instances = nil, nil
+	b := f.fvar
+	if b == nil {
+		return
+	}
+	if len(b) < 16 || u16(b, 0) != 1 {
+		f.warn("truetype: ignoring invalid fvar table")
+		return
+	}
+	offset, nAxis, axisSize := int(u16(b, 4)), int(u16(b, 8)), int(u16(b, 10))
+	nInstance, instanceSize := int(u16(b, 12)), int(u16(b, 14))
+	if axisSize < 20 || instanceSize < 4+4*nAxis ||
+		len(b) < offset+nAxis*axisSize+nInstance*instanceSize {
+		f.warn("truetype: ignoring invalid fvar table")
+		return
+	}
+	axes := make([]VariationAxis, nAxis)
+	for i := range axes {
+		x := offset + i*axisSize
+		a := &axes[i]
+		a.Tag = string(b[x : x+4])
+		a.Min = int32(u32(b, x+4))
+		a.Default = int32(u32(b, x+8))
+		a.Max = int32(u32(b, x+12))
+		a.Hidden = u16(b, x+16)&1 != 0
+		a.NameID = NameID(u16(b, x+18))
+		a.Name = f.Name(a.NameID)
+		if a.Min > a.Default || a.Default > a.Max {
+			f.warn("truetype: ignoring invalid fvar table", "axis", a.Tag)
+			return
+		}
+	}
+	instances := make([]NamedInstance, nInstance)
+	for i := range instances {
+		x := offset + nAxis*axisSize + i*instanceSize
+		n := &instances[i]
+		n.SubfamilyNameID = NameID(u16(b, x))
+		n.Name = f.Name(n.SubfamilyNameID)
+		n.Coords = make([]int32, nAxis)
+		for j := range n.Coords {
+			n.Coords[j] = int32(u32(b, x+4+4*j))
+		}
+		// The PostScript name ID is optional, and 0xffff means none.
+		if instanceSize >= 6+4*nAxis {
+			if id := u16(b, x+4+4*nAxis); id != 0xffff {
+				n.PostScriptNameID = NameID(id)
+			}
+		}
+	}
+	f.axes, f.instances = axes, instances
+}
+
+// parseAvar parses the avar table's segment maps, which are pairs of
+// normalized co-ordinates before and after the mapping, for each of the fvar
+// table's axes. An invalid table is ignored, and no mapping is applied.
+func (f *Font) parseAvar() {
+	f.avarMaps = nil
+	b := f.avar
+	if b == nil || f.axes == nil {
+		return
+	}
+	if len(b) < 8 || u16(b, 0) != 1 || int(u16(b, 6)) != len(f.axes) {
+		f.warn("truetype: ignoring invalid avar table")
+		return
+	}
+	maps := make([][]int16, len(f.axes))
+	offset := 8
+	for i := range maps {
+		if len(b) < offset+2 {
+			f.warn("truetype: ignoring invalid avar table")
+			return
+		}
+		n := int(u16(b, offset))
+		offset += 2
+		if len(b) < offset+4*n {
+			f.warn("truetype: ignoring invalid avar table")
+			return
+		}
+		m := make([]int16, 2*n)
+		for j := range m {
+			m[j] = int16(u16(b, offset+2*j))
+		}
+		// The from co-ordinates must be in increasing order.
+		for j := 2; j < len(m); j += 2 {
+			if m[j] < m[j-2] {
+				f.warn("truetype: ignoring invalid avar table", "axis", f.axes[i].Tag)
+				return
+			}
+		}
+		maps[i] = m
+		offset += 4 * n
+	}
+	f.avarMaps = maps
+}
+
+// VariationAxes returns the design axes of a variable font, or nil if the
+// font is not variable.
+func (f *Font) VariationAxes() []VariationAxis {
+	return append([]VariationAxis(nil), f.axes...)
+}
+
+// NamedInstances returns the named instances of a variable font.
+func (f *Font) NamedInstances() []NamedInstance {
+	return append([]NamedInstance(nil), f.instances...)
+}
+
+// NormalizeVariation returns the normalized co-ordinates for the given axis
+// values, as 2.14 fixed point numbers from -1 to 1, where 0 is an axis's
+// default value. It applies the font's avar table, if it has one. coords are
+// in the order of the font's VariationAxes, and missing values are the axes'
+// defaults.
+func (f *Font) NormalizeVariation(coords []int32) []int16 {
+	n := make([]int16, len(f.axes))
+	for i, a := range f.axes {
+		if i >= len(coords) {
+			break
+		}
+		v, x := coords[i], int64(0)
+		if v < a.Min {
+			v = a.Min
+		} else if v > a.Max {
+			v = a.Max
+		}
+		// x is in 16.16 fixed point.
+		if v < a.Default {
+			x = -(int64(a.Default-v) << 16) / int64(a.Default-a.Min)
+		} else if v > a.Default {
+			x = (int64(v-a.Default) << 16) / int64(a.Max-a.Default)
+		}
+		// Convert to 2.14 fixed point, rounding to nearest.
+		n[i] = int16((x + 2) >> 2)
+		if i < len(f.avarMaps) {
+			n[i] = avarMap(f.avarMaps[i], n[i])
+		}
+	}
+	return n
+}
+
+// avarMap applies an avar segment map to the normalized co-ordinate x, by
+// linear interpolation between the map's pairs.
+func avarMap(m []int16, x int16) int16 {
+	if len(m) < 2 {
+		return x
+	}
+	if x <= m[0] {
+		return x - m[0] + m[1]
+	}
+	for j := 2; j < len(m); j += 2 {
+		if x < m[j] {
+			from0, to0, from1, to1 := int32(m[j-2]), int32(m[j-1]), int32(m[j]), int32(m[j+1])
+			return int16(to0 + ((int32(x)-from0)*(to1-to0)+(from1-from0)/2)/(from1-from0))
+		}
+	}
+	return x - m[len(m)-2] + m[len(m)-1]
+}
@@ -0,0 +1,63 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// VDMX returns the font's recorded maximum and minimum Y extents of its
+// hinted glyphs, from the Vertical Device Metrics (VDMX) table. Unlike
+// the linearly scaled hhea or head values, these are the exact extents after
+// grid-fitting, so a line box sized from them will not clip hinted glyphs.
+//
+// The VDMX table only records values for square pixels and whole numbers of
+// pixels per em, so scale must be a multiple of 64, the same as the bytecode
+// hinter assumes. The returned yMax and yMin are in the same units as scale.
+// ok is false if the font has no VDMX entry for the given scale.
+//
+// The table is documented at http://www.microsoft.com/typography/otspec/vdmx.htm
+func (f *Font) VDMX(scale int32) (yMax, yMin int32, ok bool) {
+	if scale <= 0 || scale&63 != 0 || len(f.vdmx) < 6 {
+		return 0, 0, false
+	}
+	ppem := scale >> 6
+	numRatios := int(u16(f.vdmx, 4))
+	if len(f.vdmx) < 6+6*numRatios {
+		return 0, 0, false
+	}
+	// Find the first ratio record that matches a 1:1 aspect ratio. An xRatio
+	// of zero is a catch-all record that matches every aspect ratio.
+	offset := -1
+	for i := 0; i < numRatios; i++ {
+		xRatio := f.vdmx[6+4*i+1]
+		yStartRatio := f.vdmx[6+4*i+2]
+		yEndRatio := f.vdmx[6+4*i+3]
+		if xRatio == 0 || (yStartRatio <= xRatio && xRatio <= yEndRatio) {
+			offset = int(u16(f.vdmx, 6+4*numRatios+2*i))
+			break
+		}
+	}
+	if offset < 0 || len(f.vdmx) < offset+4 {
+		return 0, 0, false
+	}
+	recs := int(u16(f.vdmx, offset))
+	startsz, endsz := int32(f.vdmx[offset+2]), int32(f.vdmx[offset+3])
+	if ppem < startsz || endsz < ppem || len(f.vdmx) < offset+4+6*recs {
+		return 0, 0, false
+	}
+	// The records are sorted by yPelHeight.
+	for lo, hi := 0, recs; lo < hi; {
+		i := (lo + hi) / 2
+		x := offset + 4 + 6*i
+		if h := int32(u16(f.vdmx, x)); h < ppem {
+			lo = i + 1
+		} else if h > ppem {
+			hi = i
+		} else {
+			yMax = int32(int16(u16(f.vdmx, x+2)))
+			yMin = int32(int16(u16(f.vdmx, x+4)))
+			return yMax << 6, yMin << 6, true
+		}
+	}
+	return 0, 0, false
+}
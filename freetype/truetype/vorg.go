@@ -0,0 +1,44 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+// VertOriginY returns the y coordinate of the vertical origin of the glyph
+// with the given index, as given by the font's VORG table. In vertical
+// layout, the glyph is positioned so that this point, horizontally centered,
+// is on the pen position. ok is false if the font has no valid VORG table,
+// which CFF fonts may have, in which case the vertical origin is the top of
+// the glyph's bounding box plus its top side bearing.
+//
+// The table is documented at http://www.microsoft.com/typography/otspec/vorg.htm
+func (f *Font) VertOriginY(scale int32, i Index) (y int32, ok bool) {
+	y, ok = f.unscaledVertOriginY(i)
+	return f.scale(scale * y), ok
+}
+
+// unscaledVertOriginY is like VertOriginY, but in FUnits.
+func (f *Font) unscaledVertOriginY(i Index) (y int32, ok bool) {
+	if len(f.vorg) < 8 || u16(f.vorg, 0) != 1 {
+		return 0, false
+	}
+	n := int(u16(f.vorg, 6))
+	if len(f.vorg) < 8+4*n {
+		return 0, false
+	}
+	// The metrics are sorted by glyph index.
+	lo, hi := 0, n
+	for lo < hi {
+		j := (lo + hi) / 2
+		g := Index(u16(f.vorg, 8+4*j))
+		if g < i {
+			lo = j + 1
+		} else if g > i {
+			hi = j
+		} else {
+			return int32(int16(u16(f.vorg, 8+4*j+2))), true
+		}
+	}
+	return int32(int16(u16(f.vorg, 4))), true
+}
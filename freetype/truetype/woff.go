@@ -0,0 +1,100 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+)
+
+// maxSFNTSize is the largest TTF data, in bytes, that WOFF and WOFF2 data may
+// decode to, so that small but highly compressed data cannot exhaust memory.
+const maxSFNTSize = 1 << 27
+
+// decodeWOFF returns the TTF data wrapped by the given WOFF 1.0 data. The
+// format is documented at http://www.w3.org/TR/WOFF/
+//
+// The WOFF's tables are decompressed, if they are compressed, and laid out
+// after a rebuilt table directory. Its extended metadata and private data
+// are dropped.
+func decodeWOFF(woff []byte) ([]byte, error) {
+	if len(woff) < 44 {
+		return nil, FormatError{Reason: "WOFF data is too short"}
+	}
+	flavor := u32(woff, 4)
+	n := int(u16(woff, 12))
+	if len(woff) < 44+20*n {
+		return nil, FormatError{Offset: 44, Reason: "WOFF data is too short"}
+	}
+	if n == 0 {
+		return nil, FormatError{Offset: 12, Reason: "WOFF has no tables"}
+	}
+	// Check the decoded size before decompressing any table.
+	size := uint64(12 + 16*n)
+	for i := 0; i < n; i++ {
+		size += (uint64(u32(woff, 44+20*i+12)) + 3) &^ 3
+	}
+	if size != uint64(u32(woff, 16)) {
+		return nil, FormatError{Offset: 16, Reason: "bad WOFF totalSfntSize"}
+	}
+	if size > maxSFNTSize {
+		return nil, UnsupportedError{Feature: fmt.Sprintf("WOFF data that decodes to %d bytes", size)}
+	}
+
+	// Write the offset table, with the search parameters for n tables.
+	searchRange, entrySelector := 16, 0
+	for searchRange*2 <= 16*n {
+		searchRange, entrySelector = searchRange*2, entrySelector+1
+	}
+	ttf := make([]byte, 12+16*n, size)
+	putU32(ttf, 0, flavor)
+	putU16(ttf, 4, uint16(n))
+	putU16(ttf, 6, uint16(searchRange))
+	putU16(ttf, 8, uint16(entrySelector))
+	putU16(ttf, 10, uint16(16*n-searchRange))
+
+	for i := 0; i < n; i++ {
+		e := woff[44+20*i:]
+		offset, compLength, origLength := u32(e, 4), u32(e, 8), u32(e, 12)
+		if uint64(offset)+uint64(compLength) > uint64(len(woff)) || compLength > origLength {
+			return nil, FormatError{Offset: 44 + 20*i, Reason: fmt.Sprintf("bad WOFF table %q", e[:4])}
+		}
+		data := woff[offset : offset+compLength]
+
+		// Copy the table's tag and checksum, and lay out its data at the
+		// next 4 byte boundary.
+		d := ttf[12+16*i:]
+		copy(d[:4], e[:4])
+		putU32(d, 4, u32(e, 16))
+		putU32(d, 8, uint32(len(ttf)))
+		putU32(d, 12, origLength)
+		if compLength == origLength {
+			ttf = append(ttf, data...)
+		} else {
+			r, err := zlib.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, FormatError{Offset: int(offset), Reason: fmt.Sprintf("WOFF table %q: %v", e[:4], err)}
+			}
+			// Read at most one byte more than expected, to detect tables
+			// that decompress to more than their stated length.
+			buf := bytes.NewBuffer(ttf)
+			m, err := io.Copy(buf, io.LimitReader(r, int64(origLength)+1))
+			if err != nil {
+				return nil, FormatError{Offset: int(offset), Reason: fmt.Sprintf("WOFF table %q: %v", e[:4], err)}
+			}
+			if m != int64(origLength) {
+				return nil, FormatError{Offset: int(offset), Reason: fmt.Sprintf("WOFF table %q: bad decompressed length", e[:4])}
+			}
+			ttf = buf.Bytes()
+		}
+		for len(ttf)%4 != 0 {
+			ttf = append(ttf, 0)
+		}
+	}
+	return ttf, nil
+}
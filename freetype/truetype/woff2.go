@@ -0,0 +1,548 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import "fmt"
+
+// woff2Tags are the tags of a WOFF2 table directory's known tables, by their
+// index in the low 6 bits of an entry's flags.
+var woff2Tags = [63]string{
+	"cmap", "head", "hhea", "hmtx", "maxp", "name", "OS/2", "post",
+	"cvt ", "fpgm", "glyf", "loca", "prep", "CFF ", "VORG", "EBDT",
+	"EBLC", "gasp", "hdmx", "kern", "LTSH", "PCLT", "VDMX", "vhea",
+	"vmtx", "BASE", "GDEF", "GPOS", "GSUB", "EBSC", "JSTF", "MATH",
+	"CBDT", "CBLC", "COLR", "CPAL", "SVG ", "sbix", "acnt", "avar",
+	"bdat", "bloc", "bsln", "cvar", "fdsc", "feat", "fmtx", "fvar",
+	"gvar", "hsty", "just", "lcar", "mort", "morx", "opbd", "prop",
+	"trak", "Zapf", "Silf", "Glat", "Gloc", "Feat", "Sill",
+}
+
+// decodeWOFF2 returns the TTF data wrapped by the given WOFF2 data. The
+// format is documented at http://www.w3.org/TR/WOFF2/
+//
+// The WOFF2's tables are decompressed and, if they were transformed, such as
+// the glyf, loca and hmtx tables typically are, reconstructed. The resultant
+// glyf table's glyphs are equivalent to, but not necessarily byte for byte
+// the same as, those of the original font. Collections are unsupported.
+func decodeWOFF2(woff []byte) ([]byte, error) {
+	if len(woff) < 48 {
+		return nil, FormatError{Reason: "WOFF2 data is too short"}
+	}
+	flavor := u32(woff, 4)
+	if flavor == 0x74746366 { // "ttcf" as a big-endian uint32.
+		return nil, UnsupportedError{Feature: "WOFF2 collection"}
+	}
+	n := int(u16(woff, 12))
+	if n == 0 {
+		return nil, FormatError{Offset: 12, Reason: "WOFF2 has no tables"}
+	}
+	compressedSize := u32(woff, 20)
+
+	// Read the table directory.
+	type woff2Table struct {
+		tag         string
+		transformed bool
+		length      uint32
+		// entry is the offset of the table's directory entry.
+		entry int
+	}
+	tables := make([]woff2Table, n)
+	offset, total := 48, uint64(0)
+	for i := range tables {
+		t := &tables[i]
+		t.entry = offset
+		if offset >= len(woff) {
+			return nil, FormatError{Offset: offset, Reason: "WOFF2 directory too short"}
+		}
+		flags := woff[offset]
+		offset++
+		if flags&0x3f == 0x3f {
+			if offset+4 > len(woff) {
+				return nil, FormatError{Offset: t.entry, Reason: "WOFF2 directory too short"}
+			}
+			t.tag = string(woff[offset : offset+4])
+			offset += 4
+		} else {
+			t.tag = woff2Tags[flags&0x3f]
+		}
+		// The glyf and loca tables' null transform is version 3. Other
+		// tables' is version 0, and hmtx also has a version 1 transform.
+		switch version := flags >> 6; {
+		case t.tag == "glyf" || t.tag == "loca":
+			if version != 0 && version != 3 {
+				return nil, UnsupportedError{Feature: fmt.Sprintf("WOFF2 %s transform %d", t.tag, version)}
+			}
+			t.transformed = version == 0
+		case version == 1 && t.tag == "hmtx":
+			t.transformed = true
+		case version != 0:
+			return nil, UnsupportedError{Feature: fmt.Sprintf("WOFF2 %s transform %d", t.tag, version)}
+		}
+		var err error
+		if t.length, offset, err = readUIntBase128(woff, offset); err != nil {
+			return nil, err
+		}
+		if t.transformed {
+			if t.length, offset, err = readUIntBase128(woff, offset); err != nil {
+				return nil, err
+			}
+		}
+		total += uint64(t.length)
+	}
+	if uint64(offset)+uint64(compressedSize) > uint64(len(woff)) {
+		return nil, FormatError{Offset: offset, Reason: "WOFF2 data is too short"}
+	}
+
+	if total > maxSFNTSize {
+		return nil, UnsupportedError{Feature: fmt.Sprintf("WOFF2 data whose tables decompress to %d bytes", total)}
+	}
+
+	// Decompress the tables, which are concatenated without padding.
+	data, err := decodeBrotli(woff[offset:offset+int(compressedSize)], int(total))
+	if err != nil {
+		return nil, addOffset(err, offset)
+	}
+	if uint64(len(data)) != total {
+		return nil, FormatError{Offset: offset, Reason: "WOFF2 tables have the wrong decompressed length"}
+	}
+
+	sfnt := make(map[string][]byte, n)
+	var hmtx, loca []byte
+	var xMins []int16
+	for _, t := range tables {
+		if _, ok := sfnt[t.tag]; ok {
+			return nil, FormatError{Offset: t.entry, Reason: fmt.Sprintf("WOFF2 has duplicate %q tables", t.tag)}
+		}
+		b := data[:t.length]
+		data = data[t.length:]
+		switch {
+		case !t.transformed:
+			sfnt[t.tag] = b
+		case t.tag == "glyf":
+			var err error
+			if sfnt[t.tag], loca, xMins, err = reconstructGlyf(b); err != nil {
+				return nil, err
+			}
+		case t.tag == "loca":
+			// The loca table is reconstructed along with the glyf table.
+			if len(b) != 0 || loca == nil {
+				return nil, FormatError{Offset: t.entry, Reason: "bad WOFF2 loca transform"}
+			}
+			sfnt[t.tag] = loca
+		case t.tag == "hmtx":
+			// The hmtx table is reconstructed after the glyf table, whose
+			// bounding boxes it may need.
+			sfnt[t.tag], hmtx = nil, b
+		}
+	}
+	if hmtx != nil {
+		hhea, maxp := sfnt["hhea"], sfnt["maxp"]
+		if len(hhea) < 36 || len(maxp) < 6 {
+			return nil, FormatError{Table: "hmtx", Reason: "WOFF2 hmtx transform without hhea or maxp"}
+		}
+		var err error
+		sfnt["hmtx"], err = reconstructHmtx(hmtx, int(u16(maxp, 4)), int(u16(hhea, 34)), xMins)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return writeSFNT(flavor, sfnt), nil
+}
+
+// readUIntBase128 reads the UIntBase128 at b[offset:]. It returns the offset
+// just past it.
+func readUIntBase128(b []byte, offset int) (v uint32, next int, err error) {
+	for i := 0; i < 5; i++ {
+		if offset >= len(b) {
+			return 0, 0, FormatError{Offset: offset, Reason: "WOFF2 directory too short"}
+		}
+		c := b[offset]
+		offset++
+		// Leading zeroes and values that overflow are invalid.
+		if (i == 0 && c == 0x80) || v&0xfe000000 != 0 {
+			return 0, 0, FormatError{Offset: offset - 1, Reason: "bad WOFF2 UIntBase128"}
+		}
+		v = v<<7 | uint32(c&0x7f)
+		if c&0x80 == 0 {
+			return v, offset, nil
+		}
+	}
+	return 0, 0, FormatError{Offset: offset - 5, Reason: "bad WOFF2 UIntBase128"}
+}
+
+// appendUIntBase128 appends v as a WOFF2 UIntBase128 to b.
+func appendUIntBase128(b []byte, v int) []byte {
+	n := 1
+	for v>>uint(7*n) != 0 {
+		n++
+	}
+	for i := n - 1; i > 0; i-- {
+		b = append(b, byte(v>>uint(7*i))|0x80)
+	}
+	return append(b, byte(v&0x7f))
+}
+
+// writeWOFF2 returns the WOFF2 encoding of the TTF data written by writeSFNT.
+// The tables are Brotli compressed, but not transformed.
+func writeWOFF2(ttf []byte) []byte {
+	var dir, data []byte
+	n := int(u16(ttf, 4))
+	version := [2]uint16{1, 0}
+	for i := 0; i < n; i++ {
+		d := ttf[12+16*i:]
+		tag := string(d[:4])
+		table := ttf[u32(d, 8) : u32(d, 8)+u32(d, 12)]
+		flags := byte(0x3f)
+		for j, t := range woff2Tags {
+			if t == tag {
+				flags = byte(j)
+				break
+			}
+		}
+		if tag == "glyf" || tag == "loca" {
+			// The glyf and loca tables' null transform is version 3.
+			flags |= 0xc0
+		}
+		if tag == "head" && len(table) >= 8 {
+			// The WOFF2 version is the font's revision.
+			version = [2]uint16{u16(table, 4), u16(table, 6)}
+		}
+		dir = append(dir, flags)
+		if flags&0x3f == 0x3f {
+			dir = append(dir, tag...)
+		}
+		dir = appendUIntBase128(dir, len(table))
+		data = append(data, table...)
+	}
+	data = encodeBrotli(data)
+
+	woff := make([]byte, 48, 48+len(dir)+len(data)+3)
+	copy(woff, "wOF2")
+	copy(woff[4:], ttf[:4])
+	putU16(woff, 12, uint16(n))
+	putU32(woff, 16, uint32(len(ttf)))
+	putU32(woff, 20, uint32(len(data)))
+	putU16(woff, 24, version[0])
+	putU16(woff, 26, version[1])
+	woff = append(append(woff, dir...), data...)
+	for len(woff)%4 != 0 {
+		woff = append(woff, 0)
+	}
+	putU32(woff, 8, uint32(len(woff)))
+	return woff
+}
+
+// woff2Stream is one of the streams of a transformed glyf table. Reading past
+// its end sets short, and returns zeroes.
+type woff2Stream struct {
+	b     []byte
+	short bool
+}
+
+func (s *woff2Stream) read(n int) []byte {
+	if len(s.b) < n {
+		s.short = true
+		return make([]byte, n)
+	}
+	b := s.b[:n]
+	s.b = s.b[n:]
+	return b
+}
+
+func (s *woff2Stream) u8() int {
+	return int(s.read(1)[0])
+}
+
+func (s *woff2Stream) u16() uint16 {
+	return u16(s.read(2), 0)
+}
+
+// u255 reads a 255UInt16.
+func (s *woff2Stream) u255() int {
+	switch c := s.u8(); c {
+	case 253:
+		return int(s.u16())
+	case 254:
+		return 2*253 + s.u8()
+	case 255:
+		return 253 + s.u8()
+	default:
+		return c
+	}
+}
+
+// reconstructGlyf returns the glyf and loca tables for a transformed glyf
+// table, and the glyphs' xMin values. A FormatError's Offset is relative to
+// the transformed glyf table.
+func reconstructGlyf(b []byte) (glyf, loca []byte, xMins []int16, err error) {
+	if len(b) < 36 {
+		return nil, nil, nil, FormatError{Table: "glyf", Reason: "WOFF2 glyf transform too short"}
+	}
+	optionFlags, numGlyphs, indexFormat := u16(b, 2), int(u16(b, 4)), u16(b, 6)
+	var streams [7]woff2Stream
+	offset := 36
+	for i := range streams {
+		n := int(u32(b, 8+4*i))
+		if n < 0 || len(b)-offset < n {
+			return nil, nil, nil, FormatError{Table: "glyf", Offset: 8 + 4*i, Reason: "WOFF2 glyf transform too short"}
+		}
+		streams[i].b = b[offset : offset+n]
+		offset += n
+	}
+	nContour, nPoints, flagStream, glyphStream := &streams[0], &streams[1], &streams[2], &streams[3]
+	compositeStream, bboxStream, instructionStream := &streams[4], &streams[5], &streams[6]
+	bboxBitmap := bboxStream.read(4 * ((numGlyphs + 31) / 32))
+	var overlapBitmap []byte
+	if optionFlags&1 != 0 {
+		n := (numGlyphs + 7) / 8
+		if len(b)-offset < n {
+			return nil, nil, nil, FormatError{Table: "glyf", Offset: offset, Reason: "WOFF2 glyf transform too short"}
+		}
+		overlapBitmap = b[offset : offset+n]
+	}
+
+	offsets := make([]int, numGlyphs+1)
+	xMins = make([]int16, numGlyphs)
+	var xs, ys []int32
+	var on []bool
+	for i := 0; i < numGlyphs; i++ {
+		offsets[i] = len(glyf)
+		bit := byte(0x80 >> uint(i&7))
+		explicitBounds := bboxBitmap[i>>3]&bit != 0
+		switch nc := int16(nContour.u16()); {
+		case nc == 0:
+			if explicitBounds {
+				return nil, nil, nil, FormatError{Table: "glyf", Reason: "WOFF2 empty glyph with bounds"}
+			}
+
+		case nc < 0:
+			// Copy the composite glyph's components, and find whether it
+			// has instructions.
+			if !explicitBounds {
+				return nil, nil, nil, FormatError{Table: "glyf", Reason: "WOFF2 composite glyph without bounds"}
+			}
+			glyf = appendU16(glyf, 0xffff)
+			glyf = append(glyf, bboxStream.read(8)...)
+			// Flags for decoding a compound glyph. These flags are
+			// documented at
+			// http://developer.apple.com/fonts/TTRefMan/RM06/Chap6glyf.html.
+			const (
+				flagArg1And2AreWords   = 1 << 0
+				flagWeHaveAScale       = 1 << 3
+				flagMoreComponents     = 1 << 5
+				flagWeHaveAnXAndYScale = 1 << 6
+				flagWeHaveATwoByTwo    = 1 << 7
+				flagWeHaveInstructions = 1 << 8
+			)
+			instructions := false
+			for more := true; more; {
+				// Each component has flags, a glyph index, two arguments
+				// and an optional transform.
+				flags := compositeStream.u16()
+				n := 6
+				if flags&flagArg1And2AreWords != 0 {
+					n += 2
+				}
+				switch {
+				case flags&flagWeHaveAScale != 0:
+					n += 2
+				case flags&flagWeHaveAnXAndYScale != 0:
+					n += 4
+				case flags&flagWeHaveATwoByTwo != 0:
+					n += 8
+				}
+				glyf = appendU16(glyf, flags)
+				glyf = append(glyf, compositeStream.read(n-2)...)
+				instructions = instructions || flags&flagWeHaveInstructions != 0
+				more = flags&flagMoreComponents != 0 && !compositeStream.short
+			}
+			if instructions {
+				n := glyphStream.u255()
+				glyf = appendU16(glyf, uint16(n))
+				glyf = append(glyf, instructionStream.read(n)...)
+			}
+
+		default:
+			// Decode the simple glyph's contours, whose points are encoded
+			// as a flag and a variable length triplet of bytes.
+			ends := make([]int, nc)
+			np := 0
+			for j := range ends {
+				np += nPoints.u255()
+				ends[j] = np - 1
+			}
+			if np > 0xffff {
+				return nil, nil, nil, FormatError{Table: "glyf", Reason: "WOFF2 glyph has too many points"}
+			}
+			xs, ys, on = xs[:0], ys[:0], on[:0]
+			x, y := int32(0), int32(0)
+			for _, f := range flagStream.read(np) {
+				dx, dy := woff2Triplet(int(f&0x7f), glyphStream)
+				x, y = x+dx, y+dy
+				xs, ys, on = append(xs, x), append(ys, y), append(on, f&0x80 == 0)
+			}
+			n := glyphStream.u255()
+			program := instructionStream.read(n)
+
+			glyf = appendU16(glyf, uint16(nc))
+			if explicitBounds {
+				glyf = append(glyf, bboxStream.read(8)...)
+			} else {
+				var bounds Bounds
+				for j := range xs {
+					if j == 0 || bounds.XMin > xs[j] {
+						bounds.XMin = xs[j]
+					}
+					if j == 0 || bounds.YMin > ys[j] {
+						bounds.YMin = ys[j]
+					}
+					if j == 0 || bounds.XMax < xs[j] {
+						bounds.XMax = xs[j]
+					}
+					if j == 0 || bounds.YMax < ys[j] {
+						bounds.YMax = ys[j]
+					}
+				}
+				glyf = appendU16(glyf, uint16(bounds.XMin))
+				glyf = appendU16(glyf, uint16(bounds.YMin))
+				glyf = appendU16(glyf, uint16(bounds.XMax))
+				glyf = appendU16(glyf, uint16(bounds.YMax))
+			}
+			for _, e := range ends {
+				glyf = appendU16(glyf, uint16(e))
+			}
+			glyf = appendU16(glyf, uint16(n))
+			glyf = append(glyf, program...)
+			glyf = appendSimpleCoordinates(glyf, xs, ys, on, overlapBitmap != nil && overlapBitmap[i>>3]&bit != 0)
+		}
+		for _, s := range streams {
+			if s.short {
+				return nil, nil, nil, FormatError{Table: "glyf", Reason: "WOFF2 glyf transform too short"}
+			}
+		}
+		if len(glyf) > offsets[i] {
+			xMins[i] = int16(u16(glyf, offsets[i]+2))
+		}
+		for len(glyf)%4 != 0 {
+			glyf = append(glyf, 0)
+		}
+	}
+	offsets[numGlyphs] = len(glyf)
+
+	for _, o := range offsets {
+		if indexFormat == 0 {
+			if o > 2*0xffff {
+				return nil, nil, nil, FormatError{Table: "glyf", Reason: "WOFF2 glyf too long for short loca offsets"}
+			}
+			loca = appendU16(loca, uint16(o/2))
+		} else {
+			loca = appendU32(loca, uint32(o))
+		}
+	}
+	return glyf, loca, xMins, nil
+}
+
+// woff2Triplet decodes a point's x and y deltas, whose encoding depends on
+// the flag, from the glyph stream. The encodings are given by a table in the
+// WOFF2 specification, which this follows.
+func woff2Triplet(f int, s *woff2Stream) (dx, dy int32) {
+	withSign := func(flag, v int) int32 {
+		if flag&1 != 0 {
+			return int32(v)
+		}
+		return -int32(v)
+	}
+	switch {
+	case f < 10:
+		return 0, withSign(f, (f&14)<<7+s.u8())
+	case f < 20:
+		return withSign(f, ((f-10)&14)<<7+s.u8()), 0
+	case f < 84:
+		b0, b1 := f-20, s.u8()
+		return withSign(f, 1+(b0&0x30)+b1>>4), withSign(f>>1, 1+(b0&0x0c)<<2+b1&0x0f)
+	case f < 120:
+		b0 := f - 84
+		b := s.read(2)
+		return withSign(f, 1+(b0/12)<<8+int(b[0])), withSign(f>>1, 1+((b0%12)>>2)<<8+int(b[1]))
+	case f < 124:
+		b := s.read(3)
+		return withSign(f, int(b[0])<<4+int(b[1])>>4), withSign(f>>1, int(b[1]&0x0f)<<8+int(b[2]))
+	default:
+		b := s.read(4)
+		return withSign(f, int(u16(b, 0))), withSign(f>>1, int(u16(b, 2)))
+	}
+}
+
+// appendSimpleCoordinates appends a simple glyph's flags and co-ordinates,
+// in the glyf table's encoding, to b. overlap is whether to set the first
+// flag's OVERLAP_SIMPLE bit.
+func appendSimpleCoordinates(b []byte, xs, ys []int32, on []bool, overlap bool) []byte {
+	flags := make([]byte, len(xs))
+	var xb, yb []byte
+	encode := func(d int32, short, same byte, data []byte) (flag byte, _ []byte) {
+		switch {
+		case d == 0:
+			return same, data
+		case -0xff <= d && d < 0:
+			return short, append(data, byte(-d))
+		case 0 < d && d <= 0xff:
+			return short | same, append(data, byte(d))
+		}
+		return 0, appendU16(data, uint16(d))
+	}
+	x, y := int32(0), int32(0)
+	for j := range xs {
+		var fx, fy byte
+		fx, xb = encode(xs[j]-x, flagXShortVector, flagThisXIsSame, xb)
+		fy, yb = encode(ys[j]-y, flagYShortVector, flagThisYIsSame, yb)
+		flags[j] = fx | fy
+		if on[j] {
+			flags[j] |= flagOnCurve
+		}
+		x, y = xs[j], ys[j]
+	}
+	if overlap && len(flags) > 0 {
+		flags[0] |= 0x40
+	}
+	b = append(b, flags...)
+	b = append(b, xb...)
+	return append(b, yb...)
+}
+
+// reconstructHmtx returns the hmtx table for a transformed hmtx table, whose
+// left side bearings may be omitted when they equal the glyphs' xMin values.
+func reconstructHmtx(b []byte, numGlyphs, numHMetrics int, xMins []int16) ([]byte, error) {
+	if len(b) < 1 || b[0]&0xfc != 0 || numHMetrics < 1 || numHMetrics > numGlyphs {
+		return nil, FormatError{Table: "hmtx", Reason: "bad WOFF2 hmtx transform"}
+	}
+	flags := b[0]
+	if flags&3 != 0 && len(xMins) != numGlyphs {
+		return nil, FormatError{Table: "hmtx", Reason: "WOFF2 hmtx transform without a glyf transform"}
+	}
+	s := &woff2Stream{b: b[1:]}
+	advances := s.read(2 * numHMetrics)
+	lsbs := func(lo, hi int, omitted bool) []byte {
+		if !omitted {
+			return s.read(2 * (hi - lo))
+		}
+		var l []byte
+		for _, x := range xMins[lo:hi] {
+			l = appendU16(l, uint16(x))
+		}
+		return l
+	}
+	proportional := lsbs(0, numHMetrics, flags&1 != 0)
+	monospaced := lsbs(numHMetrics, numGlyphs, flags&2 != 0)
+	if s.short || len(s.b) != 0 {
+		return nil, FormatError{Table: "hmtx", Reason: "bad WOFF2 hmtx transform length"}
+	}
+	hmtx := make([]byte, 0, 4*numHMetrics+len(monospaced))
+	for i := 0; i < numHMetrics; i++ {
+		hmtx = append(hmtx, advances[2*i:2*i+2]...)
+		hmtx = append(hmtx, proportional[2*i:2*i+2]...)
+	}
+	return append(hmtx, monospaced...), nil
+}
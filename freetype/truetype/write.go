@@ -0,0 +1,204 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package truetype
+
+import (
+	"sort"
+	"unicode/utf16"
+)
+
+// WriteOptions are the changes to make to a font written by Font.Write. A nil
+// *WriteOptions means to write the font's tables unchanged.
+type WriteOptions struct {
+	// Tables adds or replaces tables, keyed by tag, such as tables merged
+	// from another font. A nil table removes the font's table with that tag.
+	// They are applied after the other options.
+	Tables map[string][]byte
+	// Names replaces the name table's strings with the given IDs, with a
+	// single Windows US English record. An empty string removes the ID's
+	// records.
+	Names map[NameID]string
+	// StripHinting removes the font's TrueType hinting: its cvt, fpgm, prep,
+	// hdmx, LTSH and VDMX tables, and its glyphs' instructions.
+	StripHinting bool
+	// WOFF2 writes WOFF2 data instead of TTF data. The tables are Brotli
+	// compressed, but are not transformed, so the data is larger than that
+	// of WOFF2 encoders that transform the glyf, loca and hmtx tables.
+	WOFF2 bool
+}
+
+// Write returns the font, with the given changes, as TTF or WOFF2 data. The
+// tables are padded to a multiple of four bytes, and their checksums and the
+// head table's checksum adjustment are recomputed. A font parsed by
+// ParseIncremental or ParseReaderAt cannot be written.
+func (f *Font) Write(o *WriteOptions) ([]byte, error) {
+	if f.src != nil {
+		return nil, UnsupportedError{Feature: "writing an incremental font"}
+	}
+	if o == nil {
+		o = &WriteOptions{}
+	}
+	tables := make(map[string][]byte, len(f.tables))
+	for tag, t := range f.tables {
+		tables[tag] = t
+	}
+	if o.StripHinting {
+		for _, tag := range []string{"cvt ", "fpgm", "prep", "hdmx", "LTSH", "VDMX"} {
+			delete(tables, tag)
+		}
+		if f.glyf != nil {
+			glyf, loca, err := f.stripInstructions()
+			if err != nil {
+				return nil, err
+			}
+			tables["glyf"], tables["loca"] = glyf, loca
+			head := append([]byte(nil), f.head...)
+			putU16(head, 50, 1)
+			tables["head"] = head
+		}
+		if len(f.maxp) >= 32 {
+			// Zero maxSizeOfInstructions.
+			maxp := append([]byte(nil), f.maxp...)
+			putU16(maxp, 26, 0)
+			tables["maxp"] = maxp
+		}
+	}
+	if len(o.Names) != 0 {
+		tables["name"] = f.editNames(o.Names)
+	}
+	for tag, t := range o.Tables {
+		if t == nil {
+			delete(tables, tag)
+		} else {
+			tables[tag] = t
+		}
+	}
+	version := uint32(0x00010000)
+	if tables["glyf"] == nil && tables["CFF "] != nil {
+		version = 0x4f54544f // "OTTO" as a big-endian uint32.
+	}
+	ttf := writeSFNT(version, tables)
+	if o.WOFF2 {
+		return writeWOFF2(ttf), nil
+	}
+	return ttf, nil
+}
+
+// stripInstructions returns the font's glyf and loca tables without its
+// glyphs' instructions. The loca table is in the long format.
+func (f *Font) stripInstructions() (glyf, loca []byte, err error) {
+	const flagWeHaveInstructions = 1 << 8
+	for i := 0; i < f.nGlyph; i++ {
+		offset, length, ok := f.GlyphOffset(Index(i))
+		if !ok {
+			return nil, nil, f.locaError(Index(i))
+		}
+		data := f.glyf[offset : offset+length]
+		loca = appendU32(loca, uint32(len(glyf)))
+		start := len(glyf)
+		switch {
+		case len(data) < 10:
+			// A glyph with no contours has no instructions.
+		case int16(u16(data, 0)) >= 0:
+			x := 10 + 2*int(u16(data, 0))
+			if len(data) < x+2 || len(data) < x+2+int(u16(data, x)) {
+				return nil, nil, f.glyfError(Index(i), "bad simple glyph")
+			}
+			glyf = append(glyf, data[:x]...)
+			glyf = append(glyf, 0, 0)
+			glyf = append(glyf, data[x+2+int(u16(data, x)):]...)
+		default:
+			glyf = append(glyf, data...)
+			g := glyf[start:]
+			end, err := walkComponents(g, func(x int) {
+				putU16(g, x-2, u16(g, x-2)&^flagWeHaveInstructions)
+			})
+			if err != nil {
+				return nil, nil, f.glyfError(Index(i), "bad compound glyph")
+			}
+			glyf = glyf[:start+end]
+		}
+		for len(glyf)%4 != 0 {
+			glyf = append(glyf, 0)
+		}
+	}
+	loca = appendU32(loca, uint32(len(glyf)))
+	return glyf, loca, nil
+}
+
+// nameRecord is a record of a name table.
+type nameRecord struct {
+	platformID, encodingID, languageID uint16
+	id                                 NameID
+	value                              []byte
+}
+
+// editNames returns the font's name table, with the strings with the given
+// IDs replaced. The table is in format 0, with its records sorted as the
+// specification requires.
+func (f *Font) editNames(names map[NameID]string) []byte {
+	var records []nameRecord
+	if len(f.name) >= 6 {
+		n, storage := int(u16(f.name, 2)), int(u16(f.name, 4))
+		for i, x := 0, 6; i < n && x+12 <= len(f.name); i, x = i+1, x+12 {
+			id := NameID(u16(f.name, x+6))
+			length, offset := int(u16(f.name, x+8)), storage+int(u16(f.name, x+10))
+			if _, ok := names[id]; ok || offset+length > len(f.name) {
+				continue
+			}
+			records = append(records, nameRecord{
+				u16(f.name, x), u16(f.name, x+2), u16(f.name, x+4), id,
+				f.name[offset : offset+length],
+			})
+		}
+	}
+	for id, s := range names {
+		if s == "" {
+			continue
+		}
+		var value []byte
+		for _, u := range utf16.Encode([]rune(s)) {
+			value = appendU16(value, u)
+		}
+		// Platform 3, encoding 1 and language 0x0409 are Windows, Unicode
+		// BMP and US English.
+		records = append(records, nameRecord{3, 1, 0x0409, id, value})
+	}
+	sort.Sort(nameRecordSlice(records))
+
+	b := appendU16(nil, 0)
+	b = appendU16(b, uint16(len(records)))
+	b = appendU16(b, uint16(6+12*len(records)))
+	var storage []byte
+	for _, r := range records {
+		b = appendU16(b, r.platformID)
+		b = appendU16(b, r.encodingID)
+		b = appendU16(b, r.languageID)
+		b = appendU16(b, uint16(r.id))
+		b = appendU16(b, uint16(len(r.value)))
+		b = appendU16(b, uint16(len(storage)))
+		storage = append(storage, r.value...)
+	}
+	return append(b, storage...)
+}
+
+type nameRecordSlice []nameRecord
+
+func (s nameRecordSlice) Len() int { return len(s) }
+func (s nameRecordSlice) Less(i, j int) bool {
+	a, b := s[i], s[j]
+	if a.platformID != b.platformID {
+		return a.platformID < b.platformID
+	}
+	if a.encodingID != b.encodingID {
+		return a.encodingID < b.encodingID
+	}
+	if a.languageID != b.languageID {
+		return a.languageID < b.languageID
+	}
+	return a.id < b.id
+}
+func (s nameRecordSlice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,268 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+// Package winfnt provides a parser for legacy Windows raster fonts, in the
+// FNT and FON file formats. Those formats are documented at
+// https://support.microsoft.com/en-us/kb/65123
+//
+// A FNT file holds a single bitmap font at a single size. A FON file is a 16
+// bit Windows executable (NE) whose resources are a number of FNT fonts,
+// typically of the same face at different sizes. Vector FNT fonts are not
+// supported.
+package winfnt
+
+import (
+	"image"
+	"image/draw"
+)
+
+// A FormatError reports that the input is not a valid Windows font.
+type FormatError string
+
+func (e FormatError) Error() string {
+	return "freetype: invalid Windows font format: " + string(e)
+}
+
+// An UnsupportedError reports that the input uses a valid but unimplemented
+// Windows font feature.
+type UnsupportedError string
+
+func (e UnsupportedError) Error() string {
+	return "freetype: unsupported Windows font feature: " + string(e)
+}
+
+func u16(b []byte, i int) uint16 {
+	return uint16(b[i]) | uint16(b[i+1])<<8
+}
+
+func u32(b []byte, i int) uint32 {
+	return uint32(b[i]) | uint32(b[i+1])<<8 | uint32(b[i+2])<<16 | uint32(b[i+3])<<24
+}
+
+// A glyph is the location of a character's bitmap in a Font's data.
+type glyph struct {
+	width  int
+	offset int
+}
+
+// A Font represents a Windows raster font.
+type Font struct {
+	// Face is the font's face name, such as "Courier".
+	Face string
+	// Points is the nominal point size, at the resolution given by
+	// HorizRes and VertRes, in dots per inch.
+	Points, HorizRes, VertRes int
+	// Ascent is the distance, in pixels, from the top of a character's
+	// bitmap to the baseline. Height is the height of every character's
+	// bitmap. InternalLeading and ExternalLeading are the font's leading,
+	// in pixels, inside and outside of Height.
+	Ascent, Height, InternalLeading, ExternalLeading int
+	// Italic, Underline and StrikeOut are the font's style flags, and
+	// Weight is its weight in the range [1, 1000], where 400 is normal.
+	Italic, Underline, StrikeOut bool
+	Weight                       int
+	// Charset is the Windows character set of the font's characters.
+	Charset int
+	// FirstChar and LastChar are the range of characters in the font, and
+	// DefaultChar is the character to draw for characters outside of that
+	// range.
+	FirstChar, LastChar, DefaultChar byte
+
+	data   []byte
+	glyphs []glyph
+}
+
+// Parse returns a Font for the given FNT data.
+func Parse(fnt []byte) (*Font, error) {
+	if len(fnt) < 118 {
+		return nil, FormatError("FNT data is too short")
+	}
+	version := u16(fnt, 0)
+	if version != 0x200 && version != 0x300 {
+		return nil, UnsupportedError("FNT version")
+	}
+	if u16(fnt, 66)&1 != 0 {
+		return nil, UnsupportedError("vector font")
+	}
+	f := &Font{
+		Points:          int(u16(fnt, 68)),
+		VertRes:         int(u16(fnt, 70)),
+		HorizRes:        int(u16(fnt, 72)),
+		Ascent:          int(u16(fnt, 74)),
+		InternalLeading: int(u16(fnt, 76)),
+		ExternalLeading: int(u16(fnt, 78)),
+		Italic:          fnt[80] != 0,
+		Underline:       fnt[81] != 0,
+		StrikeOut:       fnt[82] != 0,
+		Weight:          int(u16(fnt, 83)),
+		Charset:         int(fnt[85]),
+		Height:          int(u16(fnt, 88)),
+		FirstChar:       fnt[95],
+		LastChar:        fnt[96],
+		DefaultChar:     fnt[97],
+		data:            fnt,
+	}
+	if f.FirstChar > f.LastChar {
+		return nil, FormatError("bad character range")
+	}
+	// DefaultChar is relative to FirstChar.
+	f.DefaultChar += f.FirstChar
+
+	// The character table has one more entry than the number of characters.
+	// In version 2, each entry is a 16-bit width and 16-bit offset. In
+	// version 3, the offset is 32 bits.
+	n := int(f.LastChar) - int(f.FirstChar) + 2
+	offset, entrySize := 118, 4
+	if version == 0x300 {
+		offset, entrySize = 148, 6
+	}
+	if len(fnt) < offset+n*entrySize {
+		return nil, FormatError("character table is too short")
+	}
+	f.glyphs = make([]glyph, n)
+	for i := range f.glyphs {
+		x := offset + i*entrySize
+		g := glyph{width: int(u16(fnt, x+0))}
+		if version == 0x300 {
+			g.offset = int(u32(fnt, x+2))
+		} else {
+			g.offset = int(u16(fnt, x+2))
+		}
+		// Each glyph is stored as columns of bytes, each column Height bytes
+		// tall and 8 pixels wide.
+		if g.offset < 0 || len(fnt) < g.offset+(g.width+7)/8*f.Height {
+			return nil, FormatError("bad glyph offset")
+		}
+		f.glyphs[i] = g
+	}
+
+	if face := int(u32(fnt, 105)); face != 0 {
+		if face < 0 || face >= len(fnt) {
+			return nil, FormatError("bad face name offset")
+		}
+		end := face
+		for end < len(fnt) && fnt[end] != 0 {
+			end++
+		}
+		f.Face = string(fnt[face:end])
+	}
+	return f, nil
+}
+
+// ParseFON returns the Fonts in the given FON data, a 16 bit Windows
+// executable whose resources include FNT fonts.
+func ParseFON(fon []byte) ([]*Font, error) {
+	const rtFont = 0x8008
+
+	if len(fon) < 64 || fon[0] != 'M' || fon[1] != 'Z' {
+		return nil, FormatError("bad executable header")
+	}
+	ne := int(u32(fon, 60))
+	if ne < 0 || len(fon) < ne+64 {
+		return nil, FormatError("bad NE header offset")
+	}
+	if fon[ne] == 'P' && fon[ne+1] == 'E' {
+		return nil, UnsupportedError("32 bit (PE) executable")
+	}
+	if fon[ne] != 'N' || fon[ne+1] != 'E' {
+		return nil, FormatError("bad NE header")
+	}
+	x := ne + int(u16(fon, ne+36))
+	if len(fon) < x+2 {
+		return nil, FormatError("bad resource table offset")
+	}
+	shift := uint(u16(fon, x))
+	if shift > 16 {
+		return nil, FormatError("bad resource alignment")
+	}
+	x += 2
+
+	var fonts []*Font
+	for {
+		if len(fon) < x+2 {
+			return nil, FormatError("resource table is too short")
+		}
+		typeID := u16(fon, x)
+		if typeID == 0 {
+			break
+		}
+		if len(fon) < x+8 {
+			return nil, FormatError("resource table is too short")
+		}
+		count := int(u16(fon, x+2))
+		x += 8
+		if len(fon) < x+12*count {
+			return nil, FormatError("resource table is too short")
+		}
+		for i := 0; i < count; i++ {
+			if typeID == rtFont {
+				offset := int(u16(fon, x)) << shift
+				length := int(u16(fon, x+2)) << shift
+				if len(fon) < offset+length {
+					return nil, FormatError("bad resource offset")
+				}
+				f, err := Parse(fon[offset : offset+length])
+				if err != nil {
+					return nil, err
+				}
+				fonts = append(fonts, f)
+			}
+			x += 12
+		}
+	}
+	if len(fonts) == 0 {
+		return nil, FormatError("no fonts")
+	}
+	return fonts, nil
+}
+
+// glyph returns the glyph for the given character, or for DefaultChar if the
+// font does not contain that character.
+func (f *Font) glyph(c byte) glyph {
+	if c < f.FirstChar || f.LastChar < c {
+		c = f.DefaultChar
+		if c < f.FirstChar || f.LastChar < c {
+			return glyph{}
+		}
+	}
+	return f.glyphs[c-f.FirstChar]
+}
+
+// Advance returns the advance width, in pixels, of the given character.
+func (f *Font) Advance(c byte) int {
+	return f.glyph(c).width
+}
+
+// Mask returns the given character's bitmap as an image whose bounds are
+// Advance(c) pixels wide and Height pixels tall, with the top-left at the
+// origin. The baseline is at y = Ascent.
+func (f *Font) Mask(c byte) *image.Alpha {
+	g := f.glyph(c)
+	m := image.NewAlpha(image.Rect(0, 0, g.width, f.Height))
+	for x := 0; x < g.width; x++ {
+		col := f.data[g.offset+x/8*f.Height:]
+		bit := byte(0x80) >> uint(x%8)
+		for y := 0; y < f.Height; y++ {
+			if col[y]&bit != 0 {
+				m.Pix[y*m.Stride+x] = 0xff
+			}
+		}
+	}
+	return m
+}
+
+// DrawString draws s onto dst with the given source image, such as an
+// image.Uniform, and returns p advanced by the text extent. p is the left
+// edge of the first character on the baseline. Each byte of s is one
+// character in the font's character set.
+func (f *Font) DrawString(dst draw.Image, src image.Image, p image.Point, s string) image.Point {
+	for i := 0; i < len(s); i++ {
+		mask := f.Mask(s[i])
+		r := mask.Bounds().Add(image.Point{p.X, p.Y - f.Ascent})
+		draw.DrawMask(dst, r, src, image.ZP, mask, image.ZP, draw.Over)
+		p.X += mask.Bounds().Dx()
+	}
+	return p
+}
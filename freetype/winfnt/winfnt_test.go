@@ -0,0 +1,93 @@
+// Copyright 2015 The Freetype-Go Authors. All rights reserved.
+// Use of this source code is governed by your choice of either the
+// FreeType License or the GNU General Public License version 2 (or
+// any later version), both of which can be found in the LICENSE file.
+
+package winfnt
+
+import (
+	"image"
+	"testing"
+)
+
+// testFNT returns a version 2 FNT font with two 3x2 pixel characters, 'A' and
+// 'B', and the face name "Test".
+func testFNT() []byte {
+	b := make([]byte, 118+3*4)
+	put16 := func(i int, x uint16) {
+		b[i], b[i+1] = byte(x), byte(x>>8)
+	}
+	put16(0, 0x200)
+	put16(68, 10)  // Points.
+	put16(70, 96)  // VertRes.
+	put16(72, 96)  // HorizRes.
+	put16(74, 1)   // Ascent.
+	put16(83, 400) // Weight.
+	put16(88, 2)   // PixHeight.
+	b[95], b[96], b[97] = 'A', 'B', 1
+	// The character table.
+	put16(118, 3)
+	put16(120, uint16(len(b)))
+	put16(122, 3)
+	put16(124, uint16(len(b)+2))
+	put16(126, 0)
+	put16(128, uint16(len(b)+4))
+	// 'A' is a dot at (1, 0) and a bar along y = 1. 'B' is the left column.
+	b = append(b, 0x40, 0xe0, 0x80, 0x80)
+	put32 := func(i int, x uint32) {
+		put16(i, uint16(x))
+		put16(i+2, uint16(x>>16))
+	}
+	put32(105, uint32(len(b)))
+	b = append(b, "Test\x00"...)
+	return b
+}
+
+func TestParse(t *testing.T) {
+	f, err := Parse(testFNT())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Face != "Test" || f.Points != 10 || f.Height != 2 || f.Weight != 400 {
+		t.Errorf("got %q %d %d %d, want \"Test\" 10 2 400", f.Face, f.Points, f.Height, f.Weight)
+	}
+	if f.DefaultChar != 'B' {
+		t.Errorf("DefaultChar: got %q, want 'B'", f.DefaultChar)
+	}
+	want := []string{
+		".#.",
+		"###",
+	}
+	m := f.Mask('A')
+	if got := m.Bounds(); got != image.Rect(0, 0, 3, 2) {
+		t.Fatalf("bounds: got %v", got)
+	}
+	for y, row := range want {
+		for x, c := range row {
+			if got := m.AlphaAt(x, y).A != 0; got != (c == '#') {
+				t.Errorf("(%d, %d): got %t, want %t", x, y, got, c == '#')
+			}
+		}
+	}
+	// Characters outside of the font draw as DefaultChar.
+	if got := f.Mask('z').Pix; got[0] != 0xff || got[3] != 0xff || got[1] != 0 {
+		t.Errorf("default char: got %v", got)
+	}
+
+	dst := image.NewAlpha(image.Rect(0, 0, 8, 4))
+	p := f.DrawString(dst, image.Opaque, image.Pt(1, 2), "AB")
+	if p != image.Pt(7, 2) {
+		t.Errorf("DrawString: got %v, want (7, 2)", p)
+	}
+	if dst.AlphaAt(2, 1).A == 0 || dst.AlphaAt(4, 1).A == 0 || dst.AlphaAt(1, 1).A != 0 {
+		t.Errorf("DrawString: wrong pixels %v", dst.Pix)
+	}
+
+	// A face name offset of 0xffffffff is negative as an int on 32 bit
+	// platforms, and past the end of the font on others.
+	fnt := testFNT()
+	fnt[105], fnt[106], fnt[107], fnt[108] = 0xff, 0xff, 0xff, 0xff
+	if _, err := Parse(fnt); err == nil {
+		t.Error("bad face name offset: got no error")
+	}
+}